@@ -27,6 +27,21 @@ provider-update:
 	$(AKASHCTL) tx provider update "$(KEY_OPTS)" "$(CHAIN_OPTS)" "$(PROVIDER_CONFIG_PATH)" -y \
 		--from "$(PROVIDER_KEY_NAME)"
 
+.PHONY: provider-delete
+provider-delete:
+	$(AKASHCTL) tx provider delete "$(KEY_OPTS)" "$(CHAIN_OPTS)" -y \
+		--from "$(PROVIDER_KEY_NAME)"
+
+.PHONY: provider-cert-create
+provider-cert-create:
+	$(AKASHCTL) tx cert create "$(KEY_OPTS)" "$(CHAIN_OPTS)" -y \
+		--from "$(PROVIDER_KEY_NAME)" localhost
+
+.PHONY: cert-create
+cert-create:
+	$(AKASHCTL) tx cert create "$(KEY_OPTS)" "$(CHAIN_OPTS)" -y \
+		--from "$(KEY_NAME)"
+
 .PHONY: deployment-create
 deployment-create:
 	$(AKASHCTL) tx deployment create "$(KEY_OPTS)" "$(CHAIN_OPTS)" "$(SDL_PATH)" -y \
@@ -74,6 +89,16 @@ bid-close:
 		--oseq  "$(OSEQ)"                \
 		--from  "$(PROVIDER_KEY_NAME)"
 
+.PHONY: lease-create
+lease-create:
+	$(AKASHCTL) tx market lease-create "$(KEY_OPTS)" "$(CHAIN_OPTS)" -y \
+		--owner    "$(KEY_ADDRESS)"      \
+		--dseq     "$(DSEQ)"             \
+		--gseq     "$(GSEQ)"             \
+		--oseq     "$(OSEQ)"             \
+		--provider "$(PROVIDER_ADDRESS)" \
+		--from     "$(KEY_NAME)"
+
 .PHONY: query-accounts
 query-accounts: $(patsubst %, query-account-%,$(KEY_NAMES))
 
@@ -62,7 +62,10 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ovrclk/akash/x/audit"
+	"github.com/ovrclk/akash/x/cert"
 	"github.com/ovrclk/akash/x/deployment"
+	"github.com/ovrclk/akash/x/escrow"
 	"github.com/ovrclk/akash/x/market"
 	"github.com/ovrclk/akash/x/provider"
 
@@ -134,9 +137,12 @@ type AkashApp struct {
 		scopedTransfer capabilitykeeper.ScopedKeeper
 
 		// akash keepers
+		escrow     escrow.Keeper
 		deployment deployment.Keeper
 		market     market.Keeper
 		provider   provider.Keeper
+		audit      audit.Keeper
+		cert       cert.Keeper
 	}
 
 	mm *module.Manager
@@ -561,5 +567,7 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 
+	initAkashParamsKeeper(paramsKeeper)
+
 	return paramsKeeper
 }
@@ -4,50 +4,94 @@ package app
 
 import (
 	"github.com/cosmos/cosmos-sdk/types/module"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/ovrclk/akash/x/audit"
+	"github.com/ovrclk/akash/x/cert"
 	"github.com/ovrclk/akash/x/deployment"
+	"github.com/ovrclk/akash/x/escrow"
 	"github.com/ovrclk/akash/x/market"
+	mhooks "github.com/ovrclk/akash/x/market/hooks"
 	"github.com/ovrclk/akash/x/provider"
 )
 
 func akashModuleBasics() []module.AppModuleBasic {
 	return []module.AppModuleBasic{
+		escrow.AppModuleBasic{},
 		deployment.AppModuleBasic{},
 		market.AppModuleBasic{},
 		provider.AppModuleBasic{},
+		audit.AppModuleBasic{},
+		cert.AppModuleBasic{},
 	}
 }
 
 func akashKVStoreKeys() []string {
 	return []string{
+		escrow.StoreKey,
 		deployment.StoreKey,
 		market.StoreKey,
 		provider.StoreKey,
+		audit.StoreKey,
+		cert.StoreKey,
 	}
 }
 
+func initAkashParamsKeeper(params paramskeeper.Keeper) {
+	params.Subspace(deployment.ModuleName)
+	params.Subspace(market.ModuleName)
+}
+
 func (app *AkashApp) setAkashKeepers() {
+	app.keeper.escrow = escrow.NewKeeper(
+		app.appCodec,
+		app.keys[escrow.StoreKey],
+		app.keeper.bank,
+	)
+
 	app.keeper.deployment = deployment.NewKeeper(
 		app.appCodec,
 		app.keys[deployment.StoreKey],
+		app.GetSubspace(deployment.ModuleName),
 	)
 
 	app.keeper.market = market.NewKeeper(
 		app.appCodec,
 		app.keys[market.StoreKey],
+		app.GetSubspace(market.ModuleName),
 	)
 
 	app.keeper.provider = provider.NewKeeper(
 		app.appCodec,
 		app.keys[provider.StoreKey],
 	)
+
+	app.keeper.audit = audit.NewKeeper(
+		app.appCodec,
+		app.keys[audit.StoreKey],
+	)
+
+	app.keeper.cert = cert.NewKeeper(
+		app.appCodec,
+		app.keys[cert.StoreKey],
+	)
+
+	hook := mhooks.New(app.keeper.deployment, app.keeper.market)
+	app.keeper.escrow.AddOnPaymentClosedHook(hook.OnEscrowPaymentClosed)
+	app.keeper.escrow.AddOnPaymentReopenedHook(hook.OnEscrowPaymentReopened)
 }
 
 func (app *AkashApp) akashAppModules() []module.AppModule {
 	return []module.AppModule{
+		escrow.NewAppModule(
+			app.appCodec,
+			app.keeper.escrow,
+		),
+
 		deployment.NewAppModule(
 			app.appCodec,
 			app.keeper.deployment,
 			app.keeper.market,
+			app.keeper.escrow,
 			app.keeper.bank,
 		),
 
@@ -56,23 +100,46 @@ func (app *AkashApp) akashAppModules() []module.AppModule {
 			app.keeper.market,
 			app.keeper.deployment,
 			app.keeper.provider,
+			app.keeper.audit,
+			app.keeper.escrow,
 			app.keeper.bank,
 		),
 
-		provider.NewAppModule(app.appCodec, app.keeper.provider, app.keeper.bank, app.keeper.market),
+		provider.NewAppModule(
+			app.appCodec,
+			app.keeper.provider,
+			app.keeper.bank,
+			app.keeper.market,
+			app.keeper.deployment,
+			app.keeper.escrow,
+		),
+
+		audit.NewAppModule(
+			app.appCodec,
+			app.keeper.audit,
+			app.keeper.provider,
+		),
+
+		cert.NewAppModule(
+			app.appCodec,
+			app.keeper.cert,
+		),
 	}
 }
 
 func (app *AkashApp) akashEndBlockModules() []string {
 	return []string{
-		deployment.ModuleName, market.ModuleName,
+		escrow.ModuleName, deployment.ModuleName, market.ModuleName,
 	}
 }
 
 func (app *AkashApp) akashInitGenesisOrder() []string {
 	return []string{
+		escrow.ModuleName,
 		deployment.ModuleName,
 		provider.ModuleName,
+		audit.ModuleName,
+		cert.ModuleName,
 		market.ModuleName,
 	}
 }
@@ -81,7 +148,7 @@ func (app *AkashApp) akashSimModules() []module.AppModuleSimulation {
 	return []module.AppModuleSimulation{
 		deployment.NewAppModuleSimulation(app.keeper.deployment, app.keeper.acct, app.keeper.bank),
 		market.NewAppModuleSimulation(app.keeper.market, app.keeper.acct, app.keeper.deployment,
-			app.keeper.provider, app.keeper.bank),
+			app.keeper.provider, app.keeper.escrow, app.keeper.bank),
 		provider.NewAppModuleSimulation(app.keeper.provider, app.keeper.acct, app.keeper.bank),
 	}
 }
@@ -4,6 +4,7 @@ package app
 
 import (
 	"github.com/cosmos/cosmos-sdk/types/module"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 )
 
 func akashModuleBasics() []module.AppModuleBasic {
@@ -14,6 +15,9 @@ func akashKVStoreKeys() []string {
 	return []string{}
 }
 
+func initAkashParamsKeeper(_ paramskeeper.Keeper) {
+}
+
 func (app *AkashApp) setAkashKeepers() {
 }
 
@@ -7,6 +7,8 @@ import (
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	escrowtypes "github.com/ovrclk/akash/x/escrow/types"
 )
 
 func MacPerms() map[string][]string {
@@ -18,6 +20,7 @@ func MacPerms() map[string][]string {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		escrowtypes.ModuleName:         nil,
 	}
 }
 
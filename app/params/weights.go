@@ -10,7 +10,8 @@ const (
 	DefaultWeightMsgCloseDeployment  int = 100
 	DefaultWeightMsgCloseGroup       int = 100
 
-	DefaultWeightMsgCreateBid  int = 100
-	DefaultWeightMsgCloseBid   int = 100
-	DefaultWeightMsgCloseOrder int = 10
+	DefaultWeightMsgCreateBid   int = 100
+	DefaultWeightMsgCloseBid    int = 100
+	DefaultWeightMsgCloseOrder  int = 10
+	DefaultWeightMsgCreateLease int = 100
 )
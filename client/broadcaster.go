@@ -0,0 +1,450 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	// ErrBroadcasterStopped is returned for messages broadcast after the client was shut down
+	ErrBroadcasterStopped = errors.New("broadcaster stopped")
+
+	// ErrTxNotIncluded is returned when a transaction is accepted into the mempool but not
+	// included in a block before the inclusion timeout
+	ErrTxNotIncluded = errors.New("transaction not included in a block")
+
+	errSequenceMismatch = errors.New("account sequence mismatch")
+	errMempoolFull      = errors.New("mempool is full")
+)
+
+type broadcasterConfig struct {
+	// maxBatchMessages is the largest number of messages sent in a single transaction
+	maxBatchMessages int
+	// maxAttempts is the number of times a transaction is sent before giving up
+	maxAttempts int
+	// retryBackoff is the delay before the first retry, doubled on each retry up to maxRetryBackoff
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	// inclusionTimeout is how long to wait for a transaction to be included in a block
+	inclusionTimeout time.Duration
+	// inclusionPollPeriod is how often to check whether a transaction was included
+	inclusionPollPeriod time.Duration
+	// gasAdjustment scales the gas used by the simulation of a transaction into its gas limit
+	gasAdjustment float64
+}
+
+func defaultBroadcasterConfig() broadcasterConfig {
+	return broadcasterConfig{
+		maxBatchMessages:    20,
+		maxAttempts:         5,
+		retryBackoff:        500 * time.Millisecond,
+		maxRetryBackoff:     8 * time.Second,
+		inclusionTimeout:    time.Minute,
+		inclusionPollPeriod: time.Second,
+		gasAdjustment:       1.3,
+	}
+}
+
+// broadcastBackend performs the chain operations of the broadcaster
+type broadcastBackend interface {
+	// AccountSequence returns the account number and sequence of the signer
+	AccountSequence() (uint64, uint64, error)
+	// SimulateTx executes a transaction with msgs without committing it and returns the
+	// result, including the gas used
+	SimulateTx(accountNumber, sequence uint64, msgs []sdk.Msg) (*sdk.TxResponse, error)
+	// BroadcastTx signs msgs with the given account number, sequence and gas limit and returns
+	// the result of CheckTx
+	BroadcastTx(accountNumber, sequence, gas uint64, msgs []sdk.Msg) (*sdk.TxResponse, error)
+	// TxResult returns the result of executing the transaction with the given hash. It fails
+	// until the transaction is included in a block.
+	TxResult(ctx context.Context, hash string) (*sdk.TxResponse, error)
+}
+
+// txError is the failure of a transaction with a non zero result code
+type txError struct {
+	code      uint32
+	codespace string
+	log       string
+}
+
+func (e txError) Error() string {
+	return fmt.Sprintf("%v: codespace %v code %v: %v", ErrBroadcastTx, e.codespace, e.code, e.log)
+}
+
+func (e txError) Unwrap() error {
+	return ErrBroadcastTx
+}
+
+type broadcastRequest struct {
+	msgs  []sdk.Msg
+	errch chan error
+}
+
+// broadcaster serializes the transactions signed with one key. It keeps track of the account
+// sequence, batches the messages of concurrent callers into one transaction, retries failed
+// transactions and reports the result of each caller's messages once they are included in
+// a block.
+type broadcaster struct {
+	backend broadcastBackend
+	config  broadcasterConfig
+	log     log.Logger
+
+	reqch  chan broadcastRequest
+	donech chan struct{}
+
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+func newBroadcaster(ctx context.Context, log log.Logger, backend broadcastBackend, config broadcasterConfig) *broadcaster {
+	b := &broadcaster{
+		backend: backend,
+		config:  config,
+		log:     log.With("cmp", "client/broadcaster"),
+		reqch:   make(chan broadcastRequest),
+		donech:  make(chan struct{}),
+	}
+
+	go b.run(ctx)
+
+	return b
+}
+
+// Broadcast sends msgs in a transaction and waits for it to be included in a block
+func (b *broadcaster) Broadcast(msgs ...sdk.Msg) error {
+	req := broadcastRequest{
+		msgs:  msgs,
+		errch: make(chan error, 1),
+	}
+
+	select {
+	case b.reqch <- req:
+	case <-b.donech:
+		return ErrBroadcasterStopped
+	}
+
+	return <-req.errch
+}
+
+func (b *broadcaster) run(ctx context.Context) {
+	defer close(b.donech)
+
+	var pending []broadcastRequest
+
+	for {
+		if len(pending) == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-b.reqch:
+				pending = append(pending, req)
+			}
+		}
+
+		// collect the messages of the callers waiting, without blocking
+	collect:
+		for {
+			select {
+			case req := <-b.reqch:
+				pending = append(pending, req)
+			default:
+				break collect
+			}
+		}
+
+		var batch []broadcastRequest
+		batch, pending = nextBatch(pending, b.config.maxBatchMessages)
+
+		b.process(ctx, batch)
+
+		if ctx.Err() != nil {
+			for _, req := range pending {
+				req.errch <- ErrBroadcasterStopped
+			}
+			return
+		}
+	}
+}
+
+// nextBatch splits the requests whose messages fit in one transaction from the rest.
+// The first request is always part of the batch.
+func nextBatch(pending []broadcastRequest, maxMessages int) ([]broadcastRequest, []broadcastRequest) {
+	count := len(pending[0].msgs)
+	idx := 1
+
+	for ; idx < len(pending); idx++ {
+		if count+len(pending[idx].msgs) > maxMessages {
+			break
+		}
+		count += len(pending[idx].msgs)
+	}
+
+	return pending[:idx], pending[idx:]
+}
+
+func (b *broadcaster) process(ctx context.Context, batch []broadcastRequest) {
+	var msgs []sdk.Msg
+	for _, req := range batch {
+		msgs = append(msgs, req.msgs...)
+	}
+
+	err := b.broadcast(ctx, msgs)
+
+	if len(batch) > 1 && errors.Is(err, ErrBroadcastTx) {
+		// the transaction failed as a whole; send each caller's messages on their own
+		// so that the failure is only reported to the callers it belongs to
+		b.log.Info("batched transaction failed, retrying messages separately", "err", err, "requests", len(batch))
+		for _, req := range batch {
+			req.errch <- b.broadcast(ctx, req.msgs)
+		}
+		return
+	}
+
+	for _, req := range batch {
+		req.errch <- err
+	}
+}
+
+// broadcast sends a transaction with msgs, retrying with backoff while it fails for reasons
+// other than the execution of its messages
+func (b *broadcaster) broadcast(ctx context.Context, msgs []sdk.Msg) error {
+	backoff := b.config.retryBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := b.broadcastOnce(ctx, msgs)
+		if err == nil {
+			return nil
+		}
+
+		if !isRetryable(err) || attempt >= b.config.maxAttempts || ctx.Err() != nil {
+			b.log.Error("broadcasting transaction", "err", err, "attempt", attempt)
+			return err
+		}
+
+		b.log.Info("broadcasting transaction, retrying", "err", err, "attempt", attempt, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > b.config.maxRetryBackoff {
+			backoff = b.config.maxRetryBackoff
+		}
+	}
+}
+
+func isRetryable(err error) bool {
+	switch {
+	case errors.Is(err, errSequenceMismatch):
+		return true
+	case errors.Is(err, ErrBroadcastTx), errors.Is(err, ErrTxNotIncluded):
+		return false
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	}
+	return true
+}
+
+func (b *broadcaster) broadcastOnce(ctx context.Context, msgs []sdk.Msg) error {
+	if !b.synced {
+		accountNumber, sequence, err := b.backend.AccountSequence()
+		if err != nil {
+			return err
+		}
+		b.accountNumber = accountNumber
+		b.sequence = sequence
+		b.synced = true
+	}
+
+	// a fixed gas limit does not fit batches of any size, so every transaction gets the gas
+	// used by its simulation. Messages failing the simulation are not sent.
+	sim, err := b.backend.SimulateTx(b.accountNumber, b.sequence, msgs)
+	if err != nil {
+		return err
+	}
+
+	if sim.Code != 0 {
+		return b.rejected(sim)
+	}
+
+	gas := uint64(math.Ceil(float64(sim.GasUsed) * b.config.gasAdjustment))
+
+	res, err := b.backend.BroadcastTx(b.accountNumber, b.sequence, gas, msgs)
+	if err != nil {
+		// the transaction may or may not have reached the mempool
+		b.synced = false
+		return err
+	}
+
+	if res.Code != 0 {
+		return b.rejected(res)
+	}
+
+	// accepted into the mempool, the sequence is used even if the messages fail
+	b.sequence++
+
+	result, err := b.waitForInclusion(ctx, res.TxHash)
+	if err != nil {
+		b.synced = false
+		return err
+	}
+
+	if result.Code != 0 {
+		return txError{code: result.Code, codespace: result.Codespace, log: result.RawLog}
+	}
+
+	b.log.Debug("transaction included", "hash", res.TxHash, "height", result.Height, "messages", len(msgs))
+
+	return nil
+}
+
+// rejected returns the error of a transaction which failed its simulation or CheckTx
+func (b *broadcaster) rejected(res *sdk.TxResponse) error {
+	if res.Codespace == sdkerrors.RootCodespace {
+		switch res.Code {
+		case sdkerrors.ErrWrongSequence.ABCICode(), sdkerrors.ErrTxInMempoolCache.ABCICode():
+			b.synced = false
+			return fmt.Errorf("%w: %v", errSequenceMismatch, res.RawLog)
+		case sdkerrors.ErrMempoolIsFull.ABCICode():
+			return errMempoolFull
+		}
+	}
+	return txError{code: res.Code, codespace: res.Codespace, log: res.RawLog}
+}
+
+func (b *broadcaster) waitForInclusion(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.config.inclusionTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(b.config.inclusionPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w: %v", ErrTxNotIncluded, hash)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		result, err := b.backend.TxResult(ctx, hash)
+		if err != nil {
+			b.log.Debug("transaction not included yet", "hash", hash, "err", err)
+			continue
+		}
+
+		return result, nil
+	}
+}
+
+const simulatePath = "/cosmos.tx.v1beta1.Service/Simulate"
+
+// chainBackend signs transactions with a keyring key and sends them to a node
+type chainBackend struct {
+	cctx sdkclient.Context
+	txf  tx.Factory
+	info keyring.Info
+}
+
+func (c chainBackend) AccountSequence() (uint64, uint64, error) {
+	return c.txf.AccountRetriever().GetAccountNumberSequence(c.cctx, c.info.GetAddress())
+}
+
+func (c chainBackend) SimulateTx(accountNumber, sequence uint64, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txf := c.txf.WithAccountNumber(accountNumber).WithSequence(sequence)
+
+	txBytes, err := tx.BuildSimTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := c.cctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	// queried directly, as the client context reduces failed queries to their log
+	res, err := node.ABCIQuery(context.Background(), simulatePath, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.Response.IsOK() {
+		return &sdk.TxResponse{
+			Code:      res.Response.Code,
+			Codespace: res.Response.Codespace,
+			RawLog:    res.Response.Log,
+		}, nil
+	}
+
+	var simRes txtypes.SimulateResponse
+	if err := simRes.Unmarshal(res.Response.Value); err != nil {
+		return nil, err
+	}
+
+	return &sdk.TxResponse{GasUsed: int64(simRes.GasInfo.GasUsed)}, nil
+}
+
+func (c chainBackend) BroadcastTx(accountNumber, sequence, gas uint64, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txf := c.txf.WithAccountNumber(accountNumber).WithSequence(sequence).WithGas(gas)
+
+	txn, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Sign(txf, c.info.GetName(), txn); err != nil {
+		return nil, err
+	}
+
+	bytes, err := c.cctx.TxConfig.TxEncoder()(txn.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cctx.BroadcastTxSync(bytes)
+}
+
+func (c chainBackend) TxResult(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	node, err := c.cctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	hbytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// not found until the transaction is included in a block
+	res, err := node.Tx(ctx, hbytes, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.TxResponse{
+		Height:    res.Height,
+		TxHash:    hash,
+		Code:      res.TxResult.Code,
+		Codespace: res.TxResult.Codespace,
+		RawLog:    res.TxResult.Log,
+	}, nil
+}
@@ -0,0 +1,338 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// fakeChain accepts transactions with the current account sequence and executes them
+// immediately. Messages for orders with an OSeq of failOSeq fail. Each message uses
+// gasPerMsg gas.
+type fakeChain struct {
+	sequence  uint64
+	failOSeq  uint32
+	gasPerMsg uint64
+	// pending is the number of TxResult calls that fail before a transaction is found
+	pending int
+	// outOfGasMsgs is the number of messages from which transactions run out of gas
+	// whatever their gas limit, zero for none
+	outOfGasMsgs int
+
+	lock    sync.Mutex
+	txs     [][]sdk.Msg
+	gas     []uint64
+	results map[string]*sdk.TxResponse
+	polls   map[string]int
+}
+
+func newFakeChain(sequence uint64) *fakeChain {
+	return &fakeChain{
+		sequence:  sequence,
+		gasPerMsg: 50000,
+		results:   make(map[string]*sdk.TxResponse),
+		polls:     make(map[string]int),
+	}
+}
+
+func (c *fakeChain) SimulateTx(_, sequence uint64, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if sequence != c.sequence {
+		return &sdk.TxResponse{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.RootCodespace,
+		}, nil
+	}
+
+	for _, msg := range msgs {
+		if msg.(*mtypes.MsgCloseBid).BidID.OSeq == c.failOSeq {
+			return &sdk.TxResponse{Code: 1, Codespace: "market"}, nil
+		}
+	}
+
+	return &sdk.TxResponse{GasUsed: int64(c.gasPerMsg) * int64(len(msgs))}, nil
+}
+
+func (c *fakeChain) AccountSequence() (uint64, uint64, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return 1, c.sequence, nil
+}
+
+func (c *fakeChain) BroadcastTx(_, sequence, gas uint64, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if sequence != c.sequence {
+		return &sdk.TxResponse{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.RootCodespace,
+		}, nil
+	}
+
+	hash := fmt.Sprintf("%X", sequence)
+	c.sequence++
+
+	result := &sdk.TxResponse{TxHash: hash, Height: int64(sequence)}
+	for _, msg := range msgs {
+		if msg.(*mtypes.MsgCloseBid).BidID.OSeq == c.failOSeq {
+			result.Code = 1
+			result.Codespace = "market"
+		}
+	}
+
+	if gas < c.gasPerMsg*uint64(len(msgs)) || (c.outOfGasMsgs > 0 && len(msgs) >= c.outOfGasMsgs) {
+		result.Code = sdkerrors.ErrOutOfGas.ABCICode()
+		result.Codespace = sdkerrors.RootCodespace
+	}
+
+	if result.Code == 0 {
+		c.txs = append(c.txs, msgs)
+		c.gas = append(c.gas, gas)
+	}
+	c.results[hash] = result
+
+	return &sdk.TxResponse{TxHash: hash}, nil
+}
+
+func (c *fakeChain) TxResult(_ context.Context, hash string) (*sdk.TxResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.polls[hash]++
+	if c.polls[hash] <= c.pending {
+		return nil, errors.New("tx not found")
+	}
+
+	result, ok := c.results[hash]
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+	return result, nil
+}
+
+func (c *fakeChain) included() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	count := 0
+	for _, msgs := range c.txs {
+		count += len(msgs)
+	}
+	return count
+}
+
+func testBroadcasterConfig() broadcasterConfig {
+	return broadcasterConfig{
+		maxBatchMessages:    3,
+		maxAttempts:         3,
+		retryBackoff:        time.Millisecond,
+		maxRetryBackoff:     time.Millisecond,
+		inclusionTimeout:    time.Second,
+		inclusionPollPeriod: time.Millisecond,
+		gasAdjustment:       1.5,
+	}
+}
+
+func closeBidMsg(oseq uint32) sdk.Msg {
+	return &mtypes.MsgCloseBid{
+		BidID: mtypes.BidID{OSeq: oseq},
+	}
+}
+
+func TestBroadcasterConcurrentBroadcasts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := newFakeChain(7)
+	chain.failOSeq = 1000
+	chain.pending = 2
+
+	b := newBroadcaster(ctx, testutil.Logger(t), chain, testBroadcasterConfig())
+
+	const count = 20
+
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(oseq uint32) {
+			defer wg.Done()
+			errs <- b.Broadcast(closeBidMsg(oseq))
+		}(uint32(i + 1))
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, count, chain.included())
+	for _, msgs := range chain.txs {
+		require.LessOrEqual(t, len(msgs), 3)
+	}
+}
+
+func TestBroadcasterResyncsSequence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := newFakeChain(3)
+	b := newBroadcaster(ctx, testutil.Logger(t), chain, testBroadcasterConfig())
+
+	require.NoError(t, b.Broadcast(closeBidMsg(1)))
+
+	// another signer used the key
+	chain.lock.Lock()
+	chain.sequence += 2
+	chain.lock.Unlock()
+
+	require.NoError(t, b.Broadcast(closeBidMsg(2)))
+	require.Equal(t, 2, chain.included())
+}
+
+func TestBroadcasterReportsFailuresPerRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := newFakeChain(1)
+	chain.failOSeq = 2
+
+	b := &broadcaster{
+		backend: chain,
+		config:  testBroadcasterConfig(),
+		log:     testutil.Logger(t),
+	}
+
+	batch := []broadcastRequest{
+		{msgs: []sdk.Msg{closeBidMsg(1)}, errch: make(chan error, 1)},
+		{msgs: []sdk.Msg{closeBidMsg(2)}, errch: make(chan error, 1)},
+		{msgs: []sdk.Msg{closeBidMsg(3)}, errch: make(chan error, 1)},
+	}
+
+	b.process(ctx, batch)
+
+	require.NoError(t, <-batch[0].errch)
+	err := <-batch[1].errch
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrBroadcastTx))
+	require.NoError(t, <-batch[2].errch)
+
+	require.Equal(t, 2, chain.included())
+
+	// the failing messages were rejected by their simulation and not sent
+	require.Equal(t, uint64(3), chain.sequence)
+}
+
+func TestBroadcasterBatchGas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := newFakeChain(1)
+	chain.gasPerMsg = 100000
+
+	b := &broadcaster{
+		backend: chain,
+		config:  testBroadcasterConfig(),
+		log:     testutil.Logger(t),
+	}
+
+	batch := []broadcastRequest{
+		{msgs: []sdk.Msg{closeBidMsg(1)}, errch: make(chan error, 1)},
+		{msgs: []sdk.Msg{closeBidMsg(2), closeBidMsg(3)}, errch: make(chan error, 1)},
+	}
+
+	b.process(ctx, batch)
+
+	require.NoError(t, <-batch[0].errch)
+	require.NoError(t, <-batch[1].errch)
+
+	// one transaction with the gas of all its messages
+	require.Len(t, chain.txs, 1)
+	require.Len(t, chain.txs[0], 3)
+	require.Equal(t, []uint64{450000}, chain.gas)
+}
+
+func TestBroadcasterBatchOutOfGas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := newFakeChain(1)
+	chain.outOfGasMsgs = 2
+
+	b := &broadcaster{
+		backend: chain,
+		config:  testBroadcasterConfig(),
+		log:     testutil.Logger(t),
+	}
+
+	batch := []broadcastRequest{
+		{msgs: []sdk.Msg{closeBidMsg(1)}, errch: make(chan error, 1)},
+		{msgs: []sdk.Msg{closeBidMsg(2)}, errch: make(chan error, 1)},
+	}
+
+	b.process(ctx, batch)
+
+	// the batch ran out of gas and the messages were sent separately
+	require.NoError(t, <-batch[0].errch)
+	require.NoError(t, <-batch[1].errch)
+
+	require.Len(t, chain.txs, 2)
+	require.Equal(t, uint64(4), chain.sequence)
+}
+
+func TestBroadcasterInclusionTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := newFakeChain(1)
+	chain.pending = 1000
+
+	config := testBroadcasterConfig()
+	config.inclusionTimeout = 20 * time.Millisecond
+
+	b := newBroadcaster(ctx, testutil.Logger(t), chain, config)
+
+	err := b.Broadcast(closeBidMsg(1))
+	require.True(t, errors.Is(err, ErrTxNotIncluded))
+}
+
+func TestBroadcasterStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := newBroadcaster(ctx, testutil.Logger(t), newFakeChain(1), testBroadcasterConfig())
+	cancel()
+	<-b.donech
+
+	require.Equal(t, ErrBroadcasterStopped, b.Broadcast(closeBidMsg(1)))
+}
+
+func TestNextBatch(t *testing.T) {
+	reqs := []broadcastRequest{
+		{msgs: []sdk.Msg{closeBidMsg(1), closeBidMsg(2)}},
+		{msgs: []sdk.Msg{closeBidMsg(3)}},
+		{msgs: []sdk.Msg{closeBidMsg(4)}},
+	}
+
+	batch, rest := nextBatch(reqs, 3)
+	require.Len(t, batch, 2)
+	require.Len(t, rest, 1)
+
+	// a request larger than the limit is sent on its own
+	batch, rest = nextBatch(reqs, 1)
+	require.Len(t, batch, 1)
+	require.Len(t, rest, 2)
+}
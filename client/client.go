@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	atypes "github.com/ovrclk/akash/x/audit/types"
+	ctypes "github.com/ovrclk/akash/x/cert/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
@@ -20,17 +22,18 @@ var (
 	// ErrClientNotFound is a new error with message "Client not found"
 	ErrClientNotFound = errors.New("Client not found")
 
-	// ErrBroadcastTx is used when a broadcast fails due to tendermint errors
+	// ErrBroadcastTx is used when a transaction fails in CheckTx or in the block it is included in
 	ErrBroadcastTx = errors.New("broadcast tx error")
 )
 
-// QueryClient interface includes query clients of deployment, market and provider modules
+// QueryClient interface includes query clients of deployment, market, provider, audit and cert modules
 type QueryClient interface {
 	dtypes.QueryClient
 	mtypes.QueryClient
 	ptypes.QueryClient
+	atypes.QueryClient
+	ctypes.QueryClient
 
-	// TODO: implement with search parameters
 	ActiveLeasesForProvider(id sdk.AccAddress) (mtypes.Leases, error)
 }
 
@@ -46,7 +49,9 @@ type Client interface {
 }
 
 // NewClient creates new client instance to interface with terndermint.
+// Transactions are broadcast one at a time until ctx is done.
 func NewClient(
+	ctx context.Context,
 	log log.Logger,
 	cctx sdkclient.Context,
 	txf tx.Factory,
@@ -54,61 +59,46 @@ func NewClient(
 	passphrase string,
 	qclient QueryClient,
 ) Client {
+	log = log.With("cmp", "client/client")
+
+	bconfig := defaultBroadcasterConfig()
+	if adjustment := txf.GasAdjustment(); adjustment > bconfig.gasAdjustment {
+		bconfig.gasAdjustment = adjustment
+	}
+
 	return &client{
 		cctx:       cctx,
 		txf:        txf,
 		info:       info,
 		passphrase: passphrase,
 		qclient:    qclient,
-		log:        log.With("cmp", "client/client"),
+		log:        log,
+		broadcaster: newBroadcaster(ctx, log, chainBackend{
+			cctx: cctx,
+			txf:  txf,
+			info: info,
+		}, bconfig),
 	}
 }
 
 type client struct {
-	cctx       sdkclient.Context
-	txf        tx.Factory
-	info       keyring.Info
-	passphrase string
-	qclient    QueryClient
-	log        log.Logger
+	cctx        sdkclient.Context
+	txf         tx.Factory
+	info        keyring.Info
+	passphrase  string
+	qclient     QueryClient
+	log         log.Logger
+	broadcaster *broadcaster
 }
 
 func (c *client) Tx() TxClient {
 	return c
 }
 
+// Broadcast sends msgs in a transaction and waits for it to be included in a block.
+// It is safe to call concurrently; messages of concurrent calls may share a transaction.
 func (c *client) Broadcast(msgs ...sdk.Msg) error {
-	txf, err := tx.PrepareFactory(c.cctx, c.txf)
-	if err != nil {
-		return err
-	}
-
-	txn, err := tx.BuildUnsignedTx(txf, msgs...)
-	if err != nil {
-		return err
-	}
-
-	err = tx.Sign(txf, c.info.GetName(), txn)
-	if err != nil {
-		return err
-	}
-
-	bytes, err := c.cctx.TxConfig.TxEncoder()(txn.GetTx())
-	if err != nil {
-		return err
-	}
-
-	response, err := c.cctx.BroadcastTxSync(bytes)
-	if err != nil {
-		return err
-	}
-
-	if response.Code != 0 {
-		c.log.Error("error broadcasting transaction", "response", response)
-		return ErrBroadcastTx
-	}
-
-	return nil
+	return c.broadcaster.Broadcast(msgs...)
 }
 
 func (c *client) Query() QueryClient {
@@ -119,6 +109,8 @@ type qclient struct {
 	dclient dtypes.QueryClient
 	mclient mtypes.QueryClient
 	pclient ptypes.QueryClient
+	aclient atypes.QueryClient
+	cclient ctypes.QueryClient
 }
 
 // NewQueryClient creates new query client instance
@@ -126,11 +118,15 @@ func NewQueryClient(
 	dclient dtypes.QueryClient,
 	mclient mtypes.QueryClient,
 	pclient ptypes.QueryClient,
+	aclient atypes.QueryClient,
+	cclient ctypes.QueryClient,
 ) QueryClient {
 	return &qclient{
 		dclient: dclient,
 		mclient: mclient,
 		pclient: pclient,
+		aclient: aclient,
+		cclient: cclient,
 	}
 }
 
@@ -155,6 +151,13 @@ func (c *qclient) Group(ctx context.Context, in *dtypes.QueryGroupRequest, opts
 	return c.dclient.Group(ctx, in, opts...)
 }
 
+func (c *qclient) DeploymentParams(ctx context.Context, in *dtypes.QueryParamsRequest, opts ...grpc.CallOption) (*dtypes.QueryParamsResponse, error) {
+	if c.dclient == nil {
+		return &dtypes.QueryParamsResponse{}, ErrClientNotFound
+	}
+	return c.dclient.DeploymentParams(ctx, in, opts...)
+}
+
 func (c *qclient) Orders(ctx context.Context, in *mtypes.QueryOrdersRequest, opts ...grpc.CallOption) (*mtypes.QueryOrdersResponse, error) {
 	if c.mclient == nil {
 		return &mtypes.QueryOrdersResponse{}, ErrClientNotFound
@@ -197,6 +200,27 @@ func (c *qclient) Lease(ctx context.Context, in *mtypes.QueryLeaseRequest, opts
 	return c.mclient.Lease(ctx, in, opts...)
 }
 
+func (c *qclient) BidsByProvider(ctx context.Context, in *mtypes.QueryBidsByProviderRequest, opts ...grpc.CallOption) (*mtypes.QueryBidsByProviderResponse, error) {
+	if c.mclient == nil {
+		return &mtypes.QueryBidsByProviderResponse{}, ErrClientNotFound
+	}
+	return c.mclient.BidsByProvider(ctx, in, opts...)
+}
+
+func (c *qclient) LeasesByProvider(ctx context.Context, in *mtypes.QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*mtypes.QueryLeasesByProviderResponse, error) {
+	if c.mclient == nil {
+		return &mtypes.QueryLeasesByProviderResponse{}, ErrClientNotFound
+	}
+	return c.mclient.LeasesByProvider(ctx, in, opts...)
+}
+
+func (c *qclient) MarketParams(ctx context.Context, in *mtypes.QueryParamsRequest, opts ...grpc.CallOption) (*mtypes.QueryParamsResponse, error) {
+	if c.mclient == nil {
+		return &mtypes.QueryParamsResponse{}, ErrClientNotFound
+	}
+	return c.mclient.MarketParams(ctx, in, opts...)
+}
+
 func (c *qclient) Providers(ctx context.Context, in *ptypes.QueryProvidersRequest, opts ...grpc.CallOption) (*ptypes.QueryProvidersResponse, error) {
 	if c.pclient == nil {
 		return &ptypes.QueryProvidersResponse{}, ErrClientNotFound
@@ -210,3 +234,31 @@ func (c *qclient) Provider(ctx context.Context, in *ptypes.QueryProviderRequest,
 	}
 	return c.pclient.Provider(ctx, in, opts...)
 }
+
+func (c *qclient) AllProvidersAttributes(ctx context.Context, in *atypes.QueryAllProvidersAttributesRequest, opts ...grpc.CallOption) (*atypes.QueryProvidersResponse, error) {
+	if c.aclient == nil {
+		return &atypes.QueryProvidersResponse{}, ErrClientNotFound
+	}
+	return c.aclient.AllProvidersAttributes(ctx, in, opts...)
+}
+
+func (c *qclient) ProviderAttributes(ctx context.Context, in *atypes.QueryProviderAttributesRequest, opts ...grpc.CallOption) (*atypes.QueryProvidersResponse, error) {
+	if c.aclient == nil {
+		return &atypes.QueryProvidersResponse{}, ErrClientNotFound
+	}
+	return c.aclient.ProviderAttributes(ctx, in, opts...)
+}
+
+func (c *qclient) ProviderAuditorAttributes(ctx context.Context, in *atypes.QueryProviderAuditorRequest, opts ...grpc.CallOption) (*atypes.QueryProvidersResponse, error) {
+	if c.aclient == nil {
+		return &atypes.QueryProvidersResponse{}, ErrClientNotFound
+	}
+	return c.aclient.ProviderAuditorAttributes(ctx, in, opts...)
+}
+
+func (c *qclient) Certificates(ctx context.Context, in *ctypes.QueryCertificatesRequest, opts ...grpc.CallOption) (*ctypes.QueryCertificatesResponse, error) {
+	if c.cclient == nil {
+		return &ctypes.QueryCertificatesResponse{}, ErrClientNotFound
+	}
+	return c.cclient.Certificates(ctx, in, opts...)
+}
@@ -8,23 +8,26 @@ import (
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
-// TODO: implement with search parameters
-
 func (c *qclient) ActiveLeasesForProvider(id sdk.AccAddress) (mtypes.Leases, error) {
-	params := &mtypes.QueryLeasesRequest{
-		Filters: mtypes.LeaseFilters{
-			Provider: id.String(),
-			State:    mtypes.LeaseActive.String(),
-		},
-		Pagination: &sdkquery.PageRequest{
-			Limit: 10000,
-		},
-	}
+	var leases mtypes.Leases
 
-	res, err := c.Leases(context.Background(), params)
-	if err != nil {
-		return nil, err
+	params := &mtypes.QueryLeasesByProviderRequest{
+		Provider:   id.String(),
+		State:      mtypes.LeaseActive.String(),
+		Pagination: &sdkquery.PageRequest{},
 	}
 
-	return res.Leases, nil
+	for {
+		res, err := c.LeasesByProvider(context.Background(), params)
+		if err != nil {
+			return nil, err
+		}
+
+		leases = append(leases, res.Leases...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return leases, nil
+		}
+		params.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+	}
 }
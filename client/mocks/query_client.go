@@ -3,6 +3,10 @@
 package mocks
 
 import (
+	audittypes "github.com/ovrclk/akash/x/audit/types"
+
+	certtypes "github.com/ovrclk/akash/x/cert/types"
+
 	context "context"
 
 	deploymenttypes "github.com/ovrclk/akash/x/deployment/types"
@@ -45,6 +49,36 @@ func (_m *QueryClient) ActiveLeasesForProvider(id types.AccAddress) (markettypes
 	return r0, r1
 }
 
+// AllProvidersAttributes provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllProvidersAttributes(ctx context.Context, in *audittypes.QueryAllProvidersAttributesRequest, opts ...grpc.CallOption) (*audittypes.QueryProvidersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *audittypes.QueryProvidersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *audittypes.QueryAllProvidersAttributesRequest, ...grpc.CallOption) *audittypes.QueryProvidersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*audittypes.QueryProvidersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *audittypes.QueryAllProvidersAttributesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Bid provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Bid(ctx context.Context, in *markettypes.QueryBidRequest, opts ...grpc.CallOption) (*markettypes.QueryBidResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -105,6 +139,66 @@ func (_m *QueryClient) Bids(ctx context.Context, in *markettypes.QueryBidsReques
 	return r0, r1
 }
 
+// BidsByProvider provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) BidsByProvider(ctx context.Context, in *markettypes.QueryBidsByProviderRequest, opts ...grpc.CallOption) (*markettypes.QueryBidsByProviderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *markettypes.QueryBidsByProviderResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *markettypes.QueryBidsByProviderRequest, ...grpc.CallOption) *markettypes.QueryBidsByProviderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*markettypes.QueryBidsByProviderResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *markettypes.QueryBidsByProviderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Certificates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Certificates(ctx context.Context, in *certtypes.QueryCertificatesRequest, opts ...grpc.CallOption) (*certtypes.QueryCertificatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *certtypes.QueryCertificatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *certtypes.QueryCertificatesRequest, ...grpc.CallOption) *certtypes.QueryCertificatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*certtypes.QueryCertificatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *certtypes.QueryCertificatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Deployment provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Deployment(ctx context.Context, in *deploymenttypes.QueryDeploymentRequest, opts ...grpc.CallOption) (*deploymenttypes.QueryDeploymentResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -135,6 +229,36 @@ func (_m *QueryClient) Deployment(ctx context.Context, in *deploymenttypes.Query
 	return r0, r1
 }
 
+// DeploymentParams provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) DeploymentParams(ctx context.Context, in *deploymenttypes.QueryParamsRequest, opts ...grpc.CallOption) (*deploymenttypes.QueryParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *deploymenttypes.QueryParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *deploymenttypes.QueryParamsRequest, ...grpc.CallOption) *deploymenttypes.QueryParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deploymenttypes.QueryParamsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *deploymenttypes.QueryParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Deployments provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Deployments(ctx context.Context, in *deploymenttypes.QueryDeploymentsRequest, opts ...grpc.CallOption) (*deploymenttypes.QueryDeploymentsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -255,6 +379,66 @@ func (_m *QueryClient) Leases(ctx context.Context, in *markettypes.QueryLeasesRe
 	return r0, r1
 }
 
+// LeasesByProvider provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LeasesByProvider(ctx context.Context, in *markettypes.QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*markettypes.QueryLeasesByProviderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *markettypes.QueryLeasesByProviderResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *markettypes.QueryLeasesByProviderRequest, ...grpc.CallOption) *markettypes.QueryLeasesByProviderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*markettypes.QueryLeasesByProviderResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *markettypes.QueryLeasesByProviderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketParams provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketParams(ctx context.Context, in *markettypes.QueryParamsRequest, opts ...grpc.CallOption) (*markettypes.QueryParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *markettypes.QueryParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *markettypes.QueryParamsRequest, ...grpc.CallOption) *markettypes.QueryParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*markettypes.QueryParamsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *markettypes.QueryParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Order provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Order(ctx context.Context, in *markettypes.QueryOrderRequest, opts ...grpc.CallOption) (*markettypes.QueryOrderResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -345,6 +529,66 @@ func (_m *QueryClient) Provider(ctx context.Context, in *providertypes.QueryProv
 	return r0, r1
 }
 
+// ProviderAttributes provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ProviderAttributes(ctx context.Context, in *audittypes.QueryProviderAttributesRequest, opts ...grpc.CallOption) (*audittypes.QueryProvidersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *audittypes.QueryProvidersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *audittypes.QueryProviderAttributesRequest, ...grpc.CallOption) *audittypes.QueryProvidersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*audittypes.QueryProvidersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *audittypes.QueryProviderAttributesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProviderAuditorAttributes provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ProviderAuditorAttributes(ctx context.Context, in *audittypes.QueryProviderAuditorRequest, opts ...grpc.CallOption) (*audittypes.QueryProvidersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *audittypes.QueryProvidersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *audittypes.QueryProviderAuditorRequest, ...grpc.CallOption) *audittypes.QueryProvidersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*audittypes.QueryProvidersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *audittypes.QueryProviderAuditorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Providers provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Providers(ctx context.Context, in *providertypes.QueryProvidersRequest, opts ...grpc.CallOption) (*providertypes.QueryProvidersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -7,10 +7,10 @@ import (
 	"syscall"
 )
 
-func watchSignals(ctx context.Context, cancel context.CancelFunc) <-chan struct{} {
+func watchSignals(ctx context.Context, cancel context.CancelFunc, sigs ...os.Signal) <-chan struct{} {
 	donech := make(chan struct{})
 	sigch := make(chan os.Signal, 1)
-	signal.Notify(sigch, syscall.SIGINT, syscall.SIGHUP, syscall.SIGTERM)
+	signal.Notify(sigch, sigs...)
 	go func() {
 		defer close(donech)
 		defer signal.Stop(sigch)
@@ -25,13 +25,19 @@ func watchSignals(ctx context.Context, cancel context.CancelFunc) <-chan struct{
 
 // RunForever runs a function in the background, forever. Returns error in case of failure.
 func RunForever(fn func(ctx context.Context) error) error {
-	return runForeverWithContext(context.Background(), fn)
+	return runForeverWithContext(context.Background(), fn, syscall.SIGINT, syscall.SIGHUP, syscall.SIGTERM)
 }
 
-func runForeverWithContext(ctx context.Context, fn func(ctx context.Context) error) error {
+// RunForeverWithSignals runs a function in the background until one of the given signals is
+// received, leaving any other signal to be handled by the function.
+func RunForeverWithSignals(fn func(ctx context.Context) error, sigs ...os.Signal) error {
+	return runForeverWithContext(context.Background(), fn, sigs...)
+}
+
+func runForeverWithContext(ctx context.Context, fn func(ctx context.Context) error, sigs ...os.Signal) error {
 	ctx, cancel := context.WithCancel(ctx)
 
-	donech := watchSignals(ctx, cancel)
+	donech := watchSignals(ctx, cancel, sigs...)
 
 	err := fn(ctx)
 
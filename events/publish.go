@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/sdkutil"
+	atypes "github.com/ovrclk/akash/x/audit/types"
+	certtypes "github.com/ovrclk/akash/x/cert/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
@@ -111,5 +113,13 @@ func processEvent(bev abci.Event) (interface{}, bool) {
 		return mev, true
 	}
 
+	if mev, err := atypes.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
+	if mev, err := certtypes.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
 	return nil, false
 }
@@ -24,6 +24,7 @@ import (
 
 	ptestutil "github.com/ovrclk/akash/provider/testutil"
 	"github.com/ovrclk/akash/testutil"
+	certcli "github.com/ovrclk/akash/x/cert/client/cli"
 	deploycli "github.com/ovrclk/akash/x/deployment/client/cli"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
@@ -151,7 +152,7 @@ func (s *IntegrationTestSuite) TestE2EApp() {
 	provHost := fmt.Sprintf("localhost:%s", port)
 	provURL := url.URL{
 		Host:   provHost,
-		Scheme: "http",
+		Scheme: "https",
 	}
 	provFileStr := fmt.Sprintf(providerTemplate, provURL.String())
 	tmpFile, err := ioutil.TempFile(s.network.BaseDir, "provider.yaml")
@@ -210,6 +211,29 @@ func (s *IntegrationTestSuite) TestE2EApp() {
 	// Change the akash home directory for CLI to access the test keyring
 	cliHome := strings.Replace(val.ClientCtx.HomeDir, "simd", "simcli", 1)
 
+	// Publish the certificates the gateway and the tenant authenticate with
+	_, err = certcli.TxCreateCertificateExec(
+		val.ClientCtx.WithHomeDir(cliHome),
+		keyProvider.GetAddress(),
+		"localhost",
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+
+	_, err = certcli.TxCreateCertificateExec(
+		val.ClientCtx,
+		keyTenant.GetAddress(),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
 	cctx := val.ClientCtx
 	go func() {
 		_, err := ptestutil.RunLocalProvider(cctx,
@@ -235,6 +259,7 @@ func (s *IntegrationTestSuite) TestE2EApp() {
 		val.ClientCtx,
 		keyTenant.GetAddress(),
 		deploymentPath,
+		fmt.Sprintf("--deposit=%s", sdk.NewInt64Coin(s.cfg.BondDenom, 500)),
 		fmt.Sprintf("--%s", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(20))).String()),
@@ -290,10 +315,32 @@ func (s *IntegrationTestSuite) TestE2EApp() {
 	orders := result.Orders
 	s.Require().Equal(tenantAddr, orders[0].OrderID.Owner)
 
-	// Wait for then EndBlock to handle bidding and creating lease
+	// Wait for the provider to bid on the order
 	s.Require().NoError(s.waitForBlocksCommitted(6))
 
-	// Assert provider made bid and created lease; test query leases ---------
+	// Assert provider made bid and accept it; test query bids ---------------
+	resp, err = mcli.QueryBidsExec(val.ClientCtx.WithOutputFormat("json"))
+	s.Require().NoError(err)
+
+	bidRes := &mtypes.QueryBidsResponse{}
+	err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), bidRes)
+	s.Require().NoError(err)
+	s.Require().Len(bidRes.Bids, 1)
+	s.Require().Equal(keyProvider.GetAddress().String(), bidRes.Bids[0].BidID.Provider)
+
+	_, err = mcli.TxCreateLeaseExec(
+		val.ClientCtx,
+		bidRes.Bids[0].BidID,
+		keyTenant.GetAddress(),
+		fmt.Sprintf("--%s", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(20))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// Assert lease created; test query leases -------------------------------
 	resp, err = mcli.QueryLeasesExec(val.ClientCtx.WithOutputFormat("json"))
 	s.Require().NoError(err)
 
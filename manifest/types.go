@@ -56,6 +56,68 @@ type Service struct {
 	Resources types.ResourceUnits
 	Count     uint32
 	Expose    []ServiceExpose
+	Params    *ServiceParams `json:",omitempty"`
+	Health    *ServiceHealth `json:",omitempty"`
+}
+
+// ServiceParams stores the settings of a service which are not part of its resources
+type ServiceParams struct {
+	Storage []StorageParams
+}
+
+// StorageParams stores the name and mount path of the persistent volume of a service
+type StorageParams struct {
+	Name     string
+	Mount    string
+	ReadOnly bool
+}
+
+// ServiceHealth stores the checks run against every instance of a service. Instances failing
+// their liveness check are restarted; those failing their readiness check get no traffic and
+// do not count towards the health of the lease.
+type ServiceHealth struct {
+	Liveness  *HealthCheck `json:",omitempty"`
+	Readiness *HealthCheck `json:",omitempty"`
+}
+
+// HealthCheck stores exactly one of an HTTP request, a TCP connection or a command along with
+// its schedule. Zero values take the defaults of the provider.
+type HealthCheck struct {
+	HTTP *HTTPHealthCheck `json:",omitempty"`
+	TCP  *TCPHealthCheck  `json:",omitempty"`
+	Exec *ExecHealthCheck `json:",omitempty"`
+	// InitialDelay, Interval and Timeout are in seconds
+	InitialDelay     uint32
+	Interval         uint32
+	Timeout          uint32
+	SuccessThreshold uint32
+	FailureThreshold uint32
+}
+
+// HTTPHealthCheck succeeds when a GET request of the path on the container port gets a
+// 2xx or 3xx response
+type HTTPHealthCheck struct {
+	Path string
+	Port uint16
+}
+
+// TCPHealthCheck succeeds when a connection to the container port is accepted
+type TCPHealthCheck struct {
+	Port uint16
+}
+
+// ExecHealthCheck succeeds when the command run in the container exits with zero
+type ExecHealthCheck struct {
+	Command []string
+}
+
+// PersistentVolume returns the mount of the persistent volume of the service, or nil if the
+// service has no persistent storage
+func (s Service) PersistentVolume() *StorageParams {
+	if !s.Resources.Storage.IsPersistent() || s.Params == nil || len(s.Params.Storage) == 0 {
+		return nil
+	}
+	return &s.Params.Storage[0]
 }
 
 // GetResourcesUnit returns resources unit of service
@@ -77,3 +139,24 @@ type ServiceExpose struct {
 	Global       bool
 	Hosts        []string
 }
+
+// GetExternalPort returns the port the service is exposed on, which defaults to the container port
+func (s ServiceExpose) GetExternalPort() uint16 {
+	if s.ExternalPort == 0 {
+		return s.Port
+	}
+	return s.ExternalPort
+}
+
+// IsIngress returns true when the service is exposed globally through the provider's shared HTTP ingress
+func (s ServiceExpose) IsIngress() bool {
+	return s.Proto == TCP && s.Global && s.GetExternalPort() == 80
+}
+
+// Endpoint returns the endpoint a global expose requires from the provider
+func (s ServiceExpose) Endpoint() types.Endpoint {
+	if s.IsIngress() {
+		return types.Endpoint{Kind: types.EndpointSharedHTTP}
+	}
+	return types.Endpoint{Kind: types.EndpointRandomPort}
+}
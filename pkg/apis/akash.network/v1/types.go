@@ -142,14 +142,17 @@ type ManifestGroup struct {
 	// Placement profile name
 	Name string `json:"name,omitempty"`
 	// Service definitions
-	Services []ManifestService `json:"services,omitempty"`
+	Services []ManifestService `json:"services"`
 }
 
 // ToAkash returns akash group details formatted from manifest group
 func (m ManifestGroup) toAkash() (manifest.Group, error) {
 	am := manifest.Group{
-		Name:     m.Name,
-		Services: make([]manifest.Service, 0, len(m.Services)),
+		Name: m.Name,
+	}
+
+	if m.Services != nil {
+		am.Services = make([]manifest.Service, 0, len(m.Services))
 	}
 
 	for _, svc := range m.Services {
@@ -166,8 +169,11 @@ func (m ManifestGroup) toAkash() (manifest.Group, error) {
 // ManifestGroupFromAkash returns manifest group instance from akash group
 func manifestGroupFromAkash(m *manifest.Group) (ManifestGroup, error) {
 	ma := ManifestGroup{
-		Name:     m.Name,
-		Services: make([]ManifestService, 0, len(m.Services)),
+		Name: m.Name,
+	}
+
+	if m.Services != nil {
+		ma.Services = make([]ManifestService, 0, len(m.Services))
 	}
 
 	for _, svc := range m.Services {
@@ -187,16 +193,21 @@ type ManifestService struct {
 	// Service name
 	Name string `json:"name,omitempty"`
 	// Docker image
-	Image string   `json:"image,omitempty"`
-	Args  []string `json:"args,omitempty"`
-	Env   []string `json:"env,omitempty"`
+	Image   string   `json:"image,omitempty"`
+	Command []string `json:"command"`
+	Args    []string `json:"args"`
+	Env     []string `json:"env"`
 	// Resource requirements
 	// in current version of CRD it is named as unit
 	Resources ResourceUnits `json:"unit"`
 	// Number of instances
 	Count uint32 `json:"count,omitempty"`
 	// Overlay Network Links
-	Expose []ManifestServiceExpose `json:"expose,omitempty"`
+	Expose []ManifestServiceExpose `json:"expose"`
+	// Settings which are not resources
+	Params *ManifestServiceParams `json:"params,omitempty"`
+	// Liveness and readiness checks
+	Health *ManifestServiceHealth `json:"health,omitempty"`
 }
 
 func (ms ManifestService) toAkash() (manifest.Service, error) {
@@ -208,11 +219,15 @@ func (ms ManifestService) toAkash() (manifest.Service, error) {
 	ams := &manifest.Service{
 		Name:      ms.Name,
 		Image:     ms.Image,
+		Command:   ms.Command,
 		Args:      ms.Args,
 		Env:       ms.Env,
 		Resources: res,
 		Count:     ms.Count,
-		Expose:    make([]manifest.ServiceExpose, 0, len(ms.Expose)),
+	}
+
+	if ms.Expose != nil {
+		ams.Expose = make([]manifest.ServiceExpose, 0, len(ms.Expose))
 	}
 
 	for _, expose := range ms.Expose {
@@ -223,6 +238,14 @@ func (ms ManifestService) toAkash() (manifest.Service, error) {
 		ams.Expose = append(ams.Expose, value)
 	}
 
+	if ms.Params != nil {
+		ams.Params = ms.Params.toAkash()
+	}
+
+	if ms.Health != nil {
+		ams.Health = ms.Health.toAkash()
+	}
+
 	return *ams, nil
 }
 
@@ -235,20 +258,180 @@ func manifestServiceFromAkash(ams manifest.Service) (ManifestService, error) {
 	ms := ManifestService{
 		Name:      ams.Name,
 		Image:     ams.Image,
+		Command:   ams.Command,
 		Args:      ams.Args,
 		Env:       ams.Env,
 		Resources: resources,
 		Count:     ams.Count,
-		Expose:    make([]ManifestServiceExpose, 0, len(ams.Expose)),
+	}
+
+	if ams.Expose != nil {
+		ms.Expose = make([]ManifestServiceExpose, 0, len(ams.Expose))
 	}
 
 	for _, expose := range ams.Expose {
 		ms.Expose = append(ms.Expose, manifestServiceExposeFromAkash(expose))
 	}
 
+	if ams.Params != nil {
+		ms.Params = manifestServiceParamsFromAkash(ams.Params)
+	}
+
+	if ams.Health != nil {
+		ms.Health = manifestServiceHealthFromAkash(ams.Health)
+	}
+
 	return ms, nil
 }
 
+// ManifestServiceParams stores the storage mounts of a service
+type ManifestServiceParams struct {
+	Storage []ManifestStorageParams `json:"storage"`
+}
+
+// ManifestStorageParams stores the name and mount path of a persistent volume
+type ManifestStorageParams struct {
+	Name     string `json:"name"`
+	Mount    string `json:"mount"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+}
+
+func (msp ManifestServiceParams) toAkash() *manifest.ServiceParams {
+	params := &manifest.ServiceParams{}
+
+	if msp.Storage != nil {
+		params.Storage = make([]manifest.StorageParams, 0, len(msp.Storage))
+	}
+
+	for _, storage := range msp.Storage {
+		params.Storage = append(params.Storage, manifest.StorageParams{
+			Name:     storage.Name,
+			Mount:    storage.Mount,
+			ReadOnly: storage.ReadOnly,
+		})
+	}
+
+	return params
+}
+
+func manifestServiceParamsFromAkash(params *manifest.ServiceParams) *ManifestServiceParams {
+	msp := &ManifestServiceParams{}
+
+	if params.Storage != nil {
+		msp.Storage = make([]ManifestStorageParams, 0, len(params.Storage))
+	}
+
+	for _, storage := range params.Storage {
+		msp.Storage = append(msp.Storage, ManifestStorageParams{
+			Name:     storage.Name,
+			Mount:    storage.Mount,
+			ReadOnly: storage.ReadOnly,
+		})
+	}
+
+	return msp
+}
+
+// ManifestServiceHealth stores the liveness and readiness checks of a service
+type ManifestServiceHealth struct {
+	Liveness  *ManifestHealthCheck `json:"liveness,omitempty"`
+	Readiness *ManifestHealthCheck `json:"readiness,omitempty"`
+}
+
+// ManifestHealthCheck stores the action and schedule of a health check. Durations are in seconds.
+type ManifestHealthCheck struct {
+	HTTP             *ManifestHTTPHealthCheck `json:"http,omitempty"`
+	TCP              *ManifestTCPHealthCheck  `json:"tcp,omitempty"`
+	Exec             *ManifestExecHealthCheck `json:"exec,omitempty"`
+	InitialDelay     uint32                   `json:"initial-delay,omitempty"`
+	Interval         uint32                   `json:"interval,omitempty"`
+	Timeout          uint32                   `json:"timeout,omitempty"`
+	SuccessThreshold uint32                   `json:"success-threshold,omitempty"`
+	FailureThreshold uint32                   `json:"failure-threshold,omitempty"`
+}
+
+// ManifestHTTPHealthCheck stores the path and port of an HTTP health check
+type ManifestHTTPHealthCheck struct {
+	Path string `json:"path"`
+	Port uint16 `json:"port"`
+}
+
+// ManifestTCPHealthCheck stores the port of a TCP health check
+type ManifestTCPHealthCheck struct {
+	Port uint16 `json:"port"`
+}
+
+// ManifestExecHealthCheck stores the command of an exec health check
+type ManifestExecHealthCheck struct {
+	Command []string `json:"command"`
+}
+
+func (msh ManifestServiceHealth) toAkash() *manifest.ServiceHealth {
+	return &manifest.ServiceHealth{
+		Liveness:  msh.Liveness.toAkash(),
+		Readiness: msh.Readiness.toAkash(),
+	}
+}
+
+func (mhc *ManifestHealthCheck) toAkash() *manifest.HealthCheck {
+	if mhc == nil {
+		return nil
+	}
+
+	check := &manifest.HealthCheck{
+		InitialDelay:     mhc.InitialDelay,
+		Interval:         mhc.Interval,
+		Timeout:          mhc.Timeout,
+		SuccessThreshold: mhc.SuccessThreshold,
+		FailureThreshold: mhc.FailureThreshold,
+	}
+
+	if mhc.HTTP != nil {
+		check.HTTP = &manifest.HTTPHealthCheck{Path: mhc.HTTP.Path, Port: mhc.HTTP.Port}
+	}
+	if mhc.TCP != nil {
+		check.TCP = &manifest.TCPHealthCheck{Port: mhc.TCP.Port}
+	}
+	if mhc.Exec != nil {
+		check.Exec = &manifest.ExecHealthCheck{Command: mhc.Exec.Command}
+	}
+
+	return check
+}
+
+func manifestServiceHealthFromAkash(health *manifest.ServiceHealth) *ManifestServiceHealth {
+	return &ManifestServiceHealth{
+		Liveness:  manifestHealthCheckFromAkash(health.Liveness),
+		Readiness: manifestHealthCheckFromAkash(health.Readiness),
+	}
+}
+
+func manifestHealthCheckFromAkash(hc *manifest.HealthCheck) *ManifestHealthCheck {
+	if hc == nil {
+		return nil
+	}
+
+	check := &ManifestHealthCheck{
+		InitialDelay:     hc.InitialDelay,
+		Interval:         hc.Interval,
+		Timeout:          hc.Timeout,
+		SuccessThreshold: hc.SuccessThreshold,
+		FailureThreshold: hc.FailureThreshold,
+	}
+
+	if hc.HTTP != nil {
+		check.HTTP = &ManifestHTTPHealthCheck{Path: hc.HTTP.Path, Port: hc.HTTP.Port}
+	}
+	if hc.TCP != nil {
+		check.TCP = &ManifestTCPHealthCheck{Port: hc.TCP.Port}
+	}
+	if hc.Exec != nil {
+		check.Exec = &ManifestExecHealthCheck{Command: hc.Exec.Command}
+	}
+
+	return check
+}
+
 // ManifestServiceExpose stores exposed ports and accepted hosts details
 type ManifestServiceExpose struct {
 	Port         uint16 `json:"port,omitempty"`
@@ -257,7 +440,7 @@ type ManifestServiceExpose struct {
 	Service      string `json:"service,omitempty"`
 	Global       bool   `json:"global,omitempty"`
 	// accepted hostnames
-	Hosts []string `json:"hosts,omitempty"`
+	Hosts []string `json:"hosts"`
 }
 
 func (mse ManifestServiceExpose) toAkash() (manifest.ServiceExpose, error) {
@@ -289,9 +472,80 @@ func manifestServiceExposeFromAkash(amse manifest.ServiceExpose) ManifestService
 
 // ResourceUnits stores cpu, memory and storage details
 type ResourceUnits struct {
-	CPU     uint32 `json:"cpu,omitempty"`
-	Memory  string `json:"memory,omitempty"`
-	Storage string `json:"storage,omitempty"`
+	CPU               uint32      `json:"cpu,omitempty"`
+	CPUAttributes     []Attribute `json:"cpu-attributes,omitempty"`
+	Memory            string      `json:"memory,omitempty"`
+	MemoryAttributes  []Attribute `json:"memory-attributes,omitempty"`
+	Storage           string      `json:"storage,omitempty"`
+	StorageAttributes []Attribute `json:"storage-attributes,omitempty"`
+	Endpoints         []Endpoint  `json:"endpoints"`
+}
+
+// Endpoint stores the kind of a publicly accessible service
+type Endpoint struct {
+	Kind string `json:"kind"`
+}
+
+func endpointsToAkash(endpoints []Endpoint) ([]types.Endpoint, error) {
+	if endpoints == nil {
+		return nil, nil
+	}
+
+	result := make([]types.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		kind, ok := types.Endpoint_Kind_value[endpoint.Kind]
+		if !ok {
+			return nil, errors.Errorf("k8s api: unknown endpoint kind %q", endpoint.Kind)
+		}
+		result = append(result, types.Endpoint{Kind: types.Endpoint_Kind(kind)})
+	}
+
+	return result, nil
+}
+
+func endpointsFromAkash(endpoints []types.Endpoint) []Endpoint {
+	if endpoints == nil {
+		return nil
+	}
+
+	result := make([]Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, Endpoint{Kind: endpoint.Kind.String()})
+	}
+
+	return result
+}
+
+// Attribute stores a resource attribute
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func attributesToAkash(attrs []Attribute) types.Attributes {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	result := make(types.Attributes, 0, len(attrs))
+	for _, attr := range attrs {
+		result = append(result, types.NewStringAttribute(attr.Key, attr.Value))
+	}
+
+	return result
+}
+
+func attributesFromAkash(attrs types.Attributes) []Attribute {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	result := make([]Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		result = append(result, Attribute{Key: attr.Key, Value: attr.Value})
+	}
+
+	return result
 }
 
 func (ru ResourceUnits) toAkash() (types.ResourceUnits, error) {
@@ -303,17 +557,25 @@ func (ru ResourceUnits) toAkash() (types.ResourceUnits, error) {
 	if err != nil {
 		return types.ResourceUnits{}, err
 	}
+	endpoints, err := endpointsToAkash(ru.Endpoints)
+	if err != nil {
+		return types.ResourceUnits{}, err
+	}
 
 	return types.ResourceUnits{
 		CPU: &types.CPU{
-			Units: types.NewResourceValue(uint64(ru.CPU)),
+			Units:      types.NewResourceValue(uint64(ru.CPU)),
+			Attributes: attributesToAkash(ru.CPUAttributes),
 		},
 		Memory: &types.Memory{
-			Quantity: types.NewResourceValue(memory),
+			Quantity:   types.NewResourceValue(memory),
+			Attributes: attributesToAkash(ru.MemoryAttributes),
 		},
 		Storage: &types.Storage{
-			Quantity: types.NewResourceValue(storage),
+			Quantity:   types.NewResourceValue(storage),
+			Attributes: attributesToAkash(ru.StorageAttributes),
 		},
+		Endpoints: endpoints,
 	}, nil
 }
 
@@ -324,15 +586,20 @@ func resourceUnitsFromAkash(aru types.ResourceUnits) (ResourceUnits, error) {
 			return ResourceUnits{}, errors.Errorf("k8s api: cpu units value overflows uint32")
 		}
 		res.CPU = uint32(aru.CPU.Units.Value())
+		res.CPUAttributes = attributesFromAkash(aru.CPU.Attributes)
 	}
 	if aru.Memory != nil {
 		res.Memory = strconv.FormatUint(aru.Memory.Quantity.Value(), 10)
+		res.MemoryAttributes = attributesFromAkash(aru.Memory.Attributes)
 	}
 
 	if aru.Storage != nil {
 		res.Storage = strconv.FormatUint(aru.Storage.Quantity.Value(), 10)
+		res.StorageAttributes = attributesFromAkash(aru.Storage.Attributes)
 	}
 
+	res.Endpoints = endpointsFromAkash(aru.Endpoints)
+
 	return res, nil
 }
 
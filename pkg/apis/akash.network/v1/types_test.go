@@ -1,12 +1,16 @@
 package v1
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ovrclk/akash/manifest"
+	"github.com/ovrclk/akash/sdl"
 	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/types"
 )
 
 func Test_Manifest_encoding(t *testing.T) {
@@ -19,6 +23,16 @@ func Test_Manifest_encoding(t *testing.T) {
 			mgrp = spec.Generator.Group(t)
 		)
 
+		// global exposes reserve endpoints
+		for idx := range mgrp.Services {
+			svc := &mgrp.Services[idx]
+			for _, expose := range svc.Expose {
+				if expose.Global {
+					svc.Resources.Endpoints = append(svc.Resources.Endpoints, expose.Endpoint())
+				}
+			}
+		}
+
 		kmani, err := NewManifest("foo", lid, &mgrp)
 		require.NoError(t, err, spec.Name)
 
@@ -27,5 +41,146 @@ func Test_Manifest_encoding(t *testing.T) {
 
 		assert.Equal(t, lid, deployment.LeaseID(), spec.Name)
 		assert.Equal(t, mgrp, deployment.ManifestGroup(), spec.Name)
+
+		copied, err := kmani.DeepCopy().Deployment()
+		require.NoError(t, err, spec.Name)
+		assert.Equal(t, mgrp, copied.ManifestGroup(), spec.Name)
+	}
+}
+
+func Test_Manifest_encoding_persistent_storage(t *testing.T) {
+	lid := testutil.LeaseID(t)
+	mgrp := testutil.AppManifestGenerator.Group(t)
+
+	svc := &mgrp.Services[0]
+	svc.Resources.Storage.Attributes = types.Attributes{
+		types.NewStringAttribute(types.StorageAttributeClass, "default"),
+		types.NewStringAttribute(types.StorageAttributePersistent, "true"),
+	}
+	svc.Params = &manifest.ServiceParams{
+		Storage: []manifest.StorageParams{{Name: "data", Mount: "/data", ReadOnly: true}},
+	}
+
+	kmani, err := NewManifest("foo", lid, &mgrp)
+	require.NoError(t, err)
+
+	deployment, err := kmani.Deployment()
+	require.NoError(t, err)
+
+	assert.Equal(t, mgrp, deployment.ManifestGroup())
+
+	copied, err := kmani.DeepCopy().Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, mgrp, copied.ManifestGroup())
+}
+
+func Test_Manifest_encoding_health_checks(t *testing.T) {
+	lid := testutil.LeaseID(t)
+	mgrp := testutil.AppManifestGenerator.Group(t)
+
+	mgrp.Services[0].Health = &manifest.ServiceHealth{
+		Liveness: &manifest.HealthCheck{
+			Exec:             &manifest.ExecHealthCheck{Command: []string{"pg_isready", "-q"}},
+			Interval:         30,
+			FailureThreshold: 5,
+		},
+		Readiness: &manifest.HealthCheck{
+			HTTP:         &manifest.HTTPHealthCheck{Path: "/healthz", Port: 8080},
+			InitialDelay: 5,
+			Timeout:      2,
+		},
 	}
+
+	kmani, err := NewManifest("foo", lid, &mgrp)
+	require.NoError(t, err)
+
+	deployment, err := kmani.Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, mgrp, deployment.ManifestGroup())
+
+	copied := kmani.DeepCopy()
+	copied.Spec.Group.Services[0].Health.Liveness.Exec.Command[0] = "true"
+
+	deployment, err = kmani.Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, mgrp, deployment.ManifestGroup())
+}
+
+// Test_Manifest_encoding_sdl_version ensures the version of a lease manifest read back from
+// the cluster matches the version of the SDL it was deployed from.
+func Test_Manifest_encoding_sdl_version(t *testing.T) {
+	paths := []string{
+		"../../../../sdl/_testdata/simple.yaml",
+		"../../../../_run/kube/deployment.yaml",
+		"../../../../_run/lite/deployment.yaml",
+		"../../../../_run/single/deployment.yaml",
+	}
+
+	for _, path := range paths {
+		obj, err := sdl.ReadFile(path)
+		require.NoError(t, err, path)
+
+		mani, err := obj.Manifest()
+		require.NoError(t, err, path)
+
+		expected, err := sdl.Version(obj)
+		require.NoError(t, err, path)
+
+		for idx := range mani {
+			kmani, err := NewManifest("foo", testutil.LeaseID(t), &mani[idx])
+			require.NoError(t, err, path)
+
+			mani[idx] = decodedManifestGroup(t, kmani)
+		}
+
+		version, err := sdl.ManifestVersion(mani)
+		require.NoError(t, err, path)
+		assert.Equal(t, expected, version, path)
+	}
+}
+
+func Test_Manifest_encoding_lists(t *testing.T) {
+	lid := testutil.LeaseID(t)
+	mgrp := testutil.AppManifestGenerator.Group(t)
+
+	svc := &mgrp.Services[0]
+	svc.Command = []string{"/bin/sh", "-c"}
+	svc.Args = []string{}
+	svc.Env = nil
+	svc.Expose[0].Hosts = []string{}
+	svc.Resources.CPU.Attributes = types.Attributes{types.NewStringAttribute("arch", "amd64")}
+	svc.Resources.Memory.Attributes = types.Attributes{types.NewStringAttribute("type", "ecc")}
+	svc.Resources.Endpoints = []types.Endpoint{}
+
+	mgrp.Services = append(mgrp.Services, testutil.AppManifestGenerator.Service(t))
+	other := &mgrp.Services[1]
+	other.Name = "other"
+	other.Expose = nil
+	other.Params = &manifest.ServiceParams{}
+
+	kmani, err := NewManifest("foo", lid, &mgrp)
+	require.NoError(t, err)
+
+	assert.Equal(t, mgrp, decodedManifestGroup(t, kmani))
+
+	copied, err := kmani.DeepCopy().Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, mgrp, copied.ManifestGroup())
+}
+
+// decodedManifestGroup returns the group of the manifest after a round trip through its JSON
+// encoding, as stored by the cluster
+func decodedManifestGroup(t *testing.T, kmani *Manifest) manifest.Group {
+	t.Helper()
+
+	data, err := json.Marshal(kmani)
+	require.NoError(t, err)
+
+	decoded := &Manifest{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+
+	deployment, err := decoded.Deployment()
+	require.NoError(t, err)
+
+	return deployment.ManifestGroup()
 }
@@ -24,6 +24,38 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Attribute) DeepCopyInto(out *Attribute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Attribute.
+func (in *Attribute) DeepCopy() *Attribute {
+	if in == nil {
+		return nil
+	}
+	out := new(Attribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaseID) DeepCopyInto(out *LeaseID) {
 	*out = *in
@@ -68,6 +100,27 @@ func (in *Manifest) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestExecHealthCheck) DeepCopyInto(out *ManifestExecHealthCheck) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestExecHealthCheck.
+func (in *ManifestExecHealthCheck) DeepCopy() *ManifestExecHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestExecHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestGroup) DeepCopyInto(out *ManifestGroup) {
 	*out = *in
@@ -91,6 +144,53 @@ func (in *ManifestGroup) DeepCopy() *ManifestGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestHTTPHealthCheck) DeepCopyInto(out *ManifestHTTPHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestHTTPHealthCheck.
+func (in *ManifestHTTPHealthCheck) DeepCopy() *ManifestHTTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestHTTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestHealthCheck) DeepCopyInto(out *ManifestHealthCheck) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ManifestHTTPHealthCheck)
+		**out = **in
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(ManifestTCPHealthCheck)
+		**out = **in
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ManifestExecHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestHealthCheck.
+func (in *ManifestHealthCheck) DeepCopy() *ManifestHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestList) DeepCopyInto(out *ManifestList) {
 	*out = *in
@@ -127,6 +227,11 @@ func (in *ManifestList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestService) DeepCopyInto(out *ManifestService) {
 	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Args != nil {
 		in, out := &in.Args, &out.Args
 		*out = make([]string, len(*in))
@@ -137,7 +242,7 @@ func (in *ManifestService) DeepCopyInto(out *ManifestService) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	out.Resources = in.Resources
+	in.Resources.DeepCopyInto(&out.Resources)
 	if in.Expose != nil {
 		in, out := &in.Expose, &out.Expose
 		*out = make([]ManifestServiceExpose, len(*in))
@@ -145,6 +250,16 @@ func (in *ManifestService) DeepCopyInto(out *ManifestService) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = new(ManifestServiceParams)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(ManifestServiceHealth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -179,6 +294,53 @@ func (in *ManifestServiceExpose) DeepCopy() *ManifestServiceExpose {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestServiceHealth) DeepCopyInto(out *ManifestServiceHealth) {
+	*out = *in
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(ManifestHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ManifestHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestServiceHealth.
+func (in *ManifestServiceHealth) DeepCopy() *ManifestServiceHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestServiceHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestServiceParams) DeepCopyInto(out *ManifestServiceParams) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make([]ManifestStorageParams, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestServiceParams.
+func (in *ManifestServiceParams) DeepCopy() *ManifestServiceParams {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestServiceParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestSpec) DeepCopyInto(out *ManifestSpec) {
 	*out = *in
@@ -213,9 +375,61 @@ func (in *ManifestStatus) DeepCopy() *ManifestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestStorageParams) DeepCopyInto(out *ManifestStorageParams) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestStorageParams.
+func (in *ManifestStorageParams) DeepCopy() *ManifestStorageParams {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestStorageParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestTCPHealthCheck) DeepCopyInto(out *ManifestTCPHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestTCPHealthCheck.
+func (in *ManifestTCPHealthCheck) DeepCopy() *ManifestTCPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestTCPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceUnits) DeepCopyInto(out *ResourceUnits) {
 	*out = *in
+	if in.CPUAttributes != nil {
+		in, out := &in.CPUAttributes, &out.CPUAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemoryAttributes != nil {
+		in, out := &in.MemoryAttributes, &out.MemoryAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageAttributes != nil {
+		in, out := &in.StorageAttributes, &out.StorageAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]Endpoint, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2,6 +2,8 @@ package bidengine
 
 import (
 	"context"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 
@@ -12,16 +14,45 @@ import (
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/util/runner"
 	"github.com/ovrclk/akash/validation"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+var (
+	bidCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_bidengine_bids_total",
+		Help: "The number of bids by result: placed, failed, won, lost or closed",
+	}, []string{"result"})
+
+	orderDeclinedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_bidengine_orders_declined_total",
+		Help: "The number of orders not bid on, by reason",
+	}, []string{"reason"})
+
+	pricingErrorCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "provider_bidengine_pricing_errors_total",
+		Help: "The number of failed bid price calculations",
+	})
+
+	pricingDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "provider_bidengine_pricing_duration_seconds",
+		Help:    "The duration of bid price calculations",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
 // order manages bidding and general lifecycle handling of an order.
 type order struct {
-	orderID         mtypes.OrderID
-	bid             *mtypes.Bid
-	pricingStrategy BidPricingStrategy
+	orderID  mtypes.OrderID
+	bid      *mtypes.Bid
+	settings *Settings
+
+	// rejected is the reason the order was rejected by policy, if it was
+	rejected string
 
 	session session.Session
 	cluster cluster.Cluster
@@ -32,7 +63,7 @@ type order struct {
 	lc  lifecycle.Lifecycle
 }
 
-func newOrder(svc *service, oid mtypes.OrderID, bid *mtypes.Bid, pricingStrategy BidPricingStrategy) (*order, error) {
+func newOrder(svc *service, oid mtypes.OrderID, bid *mtypes.Bid) (*order, error) {
 	// Create a subscription that will see all events that have not been read from e.sub.Events()
 	sub, err := svc.sub.Clone()
 	if err != nil {
@@ -44,15 +75,15 @@ func newOrder(svc *service, oid mtypes.OrderID, bid *mtypes.Bid, pricingStrategy
 	log := session.Log().With("order", oid)
 
 	order := &order{
-		orderID:         oid,
-		bid:             bid,
-		session:         session,
-		cluster:         svc.cluster,
-		bus:             svc.bus,
-		sub:             sub,
-		log:             log,
-		lc:              lifecycle.New(),
-		pricingStrategy: pricingStrategy,
+		orderID:  oid,
+		bid:      bid,
+		settings: svc.settings,
+		session:  session,
+		cluster:  svc.cluster,
+		bus:      svc.bus,
+		sub:      sub,
+		log:      log,
+		lc:       lifecycle.New(),
 	}
 
 	// Shut down when parent begins shutting down
@@ -112,11 +143,13 @@ loop:
 				// check winning provider
 				if ev.ID.Provider != o.session.Provider().Address().String() {
 					o.log.Info("lease lost", "lease", ev.ID)
+					bidCounter.WithLabelValues("lost").Inc()
 					break loop
 				}
 
 				// TODO: sanity check (price, state, etc...)
 				o.log.Info("lease won", "lease", ev.ID)
+				bidCounter.WithLabelValues("won").Inc()
 
 				if err := o.bus.Publish(event.LeaseWon{
 					LeaseID: ev.ID,
@@ -148,6 +181,7 @@ loop:
 
 			if result.Error() != nil {
 				o.log.Error("fetching group", "err", result.Error())
+				orderDeclinedCounter.WithLabelValues("group-fetch").Inc()
 				break loop
 			}
 
@@ -169,6 +203,7 @@ loop:
 
 			if result.Error() != nil {
 				o.log.Error("reserving resources", "err", result.Error())
+				orderDeclinedCounter.WithLabelValues("reservation").Inc()
 				break loop
 			}
 
@@ -184,13 +219,26 @@ loop:
 
 			pricech = runner.Do(func() runner.Result {
 				// Calculate price & bid
-				return runner.NewResult(o.pricingStrategy.calculatePrice(ctx, &group.GroupSpec))
+				start := time.Now()
+				defer func() {
+					pricingDuration.Observe(time.Since(start).Seconds())
+				}()
+
+				// price within the params in force on chain, they may have been changed by governance
+				params, err := o.session.Client().Query().DeploymentParams(ctx, &dtypes.QueryParamsRequest{})
+				if err != nil {
+					return runner.NewResult(nil, err)
+				}
+
+				return runner.NewResult(o.settings.pricingStrategy().calculatePrice(ctx, params.Params, &group.GroupSpec))
 
 			})
 		case result := <-pricech:
 			pricech = nil
 			if result.Error() != nil {
 				o.log.Error("error calculating price", "err", result.Error())
+				pricingErrorCounter.Inc()
+				orderDeclinedCounter.WithLabelValues("pricing").Inc()
 				break loop
 			}
 			price := result.Value().(sdk.Coin)
@@ -211,10 +259,12 @@ loop:
 
 			if result.Error() != nil {
 				o.log.Error("submitting fulfillment", "err", result.Error())
+				bidCounter.WithLabelValues("failed").Inc()
 				break loop
 			}
 
 			// Fulfillment placed.
+			bidCounter.WithLabelValues("placed").Inc()
 		}
 	}
 
@@ -239,6 +289,8 @@ loop:
 			})
 			if err != nil {
 				o.log.Error("closing bid", "err", err)
+			} else {
+				bidCounter.WithLabelValues("closed").Inc()
 			}
 		}
 	}
@@ -259,17 +311,72 @@ loop:
 }
 
 func (o *order) shouldBid(group *dtypes.Group) bool {
+	filters := o.settings.orderFilters()
+
+	// is the group excluded by the operator's filters and policies?
+	if reason := filters.check(&group.GroupSpec, group.GroupID.Owner); reason != "" {
+		o.reject(reason)
+		return false
+	}
 
 	// does provider have required attributes?
 	if !group.GroupSpec.MatchAttributes(o.session.Provider().Attributes) {
 		o.log.Debug("unable to fulfill: incompatible attributes")
+		orderDeclinedCounter.WithLabelValues("attributes").Inc()
+		return false
+	}
+
+	// are required attributes signed by the auditors the group trusts?
+	if !group.GroupSpec.SignedBy.Empty() {
+		res, err := o.session.Client().Query().ProviderAttributes(context.Background(), &atypes.QueryProviderAttributesRequest{
+			Owner: o.session.Provider().Owner,
+		})
+		if err != nil {
+			o.log.Error("unable to fulfill: fetching signed attributes", "err", err)
+			orderDeclinedCounter.WithLabelValues("signed-attributes-fetch").Inc()
+			return false
+		}
+
+		if !group.GroupSpec.MatchSignedAttributes(res.Providers) {
+			o.log.Debug("unable to fulfill: attributes not signed by required auditors")
+			orderDeclinedCounter.WithLabelValues("signed-attributes").Inc()
+			return false
+		}
+	}
+
+	// validate against the params in force on chain, they may have been changed by governance
+	params, err := o.session.Client().Query().DeploymentParams(context.Background(), &dtypes.QueryParamsRequest{})
+	if err != nil {
+		o.log.Error("unable to fulfill: fetching deployment params", "err", err)
+		orderDeclinedCounter.WithLabelValues("params-fetch").Inc()
 		return false
 	}
 
-	if err := validation.ValidateDeploymentGroup(group.GroupSpec); err != nil {
+	if err := validation.ValidateDeploymentGroup(params.Params, group.GroupSpec); err != nil {
 		o.log.Error("unable to fulfill: group validation error",
 			"err", err)
+		orderDeclinedCounter.WithLabelValues("validation").Inc()
+		return false
+	}
+
+	// does the tenant have room left within its limits?
+	reason, err := o.checkTenantLimits(context.Background(), filters.Tenant, group)
+	if err != nil {
+		o.log.Error("unable to fulfill: fetching tenant leases", "err", err)
+		orderDeclinedCounter.WithLabelValues("tenant-leases-fetch").Inc()
+		return false
+	}
+	if reason != "" {
+		o.reject(reason)
 		return false
 	}
+
 	return true
 }
+
+// reject records that the order was declined by the operator's filters or policies
+func (o *order) reject(reason string) {
+	o.log.Info("unable to fulfill: rejected by policy", "reason", reason, "owner", o.orderID.Owner)
+	orderDeclinedCounter.WithLabelValues(reason).Inc()
+	o.rejected = reason
+}
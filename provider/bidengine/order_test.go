@@ -8,13 +8,16 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ovrclk/akash/provider/event"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/testutil"
 	atypes "github.com/ovrclk/akash/types"
+	audittypes "github.com/ovrclk/akash/x/audit/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
@@ -37,6 +40,8 @@ type orderTestScaffold struct {
 
 	broadcasts        chan sdk.Msg
 	reserveCallNotify chan int
+
+	service *service
 }
 
 func makeMocks(s *orderTestScaffold) {
@@ -73,6 +78,9 @@ func makeMocks(s *orderTestScaffold) {
 	queryClientMock.On("Group", mock.Anything, mock.Anything).Return(groupResult, nil)
 
 	queryClientMock.On("Orders", mock.Anything, mock.Anything).Return(&mtypes.QueryOrdersResponse{}, nil)
+	queryClientMock.On("BidsByProvider", mock.Anything, mock.Anything).Return(&mtypes.QueryBidsByProviderResponse{}, nil)
+	queryClientMock.On("DeploymentParams", mock.Anything, mock.Anything).
+		Return(&dtypes.QueryParamsResponse{Params: dtypes.DefaultParams()}, nil)
 
 	txClientMock := &clientmocks.TxClient{}
 	s.broadcasts = make(chan sdk.Msg, 1)
@@ -103,6 +111,10 @@ func makeMocks(s *orderTestScaffold) {
 }
 
 func makeOrderForTest(t *testing.T, bid *mtypes.Bid, pricing BidPricingStrategy) (*order, orderTestScaffold) {
+	return makeOrderForTestWithFilters(t, bid, pricing, Filters{})
+}
+
+func makeOrderForTestWithFilters(t *testing.T, bid *mtypes.Bid, pricing BidPricingStrategy, filters Filters) (*order, orderTestScaffold) {
 	if pricing == nil {
 		var err error
 		pricing, err = MakeRandomRangePricing()
@@ -132,17 +144,21 @@ func makeOrderForTest(t *testing.T, bid *mtypes.Bid, pricing BidPricingStrategy)
 
 	scaffold.testBus = pubsub.NewBus()
 
-	myService, err := NewService(context.Background(), mySession, scaffold.cluster, scaffold.testBus, pricing)
+	settings, err := NewSettings(pricing, filters)
+	require.NoError(t, err)
+
+	myService, err := NewService(context.Background(), mySession, scaffold.cluster, scaffold.testBus, settings)
 	require.NoError(t, err)
 	require.NotNil(t, myService)
 
 	serviceCast := myService.(*service)
+	scaffold.service = serviceCast
 
 	if bid != nil {
 		bid.BidID = mtypes.MakeBidID(scaffold.orderID, scaffold.testAddr)
 		bid.Price = testutil.AkashCoin(t, 1)
 	}
-	order, err := newOrder(serviceCast, scaffold.orderID, bid, pricing)
+	order, err := newOrder(serviceCast, scaffold.orderID, bid)
 
 	require.NoError(t, err)
 	require.NotNil(t, order)
@@ -366,7 +382,7 @@ func Test_ShouldRecognizeLeaseCreatedIfBiddingIsSkipped(t *testing.T) {
 
 type testBidPricingStrategy int64
 
-func (tbps testBidPricingStrategy) calculatePrice(_ context.Context, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
+func (tbps testBidPricingStrategy) calculatePrice(_ context.Context, _ dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 	return sdk.NewInt64Coin(testutil.CoinDenom, int64(tbps)), nil
 }
 
@@ -400,7 +416,7 @@ type alwaysFailsBidPricingStrategy struct {
 	failure error
 }
 
-func (afbps alwaysFailsBidPricingStrategy) calculatePrice(_ context.Context, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
+func (afbps alwaysFailsBidPricingStrategy) calculatePrice(_ context.Context, _ dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 	return sdk.Coin{}, afbps.failure
 }
 
@@ -429,5 +445,237 @@ func Test_BidOrderFailsAndAborts(t *testing.T) {
 	scaffold.cluster.AssertCalled(t, "Unreserve", scaffold.orderID, mock.Anything)
 }
 
+func Test_BidOrderRejectedByPolicy(t *testing.T) {
+	order, scaffold := makeOrderForTestWithFilters(t, nil, nil, Filters{
+		AllowedOwners: []string{testutil.AccAddress(t).String()},
+	})
+
+	<-order.lc.Done()
+
+	require.Eventually(t, func() bool {
+		status, err := scaffold.service.Status(context.Background())
+		require.NoError(t, err)
+		return status.Rejected["policy-owner-not-allowed"] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Should never have reserved or bid
+	scaffold.cluster.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+	select {
+	case broadcast := <-scaffold.broadcasts:
+		t.Fatalf("unexpected broadcast %v", broadcast)
+	default:
+	}
+}
+
+func Test_ManifestRejectedCounted(t *testing.T) {
+	order, scaffold := makeOrderForTest(t, nil, nil)
+
+	leaseID := mtypes.MakeLeaseID(mtypes.MakeBidID(order.orderID, scaffold.testAddr))
+	require.NoError(t, scaffold.testBus.Publish(event.ManifestRejected{
+		LeaseID: leaseID,
+		Reason:  "policy-image",
+	}))
+
+	require.Eventually(t, func() bool {
+		status, err := scaffold.service.Status(context.Background())
+		require.NoError(t, err)
+		return status.Rejected["policy-image"] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 // TODO - add test failing the call to Broadcast on TxClient and
 // and then confirm that the reservation is cancelled
+
+func Test_ShouldBidSignedAttributes(t *testing.T) {
+	provider := testutil.AccAddress(t)
+	auditor := testutil.AccAddress(t)
+
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+	group.GroupSpec.Requirements = []atypes.Attribute{atypes.NewStringAttribute("region", "us-west")}
+	group.GroupSpec.SignedBy.AllOf = []string{auditor.String()}
+
+	newOrder := func(signed audittypes.Providers) *order {
+		queryClientMock := &clientmocks.QueryClient{}
+		queryClientMock.On("ProviderAttributes", mock.Anything, mock.Anything).
+			Return(&audittypes.QueryProvidersResponse{Providers: signed}, nil)
+		queryClientMock.On("DeploymentParams", mock.Anything, mock.Anything).
+			Return(&dtypes.QueryParamsResponse{Params: dtypes.DefaultParams()}, nil)
+
+		clientMock := &clientmocks.Client{}
+		clientMock.On("Query").Return(queryClientMock)
+
+		myProvider := &ptypes.Provider{
+			Owner:      provider.String(),
+			Attributes: group.GroupSpec.Requirements,
+		}
+
+		settings, err := NewSettings(testBidPricingStrategy(1), Filters{})
+		require.NoError(t, err)
+
+		return &order{
+			settings: settings,
+			session:  session.New(testutil.Logger(t), clientMock, myProvider),
+			log:      testutil.Logger(t),
+		}
+	}
+
+	t.Run("attributes not signed", func(t *testing.T) {
+		require.False(t, newOrder(nil).shouldBid(&group))
+	})
+
+	t.Run("attributes signed by required auditor", func(t *testing.T) {
+		require.True(t, newOrder(audittypes.Providers{{
+			Owner:      provider.String(),
+			Auditor:    auditor.String(),
+			Attributes: group.GroupSpec.Requirements,
+		}}).shouldBid(&group))
+	})
+
+	t.Run("attributes signed by another auditor", func(t *testing.T) {
+		require.False(t, newOrder(audittypes.Providers{{
+			Owner:      provider.String(),
+			Auditor:    testutil.AccAddress(t).String(),
+			Attributes: group.GroupSpec.Requirements,
+		}}).shouldBid(&group))
+	})
+}
+
+func Test_ShouldBidFilters(t *testing.T) {
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+	group.GroupSpec.Requirements = []atypes.Attribute{atypes.NewStringAttribute("region", "us-west")}
+	for idx := range group.GroupSpec.Resources {
+		group.GroupSpec.Resources[idx].Price = sdk.NewInt64Coin(testutil.CoinDenom, 1000)
+	}
+
+	cpu, _, _ := groupResources(&group.GroupSpec)
+
+	// the tenant already holds a lease for a group identical to the one ordered
+	lease := mtypes.Lease{
+		LeaseID: mtypes.MakeLeaseID(mtypes.MakeBidID(mtypes.MakeOrderID(group.GroupID, 1), testutil.AccAddress(t))),
+		State:   mtypes.LeaseActive,
+	}
+
+	newOrder := func(filters Filters) *order {
+		queryClientMock := &clientmocks.QueryClient{}
+		queryClientMock.On("DeploymentParams", mock.Anything, mock.Anything).
+			Return(&dtypes.QueryParamsResponse{Params: dtypes.DefaultParams()}, nil)
+		queryClientMock.On("Leases", mock.Anything, mock.Anything).
+			Return(&mtypes.QueryLeasesResponse{Leases: mtypes.Leases{lease}}, nil)
+		queryClientMock.On("Group", mock.Anything, mock.Anything).
+			Return(&dtypes.QueryGroupResponse{Group: group}, nil)
+
+		clientMock := &clientmocks.Client{}
+		clientMock.On("Query").Return(queryClientMock)
+
+		myProvider := &ptypes.Provider{
+			Owner:      testutil.AccAddress(t).String(),
+			Attributes: group.GroupSpec.Requirements,
+		}
+
+		settings, err := NewSettings(testBidPricingStrategy(1), filters)
+		require.NoError(t, err)
+
+		return &order{
+			settings: settings,
+			session:  session.New(testutil.Logger(t), clientMock, myProvider),
+			log:      testutil.Logger(t),
+		}
+	}
+
+	t.Run("no filters", func(t *testing.T) {
+		require.True(t, newOrder(Filters{}).shouldBid(&group))
+	})
+
+	t.Run("group exceeds cpu", func(t *testing.T) {
+		require.False(t, newOrder(Filters{MaxGroupCPU: 1}).shouldBid(&group))
+	})
+
+	t.Run("group exceeds memory", func(t *testing.T) {
+		require.False(t, newOrder(Filters{MaxGroupMemory: 1}).shouldBid(&group))
+	})
+
+	t.Run("required attributes present", func(t *testing.T) {
+		require.True(t, newOrder(Filters{
+			RequiredAttributes: group.GroupSpec.Requirements,
+		}).shouldBid(&group))
+	})
+
+	t.Run("required attributes missing", func(t *testing.T) {
+		require.False(t, newOrder(Filters{
+			RequiredAttributes: []atypes.Attribute{atypes.NewStringAttribute("tier", "premium")},
+		}).shouldBid(&group))
+	})
+
+	t.Run("denied attributes", func(t *testing.T) {
+		require.False(t, newOrder(Filters{
+			DeniedAttributes: group.GroupSpec.Requirements,
+		}).shouldBid(&group))
+	})
+
+	t.Run("denied attribute pattern", func(t *testing.T) {
+		require.False(t, newOrder(Filters{
+			DeniedAttributes: []atypes.Attribute{atypes.NewStringAttribute("reg*", "us-*")},
+		}).shouldBid(&group))
+	})
+
+	t.Run("owner allowed", func(t *testing.T) {
+		require.True(t, newOrder(Filters{
+			AllowedOwners: []string{group.GroupID.Owner},
+		}).shouldBid(&group))
+	})
+
+	t.Run("owner not allowed", func(t *testing.T) {
+		require.False(t, newOrder(Filters{
+			AllowedOwners: []string{testutil.AccAddress(t).String()},
+		}).shouldBid(&group))
+	})
+
+	t.Run("owner denied", func(t *testing.T) {
+		require.False(t, newOrder(Filters{
+			DeniedOwners: []string{group.GroupID.Owner},
+		}).shouldBid(&group))
+	})
+
+	// every resource has 100 millicpu and a price of 1000uakt
+	t.Run("price at minimum", func(t *testing.T) {
+		require.True(t, newOrder(Filters{MinPrice: ResourcePrices{CPU: 10}}).shouldBid(&group))
+	})
+
+	t.Run("price below minimum", func(t *testing.T) {
+		require.False(t, newOrder(Filters{MinPrice: ResourcePrices{CPU: 11}}).shouldBid(&group))
+	})
+
+	t.Run("tenant within lease limit", func(t *testing.T) {
+		require.True(t, newOrder(Filters{Tenant: TenantLimits{MaxLeases: 2}}).shouldBid(&group))
+	})
+
+	t.Run("tenant exceeds lease limit", func(t *testing.T) {
+		require.False(t, newOrder(Filters{Tenant: TenantLimits{MaxLeases: 1}}).shouldBid(&group))
+	})
+
+	t.Run("tenant within cpu limit", func(t *testing.T) {
+		require.True(t, newOrder(Filters{Tenant: TenantLimits{MaxCPU: 2 * cpu}}).shouldBid(&group))
+	})
+
+	t.Run("tenant exceeds cpu limit", func(t *testing.T) {
+		require.False(t, newOrder(Filters{Tenant: TenantLimits{MaxCPU: 2*cpu - 1}}).shouldBid(&group))
+	})
+}
+
+func Test_SettingsUpdate(t *testing.T) {
+	_, err := NewSettings(nil, Filters{})
+	require.Error(t, err)
+
+	settings, err := NewSettings(testBidPricingStrategy(1), Filters{})
+	require.NoError(t, err)
+
+	require.Error(t, settings.Update(nil, Filters{MaxGroupCPU: 1}))
+	require.Equal(t, Filters{}, settings.orderFilters())
+
+	require.NoError(t, settings.Update(testBidPricingStrategy(2), Filters{MaxGroupCPU: 1}))
+	require.Equal(t, Filters{MaxGroupCPU: 1}, settings.orderFilters())
+
+	price, err := settings.pricingStrategy().calculatePrice(context.Background(), dtypes.DefaultParams(), &dtypes.GroupSpec{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), price.Amount.Int64())
+}
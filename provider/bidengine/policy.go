@@ -0,0 +1,181 @@
+package bidengine
+
+import (
+	"context"
+	"errors"
+
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/util/wildcard"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// ResourcePrices are prices in uakt per millicpu, byte of memory, byte of storage and
+// dedicated port endpoint
+type ResourcePrices struct {
+	CPU      uint64
+	Memory   uint64
+	Storage  uint64
+	Endpoint uint64
+}
+
+func (p ResourcePrices) empty() bool {
+	return p == ResourcePrices{}
+}
+
+// accepts returns true when the price of the group is at least the price of its
+// resources at the given prices
+func (p ResourcePrices) accepts(gspec *dtypes.GroupSpec) bool {
+	if p.empty() {
+		return true
+	}
+
+	// scale pricing does not depend on the deployment params
+	min, err := scalePricing{
+		cpuScale:      p.CPU,
+		memoryScale:   p.Memory,
+		storageScale:  p.Storage,
+		endpointScale: p.Endpoint,
+	}.calculatePrice(context.Background(), dtypes.Params{}, gspec)
+
+	switch {
+	case errors.Is(err, ErrBidZero):
+		return true
+	case err != nil:
+		return false
+	}
+
+	price := gspec.Price()
+	return price.Denom == min.Denom && !price.IsLT(min)
+}
+
+// TenantLimits caps the active leases a single tenant holds with the provider, counting
+// the group being bid on. Zero values disable the corresponding limit.
+type TenantLimits struct {
+	MaxLeases  uint32
+	MaxCPU     uint64
+	MaxMemory  uint64
+	MaxStorage uint64
+}
+
+func (l TenantLimits) empty() bool {
+	return l == TenantLimits{}
+}
+
+// checkTenantLimits returns the reason the group is declined, or an empty string if the
+// leases the group owner already holds with the provider leave room for it
+func (o *order) checkTenantLimits(ctx context.Context, limits TenantLimits, group *dtypes.Group) (string, error) {
+	if limits.empty() {
+		return "", nil
+	}
+
+	leases, err := o.tenantLeases(ctx, group.GroupID.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	if limits.MaxLeases != 0 && uint32(len(leases))+1 > limits.MaxLeases {
+		return "policy-tenant-leases", nil
+	}
+
+	if limits.MaxCPU == 0 && limits.MaxMemory == 0 && limits.MaxStorage == 0 {
+		return "", nil
+	}
+
+	cpu, memory, storage := groupResources(&group.GroupSpec)
+
+	for _, lease := range leases {
+		res, err := o.session.Client().Query().Group(ctx, &dtypes.QueryGroupRequest{
+			ID: lease.LeaseID.GroupID(),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		lcpu, lmemory, lstorage := groupResources(&res.Group.GroupSpec)
+		cpu += lcpu
+		memory += lmemory
+		storage += lstorage
+	}
+
+	switch {
+	case limits.MaxCPU != 0 && cpu > limits.MaxCPU:
+		return "policy-tenant-cpu", nil
+	case limits.MaxMemory != 0 && memory > limits.MaxMemory:
+		return "policy-tenant-memory", nil
+	case limits.MaxStorage != 0 && storage > limits.MaxStorage:
+		return "policy-tenant-storage", nil
+	}
+
+	return "", nil
+}
+
+// tenantLeases returns the active leases of owner with the provider
+func (o *order) tenantLeases(ctx context.Context, owner string) ([]mtypes.Lease, error) {
+	var leases []mtypes.Lease
+
+	params := &mtypes.QueryLeasesRequest{
+		Filters: mtypes.LeaseFilters{
+			Owner:    owner,
+			Provider: o.session.Provider().Owner,
+			State:    mtypes.LeaseActive.String(),
+		},
+		Pagination: &sdkquery.PageRequest{},
+	}
+
+	for {
+		res, err := o.session.Client().Query().Leases(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		leases = append(leases, res.Leases...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return leases, nil
+		}
+		params.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// groupResources returns the total cpu units, memory and storage of the group
+func groupResources(gspec *dtypes.GroupSpec) (uint64, uint64, uint64) {
+	var cpu, memory, storage uint64
+
+	for _, res := range gspec.Resources {
+		count := uint64(res.Count)
+		if res.Resources.CPU != nil {
+			cpu += res.Resources.CPU.Units.Val.Uint64() * count
+		}
+		if res.Resources.Memory != nil {
+			memory += res.Resources.Memory.Quantity.Val.Uint64() * count
+		}
+		if res.Resources.Storage != nil {
+			storage += res.Resources.Storage.Quantity.Val.Uint64() * count
+		}
+	}
+
+	return cpu, memory, storage
+}
+
+// attributeRuleMatches returns true when a requirement matches the key and value
+// patterns of rule
+func attributeRuleMatches(rule types.Attribute, requirements types.Attributes) bool {
+	for _, req := range requirements {
+		if wildcard.Match(rule.Key, req.Key) && wildcard.Match(rule.Value, req.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -11,13 +11,15 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	atypes "github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/types/unit"
-	"github.com/ovrclk/akash/validation"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
+// BidPricingStrategy calculates the price of a bid on a group, given the deployment params
+// in force on chain
 type BidPricingStrategy interface {
-	calculatePrice(ctx context.Context, gspec *dtypes.GroupSpec) (sdk.Coin, error)
+	calculatePrice(ctx context.Context, params dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, error)
 }
 
 const denom = "uakt"
@@ -54,7 +56,7 @@ func MakeScalePricing(
 var ErrBidQuantityInvalid = errors.New("A bid quantity is invalid")
 var ErrBidZero = errors.New("A bid of zero was produced")
 
-func (fp scalePricing) calculatePrice(ctx context.Context, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
+func (fp scalePricing) calculatePrice(ctx context.Context, _ dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 	// Use unlimited precision math here.
 	// Otherwise a correctly crafted order could create a cost of '1' given
 	// a possible configuration
@@ -84,8 +86,10 @@ func (fp scalePricing) calculatePrice(ctx context.Context, gspec *dtypes.GroupSp
 		storageQuantity.Mul(storageQuantity, groupCount)
 		storageTotal.Add(storageTotal, storageQuantity)
 
+		// Only endpoints on dedicated ports are priced, shared HTTP ingress is not a scarce
+		// resource. As with the inventory, instances share the ports of their service.
 		endpointQuantity := big.NewInt(0)
-		endpointQuantity.SetUint64(uint64(len(group.Resources.Endpoints)))
+		endpointQuantity.SetUint64(uint64(atypes.CountRandomPortEndpoints(group.Resources.Endpoints)))
 		endpointTotal.Add(endpointTotal, endpointQuantity)
 	}
 
@@ -114,9 +118,10 @@ func (fp scalePricing) calculatePrice(ctx context.Context, gspec *dtypes.GroupSp
 	cpuCost := sdk.NewCoin(denom, sdk.NewIntFromBigInt(cpuTotal))
 	memoryCost := sdk.NewCoin(denom, sdk.NewIntFromBigInt(memoryTotal))
 	storageCost := sdk.NewCoin(denom, sdk.NewIntFromBigInt(storageTotal))
+	endpointCost := sdk.NewCoin(denom, sdk.NewIntFromBigInt(endpointTotal))
 
 	// Check for less than or equal to zero
-	cost := cpuCost.Add(memoryCost).Add(storageCost)
+	cost := cpuCost.Add(memoryCost).Add(storageCost).Add(endpointCost)
 
 	if cost.Amount.IsZero() {
 		// Return an error indicating we can't bid with a cost of zero
@@ -132,9 +137,9 @@ func MakeRandomRangePricing() (BidPricingStrategy, error) {
 	return randomRangePricing(0), nil
 }
 
-func (randomRangePricing) calculatePrice(ctx context.Context, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
+func (randomRangePricing) calculatePrice(ctx context.Context, params dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 
-	min, max := calculatePriceRange(gspec)
+	min, max := calculatePriceRange(params, gspec)
 
 	if min.IsEqual(max) {
 		return max, nil
@@ -150,10 +155,10 @@ func (randomRangePricing) calculatePrice(ctx context.Context, gspec *dtypes.Grou
 	return sdk.NewCoin(min.Denom, min.Amount.Add(sdk.NewIntFromBigInt(val))), nil
 }
 
-func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
+func calculatePriceRange(params dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
 	// memory-based pricing:
-	//   min: requested memory * configured min price per Gi
-	//   max: requested memory * configured max price per Gi
+	//   min: requested memory * min price per Gi of the params
+	//   max: requested memory * max price per Gi of the params
 
 	// assumption: group.Count > 0
 	// assumption: all same denom (returned by gspec.Price())
@@ -161,8 +166,6 @@ func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
 
 	mem := sdk.NewInt(0)
 
-	cfg := validation.Config()
-
 	for _, group := range gspec.Resources {
 		mem = mem.Add(
 			sdk.NewIntFromUint64(group.Resources.Memory.Quantity.Value()).
@@ -172,17 +175,21 @@ func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
 	rmax := gspec.Price()
 
 	cmin := mem.MulRaw(
-		cfg.MinGroupMemPrice).
+		params.MinGroupMemPrice).
 		Quo(sdk.NewInt(unit.Gi))
 
 	cmax := mem.MulRaw(
-		cfg.MaxGroupMemPrice).
+		params.MaxGroupMemPrice).
 		Quo(sdk.NewInt(unit.Gi))
 
 	if cmax.GT(rmax.Amount) {
 		cmax = rmax.Amount
 	}
 
+	if cmin.GT(cmax) {
+		cmin = cmax
+	}
+
 	if cmin.IsZero() {
 		cmin = sdk.NewInt(1)
 	}
@@ -239,7 +246,7 @@ type dataForScriptElement struct {
 	EndpointQuantity int    `json:"endpoint-quantity"`
 }
 
-func (ssp shellScriptPricing) calculatePrice(ctx context.Context, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
+func (ssp shellScriptPricing) calculatePrice(ctx context.Context, _ dtypes.Params, gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 	buf := &bytes.Buffer{}
 
 	dataForScript := make([]dataForScriptElement, len(gspec.Resources))
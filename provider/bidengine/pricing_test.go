@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/testutil"
 	atypes "github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/stretchr/testify/require"
 	io "io"
@@ -81,7 +82,7 @@ func Test_ScalePricingFailsOnOverflow(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	price, err := pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 
 	require.Equal(t, sdk.Coin{}, price)
 	require.Equal(t, err, ErrBidQuantityInvalid)
@@ -96,7 +97,7 @@ func Test_ScalePricingOnCpu(t *testing.T) {
 	gspec := defaultGroupSpec()
 	cpuQuantity := uint64(13)
 	gspec.Resources[0].Resources.CPU.Units = atypes.NewResourceValue(cpuQuantity)
-	price, err := pricing.calculatePrice(context.Background(), gspec)
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
 
 	expectedPrice := testutil.AkashCoin(t, int64(cpuScale*cpuQuantity))
 	require.Equal(t, expectedPrice, price)
@@ -112,7 +113,7 @@ func Test_ScalePricingOnMemory(t *testing.T) {
 	gspec := defaultGroupSpec()
 	memoryQuantity := uint64(123456)
 	gspec.Resources[0].Resources.Memory.Quantity = atypes.NewResourceValue(memoryQuantity)
-	price, err := pricing.calculatePrice(context.Background(), gspec)
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
 
 	expectedPrice := testutil.AkashCoin(t, int64(memoryScale*memoryQuantity))
 	require.Equal(t, expectedPrice, price)
@@ -128,13 +129,33 @@ func Test_ScalePricingOnStorage(t *testing.T) {
 	gspec := defaultGroupSpec()
 	storageQuantity := uint64(98765)
 	gspec.Resources[0].Resources.Storage.Quantity = atypes.NewResourceValue(storageQuantity)
-	price, err := pricing.calculatePrice(context.Background(), gspec)
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
 
 	expectedPrice := testutil.AkashCoin(t, int64(storageScale*storageQuantity))
 	require.Equal(t, expectedPrice, price)
 	require.NoError(t, err)
 }
 
+func Test_ScalePricingOnEndpoints(t *testing.T) {
+	endpointScale := uint64(31)
+	pricing, err := MakeScalePricing(0, 0, 0, endpointScale)
+	require.NoError(t, err)
+	require.NotNil(t, pricing)
+
+	gspec := defaultGroupSpec()
+	gspec.Resources[0].Resources.Endpoints = []atypes.Endpoint{
+		{Kind: atypes.EndpointRandomPort},
+		{Kind: atypes.EndpointSharedHTTP},
+		{Kind: atypes.EndpointRandomPort},
+	}
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
+
+	// shared HTTP endpoints are not priced
+	expectedPrice := testutil.AkashCoin(t, int64(2*endpointScale))
+	require.Equal(t, expectedPrice, price)
+	require.NoError(t, err)
+}
+
 func Test_ScalePricingByCountOfResources(t *testing.T) {
 	storageScale := uint64(3)
 	pricing, err := MakeScalePricing(0, 0, storageScale, 0)
@@ -144,19 +165,45 @@ func Test_ScalePricingByCountOfResources(t *testing.T) {
 	gspec := defaultGroupSpec()
 	storageQuantity := uint64(111)
 	gspec.Resources[0].Resources.Storage.Quantity = atypes.NewResourceValue(storageQuantity)
-	firstPrice, err := pricing.calculatePrice(context.Background(), gspec)
+	firstPrice, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
 
 	firstExpectedPrice := testutil.AkashCoin(t, int64(storageScale*storageQuantity))
 	require.Equal(t, firstExpectedPrice, firstPrice)
 	require.NoError(t, err)
 
 	gspec.Resources[0].Count = 2
-	secondPrice, err := pricing.calculatePrice(context.Background(), gspec)
+	secondPrice, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
 	secondExpectedPrice := testutil.AkashCoin(t, 2*int64(storageScale*storageQuantity))
 	require.Equal(t, secondExpectedPrice, secondPrice)
 	require.NoError(t, err)
 }
 
+func Test_RandomRangePricingUsesParams(t *testing.T) {
+	pricing, err := MakeRandomRangePricing()
+	require.NoError(t, err)
+
+	gspec := defaultGroupSpec()
+	gspec.Resources[0].Resources.Memory.Quantity = atypes.NewResourceValue(2 * unit.Gi)
+	gspec.Resources[0].Price = sdk.NewInt64Coin("uakt", 1000)
+
+	// a range of a single price per Gi
+	params := dtypes.DefaultParams()
+	params.MinGroupMemPrice = 7
+	params.MaxGroupMemPrice = 7
+
+	price, err := pricing.calculatePrice(context.Background(), params, gspec)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uakt", 14), price)
+
+	// the price of the group bounds the range
+	params.MinGroupMemPrice = 600
+	params.MaxGroupMemPrice = 900
+
+	price, err = pricing.calculatePrice(context.Background(), params, gspec)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uakt", 1000), price)
+}
+
 func Test_ScriptPricingRejectsEmptyStringForPath(t *testing.T) {
 	pricing, err := MakeShellScriptPricing("", 1, 30000*time.Millisecond)
 	require.NotNil(t, err)
@@ -186,7 +233,7 @@ func Test_ScriptPricingFailsWhenScriptDoesNotExist(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.IsType(t, &os.PathError{}, err)
 }
 
@@ -205,7 +252,7 @@ func Test_ScriptPricingFailsWhenScriptExitsNonZero(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.IsType(t, &exec.ExitError{}, err)
 }
 
@@ -224,7 +271,7 @@ func Test_ScriptPricingFailsWhenScriptExitsWithoutWritingResultToStdout(t *testi
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.Equal(t, io.EOF, err)
 }
 
@@ -243,7 +290,7 @@ func Test_ScriptPricingFailsWhenScriptWritesZeroResult(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.Equal(t, ErrBidZero, err)
 }
 
@@ -262,7 +309,7 @@ func Test_ScriptPricingFailsWhenScriptWritesNegativeResult(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.Equal(t, ErrBidQuantityInvalid, err)
 }
 
@@ -281,7 +328,7 @@ func Test_ScriptPricingFailsWhenScriptWritesFractionalResult(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.Equal(t, ErrBidQuantityInvalid, err)
 }
 
@@ -301,7 +348,7 @@ func Test_ScriptPricingFailsWhenScriptWritesOverflowResult(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.Equal(t, ErrBidQuantityInvalid, err)
 }
 
@@ -321,7 +368,7 @@ func Test_ScriptPricingReturnsResultFromScript(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, pricing)
 
-	price, err := pricing.calculatePrice(context.Background(), defaultGroupSpec())
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 	require.NoError(t, err)
 	require.Equal(t, "uakt", price.Denom)
 	require.Equal(t, int64(132), price.Amount.Int64())
@@ -345,7 +392,7 @@ func Test_ScriptPricingDoesNotExhaustSemaphore(t *testing.T) {
 	// run the script lots of time to make sure the channel used
 	// as a semaphore always has things returned to it
 	for i := 0; i != 111; i++ {
-		_, err = pricing.calculatePrice(context.Background(), defaultGroupSpec())
+		_, err = pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), defaultGroupSpec())
 		require.NoError(t, err)
 	}
 }
@@ -367,7 +414,7 @@ func Test_ScriptPricingStopsByContext(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	_, err = pricing.calculatePrice(ctx, defaultGroupSpec())
+	_, err = pricing.calculatePrice(ctx, dtypes.DefaultParams(), defaultGroupSpec())
 	require.Error(t, err)
 	require.Equal(t, context.Canceled, err)
 }
@@ -393,7 +440,7 @@ func Test_ScriptPricingStopsByTimeout(t *testing.T) {
 	require.NotNil(t, pricing)
 
 	ctx := context.Background()
-	_, err = pricing.calculatePrice(ctx, defaultGroupSpec())
+	_, err = pricing.calculatePrice(ctx, dtypes.DefaultParams(), defaultGroupSpec())
 	require.Error(t, err)
 	require.Equal(t, context.DeadlineExceeded, err)
 }
@@ -416,7 +463,7 @@ func Test_ScriptPricingWritesJsonToStdin(t *testing.T) {
 	require.NotNil(t, pricing)
 
 	gspec := defaultGroupSpec()
-	price, err := pricing.calculatePrice(context.Background(), gspec)
+	price, err := pricing.calculatePrice(context.Background(), dtypes.DefaultParams(), gspec)
 	require.NoError(t, err)
 	require.Equal(t, "uakt", price.Denom)
 	require.Equal(t, int64(1), price.Amount.Int64())
@@ -7,7 +7,11 @@ import (
 	lifecycle "github.com/boz/go-lifecycle"
 
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	"github.com/ovrclk/akash/provider/cluster"
+	"github.com/ovrclk/akash/provider/event"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
 	mquery "github.com/ovrclk/akash/x/market/query"
@@ -17,6 +21,18 @@ import (
 // ErrNotRunning declares new error with message "not running"
 var ErrNotRunning = errors.New("not running")
 
+var (
+	ordersDetectedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "provider_bidengine_orders_detected_total",
+		Help: "The number of orders seen by the bid engine",
+	})
+
+	orderManagerGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "provider_bidengine_order_managers",
+		Help: "The number of orders being handled by the bid engine",
+	})
+)
+
 // StatusClient interface predefined with Status method
 type StatusClient interface {
 	Status(context.Context) (*Status, error)
@@ -30,7 +46,7 @@ type Service interface {
 }
 
 // NewService creates new service instance and returns error incase of failure
-func NewService(ctx context.Context, session session.Session, cluster cluster.Cluster, bus pubsub.Bus, bps BidPricingStrategy) (Service, error) {
+func NewService(ctx context.Context, session session.Session, cluster cluster.Cluster, bus pubsub.Bus, settings *Settings) (Service, error) {
 	session = session.ForModule("bidengine-service")
 
 	sub, err := bus.Subscribe()
@@ -47,15 +63,16 @@ func NewService(ctx context.Context, session session.Session, cluster cluster.Cl
 	session.Log().Info("found orders", "count", len(existingOrders))
 
 	s := &service{
-		session:         session,
-		cluster:         cluster,
-		bus:             bus,
-		sub:             sub,
-		statusch:        make(chan chan<- *Status),
-		orders:          make(map[string]*order),
-		drainch:         make(chan *order),
-		lc:              lifecycle.New(),
-		pricingStrategy: bps,
+		session:  session,
+		cluster:  cluster,
+		bus:      bus,
+		sub:      sub,
+		statusch: make(chan chan<- *Status),
+		orders:   make(map[string]*order),
+		rejected: make(map[string]uint32),
+		drainch:  make(chan *order),
+		settings: settings,
+		lc:       lifecycle.New(),
 	}
 
 	go s.lc.WatchContext(ctx)
@@ -71,10 +88,11 @@ type service struct {
 	bus pubsub.Bus
 	sub pubsub.Subscriber
 
-	statusch        chan chan<- *Status
-	orders          map[string]*order
-	drainch         chan *order
-	pricingStrategy BidPricingStrategy
+	statusch chan chan<- *Status
+	orders   map[string]*order
+	rejected map[string]uint32
+	drainch  chan *order
+	settings *Settings
 
 	lc lifecycle.Lifecycle
 }
@@ -116,13 +134,14 @@ func (s *service) run(existingOrders []existingOrder) {
 	for _, eo := range existingOrders {
 		key := mquery.OrderPath(eo.order.OrderID)
 		s.session.Log().Debug("running order", "order", key)
-		order, err := newOrder(s, eo.order.OrderID, eo.bid, s.pricingStrategy)
+		order, err := newOrder(s, eo.order.OrderID, eo.bid)
 		if err != nil {
 			s.session.Log().Error("creating catchup order", "order", key, "err", err)
 			continue
 		}
 		s.orders[key] = order
 	}
+	orderManagerGauge.Set(float64(len(s.orders)))
 
 loop:
 	for {
@@ -132,12 +151,13 @@ loop:
 			break loop
 
 		case ev := <-s.sub.Events():
-			switch ev := ev.(type) { // nolint: gocritic
+			switch ev := ev.(type) {
 			case mtypes.EventOrderCreated:
 				// new order
 				key := mquery.OrderPath(ev.ID)
 
 				s.session.Log().Info("order detected", "order", key)
+				ordersDetectedCounter.Inc()
 
 				if order := s.orders[key]; order != nil {
 					s.session.Log().Debug("existing order", "order", key)
@@ -145,7 +165,7 @@ loop:
 				}
 
 				// create an order object for managing the bid process and order lifecycle
-				order, err := newOrder(s, ev.ID, nil, s.pricingStrategy)
+				order, err := newOrder(s, ev.ID, nil)
 				if err != nil {
 					// todo: handle error
 					s.session.Log().Error("handling order", "order", key, "err", err)
@@ -153,15 +173,29 @@ loop:
 				}
 
 				s.orders[key] = order
+				orderManagerGauge.Set(float64(len(s.orders)))
+
+			case event.ManifestRejected:
+				// the order was won, but its manifest is not acceptable
+				s.rejected[ev.Reason]++
 			}
 		case ch := <-s.statusch:
+			rejected := make(map[string]uint32, len(s.rejected))
+			for reason, count := range s.rejected {
+				rejected[reason] = count
+			}
 			ch <- &Status{
-				Orders: uint32(len(s.orders)),
+				Orders:   uint32(len(s.orders)),
+				Rejected: rejected,
 			}
 		case order := <-s.drainch:
 			// child done
 			key := mquery.OrderPath(order.orderID)
 			delete(s.orders, key)
+			orderManagerGauge.Set(float64(len(s.orders)))
+			if order.rejected != "" {
+				s.rejected[order.rejected]++
+			}
 		}
 	}
 
@@ -169,6 +203,7 @@ loop:
 	for len(s.orders) > 0 {
 		key := mquery.OrderPath((<-s.drainch).orderID)
 		delete(s.orders, key)
+		orderManagerGauge.Set(float64(len(s.orders)))
 	}
 }
 
@@ -177,19 +212,18 @@ type existingOrder struct {
 	bid   *mtypes.Bid
 }
 
-func queryExistingOrders(_ context.Context, session session.Session) ([]existingOrder, error) {
-	params := &mtypes.QueryOrdersRequest{
-		Filters: mtypes.OrderFilters{},
-		Pagination: &sdkquery.PageRequest{
-			Limit: 10000,
-		},
-	}
-	res, err := session.Client().Query().Orders(context.Background(), params)
+func queryExistingOrders(ctx context.Context, session session.Session) ([]existingOrder, error) {
+	orders, err := queryOpenOrders(ctx, session)
 	if err != nil {
 		session.Log().Error("error querying open orders:", "err", err)
 		return nil, err
 	}
-	orders := res.Orders
+
+	bids, err := queryOpenBids(ctx, session)
+	if err != nil {
+		session.Log().Error("error querying open bids:", "err", err)
+		return nil, err
+	}
 
 	existingOrders := make([]existingOrder, 0)
 	for i := range orders {
@@ -198,22 +232,64 @@ func queryExistingOrders(_ context.Context, session session.Session) ([]existing
 			continue
 		}
 
-		eo := existingOrder{order: pOrder}
+		existingOrders = append(existingOrders, existingOrder{
+			order: pOrder,
+			bid:   bids[pOrder.OrderID],
+		})
+	}
+
+	return existingOrders, nil
+}
+
+// queryOpenOrders returns the open orders of all pages
+func queryOpenOrders(ctx context.Context, session session.Session) ([]mtypes.Order, error) {
+	var orders []mtypes.Order
 
-		res, _ := session.Client().Query().Bid(
-			context.Background(),
-			&mtypes.QueryBidRequest{
-				ID: mtypes.MakeBidID(pOrder.OrderID, session.Provider().Address()),
-			},
-		)
+	params := &mtypes.QueryOrdersRequest{
+		Filters: mtypes.OrderFilters{
+			State: mtypes.OrderOpen.String(),
+		},
+		Pagination: &sdkquery.PageRequest{},
+	}
 
-		bid := res.GetBid()
+	for {
+		res, err := session.Client().Query().Orders(ctx, params)
+		if err != nil {
+			return nil, err
+		}
 
-		eo.bid = &bid
+		orders = append(orders, res.Orders...)
 
-		existingOrders = append(existingOrders, eo)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return orders, nil
+		}
+		params.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
 	}
+}
 
-	return existingOrders, nil
+// queryOpenBids returns the open bids of the provider of all pages, by order
+func queryOpenBids(ctx context.Context, session session.Session) (map[mtypes.OrderID]*mtypes.Bid, error) {
+	bids := make(map[mtypes.OrderID]*mtypes.Bid)
 
+	params := &mtypes.QueryBidsByProviderRequest{
+		Provider:   session.Provider().Address().String(),
+		State:      mtypes.BidOpen.String(),
+		Pagination: &sdkquery.PageRequest{},
+	}
+
+	for {
+		res, err := session.Client().Query().BidsByProvider(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Bids {
+			bids[res.Bids[i].ID().OrderID()] = &res.Bids[i]
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return bids, nil
+		}
+		params.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+	}
 }
@@ -0,0 +1,77 @@
+package bidengine
+
+import (
+	"context"
+	"testing"
+
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientmocks "github.com/ovrclk/akash/client/mocks"
+	"github.com/ovrclk/akash/provider/session"
+	"github.com/ovrclk/akash/testutil"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+func Test_QueryExistingOrdersFollowsPages(t *testing.T) {
+	provider := testutil.AccAddress(t)
+
+	orders := make([]mtypes.Order, 0, 3)
+	for i := 0; i < 3; i++ {
+		orders = append(orders, mtypes.Order{
+			OrderID: mtypes.MakeOrderID(dtypes.MakeGroupID(testutil.DeploymentID(t), 1), 1),
+			State:   mtypes.OrderOpen,
+		})
+	}
+	bid := mtypes.Bid{
+		BidID: mtypes.MakeBidID(orders[2].OrderID, provider),
+		State: mtypes.BidOpen,
+	}
+
+	queryClient := &clientmocks.QueryClient{}
+	queryClient.On("Orders", mock.Anything, mock.MatchedBy(func(req *mtypes.QueryOrdersRequest) bool {
+		return req.Pagination.Key == nil
+	})).Return(&mtypes.QueryOrdersResponse{
+		Orders:     orders[:2],
+		Pagination: &sdkquery.PageResponse{NextKey: []byte("orders")},
+	}, nil)
+	queryClient.On("Orders", mock.Anything, mock.MatchedBy(func(req *mtypes.QueryOrdersRequest) bool {
+		return string(req.Pagination.Key) == "orders"
+	})).Return(&mtypes.QueryOrdersResponse{
+		Orders:     orders[2:],
+		Pagination: &sdkquery.PageResponse{},
+	}, nil)
+
+	queryClient.On("BidsByProvider", mock.Anything, mock.MatchedBy(func(req *mtypes.QueryBidsByProviderRequest) bool {
+		return req.Pagination.Key == nil
+	})).Return(&mtypes.QueryBidsByProviderResponse{
+		Pagination: &sdkquery.PageResponse{NextKey: []byte("bids")},
+	}, nil)
+	queryClient.On("BidsByProvider", mock.Anything, mock.MatchedBy(func(req *mtypes.QueryBidsByProviderRequest) bool {
+		return string(req.Pagination.Key) == "bids"
+	})).Return(&mtypes.QueryBidsByProviderResponse{
+		Bids: []mtypes.Bid{bid},
+	}, nil)
+
+	client := &clientmocks.Client{}
+	client.On("Query").Return(queryClient)
+
+	sess := session.New(testutil.Logger(t), client, &ptypes.Provider{Owner: provider.String()})
+
+	existing, err := queryExistingOrders(context.Background(), sess)
+	require.NoError(t, err)
+	require.Len(t, existing, 3)
+
+	for i, eo := range existing {
+		require.Equal(t, orders[i].OrderID, eo.order.OrderID)
+	}
+	require.Nil(t, existing[0].bid)
+	require.Nil(t, existing[1].bid)
+	require.Equal(t, &bid, existing[2].bid)
+
+	queryClient.AssertNumberOfCalls(t, "Orders", 2)
+	queryClient.AssertNumberOfCalls(t, "BidsByProvider", 2)
+}
@@ -0,0 +1,124 @@
+package bidengine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ovrclk/akash/types"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+)
+
+var errPricingStrategyRequired = errors.New("a bid pricing strategy is required")
+
+// Filters decline orders the provider could fulfill but does not want to bid on.
+// Zero values disable the corresponding filter.
+type Filters struct {
+	// MaxGroupCPU is the largest total of cpu units, in millicpu, of a group bid on
+	MaxGroupCPU uint64
+	// MaxGroupMemory is the largest total of memory, in bytes, of a group bid on
+	MaxGroupMemory uint64
+	// MaxGroupStorage is the largest total of storage, in bytes, of a group bid on
+	MaxGroupStorage uint64
+	// RequiredAttributes must each match a placement requirement of a group bid on.
+	// Keys and values are wildcard patterns, see package wildcard.
+	RequiredAttributes types.Attributes
+	// DeniedAttributes must not match any placement requirement of a group bid on.
+	// Keys and values are wildcard patterns, see package wildcard.
+	DeniedAttributes types.Attributes
+	// AllowedOwners, when not empty, are the only tenants bid for
+	AllowedOwners []string
+	// DeniedOwners are the tenants never bid for
+	DeniedOwners []string
+	// MinPrice is the lowest price per unit of each resource a group may offer
+	MinPrice ResourcePrices
+	// Tenant limits the leases a single tenant holds with the provider
+	Tenant TenantLimits
+}
+
+// check returns the reason the group is declined, or an empty string if it passes all filters
+func (f Filters) check(gspec *dtypes.GroupSpec, owner string) string {
+	if len(f.AllowedOwners) != 0 && !containsString(f.AllowedOwners, owner) {
+		return "policy-owner-not-allowed"
+	}
+
+	if containsString(f.DeniedOwners, owner) {
+		return "policy-owner-denied"
+	}
+
+	cpu, memory, storage := groupResources(gspec)
+
+	switch {
+	case f.MaxGroupCPU != 0 && cpu > f.MaxGroupCPU:
+		return "filter-cpu"
+	case f.MaxGroupMemory != 0 && memory > f.MaxGroupMemory:
+		return "filter-memory"
+	case f.MaxGroupStorage != 0 && storage > f.MaxGroupStorage:
+		return "filter-storage"
+	}
+
+	for _, rule := range f.RequiredAttributes {
+		if !attributeRuleMatches(rule, gspec.Requirements) {
+			return "filter-required-attributes"
+		}
+	}
+
+	for _, rule := range f.DeniedAttributes {
+		if attributeRuleMatches(rule, gspec.Requirements) {
+			return "filter-denied-attributes"
+		}
+	}
+
+	if !f.MinPrice.accepts(gspec) {
+		return "policy-min-price"
+	}
+
+	return ""
+}
+
+// Settings holds the bid engine parameters which may be replaced while the provider is running.
+// Orders read the current values each time they use them, so orders in flight are not dropped
+// when the settings change.
+type Settings struct {
+	pricing BidPricingStrategy
+	filters Filters
+	lock    sync.RWMutex
+}
+
+// NewSettings returns settings with the given pricing strategy and filters
+func NewSettings(pricing BidPricingStrategy, filters Filters) (*Settings, error) {
+	if pricing == nil {
+		return nil, errPricingStrategyRequired
+	}
+
+	return &Settings{
+		pricing: pricing,
+		filters: filters,
+	}, nil
+}
+
+// Update replaces the pricing strategy and filters
+func (s *Settings) Update(pricing BidPricingStrategy, filters Filters) error {
+	if pricing == nil {
+		return errPricingStrategyRequired
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pricing = pricing
+	s.filters = filters
+
+	return nil
+}
+
+func (s *Settings) pricingStrategy() BidPricingStrategy {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.pricing
+}
+
+func (s *Settings) orderFilters() Filters {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.filters
+}
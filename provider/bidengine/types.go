@@ -3,4 +3,7 @@ package bidengine
 // Status stores orders
 type Status struct {
 	Orders uint32 `json:"orders"`
+	// Rejected counts the orders rejected by the operator's filters and policies, by reason,
+	// including the leases closed because their manifest was rejected
+	Rejected map[string]uint32 `json:"rejected,omitempty"`
 }
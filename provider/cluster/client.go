@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/ovrclk/akash/manifest"
 	ctypes "github.com/ovrclk/akash/provider/cluster/types"
@@ -17,13 +19,42 @@ import (
 
 var _ Client = (*nullClient)(nil)
 
-// ErrNoDeployments indicates no deployments exist
-var ErrNoDeployments = errors.New("no deployments")
+var (
+	// ErrNoDeployments indicates no deployments exist
+	ErrNoDeployments = errors.New("no deployments")
+	// ErrExecNotSupported indicates the cluster can not run commands in lease containers
+	ErrExecNotSupported = errors.New("exec not supported")
+	// ErrExecPodIndexOutOfRange indicates the service has no replica with the requested index
+	ErrExecPodIndexOutOfRange = errors.New("pod index out of range")
+	// ErrNoManifestForLease indicates no manifest is stored for the lease
+	ErrNoManifestForLease = errors.New("no manifest for lease")
+)
 
 type ReadClient interface {
 	LeaseStatus(context.Context, mtypes.LeaseID) (*ctypes.LeaseStatus, error)
 	ServiceStatus(context.Context, mtypes.LeaseID, string) (*ctypes.ServiceStatus, error)
-	ServiceLogs(context.Context, mtypes.LeaseID, string, bool, *int64) ([]*ctypes.ServiceLog, error)
+	ServiceLogs(context.Context, mtypes.LeaseID, ctypes.ServiceLogOptions) ([]*ctypes.ServiceLog, error)
+
+	// LeaseManifest returns the manifest group deployed for the lease
+	LeaseManifest(context.Context, mtypes.LeaseID) (*manifest.Group, error)
+
+	// LeaseEvents streams the events of the objects of the lease. Without follow
+	// the channel is closed once the recorded events are sent, otherwise when
+	// the context is done.
+	LeaseEvents(context.Context, mtypes.LeaseID, bool) (<-chan ctypes.LeaseEvent, error)
+
+	// Exec runs cmd in the replica podIndex of the service. A non-zero exit code
+	// of the command is reported through the result rather than an error.
+	Exec(ctx context.Context,
+		lid mtypes.LeaseID,
+		service string,
+		podIndex uint,
+		cmd []string,
+		stdin io.Reader,
+		stdout io.Writer,
+		stderr io.Writer,
+		tty bool,
+		tsq ctypes.TerminalSizeQueue) (ctypes.ExecResult, error)
 }
 
 // Client interface lease and deployment methods
@@ -33,11 +64,13 @@ type Client interface {
 	TeardownLease(context.Context, mtypes.LeaseID) error
 	Deployments(context.Context) ([]ctypes.Deployment, error)
 	Inventory(context.Context) ([]ctypes.Node, error)
+	StorageClasses(context.Context) ([]ctypes.StorageClass, error)
 }
 
 type node struct {
 	id                 string
 	availableResources atypes.ResourceUnits
+	usedResources      atypes.ResourceUnits
 }
 
 // NewNode returns new Node instance with provided details
@@ -45,6 +78,11 @@ func NewNode(id string, available atypes.ResourceUnits) ctypes.Node {
 	return &node{id: id, availableResources: available}
 }
 
+// NewNodeWithUsage returns new Node instance with the resources measured in use on it
+func NewNodeWithUsage(id string, available, used atypes.ResourceUnits) ctypes.Node {
+	return &node{id: id, availableResources: available, usedResources: used}
+}
+
 // ID returns id of node
 func (n *node) ID() string {
 	return n.id
@@ -59,6 +97,11 @@ func (n *node) Available() atypes.ResourceUnits {
 	return n.availableResources
 }
 
+// Used returns the units measured in use on node
+func (n *node) Used() atypes.ResourceUnits {
+	return n.usedResources
+}
+
 const (
 	// 5 CPUs, 5Gi memory for null client.
 	nullClientCPU     = 5000
@@ -72,14 +115,36 @@ type nullClient struct {
 }
 
 // NewServiceLog creates and returns a service log with provided details
-func NewServiceLog(name string, stream io.ReadCloser) *ctypes.ServiceLog {
+func NewServiceLog(service, name string, restartCount int32, stream io.ReadCloser) *ctypes.ServiceLog {
 	return &ctypes.ServiceLog{
-		Name:    name,
-		Stream:  stream,
-		Scanner: bufio.NewScanner(stream),
+		Service:      service,
+		Name:         name,
+		RestartCount: restartCount,
+		Stream:       stream,
+		Scanner:      bufio.NewScanner(stream),
 	}
 }
 
+// ParseServiceLogLine splits a line of a service log into its timestamp and message.
+// Lines without a valid timestamp are returned whole with a zero time.
+func ParseServiceLogLine(line string) (time.Time, string) {
+	idx := strings.IndexByte(line, ' ')
+	if idx < 0 {
+		idx = len(line)
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, line[:idx])
+	if err != nil {
+		return time.Time{}, line
+	}
+
+	if idx == len(line) {
+		return ts, ""
+	}
+
+	return ts, line[idx+1:]
+}
+
 // NullClient returns nullClient instance
 func NullClient() Client {
 	return &nullClient{
@@ -121,10 +186,33 @@ func (c *nullClient) ServiceStatus(ctx context.Context, _ mtypes.LeaseID, _ stri
 	return nil, nil
 }
 
-func (c *nullClient) ServiceLogs(_ context.Context, _ mtypes.LeaseID, _ string, _ bool, _ *int64) ([]*ctypes.ServiceLog, error) {
+func (c *nullClient) LeaseManifest(_ context.Context, lid mtypes.LeaseID) (*manifest.Group, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	mgroup, ok := c.leases[mquery.LeasePath(lid)]
+	if !ok {
+		return nil, ErrNoManifestForLease
+	}
+
+	return mgroup, nil
+}
+
+func (c *nullClient) ServiceLogs(_ context.Context, _ mtypes.LeaseID, _ ctypes.ServiceLogOptions) ([]*ctypes.ServiceLog, error) {
 	return nil, nil
 }
 
+func (c *nullClient) LeaseEvents(_ context.Context, _ mtypes.LeaseID, _ bool) (<-chan ctypes.LeaseEvent, error) {
+	ch := make(chan ctypes.LeaseEvent)
+	close(ch)
+	return ch, nil
+}
+
+func (c *nullClient) Exec(_ context.Context, _ mtypes.LeaseID, _ string, _ uint, _ []string,
+	_ io.Reader, _ io.Writer, _ io.Writer, _ bool, _ ctypes.TerminalSizeQueue) (ctypes.ExecResult, error) {
+	return nil, ErrExecNotSupported
+}
+
 func (c *nullClient) TeardownLease(ctx context.Context, lid mtypes.LeaseID) error {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
@@ -137,6 +225,10 @@ func (c *nullClient) Deployments(ctx context.Context) ([]ctypes.Deployment, erro
 	return nil, nil
 }
 
+func (c *nullClient) StorageClasses(ctx context.Context) ([]ctypes.StorageClass, error) {
+	return nil, nil
+}
+
 func (c *nullClient) Inventory(ctx context.Context) ([]ctypes.Node, error) {
 	return []ctypes.Node{
 		NewNode("solo", atypes.ResourceUnits{
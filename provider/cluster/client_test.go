@@ -0,0 +1,22 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseServiceLogLine(t *testing.T) {
+	ts, msg := ParseServiceLogLine("2020-11-05T10:20:30.123456789Z listening on :8080")
+	assert.Equal(t, time.Date(2020, 11, 5, 10, 20, 30, 123456789, time.UTC), ts.UTC())
+	assert.Equal(t, "listening on :8080", msg)
+
+	ts, msg = ParseServiceLogLine("2020-11-05T10:20:30Z")
+	assert.False(t, ts.IsZero())
+	assert.Equal(t, "", msg)
+
+	ts, msg = ParseServiceLogLine("no timestamp here")
+	assert.True(t, ts.IsZero())
+	assert.Equal(t, "no timestamp here", msg)
+}
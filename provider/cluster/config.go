@@ -1,11 +1,17 @@
 package cluster
 
-import "time"
+import (
+	"time"
+
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+)
 
 type Config struct {
 	InventoryResourcePollPeriod     time.Duration
 	InventoryResourceDebugFrequency uint
 	InventoryExternalPortQuantity   uint
+	InventoryRequestPolicy          ctypes.RequestPolicy
+	InventoryNodeAttributes         ctypes.NodeAttributes
 }
 
 func NewDefaultConfig() Config {
@@ -3,9 +3,13 @@ package cluster
 import (
 	"context"
 	"errors"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/boz/go-lifecycle"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tendermint/tendermint/libs/log"
 
 	ctypes "github.com/ovrclk/akash/provider/cluster/types"
@@ -23,6 +27,28 @@ var (
 	ErrInsufficientCapacity = errors.New("insufficient capacity")
 )
 
+var (
+	inventoryNodeResourcesGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "provider_inventory_node_resources",
+		Help: "The cpu, memory and storage of each node, available, reserved for pending leases or measured in use",
+	}, []string{"node", "resource", "state"})
+
+	inventoryExternalPortsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "provider_inventory_external_ports",
+		Help: "The external ports of the cluster, available or reserved for pending leases",
+	}, []string{"state"})
+
+	inventoryStorageClassGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "provider_inventory_storage_class_bytes",
+		Help: "The persistent storage of each storage class, available or reserved for pending leases",
+	}, []string{"class", "state"})
+
+	inventoryReservationsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "provider_inventory_reservations",
+		Help: "The number of reservations, pending or active",
+	}, []string{"state"})
+)
+
 type inventoryService struct {
 	config Config
 	client Client
@@ -183,6 +209,7 @@ func (is *inventoryService) run(reservations []*reservation) {
 	defer t.Stop()
 
 	var inventory []ctypes.Node
+	var storage []ctypes.StorageClass
 	ready := false
 
 	// Run an inventory check immediately.
@@ -227,6 +254,7 @@ loop:
 
 					break
 				}
+				updateInventoryMetrics(is.config, inventory, storage, is.availableExternalPorts, reservations)
 			}
 
 		case req := <-is.reservech:
@@ -235,9 +263,10 @@ loop:
 
 			is.log.Debug("reservation requested", "order", req.order, "resources", req.resources)
 
-			if reservationAllocateable(inventory, is.availableExternalPorts, reservations, reservation) {
+			if reservationAllocateable(is.config, inventory, storage, is.availableExternalPorts, reservations, reservation) {
 				reservations = append(reservations, reservation)
 				req.ch <- inventoryResponse{value: reservation}
+				updateInventoryMetrics(is.config, inventory, storage, is.availableExternalPorts, reservations)
 				break
 			}
 
@@ -275,13 +304,14 @@ loop:
 				reservations = append(reservations[:idx], reservations[idx+1:]...)
 
 				req.ch <- inventoryResponse{value: res}
+				updateInventoryMetrics(is.config, inventory, storage, is.availableExternalPorts, reservations)
 				continue loop
 			}
 
 			req.ch <- inventoryResponse{err: errNotFound}
 
 		case ch := <-is.statusch:
-			ch <- is.getStatus(inventory, reservations)
+			ch <- is.getStatus(inventory, storage, reservations)
 
 		case <-t.C:
 			// run cluster inventory check
@@ -309,7 +339,10 @@ loop:
 				close(is.readych)
 			}
 
-			inventory = res.Value().([]ctypes.Node)
+			checked := res.Value().(clusterInventory)
+			inventory = checked.nodes
+			storage = checked.storage
+			updateInventoryMetrics(is.config, inventory, storage, is.availableExternalPorts, reservations)
 			if fetchCount%is.config.InventoryResourceDebugFrequency == 0 {
 				is.log.Debug("inventory fetched", "nodes", len(inventory))
 				for _, node := range inventory {
@@ -320,6 +353,9 @@ loop:
 						"available-memory", available.Memory,
 						"available-storage", available.Storage)
 				}
+				for _, class := range storage {
+					is.log.Debug("storage class", "class", class.Name, "available", class.Available)
+				}
 			}
 			fetchCount++
 		}
@@ -331,13 +367,29 @@ loop:
 	}
 }
 
+// clusterInventory is the result of an inventory check
+type clusterInventory struct {
+	nodes   []ctypes.Node
+	storage []ctypes.StorageClass
+}
+
 func (is *inventoryService) runCheck(ctx context.Context) <-chan runner.Result {
 	return runner.Do(func() runner.Result {
-		return runner.NewResult(is.client.Inventory(ctx))
+		nodes, err := is.client.Inventory(ctx)
+		if err != nil {
+			return runner.NewResult(nil, err)
+		}
+
+		storage, err := is.client.StorageClasses(ctx)
+		if err != nil {
+			return runner.NewResult(nil, err)
+		}
+
+		return runner.NewResult(clusterInventory{nodes: nodes, storage: storage}, nil)
 	})
 }
 
-func (is *inventoryService) getStatus(inventory []ctypes.Node, reservations []*reservation) ctypes.InventoryStatus {
+func (is *inventoryService) getStatus(inventory []ctypes.Node, storage []ctypes.StorageClass, reservations []*reservation) ctypes.InventoryStatus {
 	status := ctypes.InventoryStatus{}
 	for _, reserve := range reservations {
 		total := atypes.ResourceUnits{}
@@ -360,10 +412,80 @@ func (is *inventoryService) getStatus(inventory []ctypes.Node, reservations []*r
 		status.Available = append(status.Available, node.Available())
 	}
 
+	status.AvailableStorage = storage
+
 	return status
 }
 
-func reservationAllocateable(inventory []ctypes.Node, externalPortsAvailable uint, reservations []*reservation, newReservation *reservation) bool {
+// updateInventoryMetrics reports the available resources of every node along with
+// the part of them reserved for pending leases.
+func updateInventoryMetrics(config Config, inventory []ctypes.Node, storage []ctypes.StorageClass, externalPortsAvailable uint, reservations []*reservation) {
+	remaining := inventory
+	remainingStorage := storage
+
+	var reservedPorts uint
+	var pending, active int
+
+	for _, res := range reservations {
+		if res.allocated {
+			active++
+			continue
+		}
+		pending++
+		reservedPorts += reservationCountEndpoints(res)
+
+		// ports are accounted for separately
+		if adjusted, adjustedStorage, _, _ := reservationAdjustInventory(config, remaining, remainingStorage, math.MaxUint32, res); adjusted != nil {
+			remaining = adjusted
+			remainingStorage = adjustedStorage
+		}
+	}
+
+	inventoryNodeResourcesGauge.Reset()
+	for idx, node := range inventory {
+		acpu, amemory, astorage := resourceUnitsValues(node.Available())
+		rcpu, rmemory, rstorage := resourceUnitsValues(remaining[idx].Available())
+		ucpu, umemory, ustorage := resourceUnitsValues(node.Used())
+
+		id := node.ID()
+		inventoryNodeResourcesGauge.WithLabelValues(id, "cpu", "available").Set(float64(acpu))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "memory", "available").Set(float64(amemory))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "storage", "available").Set(float64(astorage))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "cpu", "reserved").Set(float64(acpu - rcpu))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "memory", "reserved").Set(float64(amemory - rmemory))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "storage", "reserved").Set(float64(astorage - rstorage))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "cpu", "used").Set(float64(ucpu))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "memory", "used").Set(float64(umemory))
+		inventoryNodeResourcesGauge.WithLabelValues(id, "storage", "used").Set(float64(ustorage))
+	}
+
+	inventoryStorageClassGauge.Reset()
+	for idx, class := range storage {
+		inventoryStorageClassGauge.WithLabelValues(class.Name, "available").Set(float64(class.Available))
+		inventoryStorageClassGauge.WithLabelValues(class.Name, "reserved").Set(float64(class.Available - remainingStorage[idx].Available))
+	}
+
+	inventoryExternalPortsGauge.WithLabelValues("available").Set(float64(externalPortsAvailable))
+	inventoryExternalPortsGauge.WithLabelValues("reserved").Set(float64(reservedPorts))
+
+	inventoryReservationsGauge.WithLabelValues("pending").Set(float64(pending))
+	inventoryReservationsGauge.WithLabelValues("active").Set(float64(active))
+}
+
+func resourceUnitsValues(ru atypes.ResourceUnits) (cpu, memory, storage uint64) {
+	if ru.CPU != nil {
+		cpu = ru.CPU.Units.Value()
+	}
+	if ru.Memory != nil {
+		memory = ru.Memory.Quantity.Value()
+	}
+	if ru.Storage != nil {
+		storage = ru.Storage.Quantity.Value()
+	}
+	return cpu, memory, storage
+}
+
+func reservationAllocateable(config Config, inventory []ctypes.Node, storage []ctypes.StorageClass, externalPortsAvailable uint, reservations []*reservation, newReservation *reservation) bool {
 	// 1. for each unallocated reservation, subtract its resources
 	//    from inventory.
 	// 2. subtract resources for new reservation from inventory.
@@ -375,13 +497,13 @@ func reservationAllocateable(inventory []ctypes.Node, externalPortsAvailable uin
 		if res.allocated {
 			continue
 		}
-		inventory, externalPortsAvailable, ok = reservationAdjustInventory(inventory, externalPortsAvailable, res)
+		inventory, storage, externalPortsAvailable, ok = reservationAdjustInventory(config, inventory, storage, externalPortsAvailable, res)
 		if !ok {
 			return false
 		}
 	}
 
-	_, _, ok = reservationAdjustInventory(inventory, externalPortsAvailable, newReservation)
+	_, _, _, ok = reservationAdjustInventory(config, inventory, storage, externalPortsAvailable, newReservation)
 
 	return ok
 }
@@ -390,55 +512,142 @@ func reservationCountEndpoints(reservation *reservation) uint {
 	var externalPortCount uint
 
 	resources := reservation.Resources().GetResources()
-	// Count the number of endpoints per resource. The number of instances does not affect
-	// the number of ports
+	// Count the number of endpoints per resource that need a node port. The number of
+	// instances does not affect the number of ports
 	for _, resource := range resources {
-		externalPortCount += uint(len(resource.Resources.Endpoints))
+		externalPortCount += atypes.CountRandomPortEndpoints(resource.Resources.Endpoints)
 	}
 
 	return externalPortCount
 }
 
-func reservationAdjustInventory(prevInventory []ctypes.Node, externalPortsAvailable uint, reservation *reservation) ([]ctypes.Node, uint, bool) {
-	// for each node in the inventory
-	//   subtract resource capacity from node capacity if the former will fit in the latter
-	//   remove resource capacity that fit in node capacity from requested resource capacity
-	// return remaining inventory, true iff all resources are able to fit
+func reservationAdjustInventory(config Config, prevInventory []ctypes.Node, prevStorage []ctypes.StorageClass, externalPortsAvailable uint, reservation *reservation) ([]ctypes.Node, []ctypes.StorageClass, uint, bool) {
+	// subtract persistent volumes from the capacity of their storage class
+	// take the requests of the containers, rather than their limits, from the nodes
+	// place every replica, largest first, on the first node it fits on and which holds
+	// the node attributes it requires
+	// return remaining inventory, true iff all replicas are able to fit
 
-	resources := make([]atypes.Resources, len(reservation.resources.GetResources()))
-	copy(resources, reservation.resources.GetResources())
-
-	inventory := make([]ctypes.Node, 0, len(prevInventory))
+	resources := reservation.resources.GetResources()
 
 	externalPortCount := reservationCountEndpoints(reservation)
 	if externalPortsAvailable < externalPortCount {
-		return nil, 0, false
+		return nil, nil, 0, false
 	}
 	externalPortsAvailable -= externalPortCount
 
+	storage, ok := reservationAdjustStorage(prevStorage, resources)
+	if !ok {
+		return nil, nil, 0, false
+	}
+
+	var replicas []atypes.ResourceUnits
+	for _, resource := range resources {
+		// persistent volumes do not use the storage of the nodes, only their ephemeral storage
+		requests := config.InventoryRequestPolicy.Requests(resource.Resources)
+
+		for count := resource.Count; count > 0; count-- {
+			replicas = append(replicas, requests)
+		}
+	}
+
+	sort.SliceStable(replicas, func(i, j int) bool {
+		return replicaLarger(replicas[i], replicas[j])
+	})
+
+	available := make([]atypes.ResourceUnits, 0, len(prevInventory))
 	for _, node := range prevInventory {
-		available := node.Available()
-		curResources := resources[:0]
-
-		for _, resource := range resources {
-			for ; resource.Count > 0; resource.Count-- {
-				var err error
-				var remaining atypes.ResourceUnits
-				if remaining, err = available.Sub(resource.Resources); err != nil {
-					break
-				}
+		available = append(available, copyResourceUnits(node.Available()))
+	}
 
-				available = remaining
+replicas:
+	for _, replica := range replicas {
+		for idx := range available {
+			if !config.InventoryNodeAttributes.Match(available[idx], replica) {
+				continue
 			}
 
-			if resource.Count > 0 {
-				curResources = append(curResources, resource)
+			// subtracting modifies the resource units, even when it fails
+			remaining, err := copyResourceUnits(available[idx]).Sub(replica)
+			if err != nil {
+				continue
+			}
+
+			available[idx] = remaining
+			continue replicas
+		}
+
+		return nil, nil, 0, false
+	}
+
+	inventory := make([]ctypes.Node, 0, len(prevInventory))
+	for idx, node := range prevInventory {
+		inventory = append(inventory, NewNodeWithUsage(node.ID(), available[idx], node.Used()))
+	}
+
+	return inventory, storage, externalPortsAvailable, true
+}
+
+// replicaLarger orders replicas by memory, which is never oversold, then CPU and storage
+func replicaLarger(lhs, rhs atypes.ResourceUnits) bool {
+	lcpu, lmemory, lstorage := resourceUnitsValues(lhs)
+	rcpu, rmemory, rstorage := resourceUnitsValues(rhs)
+
+	if lmemory != rmemory {
+		return lmemory > rmemory
+	}
+	if lcpu != rcpu {
+		return lcpu > rcpu
+	}
+	return lstorage > rstorage
+}
+
+// copyResourceUnits returns units with its own CPU, memory and storage, as subtracting
+// from resource units modifies them in place
+func copyResourceUnits(units atypes.ResourceUnits) atypes.ResourceUnits {
+	if units.CPU != nil {
+		cpu := *units.CPU
+		units.CPU = &cpu
+	}
+	if units.Memory != nil {
+		memory := *units.Memory
+		units.Memory = &memory
+	}
+	if units.Storage != nil {
+		storage := *units.Storage
+		units.Storage = &storage
+	}
+	return units
+}
+
+// reservationAdjustStorage subtracts the persistent volumes of every replica of resources from
+// the capacity of their storage class. It returns false if a class is unknown or full.
+func reservationAdjustStorage(prevStorage []ctypes.StorageClass, resources []atypes.Resources) ([]ctypes.StorageClass, bool) {
+	storage := make([]ctypes.StorageClass, len(prevStorage))
+	copy(storage, prevStorage)
+
+resources:
+	for _, resource := range resources {
+		if !resource.Resources.Storage.IsPersistent() {
+			continue
+		}
+
+		size := resource.Resources.Storage.Quantity.Value() * uint64(resource.Count)
+		class := resource.Resources.Storage.Class()
+
+		for idx := range storage {
+			if storage[idx].Name != class {
+				continue
+			}
+			if storage[idx].Available < size {
+				return nil, false
 			}
+			storage[idx].Available -= size
+			continue resources
 		}
 
-		resources = curResources
-		inventory = append(inventory, NewNode(node.ID(), available))
+		return nil, false
 	}
 
-	return inventory, externalPortsAvailable, len(resources) == 0
+	return storage, true
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/testutil"
 	atypes "github.com/ovrclk/akash/types"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -31,6 +32,9 @@ func newResourceUnits() types.ResourceUnits {
 func TestInventory_reservationAllocateable(t *testing.T) {
 	mkrg := func(cpu uint64, memory uint64, storage uint64, endpointsCount uint, count uint32) dtypes.Resource {
 		endpoints := make([]types.Endpoint, endpointsCount)
+		for i := range endpoints {
+			endpoints[i].Kind = types.EndpointRandomPort
+		}
 		return dtypes.Resource{
 			Resources: types.ResourceUnits{
 				CPU: &types.CPU{
@@ -82,13 +86,243 @@ func TestInventory_reservationAllocateable(t *testing.T) {
 
 	externalPortQuantity := uint(3)
 
-	assert.Equal(t, tests[0].ok, reservationAllocateable(inventory, externalPortQuantity, reservations, tests[0].res))
+	assert.Equal(t, tests[0].ok, reservationAllocateable(Config{}, inventory, nil, externalPortQuantity, reservations, tests[0].res))
 	reservations[0].allocated = true
 	reservations[1].allocated = true
 
-	for _, test := range tests[1:] {
-		assert.Equal(t, test.ok, reservationAllocateable(inventory, externalPortQuantity, reservations, test.res))
+	// the requests of the pods of allocated reservations are taken from the inventory
+	inventory = []ctypes.Node{
+		NewNode("a", types.ResourceUnits{
+			CPU:     &types.CPU{Units: types.NewResourceValue(150)},
+			Memory:  &types.Memory{Quantity: types.NewResourceValue(3 * unit.Gi)},
+			Storage: &types.Storage{Quantity: types.NewResourceValue(10 * unit.Gi)},
+		}),
+		NewNode("b", types.ResourceUnits{
+			CPU:     &types.CPU{Units: types.NewResourceValue(200)},
+			Memory:  &types.Memory{Quantity: types.NewResourceValue(8 * unit.Gi)},
+			Storage: &types.Storage{Quantity: types.NewResourceValue(50 * unit.Gi)},
+		}),
+	}
+
+	tests = append(tests[1:], []struct {
+		res *reservation
+		ok  bool
+	}{
+		{mkres(false, mkrg(120, 1*unit.G, 1*unit.Gi, 0, 2)), true},
+		// every replica must fit on a node, not just the sum of them on the cluster
+		{mkres(false, mkrg(175, 1*unit.G, 1*unit.Gi, 0, 2)), false},
+	}...)
+
+	for _, test := range tests {
+		assert.Equal(t, test.ok, reservationAllocateable(Config{}, inventory, nil, externalPortQuantity, reservations, test.res))
+	}
+
+	// the inventory is left alone
+	assert.Equal(t, uint64(150), inventory[0].Available().CPU.Units.Value())
+}
+
+func TestInventory_reservationAllocateablePersistentStorage(t *testing.T) {
+	mkres := func(allocated bool, class string, size uint64, count uint32) *reservation {
+		units := newResourceUnits()
+		units.CPU.Units = types.NewResourceValue(100)
+		units.Memory.Quantity = types.NewResourceValue(1 * unit.Gi)
+		units.Storage = &types.Storage{
+			Quantity: types.NewResourceValue(size),
+			Attributes: types.Attributes{
+				types.NewStringAttribute(types.StorageAttributeClass, class),
+				types.NewStringAttribute(types.StorageAttributePersistent, "true"),
+			},
+		}
+		return &reservation{
+			allocated: allocated,
+			resources: &dtypes.GroupSpec{Resources: []dtypes.Resource{{Resources: units, Count: count}}},
+		}
+	}
+
+	inventory := []ctypes.Node{
+		NewNode("a", newResourceUnits()),
+	}
+
+	storage := []ctypes.StorageClass{
+		{Name: "fast", Available: 50 * unit.Gi},
 	}
+
+	// allocated volumes are already claimed from the storage class
+	reservations := []*reservation{
+		mkres(false, "fast", 10*unit.Gi, 2),
+		mkres(true, "fast", 40*unit.Gi, 1),
+	}
+
+	// persistent volumes do not use the storage of the nodes
+	assert.True(t, reservationAllocateable(Config{}, inventory, storage, 0, reservations, mkres(false, "fast", 30*unit.Gi, 1)))
+	assert.False(t, reservationAllocateable(Config{}, inventory, storage, 0, reservations, mkres(false, "fast", 16*unit.Gi, 2)))
+	assert.False(t, reservationAllocateable(Config{}, inventory, storage, 0, reservations, mkres(false, "slow", 1*unit.Gi, 1)))
+	assert.False(t, reservationAllocateable(Config{}, inventory, nil, 0, nil, mkres(false, "fast", 1*unit.Gi, 1)))
+
+	// containers with a volume still reserve their ephemeral storage on the node
+	small := newResourceUnits()
+	small.Storage.Quantity = types.NewResourceValue(2 * unit.Gi)
+	smallInventory := []ctypes.Node{NewNode("a", small)}
+
+	assert.False(t, reservationAllocateable(Config{}, smallInventory, storage, 0, nil, mkres(false, "fast", 1*unit.Gi, 3)))
+	config := Config{InventoryRequestPolicy: ctypes.RequestPolicy{VolumeEphemeralStorage: 512 * unit.Mi}}
+	assert.True(t, reservationAllocateable(config, smallInventory, storage, 0, nil, mkres(false, "fast", 1*unit.Gi, 3)))
+
+	// the storage classes of the inventory are left alone
+	assert.Equal(t, uint64(50*unit.Gi), storage[0].Available)
+
+	updateInventoryMetrics(Config{}, inventory, storage, 0, reservations)
+	assert.Equal(t, float64(50*unit.Gi), promtestutil.ToFloat64(inventoryStorageClassGauge.WithLabelValues("fast", "available")))
+	assert.Equal(t, float64(20*unit.Gi), promtestutil.ToFloat64(inventoryStorageClassGauge.WithLabelValues("fast", "reserved")))
+}
+
+func TestInventory_reservationAllocateableRequestPolicy(t *testing.T) {
+	mkres := func(cpu, memory, storage uint64, count uint32) *reservation {
+		return &reservation{
+			resources: &dtypes.GroupSpec{Resources: []dtypes.Resource{{
+				Resources: types.ResourceUnits{
+					CPU:     &types.CPU{Units: types.NewResourceValue(cpu)},
+					Memory:  &types.Memory{Quantity: types.NewResourceValue(memory)},
+					Storage: &types.Storage{Quantity: types.NewResourceValue(storage)},
+				},
+				Count: count,
+			}}},
+		}
+	}
+
+	inventory := []ctypes.Node{
+		NewNode("a", newResourceUnits()),
+	}
+
+	config := Config{InventoryRequestPolicy: ctypes.RequestPolicy{CPU: 0.5, Storage: 0.5}}
+
+	cpu := mkres(800, 1*unit.Gi, 1*unit.Gi, 2)
+	assert.False(t, reservationAllocateable(Config{}, inventory, nil, 0, nil, cpu))
+	assert.True(t, reservationAllocateable(config, inventory, nil, 0, nil, cpu))
+
+	storage := mkres(100, 1*unit.Gi, 60*unit.Gi, 2)
+	assert.False(t, reservationAllocateable(Config{}, inventory, nil, 0, nil, storage))
+	assert.True(t, reservationAllocateable(config, inventory, nil, 0, nil, storage))
+
+	// memory is never oversold
+	memory := mkres(100, 6*unit.Gi, 1*unit.Gi, 2)
+	assert.False(t, reservationAllocateable(config, inventory, nil, 0, nil, memory))
+
+	// the limits of the reservation are left alone
+	assert.Equal(t, uint64(800), cpu.Resources().GetResources()[0].Resources.CPU.Units.Value())
+}
+
+func TestInventory_reservationAllocateableNodeAttributes(t *testing.T) {
+	config := Config{InventoryNodeAttributes: ctypes.NodeAttributes{
+		"cpu.arch":      {Label: "kubernetes.io/arch"},
+		"storage.class": {Label: "example.com/disk"},
+	}}
+
+	inventory := []ctypes.Node{
+		NewNode("a", config.InventoryNodeAttributes.Annotate(newResourceUnits(), map[string]string{
+			"kubernetes.io/arch": "amd64",
+			"example.com/disk":   "nvme",
+		})),
+		NewNode("b", config.InventoryNodeAttributes.Annotate(newResourceUnits(), map[string]string{
+			"kubernetes.io/arch": "arm64",
+		})),
+	}
+
+	mkres := func(count uint32, cpu, storage types.Attributes) *reservation {
+		units := types.ResourceUnits{
+			CPU:     &types.CPU{Units: types.NewResourceValue(600), Attributes: cpu},
+			Memory:  &types.Memory{Quantity: types.NewResourceValue(1 * unit.Gi)},
+			Storage: &types.Storage{Quantity: types.NewResourceValue(1 * unit.Gi), Attributes: storage},
+		}
+		return &reservation{
+			resources: &dtypes.GroupSpec{Resources: []dtypes.Resource{{Resources: units, Count: count}}},
+		}
+	}
+
+	arm := types.Attributes{types.NewStringAttribute("arch", "arm64")}
+	nvme := types.Attributes{types.NewStringAttribute("class", "nvme")}
+
+	assert.True(t, reservationAllocateable(config, inventory, nil, 0, nil, mkres(1, arm, nil)))
+	// both replicas fit the cluster but only one fits the arm node
+	assert.False(t, reservationAllocateable(config, inventory, nil, 0, nil, mkres(2, arm, nil)))
+	assert.True(t, reservationAllocateable(config, inventory, nil, 0, nil, mkres(1, nil, nvme)))
+	assert.False(t, reservationAllocateable(config, inventory, nil, 0, nil, mkres(1, arm, nvme)))
+	assert.False(t, reservationAllocateable(config, inventory, nil, 0, nil,
+		mkres(1, types.Attributes{types.NewStringAttribute("arch", "riscv")}, nil)))
+
+	// attributes which are not mapped to node labels are not required of nodes
+	assert.True(t, reservationAllocateable(config, inventory, nil, 0, nil,
+		mkres(2, types.Attributes{types.NewStringAttribute("vendor", "intel")}, nil)))
+	assert.True(t, reservationAllocateable(Config{}, inventory, nil, 0, nil, mkres(2, arm, nil)))
+}
+
+func TestInventory_reservationCountEndpoints(t *testing.T) {
+	res := &reservation{
+		resources: &dtypes.GroupSpec{Resources: []dtypes.Resource{
+			{
+				Resources: types.ResourceUnits{
+					Endpoints: []types.Endpoint{
+						{Kind: types.EndpointSharedHTTP},
+						{Kind: types.EndpointRandomPort},
+					},
+				},
+				Count: 3,
+			},
+			{
+				Resources: types.ResourceUnits{
+					Endpoints: []types.Endpoint{
+						{Kind: types.EndpointRandomPort},
+						{Kind: types.EndpointSharedHTTP},
+					},
+				},
+				Count: 1,
+			},
+		}},
+	}
+
+	// shared HTTP endpoints are served by the ingress and do not use node ports
+	assert.Equal(t, uint(2), reservationCountEndpoints(res))
+}
+
+func TestInventory_updateInventoryMetrics(t *testing.T) {
+	inventory := []ctypes.Node{
+		NewNodeWithUsage("a", newResourceUnits(), types.ResourceUnits{
+			CPU: &types.CPU{Units: types.NewResourceValue(300)},
+		}),
+	}
+
+	pending := &reservation{
+		resources: &dtypes.GroupSpec{Resources: []dtypes.Resource{
+			{
+				Resources: types.ResourceUnits{
+					CPU:       &types.CPU{Units: types.NewResourceValue(250)},
+					Memory:    &types.Memory{Quantity: types.NewResourceValue(1 * unit.Gi)},
+					Storage:   &types.Storage{Quantity: types.NewResourceValue(2 * unit.Gi)},
+					Endpoints: []types.Endpoint{{Kind: types.EndpointRandomPort}},
+				},
+				Count: 2,
+			},
+		}},
+	}
+	active := &reservation{
+		allocated: true,
+		resources: &dtypes.GroupSpec{Resources: []dtypes.Resource{{Resources: newResourceUnits(), Count: 1}}},
+	}
+
+	updateInventoryMetrics(Config{}, inventory, nil, 5, []*reservation{pending, active})
+
+	assert.Equal(t, float64(1000), promtestutil.ToFloat64(inventoryNodeResourcesGauge.WithLabelValues("a", "cpu", "available")))
+	assert.Equal(t, float64(500), promtestutil.ToFloat64(inventoryNodeResourcesGauge.WithLabelValues("a", "cpu", "reserved")))
+	assert.Equal(t, float64(2*unit.Gi), promtestutil.ToFloat64(inventoryNodeResourcesGauge.WithLabelValues("a", "memory", "reserved")))
+	assert.Equal(t, float64(4*unit.Gi), promtestutil.ToFloat64(inventoryNodeResourcesGauge.WithLabelValues("a", "storage", "reserved")))
+	assert.Equal(t, float64(300), promtestutil.ToFloat64(inventoryNodeResourcesGauge.WithLabelValues("a", "cpu", "used")))
+	assert.Equal(t, float64(0), promtestutil.ToFloat64(inventoryNodeResourcesGauge.WithLabelValues("a", "memory", "used")))
+
+	assert.Equal(t, float64(5), promtestutil.ToFloat64(inventoryExternalPortsGauge.WithLabelValues("available")))
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(inventoryExternalPortsGauge.WithLabelValues("reserved")))
+
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(inventoryReservationsGauge.WithLabelValues("pending")))
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(inventoryReservationsGauge.WithLabelValues("active")))
 }
 
 func TestInventory_ClusterDeploymentNotDeployed(t *testing.T) {
@@ -108,6 +342,7 @@ func TestInventory_ClusterDeploymentNotDeployed(t *testing.T) {
 	clusterClient := &mocks.Client{}
 	result := make([]ctypes.Node, 0)
 	clusterClient.On("Inventory", mock.Anything).Return(result, nil)
+	clusterClient.On("StorageClasses", mock.Anything).Return(nil, nil)
 
 	inv, err := newInventoryService(
 		config,
@@ -147,6 +382,9 @@ func TestInventory_ClusterDeploymentDeployed(t *testing.T) {
 
 	serviceCount := testutil.RandRangeInt(1, 10)
 	serviceEndpoints := make([]atypes.Endpoint, serviceCount)
+	for i := range serviceEndpoints {
+		serviceEndpoints[i].Kind = atypes.EndpointRandomPort
+	}
 	groupServices[0] = manifest.Service{
 		Count: 1,
 		Resources: atypes.ResourceUnits{
@@ -176,6 +414,7 @@ func TestInventory_ClusterDeploymentDeployed(t *testing.T) {
 	clusterClient.On("Inventory", mock.Anything).Run(func(args mock.Arguments) {
 		inventoryCalled <- 0 // Value does not matter
 	}).Return(result, nil)
+	clusterClient.On("StorageClasses", mock.Anything).Return(nil, nil)
 
 	inv, err := newInventoryService(
 		config,
@@ -91,6 +91,23 @@ func applyDeployment(ctx context.Context, kc kubernetes.Interface, b *deployment
 	return err
 }
 
+func applyStatefulSet(ctx context.Context, kc kubernetes.Interface, b *statefulSetBuilder) error {
+	obj, err := kc.AppsV1().StatefulSets(b.ns()).Get(ctx, b.name(), metav1.GetOptions{})
+	switch {
+	case err == nil:
+		obj, err = b.update(obj)
+		if err == nil {
+			_, err = kc.AppsV1().StatefulSets(b.ns()).Update(ctx, obj, metav1.UpdateOptions{})
+		}
+	case errors.IsNotFound(err):
+		obj, err = b.create()
+		if err == nil {
+			_, err = kc.AppsV1().StatefulSets(b.ns()).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	}
+	return err
+}
+
 func applyService(ctx context.Context, kc kubernetes.Interface, b *serviceBuilder) error {
 	obj, err := kc.CoreV1().Services(b.ns()).Get(ctx, b.name(), metav1.GetOptions{})
 	switch {
@@ -5,6 +5,7 @@ import (
 	"encoding/base32"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/lithammer/shortuuid"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/ovrclk/akash/manifest"
 	akashv1 "github.com/ovrclk/akash/pkg/apis/akash.network/v1"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -194,7 +196,6 @@ func (b *deploymentBuilder) labels() map[string]string {
 
 func (b *deploymentBuilder) create() (*appsv1.Deployment, error) { // nolint:golint,unparam
 	replicas := int32(b.service.Count)
-	falseValue := false
 
 	kdeployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -206,18 +207,7 @@ func (b *deploymentBuilder) create() (*appsv1.Deployment, error) { // nolint:gol
 				MatchLabels: b.labels(),
 			},
 			Replicas: &replicas,
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: b.labels(),
-				},
-				Spec: corev1.PodSpec{
-					SecurityContext: &corev1.PodSecurityContext{
-						RunAsNonRoot: &falseValue,
-					},
-					AutomountServiceAccountToken: &falseValue,
-					Containers:                   []corev1.Container{b.container()},
-				},
-			},
+			Template: b.podTemplate(),
 		},
 	}
 
@@ -229,11 +219,90 @@ func (b *deploymentBuilder) update(obj *appsv1.Deployment) (*appsv1.Deployment,
 	obj.Labels = b.labels()
 	obj.Spec.Selector.MatchLabels = b.labels()
 	obj.Spec.Replicas = &replicas
-	obj.Spec.Template.Labels = b.labels()
-	obj.Spec.Template.Spec.Containers = []corev1.Container{b.container()}
+	template := b.podTemplate()
+	obj.Spec.Template.Labels = template.Labels
+	obj.Spec.Template.Spec.Containers = template.Spec.Containers
+	obj.Spec.Template.Spec.Affinity = template.Spec.Affinity
+	obj.Spec.Template.Spec.Tolerations = template.Spec.Tolerations
 	return obj, nil
 }
 
+func (b *deploymentBuilder) podTemplate() corev1.PodTemplateSpec {
+	falseValue := false
+
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: b.labels(),
+		},
+		Spec: corev1.PodSpec{
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: &falseValue,
+			},
+			AutomountServiceAccountToken: &falseValue,
+			Containers:                   []corev1.Container{b.container()},
+			Affinity:                     b.affinity(),
+			Tolerations:                  b.tolerations(),
+		},
+	}
+}
+
+// affinity requires the nodes of the pods to be labelled with the values of the resource
+// attributes of the service mapped in the settings
+func (b *deploymentBuilder) affinity() *corev1.Affinity {
+	requirements := b.settings.NodeAttributes.Requirements(b.service.Resources)
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	expressions := make([]corev1.NodeSelectorRequirement, 0, len(requirements))
+	for _, key := range sortedRequirementKeys(requirements) {
+		requirement := requirements[key]
+		expressions = append(expressions, corev1.NodeSelectorRequirement{
+			Key:      requirement.Label,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{requirement.Value},
+		})
+	}
+
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: expressions}},
+			},
+		},
+	}
+}
+
+// tolerations lets the pods on nodes tainted with the label and value of the resource
+// attributes of the service which are tolerated in the settings
+func (b *deploymentBuilder) tolerations() []corev1.Toleration {
+	requirements := b.settings.NodeAttributes.Requirements(b.service.Resources)
+
+	var tolerations []corev1.Toleration
+	for _, key := range sortedRequirementKeys(requirements) {
+		requirement := requirements[key]
+		if !requirement.Tolerate {
+			continue
+		}
+		tolerations = append(tolerations, corev1.Toleration{
+			Key:      requirement.Label,
+			Operator: corev1.TolerationOpEqual,
+			Value:    requirement.Value,
+		})
+	}
+
+	return tolerations
+}
+
+func sortedRequirementKeys(requirements map[string]ctypes.NodeAttributeRequirement) []string {
+	keys := make([]string, 0, len(requirements))
+	for key := range requirements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (b *deploymentBuilder) container() corev1.Container {
 	falseValue := false
 
@@ -243,9 +312,8 @@ func (b *deploymentBuilder) container() corev1.Container {
 		Command: b.service.Command,
 		Args:    b.service.Args,
 		Resources: corev1.ResourceRequirements{
-			Limits: make(corev1.ResourceList),
-			// TODO: this prevents over-subscription.  skip for now.
-			// Requests: make(corev1.ResourceList),
+			Limits:   make(corev1.ResourceList),
+			Requests: make(corev1.ResourceList),
 		},
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		SecurityContext: &corev1.SecurityContext{
@@ -255,15 +323,25 @@ func (b *deploymentBuilder) container() corev1.Container {
 		},
 	}
 
+	requests := b.settings.RequestPolicy.Requests(b.service.Resources)
+
 	if cpu := b.service.Resources.CPU; cpu != nil {
 		kcontainer.Resources.Limits[corev1.ResourceCPU] = resource.NewScaledQuantity(int64(cpu.Units.Value()), resource.Milli).DeepCopy()
+		kcontainer.Resources.Requests[corev1.ResourceCPU] = resource.NewScaledQuantity(int64(requests.CPU.Units.Value()), resource.Milli).DeepCopy()
 	}
 
 	if mem := b.service.Resources.Memory; mem != nil {
 		kcontainer.Resources.Limits[corev1.ResourceMemory] = resource.NewQuantity(int64(mem.Quantity.Value()), resource.DecimalSI).DeepCopy()
+		kcontainer.Resources.Requests[corev1.ResourceMemory] = resource.NewQuantity(int64(requests.Memory.Quantity.Value()), resource.DecimalSI).DeepCopy()
 	}
 
-	// TODO: this prevents over-subscription.  skip for now.
+	// persistent storage is claimed by the volume of the service; the container still gets
+	// bounded ephemeral storage
+	if storage := b.service.Resources.Storage; storage != nil {
+		limit := b.settings.RequestPolicy.EphemeralStorage(storage)
+		kcontainer.Resources.Limits[corev1.ResourceEphemeralStorage] = resource.NewQuantity(int64(limit), resource.DecimalSI).DeepCopy()
+		kcontainer.Resources.Requests[corev1.ResourceEphemeralStorage] = resource.NewQuantity(int64(requests.Storage.Quantity.Value()), resource.DecimalSI).DeepCopy()
+	}
 
 	for _, env := range b.service.Env {
 		parts := strings.Split(env, "=")
@@ -281,9 +359,134 @@ func (b *deploymentBuilder) container() corev1.Container {
 		})
 	}
 
+	if health := b.service.Health; health != nil {
+		kcontainer.LivenessProbe = probe(health.Liveness)
+		kcontainer.ReadinessProbe = probe(health.Readiness)
+	}
+
+	if volume := b.service.PersistentVolume(); volume != nil {
+		kcontainer.VolumeMounts = append(kcontainer.VolumeMounts, corev1.VolumeMount{
+			Name:      volume.Name,
+			MountPath: volume.Mount,
+			ReadOnly:  volume.ReadOnly,
+		})
+	}
+
 	return kcontainer
 }
 
+// probe returns the kubernetes probe running check, or nil without check. Zero values
+// take the kubernetes defaults.
+func probe(check *manifest.HealthCheck) *corev1.Probe {
+	if check == nil {
+		return nil
+	}
+
+	kprobe := &corev1.Probe{
+		InitialDelaySeconds: int32(check.InitialDelay),
+		PeriodSeconds:       int32(check.Interval),
+		TimeoutSeconds:      int32(check.Timeout),
+		SuccessThreshold:    int32(check.SuccessThreshold),
+		FailureThreshold:    int32(check.FailureThreshold),
+	}
+
+	switch {
+	case check.HTTP != nil:
+		kprobe.HTTPGet = &corev1.HTTPGetAction{
+			Path: check.HTTP.Path,
+			Port: intstr.FromInt(int(check.HTTP.Port)),
+		}
+	case check.TCP != nil:
+		kprobe.TCPSocket = &corev1.TCPSocketAction{
+			Port: intstr.FromInt(int(check.TCP.Port)),
+		}
+	case check.Exec != nil:
+		kprobe.Exec = &corev1.ExecAction{
+			Command: check.Exec.Command,
+		}
+	}
+
+	return kprobe
+}
+
+// statefulSetBuilder renders services with persistent storage. Each replica gets its own
+// volume claimed from the storage class of the service, which is kept when the replica
+// restarts or moves to another node.
+type statefulSetBuilder struct {
+	deploymentBuilder
+}
+
+func newStatefulSetBuilder(log log.Logger, settings Settings, lid mtypes.LeaseID, group *manifest.Group, service *manifest.Service) *statefulSetBuilder {
+	return &statefulSetBuilder{
+		deploymentBuilder: *newDeploymentBuilder(log, settings, lid, group, service),
+	}
+}
+
+func (b *statefulSetBuilder) create() (*appsv1.StatefulSet, error) { // nolint:golint,unparam
+	replicas := int32(b.service.Count)
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   b.name(),
+			Labels: b.labels(),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: b.labels(),
+			},
+			Replicas:             &replicas,
+			ServiceName:          b.name(),
+			Template:             b.podTemplate(),
+			VolumeClaimTemplates: b.volumeClaimTemplates(),
+		},
+	}, nil
+}
+
+// update leaves the volume claim templates alone, as they can not be changed once the
+// stateful set exists
+func (b *statefulSetBuilder) update(obj *appsv1.StatefulSet) (*appsv1.StatefulSet, error) { // nolint:golint,unparam
+	replicas := int32(b.service.Count)
+	obj.Labels = b.labels()
+	obj.Spec.Selector.MatchLabels = b.labels()
+	obj.Spec.Replicas = &replicas
+	template := b.podTemplate()
+	obj.Spec.Template.Labels = template.Labels
+	obj.Spec.Template.Spec.Containers = template.Spec.Containers
+	obj.Spec.Template.Spec.Affinity = template.Spec.Affinity
+	obj.Spec.Template.Spec.Tolerations = template.Spec.Tolerations
+	return obj, nil
+}
+
+func (b *statefulSetBuilder) volumeClaimTemplates() []corev1.PersistentVolumeClaim {
+	volume := b.service.PersistentVolume()
+	if volume == nil {
+		return nil
+	}
+
+	storage := b.service.Resources.Storage
+	class := storage.Class()
+
+	return []corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   volume.Name,
+				Labels: b.labels(),
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{
+					corev1.ReadWriteOnce,
+				},
+				StorageClassName: &class,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.NewQuantity(int64(storage.Quantity.Value()), resource.DecimalSI).DeepCopy(),
+					},
+				},
+			},
+		},
+	}
+}
+
 // service
 type serviceBuilder struct {
 	deploymentBuilder
@@ -356,20 +559,26 @@ func (b *serviceBuilder) update(obj *corev1.Service) (*corev1.Service, error) {
 }
 
 func (b *serviceBuilder) any() bool {
-	for _, expose := range b.service.Expose {
-		if expose.Global == b.requireNodePort {
+	for i := range b.service.Expose {
+		if exposeRequiresNodePort(&b.service.Expose[i]) == b.requireNodePort {
 			return true
 		}
 	}
 	return false
 }
 
+// exposeRequiresNodePort returns true when the expose is published on a dedicated node port.
+// Global HTTP services are routed by the shared ingress to the cluster local service instead.
+func exposeRequiresNodePort(expose *manifest.ServiceExpose) bool {
+	return expose.Global && !expose.IsIngress()
+}
+
 var errUnsupportedProtocol = errors.New("Unsupported protocol for service")
 
 func (b *serviceBuilder) ports() ([]corev1.ServicePort, error) {
 	ports := make([]corev1.ServicePort, 0, len(b.service.Expose))
 	for i, expose := range b.service.Expose {
-		if expose.Global == b.requireNodePort {
+		if exposeRequiresNodePort(&b.service.Expose[i]) == b.requireNodePort {
 
 			var exposeProtocol corev1.Protocol
 			switch expose.Proto {
@@ -381,6 +590,12 @@ func (b *serviceBuilder) ports() ([]corev1.ServicePort, error) {
 				return nil, errUnsupportedProtocol
 			}
 			externalPort := exposeExternalPort(&b.service.Expose[i])
+
+			// the same port may be exposed both to other services and to the ingress
+			if servicePortExists(ports, externalPort, exposeProtocol) {
+				continue
+			}
+
 			ports = append(ports, corev1.ServicePort{
 				Name:       fmt.Sprintf("%d-%d", i, int(externalPort)),
 				Port:       externalPort,
@@ -392,6 +607,15 @@ func (b *serviceBuilder) ports() ([]corev1.ServicePort, error) {
 	return ports, nil
 }
 
+func servicePortExists(ports []corev1.ServicePort, port int32, proto corev1.Protocol) bool {
+	for _, existing := range ports {
+		if existing.Port == port && existing.Protocol == proto {
+			return true
+		}
+	}
+	return false
+}
+
 type netPolBuilder struct {
 	builder
 }
@@ -674,7 +898,7 @@ func (b *ingressBuilder) rules() []netv1.IngressRule {
 			PathType: &pathTypeForAll,
 			Backend: netv1.IngressBackend{
 				Service: &netv1.IngressServiceBackend{
-					Name: makeGlobalServiceNameFromBasename(b.name()),
+					Name: b.name(),
 					Port: netv1.ServiceBackendPort{
 
 						Number: exposeExternalPort(b.expose),
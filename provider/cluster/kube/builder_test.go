@@ -8,7 +8,10 @@ import (
 	"testing"
 
 	"github.com/ovrclk/akash/manifest"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -193,3 +196,223 @@ func TestLocalServiceBuilderWithLocalServices(t *testing.T) {
 	require.Equal(t, ports[0].TargetPort, intstr.FromInt(2000))
 	require.Equal(t, ports[0].Name, "1-2001")
 }
+
+func TestServiceBuilderIngressUsesLocalService(t *testing.T) {
+	myLog := testutil.Logger(t)
+	group := &manifest.Group{}
+	service := &manifest.Service{
+		Name: "myservice",
+		Expose: []manifest.ServiceExpose{
+			{Global: true, Proto: manifest.TCP, Port: 80},
+			{Global: false, Proto: manifest.TCP, Port: 80, Service: "other"},
+		},
+	}
+	mySettings := NewDefaultSettings()
+	lid := testutil.LeaseID(t)
+
+	// the ingress does not need a node port
+	require.False(t, newServiceBuilder(myLog, mySettings, lid, group, service, true).any())
+
+	serviceBuilder := newServiceBuilder(myLog, mySettings, lid, group, service, false)
+	require.True(t, serviceBuilder.any())
+
+	result, err := serviceBuilder.create()
+	require.NoError(t, err)
+	require.Equal(t, corev1.ServiceTypeClusterIP, result.Spec.Type)
+	require.Len(t, result.Spec.Ports, 1)
+	require.Equal(t, int32(80), result.Spec.Ports[0].Port)
+}
+
+func TestStatefulSetBuilder(t *testing.T) {
+	myLog := testutil.Logger(t)
+	lid := testutil.LeaseID(t)
+
+	service := testutil.AppManifestGenerator.Service(t)
+	service.Resources.Storage.Attributes = types.Attributes{
+		types.NewStringAttribute(types.StorageAttributeClass, "fast"),
+		types.NewStringAttribute(types.StorageAttributePersistent, "true"),
+	}
+	service.Params = &manifest.ServiceParams{
+		Storage: []manifest.StorageParams{{Name: "data", Mount: "/var/lib/data"}},
+	}
+	group := &manifest.Group{Services: []manifest.Service{service}}
+
+	b := newStatefulSetBuilder(myLog, NewDefaultSettings(), lid, group, &group.Services[0])
+	sset, err := b.create()
+	require.NoError(t, err)
+
+	require.Equal(t, "demo", sset.Name)
+	require.Len(t, sset.Spec.VolumeClaimTemplates, 1)
+
+	claim := sset.Spec.VolumeClaimTemplates[0]
+	require.Equal(t, "data", claim.Name)
+	require.Equal(t, "fast", *claim.Spec.StorageClassName)
+	require.Equal(t, int64(256*unit.Mi), claim.Spec.Resources.Requests.Storage().Value())
+	require.Equal(t, "demo", claim.Labels[akashManifestServiceLabelName])
+
+	container := sset.Spec.Template.Spec.Containers[0]
+	require.Equal(t, []corev1.VolumeMount{{Name: "data", MountPath: "/var/lib/data"}}, container.VolumeMounts)
+
+	// a service with ephemeral storage mounts nothing
+	group.Services[0].Resources.Storage.Attributes = nil
+	deployment, err := newDeploymentBuilder(myLog, NewDefaultSettings(), lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+	require.Empty(t, deployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+}
+
+func TestDeploymentBuilderResources(t *testing.T) {
+	myLog := testutil.Logger(t)
+	lid := testutil.LeaseID(t)
+
+	service := testutil.AppManifestGenerator.Service(t)
+	group := &manifest.Group{Services: []manifest.Service{service}}
+
+	settings := NewDefaultSettings()
+	settings.RequestPolicy = ctypes.RequestPolicy{CPU: 0.5, Storage: 0.25}
+
+	deployment, err := newDeploymentBuilder(myLog, settings, lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+
+	resources := deployment.Spec.Template.Spec.Containers[0].Resources
+	require.Equal(t, int64(100), resources.Limits.Cpu().MilliValue())
+	require.Equal(t, int64(50), resources.Requests.Cpu().MilliValue())
+	require.Equal(t, int64(128*unit.Mi), resources.Limits.Memory().Value())
+	require.Equal(t, int64(128*unit.Mi), resources.Requests.Memory().Value())
+	require.Equal(t, int64(256*unit.Mi), resources.Limits.StorageEphemeral().Value())
+	require.Equal(t, int64(64*unit.Mi), resources.Requests.StorageEphemeral().Value())
+
+	// persistent storage is claimed by a volume, the container gets bounded ephemeral storage
+	group.Services[0].Resources.Storage.Attributes = types.Attributes{
+		types.NewStringAttribute(types.StorageAttributeClass, "fast"),
+		types.NewStringAttribute(types.StorageAttributePersistent, "true"),
+	}
+	group.Services[0].Params = &manifest.ServiceParams{
+		Storage: []manifest.StorageParams{{Name: "data", Mount: "/var/lib/data"}},
+	}
+
+	sset, err := newStatefulSetBuilder(myLog, settings, lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+
+	resources = sset.Spec.Template.Spec.Containers[0].Resources
+	require.Equal(t, int64(ctypes.DefaultVolumeEphemeralStorage), resources.Limits.StorageEphemeral().Value())
+	require.Equal(t, int64(ctypes.DefaultVolumeEphemeralStorage/4), resources.Requests.StorageEphemeral().Value())
+
+	settings.RequestPolicy.VolumeEphemeralStorage = 512 * unit.Mi
+	sset, err = newStatefulSetBuilder(myLog, settings, lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+
+	resources = sset.Spec.Template.Spec.Containers[0].Resources
+	require.Equal(t, int64(512*unit.Mi), resources.Limits.StorageEphemeral().Value())
+	require.Equal(t, int64(128*unit.Mi), resources.Requests.StorageEphemeral().Value())
+}
+
+func TestDeploymentBuilderNodeAttributes(t *testing.T) {
+	myLog := testutil.Logger(t)
+	lid := testutil.LeaseID(t)
+
+	service := testutil.AppManifestGenerator.Service(t)
+	service.Resources.CPU.Attributes = types.Attributes{
+		types.NewStringAttribute("arch", "arm64"),
+		types.NewStringAttribute("vendor", "ampere"),
+	}
+	service.Resources.Storage.Attributes = types.Attributes{
+		types.NewStringAttribute("class", "nvme"),
+	}
+	group := &manifest.Group{Services: []manifest.Service{service}}
+
+	settings := NewDefaultSettings()
+	settings.NodeAttributes = ctypes.NodeAttributes{
+		"cpu.arch":      {Label: "kubernetes.io/arch"},
+		"storage.class": {Label: "example.com/disk", Tolerate: true},
+	}
+
+	deployment, err := newDeploymentBuilder(myLog, settings, lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+
+	spec := deployment.Spec.Template.Spec
+	require.Equal(t, []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+		{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+		{Key: "example.com/disk", Operator: corev1.NodeSelectorOpIn, Values: []string{"nvme"}},
+	}}}, spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	require.Equal(t, []corev1.Toleration{
+		{Key: "example.com/disk", Operator: corev1.TolerationOpEqual, Value: "nvme"},
+	}, spec.Tolerations)
+
+	// services without mapped attributes may run on any node
+	deployment, err = newDeploymentBuilder(myLog, NewDefaultSettings(), lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+	require.Nil(t, deployment.Spec.Template.Spec.Affinity)
+	require.Empty(t, deployment.Spec.Template.Spec.Tolerations)
+}
+
+func TestBuilderUpdateNodeAttributes(t *testing.T) {
+	myLog := testutil.Logger(t)
+	lid := testutil.LeaseID(t)
+
+	service := testutil.AppManifestGenerator.Service(t)
+	service.Resources.CPU.Attributes = types.Attributes{
+		types.NewStringAttribute("arch", "arm64"),
+	}
+	service.Resources.Storage.Attributes = types.Attributes{
+		types.NewStringAttribute("class", "nvme"),
+	}
+	group := &manifest.Group{Services: []manifest.Service{service}}
+
+	settings := NewDefaultSettings()
+	settings.NodeAttributes = ctypes.NodeAttributes{
+		"cpu.arch":      {Label: "kubernetes.io/arch"},
+		"storage.class": {Label: "example.com/disk", Tolerate: true},
+	}
+
+	deployment, err := newDeploymentBuilder(myLog, settings, lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+	sset, err := newStatefulSetBuilder(myLog, settings, lid, group, &group.Services[0]).create()
+	require.NoError(t, err)
+
+	// the lease is updated with other placement attributes
+	updated := &manifest.Group{Services: []manifest.Service{service}}
+	updated.Services[0].Resources.CPU = &types.CPU{
+		Units:      service.Resources.CPU.Units,
+		Attributes: types.Attributes{types.NewStringAttribute("arch", "amd64")},
+	}
+	updated.Services[0].Resources.Storage = &types.Storage{
+		Quantity:   service.Resources.Storage.Quantity,
+		Attributes: types.Attributes{types.NewStringAttribute("class", "ssd")},
+	}
+
+	terms := []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+		{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}},
+		{Key: "example.com/disk", Operator: corev1.NodeSelectorOpIn, Values: []string{"ssd"}},
+	}}}
+	tolerations := []corev1.Toleration{
+		{Key: "example.com/disk", Operator: corev1.TolerationOpEqual, Value: "ssd"},
+	}
+
+	deployment, err = newDeploymentBuilder(myLog, settings, lid, updated, &updated.Services[0]).update(deployment)
+	require.NoError(t, err)
+	spec := deployment.Spec.Template.Spec
+	require.Equal(t, terms, spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	require.Equal(t, tolerations, spec.Tolerations)
+
+	sset, err = newStatefulSetBuilder(myLog, settings, lid, updated, &updated.Services[0]).update(sset)
+	require.NoError(t, err)
+	spec = sset.Spec.Template.Spec
+	require.Equal(t, terms, spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	require.Equal(t, tolerations, spec.Tolerations)
+
+	// placement constraints are dropped along with the attributes
+	deployment, err = newDeploymentBuilder(myLog, NewDefaultSettings(), lid, updated, &updated.Services[0]).update(deployment)
+	require.NoError(t, err)
+	require.Nil(t, deployment.Spec.Template.Spec.Affinity)
+	require.Empty(t, deployment.Spec.Template.Spec.Tolerations)
+}
+
+func TestStaleSelector(t *testing.T) {
+	selector, err := staleSelector(nil)
+	require.NoError(t, err)
+	require.Equal(t, "akash.network=true", selector)
+
+	selector, err = staleSelector([]string{"db", "web"})
+	require.NoError(t, err)
+	require.Equal(t, "akash.network=true,akash.network/manifest-service notin (db,web)", selector)
+}
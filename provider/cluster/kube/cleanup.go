@@ -16,23 +16,45 @@ func cleanupStaleResources(ctx context.Context, kc kubernetes.Interface, lid mty
 
 	// build label selector for objects not in current manifest group
 	svcnames := make([]string, 0, len(group.Services))
+	// services with persistent storage are rendered as stateful sets, the others as deployments
+	var deploymentNames, statefulSetNames []string
 	for _, svc := range group.Services {
 		svcnames = append(svcnames, svc.Name)
+		if svc.PersistentVolume() != nil {
+			statefulSetNames = append(statefulSetNames, svc.Name)
+		} else {
+			deploymentNames = append(deploymentNames, svc.Name)
+		}
 	}
 
-	req1, err := labels.NewRequirement(akashManifestServiceLabelName, selection.NotIn, svcnames)
+	selector, err := staleSelector(svcnames)
 	if err != nil {
 		return err
 	}
-	req2, err := labels.NewRequirement(akashManagedLabelName, selection.Equals, []string{"true"})
+
+	// delete stale deployments
+	deploymentSelector, err := staleSelector(deploymentNames)
 	if err != nil {
 		return err
 	}
-	selector := labels.NewSelector().Add(*req1).Add(*req2).String()
-
-	// delete stale deployments
 	if err := kc.AppsV1().Deployments(ns).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-		LabelSelector: selector,
+		LabelSelector: deploymentSelector,
+	}); err != nil {
+		return err
+	}
+
+	// delete stale stateful sets along with the claims of their volumes
+	statefulSetSelector, err := staleSelector(statefulSetNames)
+	if err != nil {
+		return err
+	}
+	if err := kc.AppsV1().StatefulSets(ns).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: statefulSetSelector,
+	}); err != nil {
+		return err
+	}
+	if err := kc.CoreV1().PersistentVolumeClaims(ns).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: statefulSetSelector,
 	}); err != nil {
 		return err
 	}
@@ -59,3 +81,24 @@ func cleanupStaleResources(ctx context.Context, kc kubernetes.Interface, lid mty
 
 	return nil
 }
+
+// staleSelector selects the managed objects which belong to none of the named services
+func staleSelector(svcnames []string) (string, error) {
+	managed, err := labels.NewRequirement(akashManagedLabelName, selection.Equals, []string{"true"})
+	if err != nil {
+		return "", err
+	}
+
+	selector := labels.NewSelector().Add(*managed)
+
+	// a set requirement needs at least one value
+	if len(svcnames) != 0 {
+		stale, err := labels.NewRequirement(akashManifestServiceLabelName, selection.NotIn, svcnames)
+		if err != nil {
+			return "", err
+		}
+		selector = selector.Add(*stale)
+	}
+
+	return selector.String(), nil
+}
@@ -2,17 +2,30 @@ package kube
 
 import (
 	"context"
-	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"path"
+	"sort"
+
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	kexec "k8s.io/client-go/util/exec"
 	"k8s.io/client-go/util/homedir"
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
 
@@ -22,7 +35,7 @@ import (
 	akashclient "github.com/ovrclk/akash/pkg/client/clientset/versioned"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/types"
-	"github.com/ovrclk/akash/validation"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -41,6 +54,7 @@ var _ Client = (*client)(nil)
 
 type client struct {
 	kc       kubernetes.Interface
+	kconfig  *rest.Config
 	ac       akashclient.Interface
 	metc     metricsclient.Interface
 	ns       string
@@ -91,6 +105,7 @@ func newClientWithSettings(log log.Logger, host, ns string, settings Settings) (
 	return &client{
 		settings: settings,
 		kc:       kc,
+		kconfig:  config,
 		ac:       mc,
 		metc:     metc,
 		ns:       ns,
@@ -113,7 +128,7 @@ func openKubeConfig(log log.Logger) (*rest.Config, error) {
 }
 
 func shouldExpose(expose *manifest.ServiceExpose) bool {
-	return expose.Proto == manifest.TCP && expose.Global && 80 == exposeExternalPort(expose)
+	return expose.IsIngress()
 }
 
 func (c *client) Deployments(ctx context.Context) ([]ctypes.Deployment, error) {
@@ -134,6 +149,25 @@ func (c *client) Deployments(ctx context.Context) ([]ctypes.Deployment, error) {
 	return deployments, nil
 }
 
+func (c *client) LeaseManifest(ctx context.Context, lid mtypes.LeaseID) (*manifest.Group, error) {
+	obj, err := c.ac.AkashV1().Manifests(c.ns).Get(ctx, lidNS(lid), metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, cluster.ErrNoManifestForLease
+		}
+		c.log.Error("fetching manifest", "err", err, "lease", lid)
+		return nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	deployment, err := obj.Deployment()
+	if err != nil {
+		return nil, err
+	}
+
+	group := deployment.ManifestGroup()
+	return &group, nil
+}
+
 func (c *client) Deploy(ctx context.Context, lid mtypes.LeaseID, group *manifest.Group) error {
 	if err := applyNS(ctx, c.kc, newNSBuilder(c.settings, lid, group)); err != nil {
 		c.log.Error("applying namespace", "err", err, "lease", lid)
@@ -162,7 +196,12 @@ func (c *client) Deploy(ctx context.Context, lid mtypes.LeaseID, group *manifest
 
 	for svcIdx := range group.Services {
 		service := &group.Services[svcIdx]
-		if err := applyDeployment(ctx, c.kc, newDeploymentBuilder(c.log, c.settings, lid, group, service)); err != nil {
+		if service.PersistentVolume() != nil {
+			if err := applyStatefulSet(ctx, c.kc, newStatefulSetBuilder(c.log, c.settings, lid, group, service)); err != nil {
+				c.log.Error("applying stateful set", "err", err, "lease", lid, "service", service.Name)
+				return err
+			}
+		} else if err := applyDeployment(ctx, c.kc, newDeploymentBuilder(c.log, c.settings, lid, group, service)); err != nil {
 			c.log.Error("applying deployment", "err", err, "lease", lid, "service", service.Name)
 			return err
 		}
@@ -203,43 +242,234 @@ func (c *client) Deploy(ctx context.Context, lid mtypes.LeaseID, group *manifest
 	return nil
 }
 
+// TeardownLease deletes the namespace of the lease. The persistent volume claims of the lease
+// are deleted with it, which releases their volumes.
 func (c *client) TeardownLease(ctx context.Context, lid mtypes.LeaseID) error {
 	return c.kc.CoreV1().Namespaces().Delete(ctx, lidNS(lid), metav1.DeleteOptions{})
 }
 
 func (c *client) ServiceLogs(ctx context.Context, lid mtypes.LeaseID,
-	_ string, follow bool, tailLines *int64) ([]*ctypes.ServiceLog, error) {
-	pods, err := c.kc.CoreV1().Pods(lidNS(lid)).List(ctx, metav1.ListOptions{})
+	opts ctypes.ServiceLogOptions) ([]*ctypes.ServiceLog, error) {
+	ns := lidNS(lid)
+
+	selector, err := serviceLogsSelector(opts.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.kc.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, errors.Wrap(err, ErrInternalError.Error())
 	}
-	streams := make([]*ctypes.ServiceLog, len(pods.Items))
-	for i, pod := range pods.Items {
-		stream, err := c.kc.CoreV1().Pods(lidNS(lid)).GetLogs(pod.Name, &corev1.PodLogOptions{
-			Follow:     follow,
-			TailLines:  tailLines,
-			Timestamps: false,
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	var since *metav1.Time
+	if opts.Since != nil {
+		st := metav1.NewTime(*opts.Since)
+		since = &st
+	}
+
+	streams := make([]*ctypes.ServiceLog, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		service := pod.Labels[akashManifestServiceLabelName]
+
+		var restarts int32
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == service {
+				restarts = status.RestartCount
+			}
+		}
+
+		// there are no logs of a previous container before the first restart
+		if opts.Previous && restarts == 0 {
+			continue
+		}
+
+		stream, err := c.kc.CoreV1().Pods(ns).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container:  service,
+			Follow:     opts.Follow,
+			Previous:   opts.Previous,
+			SinceTime:  since,
+			TailLines:  opts.TailLines,
+			Timestamps: true,
 		}).Stream(ctx)
 		if err != nil {
+			for _, opened := range streams {
+				_ = opened.Stream.Close()
+			}
 			c.log.Error(err.Error())
 			return nil, errors.Wrap(err, ErrInternalError.Error())
 		}
-		streams[i] = cluster.NewServiceLog(pod.Name, stream)
+		streams = append(streams, cluster.NewServiceLog(service, pod.Name, restarts, stream))
 	}
 	return streams, nil
 }
 
+func serviceLogsSelector(services []string) (string, error) {
+	selector := labels.NewSelector()
+
+	req, err := labels.NewRequirement(akashManagedLabelName, selection.Equals, []string{"true"})
+	if err != nil {
+		return "", err
+	}
+	selector = selector.Add(*req)
+
+	if len(services) != 0 {
+		req, err = labels.NewRequirement(akashManifestServiceLabelName, selection.In, services)
+		if err != nil {
+			return "", err
+		}
+		selector = selector.Add(*req)
+	}
+
+	return selector.String(), nil
+}
+
+func (c *client) Exec(ctx context.Context,
+	lid mtypes.LeaseID,
+	service string,
+	podIndex uint,
+	cmd []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+	tsq ctypes.TerminalSizeQueue) (ctypes.ExecResult, error) {
+	ns := lidNS(lid)
+
+	pods, err := c.kc.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", akashManifestServiceLabelName, service),
+	})
+	if err != nil {
+		c.log.Error(err.Error())
+		return nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	// keep replica indexes stable across requests
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	if podIndex >= uint(len(pods.Items)) {
+		return nil, cluster.ErrExecPodIndexOutOfRange
+	}
+
+	req := c.kc.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pods.Items[podIndex].Name).
+		Namespace(ns).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: service,
+			Command:   cmd,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil && !tty,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+
+	transport, upgrader, err := spdy.RoundTripperFor(c.kconfig)
+	if err != nil {
+		c.log.Error(err.Error())
+		return nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	// the executor does not watch ctx; the stream only ends when its connection is closed
+	exec, err := remotecommand.NewSPDYExecutorForTransports(transport, ctxUpgrader{ctx: ctx, upgrader: upgrader}, "POST", req.URL())
+	if err != nil {
+		c.log.Error(err.Error())
+		return nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	opts := remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+		Tty:    tty,
+	}
+	if tty {
+		opts.Stderr = nil
+	}
+	if tsq != nil {
+		opts.TerminalSizeQueue = terminalSizeQueue{tsq}
+	}
+
+	err = exec.Stream(opts)
+	if err != nil {
+		var exitErr kexec.CodeExitError
+		if errors.As(err, &exitErr) {
+			return execResult{exitCode: exitErr.ExitStatus()}, nil
+		}
+		return nil, err
+	}
+
+	return execResult{}, nil
+}
+
+type execResult struct {
+	exitCode int
+}
+
+func (r execResult) ExitCode() int {
+	return r.exitCode
+}
+
+// ctxUpgrader closes the exec connections it creates once ctx is done
+type ctxUpgrader struct {
+	ctx      context.Context
+	upgrader spdy.Upgrader
+}
+
+func (u ctxUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := u.upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-u.ctx.Done():
+			_ = conn.Close()
+		case <-conn.CloseChan():
+		}
+	}()
+
+	return conn, nil
+}
+
+// terminalSizeQueue adapts the cluster terminal size queue to the kubernetes one
+type terminalSizeQueue struct {
+	tsq ctypes.TerminalSizeQueue
+}
+
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size := q.tsq.Next()
+	if size == nil {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{
+		Width:  size.Width,
+		Height: size.Height,
+	}
+}
+
 // todo: limit number of results and do pagination / streaming
 func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*ctypes.LeaseStatus, error) {
-	deployments, err := c.deploymentsForLease(ctx, lid)
+	deployments, statefulSets, err := c.deploymentsForLease(ctx, lid)
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, err
 	}
 
-	serviceStatus := make(map[string]*ctypes.ServiceStatus, len(deployments))
-	forwardedPorts := make(map[string][]ctypes.ForwardedPortStatus, len(deployments))
+	serviceStatus := make(map[string]*ctypes.ServiceStatus, len(deployments)+len(statefulSets))
+	forwardedPorts := make(map[string][]ctypes.ForwardedPortStatus, len(deployments)+len(statefulSets))
 	for _, deployment := range deployments {
 		status := &ctypes.ServiceStatus{
 			Name:      deployment.Name,
@@ -250,6 +480,26 @@ func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*ctypes.L
 
 	}
 
+	// stateful sets do not report available replicas; a ready replica is available
+	for _, sset := range statefulSets {
+		serviceStatus[sset.Name] = &ctypes.ServiceStatus{
+			Name:      sset.Name,
+			Available: sset.Status.ReadyReplicas,
+			Total:     sset.Status.Replicas,
+		}
+	}
+
+	instances, err := c.serviceInstances(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	for name, status := range serviceStatus {
+		status.Instances = instances[name]
+		if status.Instances == nil {
+			status.Instances = []ctypes.InstanceStatus{}
+		}
+	}
+
 	ingress, err := c.kc.NetworkingV1().Ingresses(lidNS(lid)).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		c.log.Error(err.Error())
@@ -344,24 +594,61 @@ func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*ctypes.L
 }
 
 func (c *client) ServiceStatus(ctx context.Context, lid mtypes.LeaseID, name string) (*ctypes.ServiceStatus, error) {
+	var status *ctypes.ServiceStatus
+
 	deployment, err := c.kc.AppsV1().Deployments(lidNS(lid)).Get(ctx, name, metav1.GetOptions{})
 
+	if kerrors.IsNotFound(err) {
+		// services with persistent storage run as stateful sets
+		sset, serr := c.kc.AppsV1().StatefulSets(lidNS(lid)).Get(ctx, name, metav1.GetOptions{})
+		switch {
+		case serr == nil:
+			err = nil
+			status = &ctypes.ServiceStatus{
+				ObservedGeneration: sset.Status.ObservedGeneration,
+				Replicas:           sset.Status.Replicas,
+				UpdatedReplicas:    sset.Status.UpdatedReplicas,
+				ReadyReplicas:      sset.Status.ReadyReplicas,
+				AvailableReplicas:  sset.Status.ReadyReplicas,
+			}
+		case !kerrors.IsNotFound(serr):
+			err = serr
+		}
+	}
+
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, errors.Wrap(err, ErrInternalError.Error())
 	}
-	if deployment == nil {
-		return nil, ErrNoDeploymentForLease
+
+	if status == nil {
+		if deployment == nil {
+			return nil, ErrNoDeploymentForLease
+		}
+		status = &ctypes.ServiceStatus{
+			ObservedGeneration: deployment.Status.ObservedGeneration,
+			Replicas:           deployment.Status.Replicas,
+			UpdatedReplicas:    deployment.Status.UpdatedReplicas,
+			ReadyReplicas:      deployment.Status.ReadyReplicas,
+			AvailableReplicas:  deployment.Status.AvailableReplicas,
+		}
 	}
-	return &ctypes.ServiceStatus{
-		ObservedGeneration: deployment.Status.ObservedGeneration,
-		Replicas:           deployment.Status.Replicas,
-		UpdatedReplicas:    deployment.Status.UpdatedReplicas,
-		ReadyReplicas:      deployment.Status.ReadyReplicas,
-		AvailableReplicas:  deployment.Status.AvailableReplicas,
-	}, nil
+
+	instances, err := c.serviceInstances(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	status.Instances = instances[name]
+	if status.Instances == nil {
+		status.Instances = []ctypes.InstanceStatus{}
+	}
+
+	return status, nil
 }
 
+// Inventory returns the allocatable resources of every active node less the requests of the
+// pods scheduled on it, whether they belong to leases or not. Idle leases keep the capacity
+// they requested. Usage reported by the metrics server is returned alongside for monitoring.
 func (c *client) Inventory(ctx context.Context) ([]ctypes.Node, error) {
 	// Load all the nodes
 	knodes, err := c.activeNodes(ctx)
@@ -369,82 +656,83 @@ func (c *client) Inventory(ctx context.Context) ([]ctypes.Node, error) {
 		return nil, err
 	}
 
-	// Load all the node metrics
-	nodeMetrics, err := c.metc.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	// Load the pods holding resources on the nodes
+	pods, err := c.kc.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: podsRunningSelector,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	nodes := make([]ctypes.Node, 0, len(nodeMetrics.Items))
-	// Iterate over the node metrics
-	for _, mnode := range nodeMetrics.Items {
-		// Lookup the node
-		knode, ok := knodes[mnode.Name]
-		if !ok {
+	allocated := make(map[string]corev1.ResourceList, len(knodes))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if _, ok := knodes[pod.Spec.NodeName]; !ok {
 			continue
 		}
-
-		// Get the amount of available CPU, then subtract that in use
-		cpu := knode.Status.Allocatable.Cpu().MilliValue()
-		cpu -= mnode.Usage.Cpu().MilliValue()
-		if cpu < 0 {
-			cpu = 0
+		if allocated[pod.Spec.NodeName] == nil {
+			allocated[pod.Spec.NodeName] = make(corev1.ResourceList)
 		}
+		addResourceList(allocated[pod.Spec.NodeName], podRequests(pod))
+	}
 
-		// Get the amount of memory, then subtract that in use
-		memory := knode.Status.Allocatable.Memory().Value()
-		memory -= mnode.Usage.Memory().Value()
-		if memory < 0 {
-			memory = 0
-		}
+	usage := c.nodeUsage(ctx)
 
-		// Get the amount of storage, then subtract that in use
-		storage := knode.Status.Allocatable.StorageEphemeral().Value()
-		storage -= mnode.Usage.StorageEphemeral().Value()
-		if storage < 0 {
-			storage = 0
-		}
+	names := make([]string, 0, len(knodes))
+	for name := range knodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodes := make([]ctypes.Node, 0, len(knodes))
+	for _, name := range names {
+		knode := knodes[name]
+		available := subResourceList(knode.Status.Allocatable, allocated[name])
 
 		resources := types.ResourceUnits{
 			CPU: &types.CPU{
-				Units: types.NewResourceValue(uint64(cpu)),
+				Units: types.NewResourceValue(uint64(available.Cpu().MilliValue())),
 				Attributes: []types.Attribute{
 					{
 						Key:   "arch",
 						Value: knode.Status.NodeInfo.Architecture,
-						// Value: types.NewAttributeValue(
-						// 	[]string{
-						// 		knode.Status.NodeInfo.Architecture,
-						// 	}),
 					},
-					// todo (#788) other node attributes ?
 				},
 			},
 			Memory: &types.Memory{
-				Quantity: types.NewResourceValue(uint64(memory)),
-				// todo (#788) memory attributes ?
+				Quantity: types.NewResourceValue(uint64(available.Memory().Value())),
 			},
 			Storage: &types.Storage{
-				Quantity: types.NewResourceValue(uint64(storage)),
-				// todo (#788) storage attributes like class and iops?
+				Quantity: types.NewResourceValue(uint64(available.StorageEphemeral().Value())),
 			},
 		}
 
-		nodes = append(nodes, cluster.NewNode(knode.Name, resources))
+		// report the node labels mapped to resource attributes so reservations only place
+		// replicas on the nodes their pods may be scheduled on
+		resources = c.settings.NodeAttributes.Annotate(resources, knode.Labels)
+
+		used := types.ResourceUnits{}
+		if nodeUsage, ok := usage[name]; ok {
+			used.CPU = &types.CPU{Units: types.NewResourceValue(uint64(nodeUsage.Cpu().MilliValue()))}
+			used.Memory = &types.Memory{Quantity: types.NewResourceValue(uint64(nodeUsage.Memory().Value()))}
+			used.Storage = &types.Storage{Quantity: types.NewResourceValue(uint64(nodeUsage.StorageEphemeral().Value()))}
+		}
+
+		nodes = append(nodes, cluster.NewNodeWithUsage(name, resources, used))
 	}
 
 	if os.Getenv("AKASH_PROVIDER_FAKE_CAPACITY") == "true" {
-		cfg := validation.Config()
+		cfg := dtypes.DefaultParams()
 		return []ctypes.Node{
 			cluster.NewNode("minikube", types.ResourceUnits{
 				CPU: &types.CPU{
-					Units: types.NewResourceValue(uint64(cfg.MaxUnitCPU * 100)),
+					Units: types.NewResourceValue(cfg.MaxUnitCPU * 100),
 				},
 				Memory: &types.Memory{
-					Quantity: types.NewResourceValue(uint64(cfg.MaxUnitMemory * 100)),
+					Quantity: types.NewResourceValue(cfg.MaxUnitMemory * 100),
 				},
 				Storage: &types.Storage{
-					Quantity: types.NewResourceValue(uint64(cfg.MaxUnitStorage * 100)),
+					Quantity: types.NewResourceValue(cfg.MaxUnitStorage * 100),
 				},
 			})}, nil
 	}
@@ -452,6 +740,120 @@ func (c *client) Inventory(ctx context.Context) ([]ctypes.Node, error) {
 	return nodes, nil
 }
 
+// nodeUsage returns the resources in use on each node as reported by the metrics server.
+// The usage is informational, so nodes are left out when it cannot be loaded.
+func (c *client) nodeUsage(ctx context.Context) map[string]corev1.ResourceList {
+	usage := make(map[string]corev1.ResourceList)
+
+	if c.metc == nil {
+		return usage
+	}
+
+	nodeMetrics, err := c.metc.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.log.Error("loading node metrics", "err", err)
+		return usage
+	}
+
+	for _, mnode := range nodeMetrics.Items {
+		usage[mnode.Name] = mnode.Usage
+	}
+
+	return usage
+}
+
+// podsRunningSelector selects the pods which have not terminated and so hold resources
+const podsRunningSelector = "status.phase!=" + string(corev1.PodSucceeded) + ",status.phase!=" + string(corev1.PodFailed)
+
+// podRequests returns the resources the scheduler reserves for pod. Init containers run
+// one at a time before the other containers, so only the largest of them counts.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := make(corev1.ResourceList)
+	for _, container := range pod.Spec.Containers {
+		addResourceList(requests, container.Resources.Requests)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	addResourceList(requests, pod.Spec.Overhead)
+
+	return requests
+}
+
+func addResourceList(dst, src corev1.ResourceList) {
+	for name, quantity := range src {
+		current := dst[name]
+		current.Add(quantity)
+		dst[name] = current
+	}
+}
+
+// subResourceList returns lhs less rhs, without going below zero
+func subResourceList(lhs, rhs corev1.ResourceList) corev1.ResourceList {
+	res := lhs.DeepCopy()
+	for name, quantity := range rhs {
+		current, ok := res[name]
+		if !ok {
+			continue
+		}
+		current.Sub(quantity)
+		if current.Sign() < 0 {
+			current.Set(0)
+		}
+		res[name] = current
+	}
+	return res
+}
+
+// StorageClasses returns the capacity of the configured storage classes less the persistent
+// volumes claimed by leases
+func (c *client) StorageClasses(ctx context.Context) ([]ctypes.StorageClass, error) {
+	if len(c.settings.StorageClasses) == 0 {
+		return nil, nil
+	}
+
+	claims, err := c.kc.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=true", akashManagedLabelName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]int64)
+	for _, claim := range claims.Items {
+		if claim.Spec.StorageClassName == nil {
+			continue
+		}
+		used[*claim.Spec.StorageClassName] += claim.Spec.Resources.Requests.Storage().Value()
+	}
+
+	names := make([]string, 0, len(c.settings.StorageClasses))
+	for name := range c.settings.StorageClasses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	classes := make([]ctypes.StorageClass, 0, len(names))
+	for _, name := range names {
+		available := int64(c.settings.StorageClasses[name]) - used[name]
+		if available < 0 {
+			available = 0
+		}
+		classes = append(classes, ctypes.StorageClass{
+			Name:      name,
+			Available: uint64(available),
+		})
+	}
+
+	return classes, nil
+}
+
 func (c *client) activeNodes(ctx context.Context) (map[string]*corev1.Node, error) {
 	knodes, err := c.kc.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -507,15 +909,73 @@ func (c *client) nodeIsActive(node *corev1.Node) bool {
 	return ready && issues == 0
 }
 
-func (c *client) deploymentsForLease(ctx context.Context, lid mtypes.LeaseID) ([]appsv1.Deployment, error) {
+// deploymentsForLease returns the deployments and stateful sets running the services of the lease
+func (c *client) deploymentsForLease(ctx context.Context, lid mtypes.LeaseID) ([]appsv1.Deployment, []appsv1.StatefulSet, error) {
 	deployments, err := c.kc.AppsV1().Deployments(lidNS(lid)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.log.Error(err.Error())
+		return nil, nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	statefulSets, err := c.kc.AppsV1().StatefulSets(lidNS(lid)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.log.Error(err.Error())
+		return nil, nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	var ditems []appsv1.Deployment
+	if deployments != nil {
+		ditems = deployments.Items
+	}
+
+	var sitems []appsv1.StatefulSet
+	if statefulSets != nil {
+		sitems = statefulSets.Items
+	}
+
+	if len(ditems) == 0 && len(sitems) == 0 {
+		return nil, nil, ErrNoDeploymentForLease
+	}
+	return ditems, sitems, nil
+}
+
+// serviceInstances returns the readiness of the running pods of the lease keyed by service name
+func (c *client) serviceInstances(ctx context.Context, lid mtypes.LeaseID) (map[string][]ctypes.InstanceStatus, error) {
+	pods, err := c.kc.CoreV1().Pods(lidNS(lid)).List(ctx, metav1.ListOptions{
+		FieldSelector: podsRunningSelector,
+	})
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, errors.Wrap(err, ErrInternalError.Error())
 	}
 
-	if deployments == nil || 0 == len(deployments.Items) {
-		return nil, ErrNoDeploymentForLease
+	instances := make(map[string][]ctypes.InstanceStatus)
+	if pods == nil {
+		return instances, nil
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	for _, pod := range pods.Items {
+		service := pod.Labels[akashManifestServiceLabelName]
+		if service == "" {
+			continue
+		}
+
+		instance := ctypes.InstanceStatus{Name: pod.Name}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady {
+				instance.Ready = cond.Status == corev1.ConditionTrue
+			}
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			instance.RestartCount += status.RestartCount
+		}
+
+		instances[service] = append(instances[service], instance)
 	}
-	return deployments.Items, nil
+
+	return instances, nil
 }
@@ -3,19 +3,27 @@ package kube
 import (
 	"context"
 	"github.com/ovrclk/akash/manifest"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/testutil"
 	kubernetes_mocks "github.com/ovrclk/akash/testutil/kubernetes_mock"
 	appsv1_mocks "github.com/ovrclk/akash/testutil/kubernetes_mock/typed/apps/v1"
 	corev1_mocks "github.com/ovrclk/akash/testutil/kubernetes_mock/typed/core/v1"
 	netv1_mocks "github.com/ovrclk/akash/testutil/kubernetes_mock/typed/networking/v1"
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/client-go/kubernetes"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func clientForTest(t *testing.T, kc kubernetes.Interface) Client {
@@ -68,6 +76,10 @@ func TestLeaseStatusWithNoDeployments(t *testing.T) {
 	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
 	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
 
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", lidNS(lid)).Return(statefulSetsMock)
+	statefulSetsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(nil, nil)
+
 	deploymentsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(nil, nil)
 
 	clientInterface := clientForTest(t, kmock)
@@ -87,6 +99,10 @@ func TestLeaseStatusWithNoIngressNoService(t *testing.T) {
 	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
 	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
 
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", lidNS(lid)).Return(statefulSetsMock)
+	statefulSetsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(nil, nil)
+
 	deploymentItems := make([]appsv1.Deployment, 1)
 	deploymentItems[0].Name = "A"
 	deploymentItems[0].Status.AvailableReplicas = 10
@@ -112,6 +128,10 @@ func TestLeaseStatusWithNoIngressNoService(t *testing.T) {
 	servicesMock := &corev1_mocks.ServiceInterface{}
 	corev1Mock.On("Services", lidNS(lid)).Return(servicesMock)
 
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", lidNS(lid)).Return(podsMock)
+	podsMock.On("List", mock.Anything, metav1.ListOptions{FieldSelector: podsRunningSelector}).Return(&v1.PodList{}, nil)
+
 	servicesList := &v1.ServiceList{} // This is concrete so no mock is used
 	servicesMock.On("List", mock.Anything, metav1.ListOptions{}).Return(servicesList, nil)
 
@@ -132,6 +152,10 @@ func TestLeaseStatusWithIngressOnly(t *testing.T) {
 	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
 	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
 
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", lidNS(lid)).Return(statefulSetsMock)
+	statefulSetsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(nil, nil)
+
 	deploymentItems := make([]appsv1.Deployment, 2)
 	deploymentItems[0].Name = "myingress"
 	deploymentItems[0].Status.AvailableReplicas = 10
@@ -175,6 +199,10 @@ func TestLeaseStatusWithIngressOnly(t *testing.T) {
 	servicesMock := &corev1_mocks.ServiceInterface{}
 	corev1Mock.On("Services", lidNS(lid)).Return(servicesMock)
 
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", lidNS(lid)).Return(podsMock)
+	podsMock.On("List", mock.Anything, metav1.ListOptions{FieldSelector: podsRunningSelector}).Return(&v1.PodList{}, nil)
+
 	servicesList := &v1.ServiceList{} // This is concrete so no mock is used
 	servicesMock.On("List", mock.Anything, metav1.ListOptions{}).Return(servicesList, nil)
 
@@ -212,6 +240,10 @@ func TestLeaseStatusWithForwardedPortOnly(t *testing.T) {
 	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
 	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
 
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", lidNS(lid)).Return(statefulSetsMock)
+	statefulSetsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(nil, nil)
+
 	deploymentItems := make([]appsv1.Deployment, 2)
 	deploymentItems[0].Name = "myservice"
 	deploymentItems[0].Status.AvailableReplicas = 10
@@ -242,6 +274,10 @@ func TestLeaseStatusWithForwardedPortOnly(t *testing.T) {
 	servicesMock := &corev1_mocks.ServiceInterface{}
 	corev1Mock.On("Services", lidNS(lid)).Return(servicesMock)
 
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", lidNS(lid)).Return(podsMock)
+	podsMock.On("List", mock.Anything, metav1.ListOptions{FieldSelector: podsRunningSelector}).Return(&v1.PodList{}, nil)
+
 	servicesList := &v1.ServiceList{} // This is concrete so no mock is used
 	servicesList.Items = make([]v1.Service, 1)
 
@@ -271,3 +307,449 @@ func TestLeaseStatusWithForwardedPortOnly(t *testing.T) {
 	require.Equal(t, int(ports[0].ExternalPort), expectedExternalPort)
 
 }
+
+func TestLeaseStatusWithStatefulSet(t *testing.T) {
+	lid := testutil.LeaseID(t)
+
+	kmock := &kubernetes_mocks.Interface{}
+	appsV1Mock := &appsv1_mocks.AppsV1Interface{}
+	kmock.On("AppsV1").Return(appsV1Mock)
+
+	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
+	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
+	deploymentsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(&appsv1.DeploymentList{}, nil)
+
+	statefulSetItems := make([]appsv1.StatefulSet, 1)
+	statefulSetItems[0].Name = "db"
+	statefulSetItems[0].Status.ReadyReplicas = 1
+	statefulSetItems[0].Status.Replicas = 2
+
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", lidNS(lid)).Return(statefulSetsMock)
+	statefulSetsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(&appsv1.StatefulSetList{Items: statefulSetItems}, nil)
+
+	netv1Mock := &netv1_mocks.NetworkingV1Interface{}
+	kmock.On("NetworkingV1").Return(netv1Mock)
+	ingressesMock := &netv1_mocks.IngressInterface{}
+	ingressesMock.On("List", mock.Anything, metav1.ListOptions{}).Return(&netv1.IngressList{}, nil)
+	netv1Mock.On("Ingresses", lidNS(lid)).Return(ingressesMock)
+
+	corev1Mock := &corev1_mocks.CoreV1Interface{}
+	kmock.On("CoreV1").Return(corev1Mock)
+
+	servicesMock := &corev1_mocks.ServiceInterface{}
+	corev1Mock.On("Services", lidNS(lid)).Return(servicesMock)
+
+	readyPod := func(name string, ready v1.ConditionStatus, restarts int32) v1.Pod {
+		pod := v1.Pod{}
+		pod.Name = name
+		pod.Labels = map[string]string{akashManifestServiceLabelName: "db"}
+		pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: ready}}
+		pod.Status.ContainerStatuses = []v1.ContainerStatus{{Name: "db", RestartCount: restarts}}
+		return pod
+	}
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", lidNS(lid)).Return(podsMock)
+	podsMock.On("List", mock.Anything, metav1.ListOptions{FieldSelector: podsRunningSelector}).Return(&v1.PodList{Items: []v1.Pod{
+		readyPod("db-1", v1.ConditionFalse, 3),
+		readyPod("db-0", v1.ConditionTrue, 0),
+	}}, nil)
+
+	servicesList := &v1.ServiceList{}
+	servicesList.Items = make([]v1.Service, 1)
+	servicesList.Items[0].Name = "db" + suffixForNodePortServiceName
+	servicesList.Items[0].Spec.Type = v1.ServiceTypeNodePort
+	servicesList.Items[0].Spec.Ports = []v1.ServicePort{{NodePort: 30432, Protocol: v1.ProtocolTCP}}
+	servicesMock.On("List", mock.Anything, metav1.ListOptions{}).Return(servicesList, nil)
+
+	clientInterface := clientForTest(t, kmock)
+
+	status, err := clientInterface.LeaseStatus(context.Background(), lid)
+	require.NoError(t, err)
+
+	require.Len(t, status.Services, 1)
+	require.Equal(t, int32(1), status.Services["db"].Available)
+	require.Equal(t, int32(2), status.Services["db"].Total)
+	require.Equal(t, []ctypes.InstanceStatus{
+		{Name: "db-0", Ready: true},
+		{Name: "db-1", Ready: false, RestartCount: 3},
+	}, status.Services["db"].Instances)
+	require.Equal(t, int32(1), status.Services["db"].Ready())
+
+	ports := status.ForwardedPorts["db"]
+	require.Len(t, ports, 1)
+	require.Equal(t, int32(1), ports[0].Available)
+}
+
+func serviceStatusPodsForTest(kmock *kubernetes_mocks.Interface, ns string) {
+	servicePod := func(name, service string, ready v1.ConditionStatus, restarts int32) v1.Pod {
+		pod := v1.Pod{}
+		pod.Name = name
+		pod.Labels = map[string]string{akashManifestServiceLabelName: service}
+		pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: ready}}
+		pod.Status.ContainerStatuses = []v1.ContainerStatus{{Name: service, RestartCount: restarts}}
+		return pod
+	}
+
+	corev1Mock := &corev1_mocks.CoreV1Interface{}
+	kmock.On("CoreV1").Return(corev1Mock)
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", ns).Return(podsMock)
+	podsMock.On("List", mock.Anything, metav1.ListOptions{FieldSelector: podsRunningSelector}).Return(&v1.PodList{Items: []v1.Pod{
+		servicePod("web-b", "web", v1.ConditionFalse, 2),
+		servicePod("db-0", "db", v1.ConditionTrue, 0),
+		servicePod("web-a", "web", v1.ConditionTrue, 0),
+		servicePod("db-1", "db", v1.ConditionFalse, 3),
+	}}, nil)
+}
+
+func TestServiceStatusOfDeployment(t *testing.T) {
+	lid := testutil.LeaseID(t)
+
+	kmock := &kubernetes_mocks.Interface{}
+	appsV1Mock := &appsv1_mocks.AppsV1Interface{}
+	kmock.On("AppsV1").Return(appsV1Mock)
+
+	deployment := &appsv1.Deployment{}
+	deployment.Name = "web"
+	deployment.Status.Replicas = 2
+	deployment.Status.ReadyReplicas = 1
+	deployment.Status.AvailableReplicas = 1
+
+	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
+	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
+	deploymentsMock.On("Get", mock.Anything, "web", metav1.GetOptions{}).Return(deployment, nil)
+
+	serviceStatusPodsForTest(kmock, lidNS(lid))
+
+	status, err := clientForTest(t, kmock).ServiceStatus(context.Background(), lid, "web")
+	require.NoError(t, err)
+
+	require.Equal(t, int32(2), status.Replicas)
+	require.Equal(t, int32(1), status.AvailableReplicas)
+	require.Equal(t, []ctypes.InstanceStatus{
+		{Name: "web-a", Ready: true},
+		{Name: "web-b", Ready: false, RestartCount: 2},
+	}, status.Instances)
+}
+
+func TestServiceStatusOfStatefulSet(t *testing.T) {
+	lid := testutil.LeaseID(t)
+
+	kmock := &kubernetes_mocks.Interface{}
+	appsV1Mock := &appsv1_mocks.AppsV1Interface{}
+	kmock.On("AppsV1").Return(appsV1Mock)
+
+	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
+	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
+	deploymentsMock.On("Get", mock.Anything, "db", metav1.GetOptions{}).
+		Return(nil, kerrors.NewNotFound(appsv1.Resource("deployments"), "db"))
+
+	sset := &appsv1.StatefulSet{}
+	sset.Name = "db"
+	sset.Status.Replicas = 2
+	sset.Status.ReadyReplicas = 1
+
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", lidNS(lid)).Return(statefulSetsMock)
+	statefulSetsMock.On("Get", mock.Anything, "db", metav1.GetOptions{}).Return(sset, nil)
+
+	serviceStatusPodsForTest(kmock, lidNS(lid))
+
+	status, err := clientForTest(t, kmock).ServiceStatus(context.Background(), lid, "db")
+	require.NoError(t, err)
+
+	require.Equal(t, int32(2), status.Replicas)
+	require.Equal(t, int32(1), status.AvailableReplicas)
+	require.Equal(t, []ctypes.InstanceStatus{
+		{Name: "db-0", Ready: true},
+		{Name: "db-1", Ready: false, RestartCount: 3},
+	}, status.Instances)
+	require.Equal(t, int32(1), status.Ready())
+}
+
+func TestServiceStatusWithoutInstances(t *testing.T) {
+	lid := testutil.LeaseID(t)
+
+	kmock := &kubernetes_mocks.Interface{}
+	appsV1Mock := &appsv1_mocks.AppsV1Interface{}
+	kmock.On("AppsV1").Return(appsV1Mock)
+
+	deployment := &appsv1.Deployment{}
+	deployment.Name = "cache"
+
+	deploymentsMock := &appsv1_mocks.DeploymentInterface{}
+	appsV1Mock.On("Deployments", lidNS(lid)).Return(deploymentsMock)
+	deploymentsMock.On("Get", mock.Anything, "cache", metav1.GetOptions{}).Return(deployment, nil)
+
+	serviceStatusPodsForTest(kmock, lidNS(lid))
+
+	status, err := clientForTest(t, kmock).ServiceStatus(context.Background(), lid, "cache")
+	require.NoError(t, err)
+
+	// the client reports the readiness of the replicas, none are running
+	require.NotNil(t, status.Instances)
+	require.Empty(t, status.Instances)
+}
+
+func TestLeaseEventsFiltersUnmanagedObjects(t *testing.T) {
+	lid := testutil.LeaseID(t)
+	ns := lidNS(lid)
+
+	kmock := &kubernetes_mocks.Interface{}
+	corev1Mock := &corev1_mocks.CoreV1Interface{}
+	kmock.On("CoreV1").Return(corev1Mock)
+
+	eventsMock := &corev1_mocks.EventInterface{}
+	corev1Mock.On("Events", ns).Return(eventsMock)
+
+	now := time.Now()
+	eventList := &v1.EventList{
+		Items: []v1.Event{
+			{
+				Type:           "Normal",
+				Reason:         "Started",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web-1"},
+				LastTimestamp:  metav1.NewTime(now),
+			},
+			{
+				Type:           "Normal",
+				Reason:         "Scheduled",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web-0"},
+				LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+			},
+			{
+				Type:           "Normal",
+				Reason:         "Sync",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "other"},
+				LastTimestamp:  metav1.NewTime(now),
+			},
+			{
+				Type:           "Warning",
+				Reason:         "Evicted",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web-2"},
+				LastTimestamp:  metav1.NewTime(now.Add(-2 * time.Minute)),
+			},
+		},
+	}
+	eventsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(eventList, nil)
+
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", ns).Return(podsMock)
+
+	managed := map[string]string{akashManagedLabelName: "true"}
+	podsMock.On("Get", mock.Anything, "web-0", metav1.GetOptions{}).
+		Return(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Labels: managed}}, nil)
+	podsMock.On("Get", mock.Anything, "web-1", metav1.GetOptions{}).
+		Return(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Labels: managed}}, nil)
+	podsMock.On("Get", mock.Anything, "other", metav1.GetOptions{}).
+		Return(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other"}}, nil)
+
+	// replaced pods no longer exist
+	podsMock.On("Get", mock.Anything, "web-2", metav1.GetOptions{}).
+		Return(nil, kerrors.NewNotFound(v1.Resource("pods"), "web-2"))
+
+	clientInterface := clientForTest(t, kmock)
+
+	events, err := clientInterface.LeaseEvents(context.Background(), lid, false)
+	require.NoError(t, err)
+
+	var reasons []string
+	for ev := range events {
+		reasons = append(reasons, ev.Reason)
+	}
+
+	require.Equal(t, []string{"Evicted", "Scheduled", "Started"}, reasons)
+}
+
+func TestLeaseEventsOfStatefulSets(t *testing.T) {
+	lid := testutil.LeaseID(t)
+	ns := lidNS(lid)
+
+	kmock := &kubernetes_mocks.Interface{}
+	corev1Mock := &corev1_mocks.CoreV1Interface{}
+	kmock.On("CoreV1").Return(corev1Mock)
+	appsV1Mock := &appsv1_mocks.AppsV1Interface{}
+	kmock.On("AppsV1").Return(appsV1Mock)
+
+	eventsMock := &corev1_mocks.EventInterface{}
+	corev1Mock.On("Events", ns).Return(eventsMock)
+
+	now := time.Now()
+	eventList := &v1.EventList{
+		Items: []v1.Event{
+			{
+				Type:           "Warning",
+				Reason:         "FailedCreate",
+				InvolvedObject: v1.ObjectReference{Kind: "StatefulSet", Name: "db"},
+				LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+			},
+			{
+				Type:           "Warning",
+				Reason:         "ProvisioningFailed",
+				InvolvedObject: v1.ObjectReference{Kind: "PersistentVolumeClaim", Name: "data-db-0"},
+				LastTimestamp:  metav1.NewTime(now),
+			},
+		},
+	}
+	eventsMock.On("List", mock.Anything, metav1.ListOptions{}).Return(eventList, nil)
+
+	managed := map[string]string{akashManagedLabelName: "true"}
+
+	statefulSetsMock := &appsv1_mocks.StatefulSetInterface{}
+	appsV1Mock.On("StatefulSets", ns).Return(statefulSetsMock)
+	statefulSetsMock.On("Get", mock.Anything, "db", metav1.GetOptions{}).
+		Return(&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Labels: managed}}, nil)
+
+	claimsMock := &corev1_mocks.PersistentVolumeClaimInterface{}
+	corev1Mock.On("PersistentVolumeClaims", ns).Return(claimsMock)
+	claimsMock.On("Get", mock.Anything, "data-db-0", metav1.GetOptions{}).
+		Return(&v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Labels: managed}}, nil)
+
+	clientInterface := clientForTest(t, kmock)
+
+	events, err := clientInterface.LeaseEvents(context.Background(), lid, false)
+	require.NoError(t, err)
+
+	var reasons []string
+	for ev := range events {
+		reasons = append(reasons, ev.Reason)
+	}
+
+	require.Equal(t, []string{"FailedCreate", "ProvisioningFailed"}, reasons)
+}
+
+func TestInventorySubtractsPodRequests(t *testing.T) {
+	kmock := &kubernetes_mocks.Interface{}
+	corev1Mock := &corev1_mocks.CoreV1Interface{}
+	kmock.On("CoreV1").Return(corev1Mock)
+
+	mknode := func(name string, ready v1.ConditionStatus) v1.Node {
+		node := v1.Node{}
+		node.Name = name
+		node.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: ready}}
+		node.Status.Allocatable = v1.ResourceList{
+			v1.ResourceCPU:              resource.MustParse("4"),
+			v1.ResourceMemory:           resource.MustParse("8Gi"),
+			v1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+		}
+		return node
+	}
+
+	nodeA := mknode("a", v1.ConditionTrue)
+	nodeA.Labels = map[string]string{"example.com/disk": "nvme"}
+
+	nodesMock := &corev1_mocks.NodeInterface{}
+	corev1Mock.On("Nodes").Return(nodesMock)
+	nodesMock.On("List", mock.Anything, metav1.ListOptions{}).Return(&v1.NodeList{Items: []v1.Node{
+		mknode("b", v1.ConditionTrue),
+		nodeA,
+		mknode("down", v1.ConditionFalse),
+	}}, nil)
+
+	mkpod := func(node string, cpu, memory string) v1.Pod {
+		pod := v1.Pod{}
+		pod.Spec.NodeName = node
+		pod.Spec.Containers = []v1.Container{{
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse(cpu),
+					v1.ResourceMemory: resource.MustParse(memory),
+				},
+			},
+		}}
+		return pod
+	}
+
+	// system pods count as much as those of leases, idle or not, and the largest init
+	// container of a pod counts when it requests more than the others together
+	system := mkpod("a", "500m", "1Gi")
+	system.Spec.InitContainers = []v1.Container{{
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+		},
+	}}
+	lease := mkpod("a", "1500m", "2Gi")
+	lease.Spec.Containers[0].Resources.Requests[v1.ResourceEphemeralStorage] = resource.MustParse("10Gi")
+
+	podsMock := &corev1_mocks.PodInterface{}
+	corev1Mock.On("Pods", metav1.NamespaceAll).Return(podsMock)
+	podsMock.On("List", mock.Anything, metav1.ListOptions{FieldSelector: podsRunningSelector}).Return(&v1.PodList{Items: []v1.Pod{
+		system,
+		lease,
+		mkpod("b", "6", "1Gi"),
+		mkpod("down", "1", "1Gi"),
+		mkpod("", "1", "1Gi"),
+	}}, nil)
+
+	clientInterface := clientForTest(t, kmock)
+	clientInterface.(*client).settings.NodeAttributes = ctypes.NodeAttributes{
+		"storage.class": {Label: "example.com/disk"},
+	}
+
+	nodes, err := clientInterface.Inventory(context.Background())
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	require.Equal(t, "a", nodes[0].ID())
+	available := nodes[0].Available()
+	require.Equal(t, uint64(1500), available.CPU.Units.Value())
+	require.Equal(t, uint64(5*unit.Gi), available.Memory.Quantity.Value())
+	require.Equal(t, uint64(90*unit.Gi), available.Storage.Quantity.Value())
+	require.Equal(t, []types.Attribute{types.NewStringAttribute("class", "nvme")}, available.Storage.Attributes)
+
+	// requests beyond the allocatable resources leave nothing available
+	require.Equal(t, "b", nodes[1].ID())
+	require.Equal(t, uint64(0), nodes[1].Available().CPU.Units.Value())
+	require.Equal(t, uint64(7*unit.Gi), nodes[1].Available().Memory.Quantity.Value())
+	require.Empty(t, nodes[1].Available().Storage.Attributes)
+}
+
+type fakeStreamConnection struct {
+	closed chan struct{}
+}
+
+func (c *fakeStreamConnection) CreateStream(http.Header) (httpstream.Stream, error) {
+	return nil, nil
+}
+
+func (c *fakeStreamConnection) Close() error {
+	close(c.closed)
+	return nil
+}
+
+func (c *fakeStreamConnection) CloseChan() <-chan bool {
+	return make(chan bool)
+}
+
+func (c *fakeStreamConnection) SetIdleTimeout(time.Duration) {}
+
+type fakeUpgrader struct {
+	conn *fakeStreamConnection
+}
+
+func (u fakeUpgrader) NewConnection(*http.Response) (httpstream.Connection, error) {
+	return u.conn, nil
+}
+
+func TestExecConnectionClosedWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	conn := &fakeStreamConnection{closed: make(chan struct{})}
+
+	_, err := ctxUpgrader{ctx: ctx, upgrader: fakeUpgrader{conn: conn}}.NewConnection(nil)
+	require.NoError(t, err)
+
+	select {
+	case <-conn.closed:
+		t.Fatal("connection closed before the context is done")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancel()
+
+	select {
+	case <-conn.closed:
+	case <-time.After(time.Second):
+		t.Fatal("connection not closed once the context is done")
+	}
+}
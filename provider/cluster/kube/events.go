@@ -0,0 +1,201 @@
+package kube
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+func (c *client) LeaseEvents(ctx context.Context, lid mtypes.LeaseID, follow bool) (<-chan ctypes.LeaseEvent, error) {
+	ns := lidNS(lid)
+
+	list, err := c.kc.CoreV1().Events(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.log.Error(err.Error())
+		return nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
+	var watcher watch.Interface
+	if follow {
+		watcher, err = c.kc.CoreV1().Events(ns).Watch(ctx, metav1.ListOptions{
+			ResourceVersion: list.ResourceVersion,
+		})
+		if err != nil {
+			c.log.Error(err.Error())
+			return nil, errors.Wrap(err, ErrInternalError.Error())
+		}
+	}
+
+	items := list.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return eventTimestamp(&items[i]).Before(eventTimestamp(&items[j]))
+	})
+
+	ch := make(chan ctypes.LeaseEvent)
+
+	go func() {
+		defer close(ch)
+		if watcher != nil {
+			defer watcher.Stop()
+		}
+
+		filter := newManagedObjectFilter(c, ns)
+
+		send := func(ev *corev1.Event) bool {
+			if !filter.managed(ctx, ev.InvolvedObject) {
+				return true
+			}
+
+			select {
+			case ch <- leaseEvent(ev):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for idx := range items {
+			if !send(&items[idx]) {
+				return
+			}
+		}
+
+		if watcher == nil {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case wev, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+
+				if wev.Type != watch.Added && wev.Type != watch.Modified {
+					continue
+				}
+
+				ev, ok := wev.Object.(*corev1.Event)
+				if !ok {
+					continue
+				}
+
+				if !send(ev) {
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func leaseEvent(ev *corev1.Event) ctypes.LeaseEvent {
+	return ctypes.LeaseEvent{
+		Type:                ev.Type,
+		ReportingController: ev.ReportingController,
+		ReportingInstance:   ev.ReportingInstance,
+		Reason:              ev.Reason,
+		Note:                ev.Message,
+		Object: ctypes.LeaseEventObject{
+			Kind: ev.InvolvedObject.Kind,
+			Name: ev.InvolvedObject.Name,
+		},
+		Count:     ev.Count,
+		Timestamp: eventTimestamp(ev),
+	}
+}
+
+func eventTimestamp(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.FirstTimestamp.Time
+	}
+}
+
+// managedObjectFilter passes the events of objects labelled as managed by akash.
+// Object labels are looked up once per object. Objects of the lease namespace which no
+// longer exist, such as replaced pods, were created by akash and their events are kept.
+type managedObjectFilter struct {
+	c       *client
+	ns      string
+	objects map[corev1.ObjectReference]bool
+}
+
+func newManagedObjectFilter(c *client, ns string) *managedObjectFilter {
+	return &managedObjectFilter{
+		c:       c,
+		ns:      ns,
+		objects: make(map[corev1.ObjectReference]bool),
+	}
+}
+
+func (f *managedObjectFilter) managed(ctx context.Context, obj corev1.ObjectReference) bool {
+	key := corev1.ObjectReference{Kind: obj.Kind, Name: obj.Name, UID: obj.UID}
+
+	if managed, ok := f.objects[key]; ok {
+		return managed
+	}
+
+	var managed bool
+
+	labels, err := f.labels(ctx, obj)
+	switch {
+	case err == nil:
+		managed = labels[akashManagedLabelName] == "true"
+	case kerrors.IsNotFound(err):
+		managed = true
+	default:
+		f.c.log.Error("fetching event object", "kind", obj.Kind, "name", obj.Name, "err", err)
+		return false
+	}
+
+	f.objects[key] = managed
+
+	return managed
+}
+
+func (f *managedObjectFilter) labels(ctx context.Context, obj corev1.ObjectReference) (map[string]string, error) {
+	var meta metav1.Object
+	var err error
+
+	switch obj.Kind {
+	case "Pod":
+		meta, err = f.c.kc.CoreV1().Pods(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	case "Service":
+		meta, err = f.c.kc.CoreV1().Services(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	case "Deployment":
+		meta, err = f.c.kc.AppsV1().Deployments(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	case "ReplicaSet":
+		meta, err = f.c.kc.AppsV1().ReplicaSets(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	case "StatefulSet":
+		meta, err = f.c.kc.AppsV1().StatefulSets(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	case "PersistentVolumeClaim":
+		meta, err = f.c.kc.CoreV1().PersistentVolumeClaims(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	case "Ingress":
+		meta, err = f.c.kc.NetworkingV1().Ingresses(f.ns).Get(ctx, obj.Name, metav1.GetOptions{})
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.GetLabels(), nil
+}
@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/testutil"
 )
 
@@ -99,7 +100,10 @@ func TestNewClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEqual(t, maxtries, tries)
 
-	logs, err := ac.ServiceLogs(ctx, lid, svcname, true, nil)
+	logs, err := ac.ServiceLogs(ctx, lid, ctypes.ServiceLogOptions{
+		Services: []string{svcname},
+		Follow:   true,
+	})
 	require.NoError(t, err)
 	require.Equal(t, int(sstat.AvailableReplicas), len(logs))
 
@@ -3,6 +3,8 @@ package kube
 import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 )
 
 // settings configures k8s object generation such that it is customized to the
@@ -23,6 +25,18 @@ type Settings struct {
 	// gcp:    true
 	// others: optional
 	DeploymentIngressExposeLBHosts bool
+
+	// Capacity in bytes of each storage class which persistent volumes of leases may be
+	// claimed from. Orders requesting persistent storage of another class are not bid on.
+	StorageClasses map[string]uint64
+
+	// Fraction of their CPU and ephemeral storage limits which containers of leases request
+	// from the scheduler
+	RequestPolicy ctypes.RequestPolicy
+
+	// Node labels the resource attributes of leases are mapped to. Pods are only scheduled
+	// on nodes labelled with the values of the mapped attributes of their service.
+	NodeAttributes ctypes.NodeAttributes
 }
 
 var errSettingsValidation = errors.New("settings validation")
@@ -31,6 +45,12 @@ func validateSettings(settings Settings) error {
 	if settings.DeploymentIngressStaticHosts && settings.DeploymentIngressDomain == "" {
 		return errors.Wrap(errSettingsValidation, "empty ingress domain")
 	}
+	if err := settings.RequestPolicy.Validate(); err != nil {
+		return errors.Wrap(errSettingsValidation, err.Error())
+	}
+	if err := settings.NodeAttributes.Validate(); err != nil {
+		return errors.Wrap(errSettingsValidation, err.Error())
+	}
 	return nil
 }
 
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	lifecycle "github.com/boz/go-lifecycle"
 	"github.com/ovrclk/akash/manifest"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
 	mtypes "github.com/ovrclk/akash/x/market/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -24,6 +27,19 @@ const (
 	dsTeardownComplete deploymentState = "teardown-complete"
 )
 
+var (
+	deploymentManagerGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "provider_deployment_managers",
+		Help: "The number of leases being deployed or monitored",
+	})
+
+	deploymentDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "provider_deployment_duration_seconds",
+		Help:    "The duration of lease deployments and teardowns",
+		Buckets: []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"action", "result"})
+)
+
 type deploymentManager struct {
 	bus     pubsub.Bus
 	client  Client
@@ -64,8 +80,11 @@ func newDeploymentManager(s *service, lease mtypes.LeaseID, mgroup *manifest.Gro
 	go dm.lc.WatchChannel(s.lc.ShuttingDown())
 	go dm.run()
 
+	deploymentManagerGauge.Inc()
+
 	go func() {
 		<-dm.lc.Done()
+		deploymentManagerGauge.Dec()
 		s.managerch <- dm
 	}()
 
@@ -196,12 +215,26 @@ func (dm *deploymentManager) startTeardown() <-chan error {
 
 func (dm *deploymentManager) doDeploy() error {
 	ctx := context.Background() // TODO: refactor management
-	return dm.client.Deploy(ctx, dm.lease, dm.mgroup)
+	start := time.Now()
+	err := dm.client.Deploy(ctx, dm.lease, dm.mgroup)
+	observeDeploymentDuration("deploy", start, err)
+	return err
 }
 
 func (dm *deploymentManager) doTeardown() error {
 	ctx := context.Background() // TODO: refactor management
-	return dm.client.TeardownLease(ctx, dm.lease)
+	start := time.Now()
+	err := dm.client.TeardownLease(ctx, dm.lease)
+	observeDeploymentDuration("teardown", start, err)
+	return err
+}
+
+func observeDeploymentDuration(action string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	deploymentDuration.WithLabelValues(action, result).Observe(time.Since(start).Seconds())
 }
 
 func (dm *deploymentManager) do(fn func() error) <-chan error {
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
 	clustertypes "github.com/ovrclk/akash/provider/cluster/types"
 
 	manifest "github.com/ovrclk/akash/manifest"
@@ -56,6 +58,29 @@ func (_m *Client) Deployments(_a0 context.Context) ([]clustertypes.Deployment, e
 	return r0, r1
 }
 
+// Exec provides a mock function with given fields: ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq
+func (_m *Client) Exec(ctx context.Context, lid types.LeaseID, service string, podIndex uint, cmd []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, tty bool, tsq clustertypes.TerminalSizeQueue) (clustertypes.ExecResult, error) {
+	ret := _m.Called(ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq)
+
+	var r0 clustertypes.ExecResult
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, string, uint, []string, io.Reader, io.Writer, io.Writer, bool, clustertypes.TerminalSizeQueue) clustertypes.ExecResult); ok {
+		r0 = rf(ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clustertypes.ExecResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, string, uint, []string, io.Reader, io.Writer, io.Writer, bool, clustertypes.TerminalSizeQueue) error); ok {
+		r1 = rf(ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Inventory provides a mock function with given fields: _a0
 func (_m *Client) Inventory(_a0 context.Context) ([]clustertypes.Node, error) {
 	ret := _m.Called(_a0)
@@ -79,6 +104,52 @@ func (_m *Client) Inventory(_a0 context.Context) ([]clustertypes.Node, error) {
 	return r0, r1
 }
 
+// LeaseEvents provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Client) LeaseEvents(_a0 context.Context, _a1 types.LeaseID, _a2 bool) (<-chan clustertypes.LeaseEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 <-chan clustertypes.LeaseEvent
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, bool) <-chan clustertypes.LeaseEvent); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan clustertypes.LeaseEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaseManifest provides a mock function with given fields: _a0, _a1
+func (_m *Client) LeaseManifest(_a0 context.Context, _a1 types.LeaseID) (*manifest.Group, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *manifest.Group
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID) *manifest.Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*manifest.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LeaseStatus provides a mock function with given fields: _a0, _a1
 func (_m *Client) LeaseStatus(_a0 context.Context, _a1 types.LeaseID) (*clustertypes.LeaseStatus, error) {
 	ret := _m.Called(_a0, _a1)
@@ -102,13 +173,13 @@ func (_m *Client) LeaseStatus(_a0 context.Context, _a1 types.LeaseID) (*clustert
 	return r0, r1
 }
 
-// ServiceLogs provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
-func (_m *Client) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 string, _a3 bool, _a4 *int64) ([]*clustertypes.ServiceLog, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+// ServiceLogs provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Client) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 clustertypes.ServiceLogOptions) ([]*clustertypes.ServiceLog, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []*clustertypes.ServiceLog
-	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, string, bool, *int64) []*clustertypes.ServiceLog); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, clustertypes.ServiceLogOptions) []*clustertypes.ServiceLog); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*clustertypes.ServiceLog)
@@ -116,8 +187,8 @@ func (_m *Client) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, string, bool, *int64) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, clustertypes.ServiceLogOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,6 +219,29 @@ func (_m *Client) ServiceStatus(_a0 context.Context, _a1 types.LeaseID, _a2 stri
 	return r0, r1
 }
 
+// StorageClasses provides a mock function with given fields: _a0
+func (_m *Client) StorageClasses(_a0 context.Context) ([]clustertypes.StorageClass, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []clustertypes.StorageClass
+	if rf, ok := ret.Get(0).(func(context.Context) []clustertypes.StorageClass); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clustertypes.StorageClass)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TeardownLease provides a mock function with given fields: _a0, _a1
 func (_m *Client) TeardownLease(_a0 context.Context, _a1 types.LeaseID) error {
 	ret := _m.Called(_a0, _a1)
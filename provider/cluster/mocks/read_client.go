@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
 	cluster "github.com/ovrclk/akash/provider/cluster/types"
 
+	manifest "github.com/ovrclk/akash/manifest"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ovrclk/akash/x/market/types"
@@ -17,6 +21,75 @@ type ReadClient struct {
 	mock.Mock
 }
 
+// Exec provides a mock function with given fields: ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq
+func (_m *ReadClient) Exec(ctx context.Context, lid types.LeaseID, service string, podIndex uint, cmd []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, tty bool, tsq cluster.TerminalSizeQueue) (cluster.ExecResult, error) {
+	ret := _m.Called(ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq)
+
+	var r0 cluster.ExecResult
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, string, uint, []string, io.Reader, io.Writer, io.Writer, bool, cluster.TerminalSizeQueue) cluster.ExecResult); ok {
+		r0 = rf(ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(cluster.ExecResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, string, uint, []string, io.Reader, io.Writer, io.Writer, bool, cluster.TerminalSizeQueue) error); ok {
+		r1 = rf(ctx, lid, service, podIndex, cmd, stdin, stdout, stderr, tty, tsq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaseEvents provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReadClient) LeaseEvents(_a0 context.Context, _a1 types.LeaseID, _a2 bool) (<-chan cluster.LeaseEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 <-chan cluster.LeaseEvent
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, bool) <-chan cluster.LeaseEvent); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan cluster.LeaseEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaseManifest provides a mock function with given fields: _a0, _a1
+func (_m *ReadClient) LeaseManifest(_a0 context.Context, _a1 types.LeaseID) (*manifest.Group, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *manifest.Group
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID) *manifest.Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*manifest.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LeaseStatus provides a mock function with given fields: _a0, _a1
 func (_m *ReadClient) LeaseStatus(_a0 context.Context, _a1 types.LeaseID) (*cluster.LeaseStatus, error) {
 	ret := _m.Called(_a0, _a1)
@@ -40,13 +113,13 @@ func (_m *ReadClient) LeaseStatus(_a0 context.Context, _a1 types.LeaseID) (*clus
 	return r0, r1
 }
 
-// ServiceLogs provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
-func (_m *ReadClient) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 string, _a3 bool, _a4 *int64) ([]*cluster.ServiceLog, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+// ServiceLogs provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReadClient) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 cluster.ServiceLogOptions) ([]*cluster.ServiceLog, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []*cluster.ServiceLog
-	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, string, bool, *int64) []*cluster.ServiceLog); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, cluster.ServiceLogOptions) []*cluster.ServiceLog); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*cluster.ServiceLog)
@@ -54,8 +127,8 @@ func (_m *ReadClient) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, string, bool, *int64) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(1).(func(context.Context, types.LeaseID, cluster.ServiceLogOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
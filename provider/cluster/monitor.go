@@ -12,6 +12,8 @@ import (
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/util/runner"
 	mtypes "github.com/ovrclk/akash/x/market/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -24,6 +26,18 @@ const (
 	monitorHealthcheckPeriodJitter = time.Second * 5
 )
 
+var (
+	monitorRetryCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "provider_deployment_monitor_retries_total",
+		Help: "The number of failed lease health checks that were retried",
+	})
+
+	leaseClosedUnhealthyCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "provider_deployment_leases_closed_unhealthy_total",
+		Help: "The number of leases closed because the deployment never became healthy",
+	})
+)
+
 type deploymentMonitor struct {
 	bus     pubsub.Bus
 	session session.Session
@@ -107,6 +121,7 @@ loop:
 
 			if m.attempts <= monitorMaxRetries {
 				// unhealthy.  retry
+				monitorRetryCounter.Inc()
 				tickch = m.scheduleRetry()
 				break
 			}
@@ -157,6 +172,13 @@ func (m *deploymentMonitor) doCheck() (bool, error) {
 					"available", service.Available,
 					"target", spec.Count,
 				)
+			} else if ready := service.Ready(); uint32(ready) < spec.Count {
+				badsvc++
+				m.log.Debug("service ready replicas below target",
+					"service", spec.Name,
+					"ready", ready,
+					"target", spec.Count,
+				)
 			}
 		}
 
@@ -203,6 +225,7 @@ func (m *deploymentMonitor) runCloseLease() <-chan runner.Result {
 			m.log.Error("closing deployment", "err", err)
 		} else {
 			m.log.Info("bidding on lease closed")
+			leaseClosedUnhealthyCounter.Inc()
 		}
 		return runner.NewResult(nil, err)
 	})
@@ -139,6 +139,56 @@ func TestMonitorSendsClusterDeploymentDeployed(t *testing.T) {
 	monitor.lc.Shutdown(nil)
 }
 
+func TestMonitorSendsClusterDeploymentPendingUntilReady(t *testing.T) {
+	const serviceName = "test"
+	myLog := testutil.Logger(t)
+	bus := pubsub.NewBus()
+	lid := testutil.LeaseID(t)
+
+	group := &manifest.Group{}
+	group.Services = make([]manifest.Service, 1)
+	group.Services[0].Name = serviceName
+	group.Services[0].Count = 3
+	client := &mocks.Client{}
+
+	statusResult := &ctypes.LeaseStatus{}
+	statusResult.Services = make(map[string]*ctypes.ServiceStatus)
+	statusResult.Services[serviceName] = &ctypes.ServiceStatus{
+		Name:      serviceName,
+		Available: 3,
+		Total:     3,
+		Instances: []ctypes.InstanceStatus{
+			{Name: "test-0", Ready: true},
+			{Name: "test-1", Ready: false, RestartCount: 4},
+			{Name: "test-2", Ready: true},
+		},
+	}
+	client.On("LeaseStatus", mock.Anything, lid).Return(statusResult, nil)
+	mySession := session.New(myLog, nil, nil)
+
+	sub, err := bus.Subscribe()
+	require.NoError(t, err)
+	lc := lifecycle.New()
+	myDeploymentManager := &deploymentManager{
+		bus:     bus,
+		session: mySession,
+		client:  client,
+		lease:   lid,
+		mgroup:  group,
+		log:     myLog,
+		lc:      lc,
+	}
+	monitor := newDeploymentMonitor(myDeploymentManager)
+	require.NotNil(t, monitor)
+
+	ev := <-sub.Events()
+	result := ev.(event.ClusterDeployment)
+	require.Equal(t, lid, result.LeaseID)
+	require.Equal(t, event.ClusterDeploymentPending, result.Status)
+
+	monitor.lc.Shutdown(nil)
+}
+
 func TestMonitorSendsClusterDeploymentWithForwardedPort(t *testing.T) {
 	const serviceName = "test"
 	myLog := testutil.Logger(t)
@@ -2,10 +2,16 @@ package cluster
 
 import (
 	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
 	"github.com/ovrclk/akash/manifest"
 	atypes "github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
 	mtypes "github.com/ovrclk/akash/x/market/types"
-	"io"
 )
 
 // Status stores current leases and inventory statuses
@@ -16,10 +22,252 @@ type Status struct {
 
 // InventoryStatus stores active, pending and available units
 type InventoryStatus struct {
-	Active    []atypes.ResourceUnits `json:"active"`
-	Pending   []atypes.ResourceUnits `json:"pending"`
-	Available []atypes.ResourceUnits `json:"available"`
-	Error     error                  `json:"error"`
+	Active           []atypes.ResourceUnits `json:"active"`
+	Pending          []atypes.ResourceUnits `json:"pending"`
+	Available        []atypes.ResourceUnits `json:"available"`
+	AvailableStorage []StorageClass         `json:"available-storage,omitempty"`
+	Error            error                  `json:"error"`
+}
+
+// StorageClass stores the capacity, in bytes, left in a storage class for persistent volumes
+type StorageClass struct {
+	Name      string `json:"name"`
+	Available uint64 `json:"available"`
+}
+
+// DefaultVolumeEphemeralStorage is the node local storage limit of the containers of services
+// with persistent storage when none is configured
+const DefaultVolumeEphemeralStorage = 1 * unit.Gi
+
+// RequestPolicy sets the fraction of its limit which every container of a lease requests
+// from the cluster scheduler. A fraction below one lets the provider oversell the resource
+// and zero requests the whole limit. Memory is always requested in full, as containers
+// using more memory than their node has left are killed.
+type RequestPolicy struct {
+	CPU     float64
+	Storage float64
+	// VolumeEphemeralStorage limits the node local storage, in bytes, of containers whose
+	// storage is a persistent volume. Zero is DefaultVolumeEphemeralStorage.
+	VolumeEphemeralStorage uint64
+}
+
+// EphemeralStorage returns the node local storage limit of a container with storage
+func (p RequestPolicy) EphemeralStorage(storage *atypes.Storage) uint64 {
+	if !storage.IsPersistent() {
+		return storage.Quantity.Value()
+	}
+	if p.VolumeEphemeralStorage == 0 {
+		return DefaultVolumeEphemeralStorage
+	}
+	return p.VolumeEphemeralStorage
+}
+
+// Requests returns the resources reserved for a container limited to units. The storage
+// requested is that of the node; persistent volumes are claimed separately.
+func (p RequestPolicy) Requests(units atypes.ResourceUnits) atypes.ResourceUnits {
+	requests := atypes.ResourceUnits{
+		Memory:    units.Memory,
+		Endpoints: units.Endpoints,
+	}
+
+	if units.CPU != nil {
+		requests.CPU = &atypes.CPU{
+			Units:      atypes.NewResourceValue(requestQuantity(units.CPU.Units.Value(), p.CPU)),
+			Attributes: units.CPU.Attributes,
+		}
+	}
+
+	if units.Storage != nil {
+		requests.Storage = &atypes.Storage{
+			Quantity: atypes.NewResourceValue(requestQuantity(p.EphemeralStorage(units.Storage), p.Storage)),
+		}
+		// the attributes of persistent storage belong to its volume
+		if !units.Storage.IsPersistent() {
+			requests.Storage.Attributes = units.Storage.Attributes
+		}
+	}
+
+	return requests
+}
+
+// Validate returns an error if a fraction of p is not between zero and one
+func (p RequestPolicy) Validate() error {
+	if p.CPU < 0 || p.CPU > 1 {
+		return fmt.Errorf("cpu request fraction %v not between 0 and 1", p.CPU)
+	}
+	if p.Storage < 0 || p.Storage > 1 {
+		return fmt.Errorf("storage request fraction %v not between 0 and 1", p.Storage)
+	}
+	return nil
+}
+
+func requestQuantity(limit uint64, fraction float64) uint64 {
+	if fraction <= 0 || fraction >= 1 {
+		return limit
+	}
+	return uint64(math.Ceil(float64(limit) * fraction))
+}
+
+// Resources of which the attributes may be mapped to node labels
+const (
+	NodeAttributeCPU     = "cpu"
+	NodeAttributeMemory  = "memory"
+	NodeAttributeStorage = "storage"
+)
+
+// NodeAttribute is the node label holding the value of a resource attribute
+type NodeAttribute struct {
+	Label string
+	// Tolerate lets pods requiring the attribute on nodes tainted with the label and value
+	Tolerate bool
+}
+
+// NodeAttributes maps resource attributes, keyed by resource and attribute key such as
+// cpu.arch or storage.class, to the node labels holding them. Attributes which are not
+// mapped do not constrain the nodes a pod is scheduled on. The attributes of persistent
+// storage select the storage class of its volume rather than nodes.
+type NodeAttributes map[string]NodeAttribute
+
+// Validate returns an error if an attribute key does not name a resource or has no label
+func (a NodeAttributes) Validate() error {
+	for key, attr := range a {
+		if _, _, err := splitNodeAttributeKey(key); err != nil {
+			return err
+		}
+		if attr.Label == "" {
+			return fmt.Errorf("%v: empty node label", key)
+		}
+	}
+	return nil
+}
+
+// Requirements returns the mapped attributes of units along with the node labels they
+// are required on
+func (a NodeAttributes) Requirements(units atypes.ResourceUnits) map[string]NodeAttributeRequirement {
+	requirements := make(map[string]NodeAttributeRequirement)
+
+	for key, attr := range a {
+		resource, name, err := splitNodeAttributeKey(key)
+		if err != nil {
+			continue
+		}
+
+		for _, uattr := range nodeSelectingAttributes(units, resource) {
+			if uattr.Key == name {
+				requirements[key] = NodeAttributeRequirement{NodeAttribute: attr, Value: uattr.Value}
+			}
+		}
+	}
+
+	return requirements
+}
+
+// NodeAttributeRequirement is the value a mapped attribute requires of its node label
+type NodeAttributeRequirement struct {
+	NodeAttribute
+	Value string
+}
+
+// Annotate sets the mapped attributes of units to the values of the node labels. Labels
+// missing from the node clear their attribute.
+func (a NodeAttributes) Annotate(units atypes.ResourceUnits, labels map[string]string) atypes.ResourceUnits {
+	for key, attr := range a {
+		resource, name, err := splitNodeAttributeKey(key)
+		if err != nil {
+			continue
+		}
+
+		value, labelled := labels[attr.Label]
+
+		switch resource {
+		case NodeAttributeCPU:
+			if units.CPU != nil {
+				cpu := *units.CPU
+				cpu.Attributes = setAttribute(cpu.Attributes, name, value, labelled)
+				units.CPU = &cpu
+			}
+		case NodeAttributeMemory:
+			if units.Memory != nil {
+				memory := *units.Memory
+				memory.Attributes = setAttribute(memory.Attributes, name, value, labelled)
+				units.Memory = &memory
+			}
+		case NodeAttributeStorage:
+			if units.Storage != nil {
+				storage := *units.Storage
+				storage.Attributes = setAttribute(storage.Attributes, name, value, labelled)
+				units.Storage = &storage
+			}
+		}
+	}
+
+	return units
+}
+
+// Match returns true if the node with the available units holds every mapped attribute
+// required by units
+func (a NodeAttributes) Match(available, units atypes.ResourceUnits) bool {
+	for key, requirement := range a.Requirements(units) {
+		resource, name, _ := splitNodeAttributeKey(key)
+
+		matched := false
+		for _, attr := range nodeSelectingAttributes(available, resource) {
+			if attr.Key == name && attr.Value == requirement.Value {
+				matched = true
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func splitNodeAttributeKey(key string) (string, string, error) {
+	parts := strings.SplitN(key, ".", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("%v: expected <resource>.<attribute>", key)
+	}
+
+	switch parts[0] {
+	case NodeAttributeCPU, NodeAttributeMemory, NodeAttributeStorage:
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("%v: unknown resource %q", key, parts[0])
+}
+
+func nodeSelectingAttributes(units atypes.ResourceUnits, resource string) atypes.Attributes {
+	switch resource {
+	case NodeAttributeCPU:
+		if units.CPU != nil {
+			return units.CPU.Attributes
+		}
+	case NodeAttributeMemory:
+		if units.Memory != nil {
+			return units.Memory.Attributes
+		}
+	case NodeAttributeStorage:
+		if units.Storage != nil && !units.Storage.IsPersistent() {
+			return units.Storage.Attributes
+		}
+	}
+	return nil
+}
+
+func setAttribute(attrs atypes.Attributes, key, value string, set bool) atypes.Attributes {
+	res := make(atypes.Attributes, 0, len(attrs)+1)
+	for _, attr := range attrs {
+		if attr.Key != key {
+			res = append(res, attr)
+		}
+	}
+	if set {
+		res = append(res, atypes.NewStringAttribute(key, value))
+	}
+	return res
 }
 
 // ServiceStatus stores the current status of service
@@ -34,6 +282,31 @@ type ServiceStatus struct {
 	UpdatedReplicas    int32 `json:"updated-replicas"`
 	ReadyReplicas      int32 `json:"ready-replicas"`
 	AvailableReplicas  int32 `json:"available-replicas"`
+
+	// Instances is nil when the client does not report the readiness of the service replicas
+	Instances []InstanceStatus `json:"instances,omitempty"`
+}
+
+// Ready returns the number of service replicas passing their readiness checks; the number of
+// available replicas is used when instance readiness is not reported.
+func (s ServiceStatus) Ready() int32 {
+	if s.Instances == nil {
+		return s.Available
+	}
+	var ready int32
+	for _, instance := range s.Instances {
+		if instance.Ready {
+			ready++
+		}
+	}
+	return ready
+}
+
+// InstanceStatus stores the health of a single service replica
+type InstanceStatus struct {
+	Name         string `json:"name"`
+	Ready        bool   `json:"ready"`
+	RestartCount int32  `json:"restart-count"`
 }
 
 type ForwardedPortStatus struct {
@@ -53,7 +326,12 @@ type LeaseStatus struct {
 // Node interface predefined with ID and Available methods
 type Node interface {
 	ID() string
+	// Available returns the allocatable resources of the node less the requests of the
+	// pods scheduled on it
 	Available() atypes.ResourceUnits
+	// Used returns the resources in use on the node as last measured, which may be more
+	// or less than the requests of its pods. It is reported but not reserved from.
+	Used() atypes.ResourceUnits
 	Reserve(atypes.ResourceUnits) error
 }
 
@@ -63,9 +341,60 @@ type Deployment interface {
 	ManifestGroup() manifest.Group
 }
 
-// ServiceLog stores name, stream and scanner
+// ServiceLog stores the log stream of a service replica. Every line of the
+// stream starts with its RFC3339Nano timestamp followed by a space.
 type ServiceLog struct {
-	Name    string
-	Stream  io.ReadCloser
-	Scanner *bufio.Scanner
+	Service      string
+	Name         string
+	RestartCount int32
+	Stream       io.ReadCloser
+	Scanner      *bufio.Scanner
+}
+
+// ServiceLogOptions selects the log streams of a lease
+type ServiceLogOptions struct {
+	// Services to stream the logs of; all services of the lease when empty
+	Services []string
+	Follow   bool
+	// TailLines limits the number of lines from the end of the logs; all lines when nil
+	TailLines *int64
+	// Since omits the lines logged before it when set
+	Since *time.Time
+	// Previous streams the logs of the previous, terminated container of the replicas
+	Previous bool
+}
+
+// ExecResult is the outcome of a command run in a lease container
+type ExecResult interface {
+	ExitCode() int
+}
+
+// TerminalSize is the size of the terminal attached to a command
+type TerminalSize struct {
+	Width  uint16 `json:"width"`
+	Height uint16 `json:"height"`
+}
+
+// TerminalSizeQueue delivers terminal size changes. Next blocks until the
+// size changes and returns nil once no more changes follow.
+type TerminalSizeQueue interface {
+	Next() *TerminalSize
+}
+
+// LeaseEvent is a cluster event of an object belonging to a lease
+type LeaseEvent struct {
+	Type                string           `json:"type"`
+	ReportingController string           `json:"reportingController,omitempty"`
+	ReportingInstance   string           `json:"reportingInstance,omitempty"`
+	Reason              string           `json:"reason"`
+	Note                string           `json:"note"`
+	Object              LeaseEventObject `json:"object"`
+	Count               int32            `json:"count"`
+	Timestamp           time.Time        `json:"timestamp"`
+}
+
+// LeaseEventObject is the object a lease event is about
+type LeaseEventObject struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
 }
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"crypto/tls"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ovrclk/akash/provider/gateway"
+	cmodule "github.com/ovrclk/akash/x/cert"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// leaseGatewayClient returns a client for the gateway of the lease provider which
+// authenticates with the certificate the lease owner keeps in the home directory
+func leaseGatewayClient(cctx client.Context, lid mtypes.LeaseID) (gateway.Client, error) {
+	paddr, err := sdk.AccAddressFromBech32(lid.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(lid.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := cutils.LoadAccountCertificate(cctx.HomeDir, owner)
+	if err != nil {
+		return nil, errors.Wrap(err, "tenant certificate not found; create one with \"akash tx cert create\"")
+	}
+
+	return gateway.NewClient(cmodule.AppModuleBasic{}.GetQueryClient(cctx), paddr, []tls.Certificate{cert}), nil
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	cmdcommon "github.com/ovrclk/akash/cmd/common"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	mcli "github.com/ovrclk/akash/x/market/client/cli"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	pmodule "github.com/ovrclk/akash/x/provider"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+func leaseEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease-events",
+		Short: "get lease cluster events",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doLeaseEvents(cmd)
+		},
+	}
+
+	mcli.AddBidIDFlags(cmd.Flags())
+	mcli.MarkReqBidIDFlags(cmd)
+
+	cmd.Flags().BoolP("follow", "f", false, "Specify if the events should be streamed. Defaults to false")
+	cmd.Flags().String("format", "text", "Output format text|json. Defaults to text")
+	return cmd
+}
+
+func doLeaseEvents(cmd *cobra.Command) error {
+	cctx := client.GetClientContextFromCmd(cmd)
+
+	addr, err := mcli.ProviderFromFlagsWithoutCtx(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+
+	if outputFormat != "text" && outputFormat != "json" {
+		return errors.Errorf("invalid output format %s", outputFormat)
+	}
+
+	follow, err := cmd.Flags().GetBool("follow")
+	if err != nil {
+		return err
+	}
+
+	pclient := pmodule.AppModuleBasic{}.GetQueryClient(cctx)
+	res, err := pclient.Provider(context.Background(), &ptypes.QueryProviderRequest{Owner: addr.String()})
+	if err != nil {
+		return err
+	}
+
+	provider := &res.Provider
+
+	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	lid := mtypes.MakeLeaseID(bid)
+
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
+	result, err := gclient.LeaseEvents(context.Background(), provider.HostURI, lid, follow)
+	if err != nil {
+		return err
+	}
+
+	printFn := func(ev ctypes.LeaseEvent) error {
+		fmt.Printf("%s\t%s\t%s\t%s/%s\t%s\n",
+			ev.Timestamp.Format(time.RFC3339), ev.Type, ev.Reason, ev.Object.Kind, ev.Object.Name, ev.Note)
+		return nil
+	}
+
+	if outputFormat == "json" {
+		printFn = func(ev ctypes.LeaseEvent) error {
+			return cmdcommon.PrintJSONStdout(ev)
+		}
+	}
+
+	for ev := range result.Stream {
+		if err = printFn(ev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
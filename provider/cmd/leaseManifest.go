@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	cmdcommon "github.com/ovrclk/akash/cmd/common"
+	maniv "github.com/ovrclk/akash/manifest"
+	"github.com/ovrclk/akash/sdl"
+	mcli "github.com/ovrclk/akash/x/market/client/cli"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	pmodule "github.com/ovrclk/akash/x/provider"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+// ErrLeaseManifestMismatch is returned when the manifest deployed for a lease differs from the SDL
+var ErrLeaseManifestMismatch = errors.New("lease manifest differs from sdl")
+
+func leaseManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease-manifest [sdl-path]",
+		Short: "get the manifest deployed for a lease, optionally comparing it with a SDL",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var sdlpath string
+			if len(args) > 0 {
+				sdlpath = args[0]
+			}
+			return doLeaseManifest(cmd, sdlpath)
+		},
+	}
+
+	mcli.AddBidIDFlags(cmd.Flags())
+	mcli.MarkReqBidIDFlags(cmd)
+
+	return cmd
+}
+
+func doLeaseManifest(cmd *cobra.Command, sdlpath string) error {
+	cctx := client.GetClientContextFromCmd(cmd)
+
+	addr, err := mcli.ProviderFromFlagsWithoutCtx(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	lid := mtypes.MakeLeaseID(bid)
+
+	pclient := pmodule.AppModuleBasic{}.GetQueryClient(cctx)
+	res, err := pclient.Provider(context.Background(), &ptypes.QueryProviderRequest{Owner: addr.String()})
+	if err != nil {
+		return err
+	}
+
+	provider := &res.Provider
+
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
+	result, err := gclient.LeaseManifest(context.Background(), provider.HostURI, lid)
+	if err != nil {
+		return err
+	}
+
+	if sdlpath == "" {
+		return cmdcommon.PrintJSONStdout(result)
+	}
+
+	sdlManifest, err := readSDLManifest(sdlpath)
+	if err != nil {
+		return err
+	}
+
+	var local *maniv.Group
+	for idx := range sdlManifest {
+		if sdlManifest[idx].Name == result.Group.Name {
+			local = &sdlManifest[idx]
+			break
+		}
+	}
+
+	if local == nil {
+		return errors.Errorf("sdl %s has no group %q", sdlpath, result.Group.Name)
+	}
+
+	version, err := sdl.ManifestVersion(maniv.Manifest{*local})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("lease version: %s\n", hex.EncodeToString(result.Version))
+	fmt.Printf("sdl version:   %s\n", hex.EncodeToString(version))
+
+	if bytes.Equal(result.Version, version) {
+		fmt.Println("lease manifest matches sdl")
+		return nil
+	}
+
+	diff, err := manifestGroupDiff(result.Group, *local, "lease", sdlpath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(diff)
+
+	cmd.SilenceUsage = true
+	return ErrLeaseManifestMismatch
+}
+
+func readSDLManifest(sdlpath string) (maniv.Manifest, error) {
+	obj, err := sdl.ReadFile(sdlpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.Manifest()
+}
+
+// manifestGroupDiff renders the unified diff of the indented JSON of both groups
+func manifestGroupDiff(from, to maniv.Group, fromName, toName string) (string, error) {
+	fromJSON, err := json.MarshalIndent(from, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	toJSON, err := json.MarshalIndent(to, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fromJSON) + "\n"),
+		B:        difflib.SplitLines(string(toJSON) + "\n"),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	"github.com/ovrclk/akash/provider/gateway"
+	mcli "github.com/ovrclk/akash/x/market/client/cli"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	pmodule "github.com/ovrclk/akash/x/provider"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+const (
+	FlagStdin        = "stdin"
+	FlagTty          = "tty"
+	FlagReplicaIndex = "replica-index"
+)
+
+func leaseShellCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease-shell --service <service> <command> [args...]",
+		Short: "run a command in a container of a lease service",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doLeaseShell(cmd, args)
+		},
+	}
+
+	// flags following the command belong to the command
+	cmd.Flags().SetInterspersed(false)
+
+	mcli.AddBidIDFlags(cmd.Flags())
+	mcli.MarkReqBidIDFlags(cmd)
+	cmd.Flags().String(FlagService, "", "name of service to run the command in")
+	if err := cmd.MarkFlagRequired(FlagService); err != nil {
+		return nil
+	}
+
+	cmd.Flags().Bool(FlagStdin, false, "connect stdin to the command")
+	cmd.Flags().Bool(FlagTty, false, "allocate a terminal for the command")
+	cmd.Flags().Uint(FlagReplicaIndex, 0, "index of the service replica to run the command in")
+
+	return cmd
+}
+
+func doLeaseShell(cmd *cobra.Command, args []string) error {
+	cctx := client.GetClientContextFromCmd(cmd)
+
+	addr, err := mcli.ProviderFromFlagsWithoutCtx(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	lid := mtypes.MakeLeaseID(bid)
+
+	svcName, err := cmd.Flags().GetString(FlagService)
+	if err != nil {
+		return err
+	}
+
+	stdin, err := cmd.Flags().GetBool(FlagStdin)
+	if err != nil {
+		return err
+	}
+
+	tty, err := cmd.Flags().GetBool(FlagTty)
+	if err != nil {
+		return err
+	}
+
+	podIndex, err := cmd.Flags().GetUint(FlagReplicaIndex)
+	if err != nil {
+		return err
+	}
+
+	pclient := pmodule.AppModuleBasic{}.GetQueryClient(cctx)
+	res, err := pclient.Provider(context.Background(), &ptypes.QueryProviderRequest{Owner: addr.String()})
+	if err != nil {
+		return err
+	}
+
+	provider := &res.Provider
+
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader
+	if stdin {
+		input = os.Stdin
+	}
+
+	var tsq <-chan ctypes.TerminalSize
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if tty {
+		fd := int(os.Stdin.Fd())
+		if !terminal.IsTerminal(fd) {
+			return errors.New("--tty requires stdin to be a terminal")
+		}
+
+		state, err := terminal.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = terminal.Restore(fd, state)
+		}()
+
+		tsq = watchTerminalSize(ctx, fd)
+	}
+
+	err = gclient.LeaseShell(ctx, provider.HostURI, lid, svcName, podIndex, args,
+		input, cmd.OutOrStdout(), cmd.ErrOrStderr(), tty, tsq)
+
+	var exitErr gateway.LeaseShellExitError
+	if errors.As(err, &exitErr) {
+		cmd.SilenceUsage = true
+	}
+
+	return err
+}
+
+// watchTerminalSize sends the size of the terminal and every change of it
+func watchTerminalSize(ctx context.Context, fd int) <-chan ctypes.TerminalSize {
+	ch := make(chan ctypes.TerminalSize, 1)
+
+	changes := terminalSizeChanges(ctx)
+
+	go func() {
+		defer close(ch)
+
+		for {
+			width, height, err := terminal.GetSize(fd)
+			if err == nil {
+				select {
+				case ch <- ctypes.TerminalSize{Width: uint16(width), Height: uint16(height)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-changes:
+			}
+		}
+	}()
+
+	return ch
+}
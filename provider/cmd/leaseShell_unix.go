@@ -0,0 +1,23 @@
+// +build !windows
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// terminalSizeChanges signals every resize of the controlling terminal
+func terminalSizeChanges(ctx context.Context) <-chan os.Signal {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGWINCH)
+
+	go func() {
+		<-ctx.Done()
+		signal.Stop(ch)
+	}()
+
+	return ch
+}
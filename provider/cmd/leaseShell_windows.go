@@ -0,0 +1,13 @@
+// +build windows
+
+package cmd
+
+import (
+	"context"
+	"os"
+)
+
+// terminalSizeChanges is not supported on windows; the initial terminal size is kept
+func terminalSizeChanges(_ context.Context) <-chan os.Signal {
+	return make(chan os.Signal)
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/spf13/cobra"
 
 	cmdcommon "github.com/ovrclk/akash/cmd/common"
-	"github.com/ovrclk/akash/provider/gateway"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	pmodule "github.com/ovrclk/akash/x/provider"
@@ -44,7 +43,6 @@ func doLeaseStatus(cmd *cobra.Command) error {
 	}
 
 	provider := &res.Provider
-	gclient := gateway.NewClient()
 
 	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
 	if err != nil {
@@ -53,6 +51,11 @@ func doLeaseStatus(cmd *cobra.Command) error {
 
 	lid := mtypes.MakeLeaseID(bid)
 
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
 	result, err := gclient.LeaseStatus(context.Background(), provider.HostURI, lid)
 	if err != nil {
 		return err
@@ -4,18 +4,19 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/ovrclk/akash/provider/gateway"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/ovrclk/akash/provider/manifest"
 	"github.com/ovrclk/akash/sdl"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	pmodule "github.com/ovrclk/akash/x/provider"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 // SendManifestCmd looks up the Providers blockchain information,
-// and POSTs the SDL file to the Gateway address.
+// and POSTs the SDL file, signed by the deployment owner, to the Gateway address.
 func SendManifestCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send-manifest <sdl-path>",
@@ -25,13 +26,17 @@ func SendManifestCmd() *cobra.Command {
 			return doSendManifest(cmd, args[0])
 		},
 	}
+	flags.AddTxFlagsToCmd(cmd)
 	mcli.AddBidIDFlags(cmd.Flags())
 	mcli.MarkReqBidIDFlags(cmd)
 	return cmd
 }
 
 func doSendManifest(cmd *cobra.Command, sdlpath string) error {
-	cctx := client.GetClientContextFromCmd(cmd)
+	cctx, err := client.ReadTxCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+	if err != nil {
+		return err
+	}
 
 	sdl, err := sdl.ReadFile(sdlpath)
 	if err != nil {
@@ -50,6 +55,10 @@ func doSendManifest(cmd *cobra.Command, sdlpath string) error {
 
 	lid := mtypes.MakeLeaseID(bid)
 
+	if lid.Owner != cctx.GetFromAddress().String() {
+		return errors.Errorf("manifest must be signed by the deployment owner %v", lid.Owner)
+	}
+
 	pclient := pmodule.AppModuleBasic{}.GetQueryClient(cctx)
 	res, err := pclient.Provider(context.Background(), &ptypes.QueryProviderRequest{Owner: lid.Provider})
 	if err != nil {
@@ -57,14 +66,39 @@ func doSendManifest(cmd *cobra.Command, sdlpath string) error {
 	}
 
 	provider := &res.Provider
-	gclient := gateway.NewClient()
-
-	return gclient.SubmitManifest(
-		context.Background(),
-		provider.HostURI,
-		&manifest.SubmitRequest{
-			Deployment: lid.DeploymentID(),
-			Manifest:   mani,
-		},
-	)
+
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
+	height, err := currentBlockHeight(cctx)
+	if err != nil {
+		return err
+	}
+
+	mreq := &manifest.SubmitRequest{
+		Deployment: lid.DeploymentID(),
+		Manifest:   mani,
+	}
+
+	if err := mreq.Sign(cctx.Keyring, cctx.GetFromName(), height); err != nil {
+		return err
+	}
+
+	return gclient.SubmitManifest(context.Background(), provider.HostURI, mreq)
+}
+
+func currentBlockHeight(cctx client.Context) (int64, error) {
+	node, err := cctx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
 }
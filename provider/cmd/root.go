@@ -23,6 +23,9 @@ func RootCmd() *cobra.Command {
 	cmd.AddCommand(leaseStatusCmd())
 	cmd.AddCommand(serviceStatusCmd())
 	cmd.AddCommand(serviceLogsCmd())
+	cmd.AddCommand(leaseEventsCmd())
+	cmd.AddCommand(leaseManifestCmd())
+	cmd.AddCommand(leaseShellCmd())
 	cmd.AddCommand(RunCmd())
 
 	return cmd
@@ -2,10 +2,13 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"github.com/ovrclk/akash/provider/bidengine"
+	"net/http"
 	"os"
+	"syscall"
 	"time"
 
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/go-kit/kit/log/term"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,9 +29,13 @@ import (
 	"github.com/ovrclk/akash/provider"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/cluster/kube"
+	pconfig "github.com/ovrclk/akash/provider/config"
 	"github.com/ovrclk/akash/provider/gateway"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
+	amodule "github.com/ovrclk/akash/x/audit"
+	cmodule "github.com/ovrclk/akash/x/cert"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
 	dmodule "github.com/ovrclk/akash/x/deployment"
 	mmodule "github.com/ovrclk/akash/x/market"
 	pmodule "github.com/ovrclk/akash/x/provider"
@@ -57,6 +65,11 @@ const (
 	FlagDeploymentIngressStaticHosts    = "deployment-ingress-static-hosts"
 	FlagDeploymentIngressDomain         = "deployment-ingress-domain"
 	FlagDeploymentIngressExposeLBHosts  = "deployment-ingress-expose-lb-hosts"
+	// FlagConfig is the path of the provider configuration file. Values in the file take
+	// precedence over flags.
+	FlagConfig = "config"
+	// FlagMetricsListener determines the listening address of the Prometheus metrics endpoint
+	FlagMetricsListener = "metrics-listener"
 )
 
 var (
@@ -69,6 +82,12 @@ func RunCmd() *cobra.Command {
 		Use:   "run",
 		Short: "run akash provider",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if viper.GetString(FlagConfig) != "" {
+				// SIGHUP reloads the configuration file instead of stopping the provider
+				return common.RunForeverWithSignals(func(ctx context.Context) error {
+					return doRunCmd(ctx, cmd, args)
+				}, syscall.SIGINT, syscall.SIGTERM)
+			}
 			return common.RunForever(func(ctx context.Context) error {
 				return doRunCmd(ctx, cmd, args)
 			})
@@ -177,62 +196,90 @@ func RunCmd() *cobra.Command {
 		return nil
 	}
 
-	return cmd
-}
+	cmd.Flags().String(FlagConfig, "", "Path of a YAML configuration file; the bid and attributes sections are reloaded on SIGHUP or when the file changes")
+	if err := viper.BindPFlag(FlagConfig, cmd.Flags().Lookup(FlagConfig)); err != nil {
+		return nil
+	}
 
-const (
-	bidPricingStrategyScale       = "scale"
-	bidPricingStrategyRandomRange = "randomRange"
-	bidPricingStrategyShellScript = "shellScript"
-)
+	cmd.Flags().String(FlagMetricsListener, "", "IP and port to serve Prometheus metrics on at /metrics; disabled when empty")
+	if err := viper.BindPFlag(FlagMetricsListener, cmd.Flags().Lookup(FlagMetricsListener)); err != nil {
+		return nil
+	}
 
-var allowedBidPricingStrategies = [...]string{
-	bidPricingStrategyScale,
-	bidPricingStrategyRandomRange,
-	bidPricingStrategyShellScript,
+	return cmd
 }
 
-var errNoSuchBidPricingStrategy = fmt.Errorf("No such bid pricing strategy. Allowed: %v", allowedBidPricingStrategies)
-
-func createBidPricingStrategy(strategy string) (bidengine.BidPricingStrategy, error) {
-	if strategy == bidPricingStrategyScale {
-		cpuScale := viper.GetUint64(FlagBidPriceCPUScale)
-		memoryScale := viper.GetUint64(FlagBidPriceMemoryScale)
-		storageScale := viper.GetUint64(FlagBidPriceStorageScale)
-		endpointScale := viper.GetUint64(FlagBidPriceEndpointScale)
-
-		return bidengine.MakeScalePricing(cpuScale, memoryScale, storageScale, endpointScale)
+// configFromFlags returns the provider configuration given by the command line flags
+func configFromFlags() pconfig.Config {
+	return pconfig.Config{
+		Bid: pconfig.Bid{
+			Pricing: pconfig.Pricing{
+				Strategy: viper.GetString(FlagBidPricingStrategy),
+				Scale: pconfig.Scale{
+					CPU:      viper.GetUint64(FlagBidPriceCPUScale),
+					Memory:   viper.GetUint64(FlagBidPriceMemoryScale),
+					Storage:  viper.GetUint64(FlagBidPriceStorageScale),
+					Endpoint: viper.GetUint64(FlagBidPriceEndpointScale),
+				},
+				Script: pconfig.Script{
+					Path:         viper.GetString(FlagBidPriceScriptPath),
+					ProcessLimit: viper.GetUint(FlagBidPriceScriptProcessLimit),
+					Timeout:      viper.GetDuration(FlagBidPriceScriptTimeout),
+				},
+			},
+		},
+		Cluster: pconfig.Cluster{
+			// TODO - validate that the public hostname is a valid hostname
+			PublicHostname:    viper.GetString(FlagClusterPublicHostname),
+			NodePortQuantity:  viper.GetUint(FlagClusterNodePortQuantity),
+			WaitReadyDuration: viper.GetDuration(FlagClusterWaitReadyDuration),
+			ServiceType:       kube.NewDefaultSettings().DeploymentServiceType,
+			Ingress: pconfig.Ingress{
+				StaticHosts:   viper.GetBool(FlagDeploymentIngressStaticHosts),
+				Domain:        viper.GetString(FlagDeploymentIngressDomain),
+				ExposeLBHosts: viper.GetBool(FlagDeploymentIngressExposeLBHosts),
+			},
+		},
+		Inventory: pconfig.Inventory{
+			PollPeriod:     viper.GetDuration(FlagInventoryResourcePollPeriod),
+			DebugFrequency: viper.GetUint(FlagInventoryResourceDebugFrequency),
+		},
+		Gateway: pconfig.Gateway{
+			ListenAddress: viper.GetString(FlagGatewayListenAddress),
+		},
 	}
+}
 
-	if strategy == bidPricingStrategyRandomRange {
-		return bidengine.MakeRandomRangePricing()
-	}
+// loadConfig returns the provider configuration given by the flags and the configuration
+// file, if any, along with the configuration given by the flags alone
+func loadConfig() (pconfig.Config, pconfig.Config, error) {
+	base := configFromFlags()
 
-	if strategy == bidPricingStrategyShellScript {
-		scriptPath := viper.GetString(FlagBidPriceScriptPath)
-		processLimit := viper.GetUint(FlagBidPriceScriptProcessLimit)
-		runtimeLimit := viper.GetDuration(FlagBidPriceScriptTimeout)
-		return bidengine.MakeShellScriptPricing(scriptPath, processLimit, runtimeLimit)
+	path := viper.GetString(FlagConfig)
+	if path == "" {
+		return base, base, base.Validate()
 	}
 
-	return nil, errNoSuchBidPricingStrategy
+	cfg, err := pconfig.ReadConfigPath(path, base)
+	return cfg, base, err
 }
 
 // doRunCmd initializes all of the Provider functionality, hangs, and awaits shutdown signals.
 func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
-	clusterPublicHostname := viper.GetString(FlagClusterPublicHostname)
-	// TODO - validate that clusterPublicHostname is a valid hostname
-	nodePortQuantity := viper.GetUint(FlagClusterNodePortQuantity)
-	clusterWaitReadyDuration := viper.GetDuration(FlagClusterWaitReadyDuration)
-	inventoryResourcePollPeriod := viper.GetDuration(FlagInventoryResourcePollPeriod)
-	inventoryResourceDebugFreq := viper.GetUint(FlagInventoryResourceDebugFrequency)
-	deploymentIngressStaticHosts := viper.GetBool(FlagDeploymentIngressStaticHosts)
-	deploymentIngressDomain := viper.GetString(FlagDeploymentIngressDomain)
-	strategy := viper.GetString(FlagBidPricingStrategy)
-	deploymentIngressExposeLBHosts := viper.GetBool(FlagDeploymentIngressExposeLBHosts)
+	cfg, baseCfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	metricsListener := viper.GetString(FlagMetricsListener)
 	from := viper.GetString(flags.FlagFrom)
-	pricing, err := createBidPricingStrategy(strategy)
 
+	pricing, err := cfg.Bid.Pricing.Create()
+	if err != nil {
+		return err
+	}
+
+	bidSettings, err := bidengine.NewSettings(pricing, cfg.BidFilters())
 	if err != nil {
 		return err
 	}
@@ -257,13 +304,16 @@ func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	gwaddr := viper.GetString(FlagGatewayListenAddress)
-
 	log := openLogger()
 
+	// the broadcaster outlives the provider services, which close their bids on shutdown
+	bctx, bcancel := context.WithCancel(context.Background())
+	defer bcancel()
+
 	// TODO: actually get the passphrase?
 	// passphrase, err := keys.GetPassphrase(fromName)
 	aclient := client.NewClient(
+		bctx,
 		log,
 		cctx,
 		txFactory,
@@ -273,6 +323,8 @@ func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
 			dmodule.AppModuleBasic{}.GetQueryClient(cctx),
 			mmodule.AppModuleBasic{}.GetQueryClient(cctx),
 			pmodule.AppModuleBasic{}.GetQueryClient(cctx),
+			amodule.AppModuleBasic{}.GetQueryClient(cctx),
+			cmodule.AppModuleBasic{}.GetQueryClient(cctx),
 		),
 	)
 
@@ -286,13 +338,14 @@ func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
 
 	pinfo := &res.Provider
 
-	// k8s client creation
-	kubeSettings := kube.NewDefaultSettings()
-	kubeSettings.DeploymentIngressDomain = deploymentIngressDomain
-	kubeSettings.DeploymentIngressExposeLBHosts = deploymentIngressExposeLBHosts
-	kubeSettings.DeploymentIngressStaticHosts = deploymentIngressStaticHosts
+	// the gateway serves TLS with the certificate the provider published on chain
+	gwcert, err := cutils.LoadAccountCertificate(cctx.HomeDir, info.GetAddress())
+	if err != nil {
+		return fmt.Errorf("provider certificate not found; create one with \"akash tx cert create\": %w", err)
+	}
 
-	cclient, err := createClusterClient(log, cmd, pinfo.HostURI, kubeSettings)
+	// k8s client creation
+	cclient, err := createClusterClient(log, cmd, pinfo.HostURI, cfg.Cluster.KubeSettings())
 	if err != nil {
 		return err
 	}
@@ -309,19 +362,22 @@ func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
 	group, ctx := errgroup.WithContext(ctx)
 
 	config := provider.NewDefaultConfig()
-	config.ClusterWaitReadyDuration = clusterWaitReadyDuration
-	config.ClusterPublicHostname = clusterPublicHostname
-	config.ClusterExternalPortQuantity = nodePortQuantity
-	config.InventoryResourceDebugFrequency = inventoryResourceDebugFreq
-	config.InventoryResourcePollPeriod = inventoryResourcePollPeriod
-	config.BPS = pricing
+	config.ClusterWaitReadyDuration = cfg.Cluster.WaitReadyDuration
+	config.ClusterPublicHostname = cfg.Cluster.PublicHostname
+	config.ClusterExternalPortQuantity = cfg.Cluster.NodePortQuantity
+	config.ClusterRequestPolicy = cfg.Cluster.RequestPolicy()
+	config.ClusterNodeAttributes = cfg.Cluster.NodeAttributePolicy()
+	config.InventoryResourceDebugFrequency = cfg.Inventory.DebugFrequency
+	config.InventoryResourcePollPeriod = cfg.Inventory.PollPeriod
+	config.BidSettings = bidSettings
+	config.ManifestImages = cfg.Manifest.ImagePolicy()
 	service, err := provider.NewService(ctx, session, bus, cclient, config)
 
 	if err != nil {
 		return group.Wait()
 	}
 
-	gateway := gateway.NewServer(ctx, log, service, gwaddr)
+	gateway := gateway.NewServer(ctx, log, service, aclient.Query(), cfg.Gateway.ListenAddress, []tls.Certificate{gwcert})
 
 	group.Go(func() error {
 		return events.Publish(ctx, cctx.Client, "provider-cli", bus)
@@ -332,13 +388,50 @@ func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
 		return nil
 	})
 
-	group.Go(gateway.ListenAndServe)
+	group.Go(func() error {
+		// certificates are set in the gateway TLS config
+		return gateway.ListenAndServeTLS("", "")
+	})
 
 	group.Go(func() error {
 		<-ctx.Done()
 		return gateway.Close()
 	})
 
+	if path := viper.GetString(FlagConfig); path != "" {
+		reloader, err := pconfig.NewReloader(log, path, baseCfg, cfg, func(cfg pconfig.Config) error {
+			pricing, err := cfg.Bid.Pricing.Create()
+			if err != nil {
+				return err
+			}
+			return bidSettings.Update(pricing, cfg.BidFilters())
+		})
+		if err != nil {
+			return err
+		}
+
+		group.Go(func() error {
+			return reloader.Run(ctx)
+		})
+	}
+
+	if metricsListener != "" {
+		metrics := newMetricsServer(metricsListener)
+
+		group.Go(func() error {
+			err := metrics.ListenAndServe()
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		})
+
+		group.Go(func() error {
+			<-ctx.Done()
+			return metrics.Close()
+		})
+	}
+
 	err = group.Wait()
 	if err != nil && !errors.Is(err, context.Canceled) {
 		return err
@@ -347,6 +440,16 @@ func doRunCmd(ctx context.Context, cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+func newMetricsServer(address string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{
+		Addr:    address,
+		Handler: mux,
+	}
+}
+
 func openLogger() log.Logger {
 	// logger with no color output - current debug colors are invisible for me.
 	return log.NewTMLoggerWithColorFn(log.NewSyncWriter(os.Stdout), func(_ ...interface{}) term.FgBgColor {
@@ -3,12 +3,14 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	cmdcommon "github.com/ovrclk/akash/cmd/common"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/provider/gateway"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	mtypes "github.com/ovrclk/akash/x/market/types"
@@ -19,7 +21,7 @@ import (
 func serviceLogsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "service-logs",
-		Short: "get service status",
+		Short: "get service logs",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return doServiceLogs(cmd)
 		},
@@ -28,11 +30,12 @@ func serviceLogsCmd() *cobra.Command {
 	mcli.AddBidIDFlags(cmd.Flags())
 	mcli.MarkReqBidIDFlags(cmd)
 
-	cmd.Flags().String("service", "", "")
-	_ = cmd.MarkFlagRequired("service")
+	cmd.Flags().StringSlice("service", nil, "Services to show the logs of. Defaults to all services of the lease")
 
 	cmd.Flags().BoolP("follow", "f", false, "Specify if the logs should be streamed. Defaults to false")
 	cmd.Flags().Int64P("tail", "t", -1, "The number of lines from the end of the logs to show. Defaults to -1")
+	cmd.Flags().String("since", "", "Only show logs newer than a RFC3339 timestamp or a relative duration like 10m")
+	cmd.Flags().Bool("previous", false, "Show the logs of the previous, terminated container of each replica")
 	cmd.Flags().String("format", "text", "Output format text|json. Defaults to text")
 	return cmd
 }
@@ -46,7 +49,7 @@ func doServiceLogs(cmd *cobra.Command) error {
 		return err
 	}
 
-	svcName, err := cmd.Flags().GetString("service")
+	services, err := cmd.Flags().GetStringSlice("service")
 	if err != nil {
 		return err
 	}
@@ -67,7 +70,6 @@ func doServiceLogs(cmd *cobra.Command) error {
 	}
 
 	provider := &res.Provider
-	gclient := gateway.NewClient()
 
 	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
 	if err != nil {
@@ -76,6 +78,11 @@ func doServiceLogs(cmd *cobra.Command) error {
 
 	lid := mtypes.MakeLeaseID(bid)
 
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
 	follow, err := cmd.Flags().GetBool("follow")
 	if err != nil {
 		return err
@@ -90,13 +97,42 @@ func doServiceLogs(cmd *cobra.Command) error {
 		return errors.Errorf("tail flag supplied with invalid value. must be >= -1")
 	}
 
-	result, err := gclient.ServiceLogs(context.Background(), provider.HostURI, lid, svcName, follow, tailLines)
+	previous, err := cmd.Flags().GetBool("previous")
+	if err != nil {
+		return err
+	}
+
+	sinceVal, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return err
+	}
+
+	opts := ctypes.ServiceLogOptions{
+		Services: services,
+		Follow:   follow,
+		Previous: previous,
+	}
+
+	if tailLines > -1 {
+		opts.TailLines = &tailLines
+	}
+
+	if sinceVal != "" {
+		since, err := parseLogsSince(sinceVal, time.Now())
+		if err != nil {
+			return err
+		}
+		opts.Since = &since
+	}
+
+	result, err := gclient.ServiceLogs(context.Background(), provider.HostURI, lid, opts)
 	if err != nil {
 		return err
 	}
 
 	printFn := func(msg gateway.ServiceLogMessage) error {
-		fmt.Printf("[%s] %s\n", msg.Name, msg.Message)
+		fmt.Printf("%s [%s/%s] (restarts: %d) %s\n",
+			msg.Timestamp.Format(time.RFC3339), msg.Service, msg.Name, msg.RestartCount, msg.Message)
 		return nil
 	}
 
@@ -115,3 +151,17 @@ func doServiceLogs(cmd *cobra.Command) error {
 
 	return nil
 }
+
+// parseLogsSince reads either a RFC3339 timestamp or a duration relative to now
+func parseLogsSince(val string, now time.Time) (time.Time, error) {
+	if since, err := time.Parse(time.RFC3339, val); err == nil {
+		return since, nil
+	}
+
+	dur, err := time.ParseDuration(val)
+	if err != nil || dur < 0 {
+		return time.Time{}, errors.Errorf("since flag supplied with invalid value %q. must be a RFC3339 timestamp or a positive duration", val)
+	}
+
+	return now.Add(-dur), nil
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/spf13/cobra"
 
 	cmdcommon "github.com/ovrclk/akash/cmd/common"
-	"github.com/ovrclk/akash/provider/gateway"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	pmodule "github.com/ovrclk/akash/x/provider"
@@ -54,7 +53,6 @@ func doServiceStatus(cmd *cobra.Command) error {
 	}
 
 	provider := &res.Provider
-	gclient := gateway.NewClient()
 
 	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
 	if err != nil {
@@ -63,6 +61,11 @@ func doServiceStatus(cmd *cobra.Command) error {
 
 	lid := mtypes.MakeLeaseID(bid)
 
+	gclient, err := leaseGatewayClient(cctx, lid)
+	if err != nil {
+		return err
+	}
+
 	result, err := gclient.ServiceStatus(context.Background(), provider.HostURI, lid, svcName)
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 
 	cmdcommon "github.com/ovrclk/akash/cmd/common"
 	"github.com/ovrclk/akash/provider/gateway"
+	cmodule "github.com/ovrclk/akash/x/cert"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	pmodule "github.com/ovrclk/akash/x/provider"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
@@ -43,7 +44,7 @@ func doStatus(cmd *cobra.Command) error {
 	}
 
 	provider := &res.Provider
-	gclient := gateway.NewClient()
+	gclient := gateway.NewClient(cmodule.AppModuleBasic{}.GetQueryClient(cctx), addr, nil)
 
 	result, err := gclient.Status(context.Background(), provider.HostURI)
 	if err != nil {
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"github.com/ovrclk/akash/provider/bidengine"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	"github.com/ovrclk/akash/provider/manifest"
 	"time"
 )
 
@@ -9,9 +11,12 @@ type Config struct {
 	ClusterWaitReadyDuration        time.Duration
 	ClusterPublicHostname           string
 	ClusterExternalPortQuantity     uint
+	ClusterRequestPolicy            ctypes.RequestPolicy
+	ClusterNodeAttributes           ctypes.NodeAttributes
 	InventoryResourcePollPeriod     time.Duration
 	InventoryResourceDebugFrequency uint
-	BPS                             bidengine.BidPricingStrategy
+	BidSettings                     *bidengine.Settings
+	ManifestImages                  manifest.ImagePolicy
 }
 
 func NewDefaultConfig() Config {
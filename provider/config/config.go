@@ -0,0 +1,399 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/ovrclk/akash/provider/bidengine"
+	"github.com/ovrclk/akash/provider/cluster/kube"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	"github.com/ovrclk/akash/provider/manifest"
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/util/wildcard"
+)
+
+// Bid pricing strategies
+const (
+	BidPricingStrategyScale       = "scale"
+	BidPricingStrategyRandomRange = "randomRange"
+	BidPricingStrategyShellScript = "shellScript"
+)
+
+var allowedBidPricingStrategies = [...]string{
+	BidPricingStrategyScale,
+	BidPricingStrategyRandomRange,
+	BidPricingStrategyShellScript,
+}
+
+var (
+	// ErrInvalidConfig is returned when the configuration fails validation
+	ErrInvalidConfig = errors.New("invalid provider configuration")
+
+	errNoSuchBidPricingStrategy = fmt.Errorf("no such bid pricing strategy. Allowed: %v", allowedBidPricingStrategies)
+)
+
+// Config is the configuration of the provider services
+type Config struct {
+	Bid        Bid        `yaml:"bid"`
+	Attributes Attributes `yaml:"attributes"`
+	Cluster    Cluster    `yaml:"cluster"`
+	Inventory  Inventory  `yaml:"inventory"`
+	Gateway    Gateway    `yaml:"gateway"`
+	Manifest   Manifest   `yaml:"manifest"`
+}
+
+// Bid configures how the bid engine selects and prices orders
+type Bid struct {
+	Pricing Pricing `yaml:"pricing"`
+	Filters Filters `yaml:"filters"`
+	Tenants Tenants `yaml:"tenants"`
+}
+
+// Pricing selects a bid pricing strategy and its parameters
+type Pricing struct {
+	Strategy string `yaml:"strategy"`
+	Scale    Scale  `yaml:"scale"`
+	Script   Script `yaml:"script"`
+}
+
+// Scale holds the prices in uakt per unit of each resource for the scale strategy
+type Scale struct {
+	CPU      uint64 `yaml:"cpu"`
+	Memory   uint64 `yaml:"memory"`
+	Storage  uint64 `yaml:"storage"`
+	Endpoint uint64 `yaml:"endpoint"`
+}
+
+// Script configures the shellScript strategy
+type Script struct {
+	Path         string        `yaml:"path"`
+	ProcessLimit uint          `yaml:"process-limit"`
+	Timeout      time.Duration `yaml:"timeout"`
+}
+
+// Filters limits the size of the groups bid on and the price they must offer.
+// Zero disables a limit.
+type Filters struct {
+	MaxGroupCPU     uint64 `yaml:"max-group-cpu"`
+	MaxGroupMemory  uint64 `yaml:"max-group-memory"`
+	MaxGroupStorage uint64 `yaml:"max-group-storage"`
+	// MinPrice holds the lowest prices in uakt per unit of each resource a group may offer
+	MinPrice Scale `yaml:"min-price"`
+}
+
+// Tenants restricts the tenants bid for and the leases each may hold with the provider.
+// Zero disables a limit.
+type Tenants struct {
+	Allow      []string `yaml:"allow"`
+	Deny       []string `yaml:"deny"`
+	MaxLeases  uint32   `yaml:"max-leases"`
+	MaxCPU     uint64   `yaml:"max-cpu"`
+	MaxMemory  uint64   `yaml:"max-memory"`
+	MaxStorage uint64   `yaml:"max-storage"`
+}
+
+// Attributes restricts the placement requirements of the groups bid on. Keys and values
+// are wildcard patterns, in which '*' matches any sequence of characters.
+type Attributes struct {
+	Require types.Attributes `yaml:"require"`
+	Deny    types.Attributes `yaml:"deny"`
+}
+
+// Cluster configures the cluster service and the kubernetes objects created for leases
+type Cluster struct {
+	PublicHostname    string             `yaml:"public-hostname"`
+	NodePortQuantity  uint               `yaml:"node-port-quantity"`
+	WaitReadyDuration time.Duration      `yaml:"wait-ready-duration"`
+	ServiceType       corev1.ServiceType `yaml:"service-type"`
+	Ingress           Ingress            `yaml:"ingress"`
+	// StorageClasses holds the capacity, as a kubernetes quantity such as 500Gi, of each
+	// storage class persistent volumes of leases are claimed from
+	StorageClasses map[string]string `yaml:"storage-classes"`
+	// VolumeEphemeralStorage limits the node local storage, as a kubernetes quantity, of the
+	// containers of services with a persistent volume
+	VolumeEphemeralStorage string   `yaml:"volume-ephemeral-storage"`
+	Requests               Requests `yaml:"requests"`
+	// NodeAttributes maps resource attributes, such as cpu.arch or storage.class, to the
+	// node labels holding them
+	NodeAttributes map[string]NodeAttribute `yaml:"node-attributes"`
+}
+
+// NodeAttribute is the node label holding the value of a resource attribute. Nodes tainted
+// with the label and value are tolerated when Tolerate is set.
+type NodeAttribute struct {
+	Label    string `yaml:"label"`
+	Tolerate bool   `yaml:"tolerate"`
+}
+
+// Requests holds the fraction of their CPU and ephemeral storage limits which containers
+// of leases request from the scheduler. Fractions below one oversell the resource; zero
+// requests the whole limit. Memory is never oversold.
+type Requests struct {
+	CPU     float64 `yaml:"cpu"`
+	Storage float64 `yaml:"storage"`
+}
+
+// Ingress configures the ingress of lease services
+type Ingress struct {
+	StaticHosts   bool   `yaml:"static-hosts"`
+	Domain        string `yaml:"domain"`
+	ExposeLBHosts bool   `yaml:"expose-lb-hosts"`
+}
+
+// Inventory configures the polling of the cluster inventory
+type Inventory struct {
+	PollPeriod     time.Duration `yaml:"poll-period"`
+	DebugFrequency uint          `yaml:"debug-frequency"`
+}
+
+// Gateway configures the provider gateway
+type Gateway struct {
+	ListenAddress string `yaml:"listen-address"`
+}
+
+// Manifest configures the acceptance of submitted manifests
+type Manifest struct {
+	Images Images `yaml:"images"`
+}
+
+// Images restricts the container images of the manifests accepted with wildcard patterns,
+// in which '*' matches any sequence of characters including '/'.
+type Images struct {
+	Allow []string `yaml:"allow"`
+	Deny  []string `yaml:"deny"`
+}
+
+// ReadConfigPath reads the file at path over base, so that settings absent from the
+// file keep the value from base, and validates the result
+func ReadConfigPath(path string, base Config) (Config, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(buf))
+	dec.KnownFields(true)
+
+	cfg := base
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return Config{}, fmt.Errorf("%w: %s: %v", ErrInvalidConfig, path, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Validate checks the whole configuration and reports every invalid setting at once
+func (c Config) Validate() error {
+	var problems []string
+
+	if _, err := c.Bid.Pricing.Create(); err != nil {
+		problems = append(problems, fmt.Sprintf("bid.pricing: %v", err))
+	}
+
+	for _, owner := range c.Bid.Tenants.Allow {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			problems = append(problems, fmt.Sprintf("bid.tenants.allow: %q: %v", owner, err))
+		}
+	}
+
+	for _, owner := range c.Bid.Tenants.Deny {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			problems = append(problems, fmt.Sprintf("bid.tenants.deny: %q: %v", owner, err))
+		}
+	}
+
+	for _, attr := range c.Attributes.Require {
+		problems = appendPatternProblems(problems, "attributes.require", attr.Key, attr.Value)
+	}
+
+	for _, attr := range c.Attributes.Deny {
+		problems = appendPatternProblems(problems, "attributes.deny", attr.Key, attr.Value)
+	}
+
+	problems = appendPatternProblems(problems, "manifest.images.allow", c.Manifest.Images.Allow...)
+	problems = appendPatternProblems(problems, "manifest.images.deny", c.Manifest.Images.Deny...)
+
+	if c.Cluster.WaitReadyDuration <= 0 {
+		problems = append(problems, "cluster.wait-ready-duration: must be positive")
+	}
+
+	switch c.Cluster.ServiceType {
+	case corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer:
+	default:
+		problems = append(problems, fmt.Sprintf("cluster.service-type: unsupported type %q", c.Cluster.ServiceType))
+	}
+
+	for class, capacity := range c.Cluster.StorageClasses {
+		if quantity, err := resource.ParseQuantity(capacity); err != nil || quantity.Sign() < 0 {
+			problems = append(problems, fmt.Sprintf("cluster.storage-classes: %v: invalid capacity %q", class, capacity))
+		}
+	}
+
+	if size := c.Cluster.VolumeEphemeralStorage; size != "" {
+		if quantity, err := resource.ParseQuantity(size); err != nil || quantity.Sign() <= 0 {
+			problems = append(problems, fmt.Sprintf("cluster.volume-ephemeral-storage: invalid size %q", size))
+		}
+	}
+
+	if err := c.Cluster.RequestPolicy().Validate(); err != nil {
+		problems = append(problems, fmt.Sprintf("cluster.requests: %v", err))
+	}
+
+	if err := c.Cluster.NodeAttributePolicy().Validate(); err != nil {
+		problems = append(problems, fmt.Sprintf("cluster.node-attributes: %v", err))
+	}
+
+	if c.Cluster.Ingress.StaticHosts && c.Cluster.Ingress.Domain == "" {
+		problems = append(problems, "cluster.ingress.domain: required with static hosts")
+	}
+
+	if c.Inventory.PollPeriod <= 0 {
+		problems = append(problems, "inventory.poll-period: must be positive")
+	}
+
+	if c.Inventory.DebugFrequency == 0 {
+		problems = append(problems, "inventory.debug-frequency: must be positive")
+	}
+
+	if _, _, err := net.SplitHostPort(c.Gateway.ListenAddress); err != nil {
+		problems = append(problems, fmt.Sprintf("gateway.listen-address: %v", err))
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func appendPatternProblems(problems []string, field string, patterns ...string) []string {
+	for _, pattern := range patterns {
+		if err := wildcard.Validate(pattern); err != nil {
+			problems = append(problems, fmt.Sprintf("%v: %q is not a valid pattern", field, pattern))
+		}
+	}
+	return problems
+}
+
+// Create creates the configured bid pricing strategy
+func (p Pricing) Create() (bidengine.BidPricingStrategy, error) {
+	switch p.Strategy {
+	case BidPricingStrategyScale:
+		return bidengine.MakeScalePricing(p.Scale.CPU, p.Scale.Memory, p.Scale.Storage, p.Scale.Endpoint)
+	case BidPricingStrategyRandomRange:
+		return bidengine.MakeRandomRangePricing()
+	case BidPricingStrategyShellScript:
+		return bidengine.MakeShellScriptPricing(p.Script.Path, p.Script.ProcessLimit, p.Script.Timeout)
+	}
+
+	return nil, errNoSuchBidPricingStrategy
+}
+
+// BidFilters returns the order filters of the bid engine
+func (c Config) BidFilters() bidengine.Filters {
+	return bidengine.Filters{
+		MaxGroupCPU:        c.Bid.Filters.MaxGroupCPU,
+		MaxGroupMemory:     c.Bid.Filters.MaxGroupMemory,
+		MaxGroupStorage:    c.Bid.Filters.MaxGroupStorage,
+		RequiredAttributes: c.Attributes.Require,
+		DeniedAttributes:   c.Attributes.Deny,
+		AllowedOwners:      c.Bid.Tenants.Allow,
+		DeniedOwners:       c.Bid.Tenants.Deny,
+		MinPrice: bidengine.ResourcePrices{
+			CPU:      c.Bid.Filters.MinPrice.CPU,
+			Memory:   c.Bid.Filters.MinPrice.Memory,
+			Storage:  c.Bid.Filters.MinPrice.Storage,
+			Endpoint: c.Bid.Filters.MinPrice.Endpoint,
+		},
+		Tenant: bidengine.TenantLimits{
+			MaxLeases:  c.Bid.Tenants.MaxLeases,
+			MaxCPU:     c.Bid.Tenants.MaxCPU,
+			MaxMemory:  c.Bid.Tenants.MaxMemory,
+			MaxStorage: c.Bid.Tenants.MaxStorage,
+		},
+	}
+}
+
+// ImagePolicy returns the image policy of the manifest service
+func (m Manifest) ImagePolicy() manifest.ImagePolicy {
+	return manifest.ImagePolicy{
+		Allow: m.Images.Allow,
+		Deny:  m.Images.Deny,
+	}
+}
+
+// KubeSettings returns the settings of the kubernetes cluster client
+func (c Cluster) KubeSettings() kube.Settings {
+	settings := kube.NewDefaultSettings()
+	settings.DeploymentServiceType = c.ServiceType
+	settings.DeploymentIngressStaticHosts = c.Ingress.StaticHosts
+	settings.DeploymentIngressDomain = c.Ingress.Domain
+	settings.DeploymentIngressExposeLBHosts = c.Ingress.ExposeLBHosts
+	settings.RequestPolicy = c.RequestPolicy()
+	settings.NodeAttributes = c.NodeAttributePolicy()
+
+	if len(c.StorageClasses) != 0 {
+		settings.StorageClasses = make(map[string]uint64, len(c.StorageClasses))
+		for class, capacity := range c.StorageClasses {
+			// capacities are checked by Validate
+			quantity, _ := resource.ParseQuantity(capacity)
+			settings.StorageClasses[class] = uint64(quantity.Value())
+		}
+	}
+
+	return settings
+}
+
+// RequestPolicy returns the resource requests of lease containers
+func (c Cluster) RequestPolicy() ctypes.RequestPolicy {
+	policy := ctypes.RequestPolicy{
+		CPU:     c.Requests.CPU,
+		Storage: c.Requests.Storage,
+	}
+
+	// the size is checked by Validate
+	if quantity, err := resource.ParseQuantity(c.VolumeEphemeralStorage); err == nil && quantity.Sign() > 0 {
+		policy.VolumeEphemeralStorage = uint64(quantity.Value())
+	}
+
+	return policy
+}
+
+// NodeAttributePolicy returns the node labels resource attributes are mapped to
+func (c Cluster) NodeAttributePolicy() ctypes.NodeAttributes {
+	if len(c.NodeAttributes) == 0 {
+		return nil
+	}
+
+	attrs := make(ctypes.NodeAttributes, len(c.NodeAttributes))
+	for key, attr := range c.NodeAttributes {
+		attrs[key] = ctypes.NodeAttribute{
+			Label:    attr.Label,
+			Tolerate: attr.Tolerate,
+		}
+	}
+	return attrs
+}
+
+// withReloadable returns c with the sections which can be applied to a running provider
+// taken from other
+func (c Config) withReloadable(other Config) Config {
+	c.Bid = other.Bid
+	c.Attributes = other.Attributes
+	return c
+}
@@ -0,0 +1,250 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/types"
+)
+
+func baseConfig() Config {
+	return Config{
+		Bid: Bid{
+			Pricing: Pricing{
+				Strategy: BidPricingStrategyScale,
+				Scale:    Scale{CPU: 1},
+			},
+		},
+		Cluster: Cluster{
+			NodePortQuantity:  1,
+			WaitReadyDuration: 5 * time.Second,
+			ServiceType:       corev1.ServiceTypeClusterIP,
+		},
+		Inventory: Inventory{
+			PollPeriod:     5 * time.Second,
+			DebugFrequency: 10,
+		},
+		Gateway: Gateway{
+			ListenAddress: "0.0.0.0:8080",
+		},
+	}
+}
+
+func writeConfig(t *testing.T, path string, content string) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+}
+
+func TestReadConfigPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "provider.yaml")
+
+	writeConfig(t, path, `
+bid:
+  pricing:
+    scale:
+      cpu: 10
+      memory: 2
+  filters:
+    max-group-cpu: 4000
+attributes:
+  deny:
+    - key: tier
+      value: free
+cluster:
+  ingress:
+    static-hosts: true
+    domain: example.com
+  storage-classes:
+    fast: 500Gi
+  volume-ephemeral-storage: 2Gi
+  requests:
+    cpu: 0.5
+  node-attributes:
+    cpu.arch:
+      label: kubernetes.io/arch
+inventory:
+  poll-period: 30s
+`)
+
+	cfg, err := ReadConfigPath(path, baseConfig())
+	require.NoError(t, err)
+
+	expected := baseConfig()
+	expected.Bid.Pricing.Scale = Scale{CPU: 10, Memory: 2}
+	expected.Bid.Filters.MaxGroupCPU = 4000
+	expected.Attributes.Deny = types.Attributes{types.NewStringAttribute("tier", "free")}
+	expected.Cluster.Ingress = Ingress{StaticHosts: true, Domain: "example.com"}
+	expected.Cluster.StorageClasses = map[string]string{"fast": "500Gi"}
+	expected.Cluster.VolumeEphemeralStorage = "2Gi"
+	expected.Cluster.Requests = Requests{CPU: 0.5}
+	expected.Cluster.NodeAttributes = map[string]NodeAttribute{"cpu.arch": {Label: "kubernetes.io/arch"}}
+	expected.Inventory.PollPeriod = 30 * time.Second
+
+	require.Equal(t, expected, cfg)
+
+	filters := cfg.BidFilters()
+	require.Equal(t, uint64(4000), filters.MaxGroupCPU)
+	require.Equal(t, expected.Attributes.Deny, filters.DeniedAttributes)
+
+	settings := cfg.Cluster.KubeSettings()
+	require.True(t, settings.DeploymentIngressStaticHosts)
+	require.Equal(t, "example.com", settings.DeploymentIngressDomain)
+	require.Equal(t, map[string]uint64{"fast": 500 << 30}, settings.StorageClasses)
+	require.Equal(t, 0.5, settings.RequestPolicy.CPU)
+	require.Equal(t, uint64(2<<30), settings.RequestPolicy.VolumeEphemeralStorage)
+	require.Equal(t, "kubernetes.io/arch", settings.NodeAttributes["cpu.arch"].Label)
+}
+
+func TestReadConfigPathPolicies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "provider.yaml")
+	tenant := testutil.AccAddress(t).String()
+
+	writeConfig(t, path, fmt.Sprintf(`
+bid:
+  filters:
+    min-price:
+      cpu: 5
+  tenants:
+    deny:
+      - %v
+    max-leases: 10
+    max-cpu: 16000
+manifest:
+  images:
+    deny:
+      - "*:latest"
+`, tenant))
+
+	cfg, err := ReadConfigPath(path, baseConfig())
+	require.NoError(t, err)
+
+	filters := cfg.BidFilters()
+	require.Equal(t, []string{tenant}, filters.DeniedOwners)
+	require.Equal(t, uint64(5), filters.MinPrice.CPU)
+	require.Equal(t, uint32(10), filters.Tenant.MaxLeases)
+	require.Equal(t, uint64(16000), filters.Tenant.MaxCPU)
+	require.Equal(t, []string{"*:latest"}, cfg.Manifest.ImagePolicy().Deny)
+}
+
+func TestReadConfigPathEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "provider.yaml")
+	writeConfig(t, path, "")
+
+	cfg, err := ReadConfigPath(path, baseConfig())
+	require.NoError(t, err)
+	require.Equal(t, baseConfig(), cfg)
+}
+
+func TestReadConfigPathUnknownField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "provider.yaml")
+	writeConfig(t, path, `
+bid:
+  pricing:
+    scales:
+      cpu: 10
+`)
+
+	_, err := ReadConfigPath(path, baseConfig())
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidConfig))
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	cfg := baseConfig()
+	cfg.Bid.Pricing.Strategy = "bogus"
+	cfg.Cluster.ServiceType = "ExternalName"
+	cfg.Cluster.Ingress.StaticHosts = true
+	cfg.Inventory.DebugFrequency = 0
+	cfg.Gateway.ListenAddress = "localhost"
+	cfg.Bid.Tenants.Allow = []string{"not-an-address"}
+	cfg.Attributes.Deny = types.Attributes{types.NewStringAttribute("region", "[us")}
+	cfg.Manifest.Images.Deny = []string{"[nginx"}
+	cfg.Cluster.StorageClasses = map[string]string{"fast": "lots"}
+	cfg.Cluster.VolumeEphemeralStorage = "-1Gi"
+	cfg.Cluster.Requests.CPU = 2
+	cfg.Cluster.NodeAttributes = map[string]NodeAttribute{"gpu.model": {Label: "example.com/gpu"}}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidConfig))
+
+	for _, field := range []string{
+		"bid.pricing",
+		"cluster.service-type",
+		"cluster.ingress.domain",
+		"inventory.debug-frequency",
+		"gateway.listen-address",
+		"bid.tenants.allow",
+		"attributes.deny",
+		"manifest.images.deny",
+		"cluster.storage-classes",
+		"cluster.volume-ephemeral-storage",
+		"cluster.requests",
+		"cluster.node-attributes",
+	} {
+		require.True(t, strings.Contains(err.Error(), field), "missing %v in %v", field, err)
+	}
+
+	require.NoError(t, baseConfig().Validate())
+}
+
+func TestReloaderReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "provider.yaml")
+	writeConfig(t, path, "")
+
+	var applied []Config
+	reloader, err := NewReloader(testutil.Logger(t), path, baseConfig(), baseConfig(), func(cfg Config) error {
+		applied = append(applied, cfg)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// unchanged
+	require.NoError(t, reloader.reload())
+	require.Len(t, applied, 0)
+
+	// bid settings are applied, cluster settings are not
+	writeConfig(t, path, `
+bid:
+  pricing:
+    scale:
+      cpu: 20
+cluster:
+  public-hostname: provider.example.com
+`)
+	require.NoError(t, reloader.reload())
+	require.Len(t, applied, 1)
+	require.Equal(t, uint64(20), applied[0].Bid.Pricing.Scale.CPU)
+	require.Equal(t, "", applied[0].Cluster.PublicHostname)
+
+	// invalid configuration is not applied
+	writeConfig(t, path, `
+bid:
+  pricing:
+    strategy: bogus
+`)
+	require.Error(t, reloader.reload())
+	require.Len(t, applied, 1)
+	require.Equal(t, uint64(20), reloader.current.Bid.Pricing.Scale.CPU)
+
+	// a failure to apply keeps the current configuration
+	reloader.apply = func(Config) error {
+		return errors.New("apply failed")
+	}
+	writeConfig(t, path, `
+bid:
+  filters:
+    max-group-cpu: 1000
+`)
+	require.Error(t, reloader.reload())
+	require.Equal(t, uint64(20), reloader.current.Bid.Pricing.Scale.CPU)
+	require.Equal(t, uint64(0), reloader.current.Bid.Filters.MaxGroupCPU)
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// WatchPeriod is how often the configuration file is checked for changes
+const WatchPeriod = 5 * time.Second
+
+// Reloader re-reads the configuration file when it changes or the process receives SIGHUP.
+// Only the bid and attributes sections are applied; changes to other sections are logged and
+// require a restart.
+type Reloader struct {
+	log     log.Logger
+	path    string
+	base    Config
+	current Config
+	modTime time.Time
+	apply   func(Config) error
+}
+
+// NewReloader returns a reloader for the file at path. base holds the settings the file is
+// read over, current the configuration the provider was started with.
+func NewReloader(log log.Logger, path string, base, current Config, apply func(Config) error) (*Reloader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reloader{
+		log:     log.With("module", "provider-config"),
+		path:    path,
+		base:    base,
+		current: current,
+		modTime: info.ModTime(),
+		apply:   apply,
+	}, nil
+}
+
+// Run reloads the configuration until ctx is done
+func (r *Reloader) Run(ctx context.Context) error {
+	sigch := make(chan os.Signal, 1)
+	signal.Notify(sigch, syscall.SIGHUP)
+	defer signal.Stop(sigch)
+
+	ticker := time.NewTicker(WatchPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sigch:
+			r.log.Info("received SIGHUP, reloading configuration")
+			_ = r.reload()
+		case <-ticker.C:
+			info, err := os.Stat(r.path)
+			if err != nil {
+				r.log.Error("checking configuration file", "err", err)
+				continue
+			}
+			if info.ModTime().Equal(r.modTime) {
+				continue
+			}
+			r.modTime = info.ModTime()
+			r.log.Info("configuration file changed, reloading")
+			_ = r.reload()
+		}
+	}
+}
+
+func (r *Reloader) reload() error {
+	cfg, err := ReadConfigPath(r.path, r.base)
+	if err != nil {
+		r.log.Error("reloading configuration, keeping current settings", "err", err)
+		return err
+	}
+
+	next := r.current.withReloadable(cfg)
+
+	if !reflect.DeepEqual(next, cfg) {
+		r.log.Info("configuration changes outside of the bid and attributes sections require a restart")
+	}
+
+	if reflect.DeepEqual(next, r.current) {
+		r.log.Info("no configuration changes to apply")
+		return nil
+	}
+
+	if err := r.apply(next); err != nil {
+		r.log.Error("applying configuration, keeping current settings", "err", err)
+		return err
+	}
+
+	r.current = next
+	r.log.Info("configuration reloaded")
+
+	return nil
+}
@@ -34,6 +34,14 @@ func (ev ManifestReceived) ManifestGroup() *manifest.Group {
 	return nil
 }
 
+// ManifestRejected is published for each lease of a deployment whose manifest the
+// provider's policy rejected. The lease is closed by the provider.
+type ManifestRejected struct {
+	LeaseID mtypes.LeaseID
+	// Reason names the policy which rejected the manifest
+	Reason string
+}
+
 // ClusterDeploymentStatus represents status of the cluster deployment
 type ClusterDeploymentStatus string
 
@@ -3,6 +3,8 @@ package gateway
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,12 +14,16 @@ import (
 	"strconv"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	maniv "github.com/ovrclk/akash/manifest"
 	"github.com/ovrclk/akash/provider"
 	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/provider/manifest"
+	certtypes "github.com/ovrclk/akash/x/cert/types"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -30,22 +36,96 @@ type Client interface {
 	SubmitManifest(ctx context.Context, host string, req *manifest.SubmitRequest) error
 	LeaseStatus(ctx context.Context, host string, id mtypes.LeaseID) (*ctypes.LeaseStatus, error)
 	ServiceStatus(ctx context.Context, host string, id mtypes.LeaseID, service string) (*ctypes.ServiceStatus, error)
-	ServiceLogs(ctx context.Context, host string, id mtypes.LeaseID, service string, follow bool, tailLines int64) (*ServiceLogs, error)
+	LeaseManifest(ctx context.Context, host string, id mtypes.LeaseID) (*LeaseManifest, error)
+	ServiceLogs(ctx context.Context, host string, id mtypes.LeaseID, opts ctypes.ServiceLogOptions) (*ServiceLogs, error)
+	LeaseEvents(ctx context.Context, host string, id mtypes.LeaseID, follow bool) (*LeaseKubeEvents, error)
+	LeaseShell(ctx context.Context, host string, id mtypes.LeaseID, service string, podIndex uint, cmd []string,
+		stdin io.Reader, stdout io.Writer, stderr io.Writer, tty bool, tsq <-chan ctypes.TerminalSize) error
 }
 
+// LeaseManifest is the manifest group deployed for a lease along with
+// its version, computed like the version of a deployment.
+type LeaseManifest struct {
+	Group   maniv.Group `json:"group"`
+	Version []byte      `json:"version"`
+}
+
+// ServiceLogMessage is a line logged by a service replica. Name is the replica pod.
 type ServiceLogMessage struct {
-	Name    string `json:"name"`
-	Message string `json:"message"`
+	Name         string    `json:"name"`
+	Service      string    `json:"service"`
+	RestartCount int32     `json:"restart_count"`
+	Timestamp    time.Time `json:"timestamp"`
+	Message      string    `json:"message"`
 }
 
 type ServiceLogs struct {
 	Stream <-chan ServiceLogMessage
 }
 
-// NewClient returns a new Client
-func NewClient() Client {
+type LeaseKubeEvents struct {
+	Stream <-chan ctypes.LeaseEvent
+}
+
+// ErrLeaseShell is returned when the provider could not run the command.
+var ErrLeaseShell = errors.New("lease shell failed")
+
+// LeaseShellExitError is returned when the command exited with a non-zero code.
+type LeaseShellExitError struct {
+	ExitCode int
+}
+
+func (e LeaseShellExitError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.ExitCode)
+}
+
+// ErrProviderCertificate is returned when the gateway does not present a certificate published by the provider.
+var ErrProviderCertificate = errors.New("gateway certificate does not belong to the provider")
+
+// NewClient returns a new Client for the gateway of the given provider.
+// The tenant certificates are presented to the gateway for authorization; they may be
+// omitted for the provider status.
+func NewClient(cquery certtypes.QueryClient, paddr sdk.Address, certs []tls.Certificate) Client {
+	tlsConfig := &tls.Config{
+		// the provider certificate is self-signed; it is verified against the chain instead
+		InsecureSkipVerify: true, // nolint: gosec
+		Certificates:       certs,
+		MinVersion:         tls.VersionTLS13,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return ErrProviderCertificate
+			}
+
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+
+			owner, err := cutils.VerifyCertificate(context.Background(), cquery, cert, x509.ExtKeyUsageServerAuth)
+			if err != nil {
+				return errors.Wrap(err, "tls: gateway certificate")
+			}
+
+			if !owner.Equals(paddr) {
+				return ErrProviderCertificate
+			}
+
+			return nil
+		},
+	}
+
 	return &client{
-		hclient: http.DefaultClient,
+		hclient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		wsclient: &websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: websocket.DefaultDialer.HandshakeTimeout,
+			TLSClientConfig:  tlsConfig,
+		},
 	}
 }
 
@@ -54,7 +134,8 @@ type httpClient interface {
 }
 
 type client struct {
-	hclient httpClient
+	hclient  httpClient
+	wsclient *websocket.Dialer
 }
 
 func (c *client) Status(ctx context.Context, host string) (*provider.Status, error) {
@@ -118,6 +199,20 @@ func (c *client) LeaseStatus(ctx context.Context, host string, id mtypes.LeaseID
 	return &obj, nil
 }
 
+func (c *client) LeaseManifest(ctx context.Context, host string, id mtypes.LeaseID) (*LeaseManifest, error) {
+	uri, err := makeURI(host, leaseManifestPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var obj LeaseManifest
+	if err := c.getStatus(ctx, uri, &obj); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
 func (c *client) ServiceStatus(ctx context.Context, host string, id mtypes.LeaseID, service string) (*ctypes.ServiceStatus, error) {
 	uri, err := makeURI(host, serviceStatusPath(id, service))
 	if err != nil {
@@ -166,14 +261,8 @@ func makeURI(host string, path string) (string, error) {
 	return endpoint.String(), nil
 }
 
-func (c *client) ServiceLogs(ctx context.Context,
-	host string,
-	id mtypes.LeaseID,
-	service string,
-	follow bool,
-	tailLines int64) (*ServiceLogs, error) {
-
-	endpoint, err := url.Parse(host + "/" + serviceLogsPath(id, service))
+func makeWSURI(host string, path string) (*url.URL, error) {
+	endpoint, err := url.Parse(host + "/" + path)
 	if err != nil {
 		return nil, err
 	}
@@ -187,14 +276,41 @@ func (c *client) ServiceLogs(ctx context.Context,
 		return nil, errors.Errorf("invalid uri scheme \"%s\"", endpoint.Scheme)
 	}
 
+	return endpoint, nil
+}
+
+func (c *client) ServiceLogs(ctx context.Context,
+	host string,
+	id mtypes.LeaseID,
+	opts ctypes.ServiceLogOptions) (*ServiceLogs, error) {
+
+	endpoint, err := makeWSURI(host, leaseLogsPath(id))
+	if err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 
-	query.Set("follow", strconv.FormatBool(follow))
+	tailLines := int64(-1)
+	if opts.TailLines != nil {
+		tailLines = *opts.TailLines
+	}
+
+	query.Set("follow", strconv.FormatBool(opts.Follow))
 	query.Set("tail", strconv.FormatInt(tailLines, 10))
+	query.Set("previous", strconv.FormatBool(opts.Previous))
+
+	if opts.Since != nil {
+		query.Set("since", opts.Since.Format(time.RFC3339))
+	}
+
+	for _, service := range opts.Services {
+		query.Add("service", service)
+	}
 
 	endpoint.RawQuery = query.Encode()
 
-	conn, _, err := websocket.DefaultDialer.DialContext(ctx, endpoint.String(), nil)
+	conn, _, err := c.wsclient.DialContext(ctx, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -240,3 +356,187 @@ func (c *client) ServiceLogs(ctx context.Context,
 
 	return logs, nil
 }
+
+func (c *client) LeaseEvents(ctx context.Context, host string, id mtypes.LeaseID, follow bool) (*LeaseKubeEvents, error) {
+	endpoint, err := makeWSURI(host, leaseEventsPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("follow", strconv.FormatBool(follow))
+
+	endpoint.RawQuery = query.Encode()
+
+	conn, resp, err := c.wsclient.DialContext(ctx, endpoint.String(), nil)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("%w: %v", ErrServerResponse, resp.Status)
+		}
+		return nil, err
+	}
+
+	streamch := make(chan ctypes.LeaseEvent)
+	events := &LeaseKubeEvents{
+		Stream: streamch,
+	}
+
+	go func(conn *websocket.Conn) {
+		defer func() {
+			close(streamch)
+			_ = conn.Close()
+		}()
+
+		for {
+			_ = conn.SetReadDeadline(time.Now().Add(pingWait))
+			mType, msg, e := conn.ReadMessage()
+			if e != nil {
+				return
+			}
+
+			if mType != websocket.TextMessage {
+				continue
+			}
+
+			var ev ctypes.LeaseEvent
+			if e = json.Unmarshal(msg, &ev); e != nil {
+				return
+			}
+
+			select {
+			case streamch <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}(conn)
+
+	return events, nil
+}
+
+func (c *client) LeaseShell(ctx context.Context,
+	host string,
+	id mtypes.LeaseID,
+	service string,
+	podIndex uint,
+	cmd []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+	tsq <-chan ctypes.TerminalSize) error {
+
+	endpoint, err := makeWSURI(host, leaseShellPath(id, service))
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	for _, arg := range cmd {
+		query.Add("cmd", arg)
+	}
+	query.Set("podIndex", strconv.FormatUint(uint64(podIndex), 10))
+	query.Set("tty", strconv.FormatBool(tty))
+	query.Set("stdin", strconv.FormatBool(stdin != nil))
+
+	endpoint.RawQuery = query.Encode()
+
+	conn, resp, err := c.wsclient.DialContext(ctx, endpoint.String(), nil)
+	if err != nil {
+		if resp != nil {
+			return fmt.Errorf("%w: %v", ErrServerResponse, resp.Status)
+		}
+		return err
+	}
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	wsw := &wsStreamWriter{ws: conn}
+
+	if stdin != nil {
+		go func() {
+			buf := make([]byte, 4096)
+			for {
+				n, err := stdin.Read(buf)
+				if n > 0 {
+					if e := wsw.write(LeaseShellCodeStdin, buf[:n]); e != nil {
+						return
+					}
+				}
+
+				if err != nil {
+					// an empty message closes the input of the command
+					_ = wsw.write(LeaseShellCodeStdin, nil)
+					return
+				}
+			}
+		}()
+	}
+
+	if tsq != nil {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case size, ok := <-tsq:
+					if !ok {
+						return
+					}
+
+					buf, err := json.Marshal(size)
+					if err != nil {
+						return
+					}
+
+					if err := wsw.write(LeaseShellCodeTerminalResize, buf); err != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for {
+		mType, msg, err := conn.ReadMessage()
+		if err != nil {
+			return errors.Wrap(ErrLeaseShell, err.Error())
+		}
+
+		if mType != websocket.BinaryMessage || len(msg) == 0 {
+			continue
+		}
+
+		switch msg[0] {
+		case LeaseShellCodeStdout:
+			if stdout != nil {
+				if _, err := stdout.Write(msg[1:]); err != nil {
+					return err
+				}
+			}
+		case LeaseShellCodeStderr:
+			if stderr != nil {
+				if _, err := stderr.Write(msg[1:]); err != nil {
+					return err
+				}
+			}
+		case LeaseShellCodeResult:
+			var result LeaseShellResult
+			if err := json.Unmarshal(msg[1:], &result); err != nil {
+				return err
+			}
+
+			if result.Message != "" {
+				return errors.Wrap(ErrLeaseShell, result.Message)
+			}
+
+			if result.ExitCode != 0 {
+				return LeaseShellExitError{ExitCode: result.ExitCode}
+			}
+
+			return nil
+		}
+	}
+}
@@ -2,29 +2,47 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
-	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	"io"
+	"io/ioutil"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
 
+	qmock "github.com/ovrclk/akash/client/mocks"
+	maniv "github.com/ovrclk/akash/manifest"
 	"github.com/ovrclk/akash/provider"
+	"github.com/ovrclk/akash/provider/cluster"
 	pcmock "github.com/ovrclk/akash/provider/cluster/mocks"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/provider/manifest"
 	pmmock "github.com/ovrclk/akash/provider/manifest/mocks"
 	pmock "github.com/ovrclk/akash/provider/mocks"
+	"github.com/ovrclk/akash/sdl"
 	"github.com/ovrclk/akash/testutil"
+	certtypes "github.com/ovrclk/akash/x/cert/types"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_router_Status(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
+		accts := createAccounts(t)
 		expected := &provider.Status{}
 		pclient, _, _ := createMocks()
 		pclient.On("Status", mock.Anything).Return(expected, nil)
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			// provider status does not require a client certificate
+			client := accts.client()
 			result, err := client.Status(context.Background(), host)
 			assert.NoError(t, err)
 			assert.Equal(t, expected, result)
@@ -32,10 +50,11 @@ func Test_router_Status(t *testing.T) {
 		pclient.AssertExpectations(t)
 	})
 	t.Run("failure", func(t *testing.T) {
+		accts := createAccounts(t)
 		pclient, _, _ := createMocks()
 		pclient.On("Status", mock.Anything).Return(nil, errors.New("oops"))
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			_, err := client.Status(context.Background(), host)
 			assert.Error(t, err)
 		})
@@ -46,13 +65,15 @@ func Test_router_Status(t *testing.T) {
 func Test_router_Manifest(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
+		accts := createAccounts(t)
 		req := &manifest.SubmitRequest{
 			Deployment: testutil.DeploymentID(t),
 		}
+		req.Deployment.Owner = accts.tenant.String()
 		pclient, pmclient, _ := createMocks()
 		pmclient.On("Submit", mock.Anything, req).Return(nil)
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			err := client.SubmitManifest(context.Background(), host, req)
 			assert.NoError(t, err)
 		})
@@ -60,30 +81,51 @@ func Test_router_Manifest(t *testing.T) {
 	})
 
 	t.Run("failure", func(t *testing.T) {
+		accts := createAccounts(t)
 		req := &manifest.SubmitRequest{
 			Deployment: testutil.DeploymentID(t),
 		}
+		req.Deployment.Owner = accts.tenant.String()
 		pclient, pmclient, _ := createMocks()
 		pmclient.On("Submit", mock.Anything, req).Return(errors.New("ded"))
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			err := client.SubmitManifest(context.Background(), host, req)
 			assert.Error(t, err)
 		})
 		pmclient.AssertExpectations(t)
 	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		accts := createAccounts(t)
+		req := &manifest.SubmitRequest{
+			Deployment: testutil.DeploymentID(t),
+		}
+		req.Deployment.Owner = accts.tenant.String()
+		pclient, pmclient, _ := createMocks()
+		pmclient.On("Submit", mock.Anything, req).Return(manifest.ErrManifestSignature)
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			err := client.SubmitManifest(context.Background(), host, req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "401")
+		})
+		pmclient.AssertExpectations(t)
+	})
 }
 
 func Test_router_LeaseStatus(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
+		accts := createAccounts(t)
 		expected := &ctypes.LeaseStatus{}
 		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
 		pclient, _, pcclient := createMocks()
 
 		pcclient.On("LeaseStatus", mock.Anything, id).Return(expected, nil)
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			status, err := client.LeaseStatus(context.Background(), host, id)
 			assert.Equal(t, expected, status)
 			assert.NoError(t, err)
@@ -92,12 +134,14 @@ func Test_router_LeaseStatus(t *testing.T) {
 	})
 
 	t.Run("failure", func(t *testing.T) {
+		accts := createAccounts(t)
 		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
 		pclient, _, pcclient := createMocks()
 
 		pcclient.On("LeaseStatus", mock.Anything, id).Return(nil, errors.New("ded"))
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			status, err := client.LeaseStatus(context.Background(), host, id)
 			assert.Nil(t, status)
 			assert.Error(t, err)
@@ -106,17 +150,63 @@ func Test_router_LeaseStatus(t *testing.T) {
 	})
 }
 
+func Test_router_LeaseManifest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		pclient, _, pcclient := createMocks()
+
+		group := testutil.DefaultManifestGenerator.Manifest(t)[0]
+		version, err := sdl.ManifestVersion(maniv.Manifest{group})
+		require.NoError(t, err)
+
+		pcclient.On("LeaseManifest", mock.Anything, id).Return(&group, nil)
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			lm, err := client.LeaseManifest(context.Background(), host, id)
+			require.NoError(t, err)
+			assert.Equal(t, version, lm.Version)
+
+			received, err := sdl.ManifestVersion(maniv.Manifest{lm.Group})
+			require.NoError(t, err)
+			assert.Equal(t, version, received)
+		})
+		pcclient.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		pclient, _, pcclient := createMocks()
+
+		pcclient.On("LeaseManifest", mock.Anything, id).Return(nil, cluster.ErrNoManifestForLease)
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			lm, err := client.LeaseManifest(context.Background(), host, id)
+			assert.Nil(t, lm)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "404")
+		})
+		pcclient.AssertExpectations(t)
+	})
+}
+
 func Test_router_ServiceStatus(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
+		accts := createAccounts(t)
 		expected := &ctypes.ServiceStatus{}
 		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
 		service := "svc"
 
 		pclient, _, pcclient := createMocks()
 
 		pcclient.On("ServiceStatus", mock.Anything, id, service).Return(expected, nil)
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			status, err := client.ServiceStatus(context.Background(), host, id, service)
 			assert.NoError(t, err)
 			assert.Equal(t, expected, status)
@@ -125,13 +215,15 @@ func Test_router_ServiceStatus(t *testing.T) {
 	})
 
 	t.Run("failure", func(t *testing.T) {
+		accts := createAccounts(t)
 		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
 		service := "svc"
 		pclient, _, pcclient := createMocks()
 
 		pcclient.On("ServiceStatus", mock.Anything, id, service).Return(nil, errors.New("ded"))
-		withServer(t, pclient, func(host string) {
-			client := NewClient()
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
 			status, err := client.ServiceStatus(context.Background(), host, id, service)
 			assert.Nil(t, status)
 			assert.Error(t, err)
@@ -140,6 +232,335 @@ func Test_router_ServiceStatus(t *testing.T) {
 	})
 }
 
+type fakeExecResult int
+
+func (r fakeExecResult) ExitCode() int {
+	return int(r)
+}
+
+func Test_router_LeaseShell(t *testing.T) {
+	cmd := []string{"/bin/sh", "-c", "cat"}
+
+	t.Run("success", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		service := "svc"
+
+		pclient, _, pcclient := createMocks()
+
+		pcclient.On("Exec", mock.Anything, id, service, uint(1), cmd,
+			mock.Anything, mock.Anything, mock.Anything, false, nil).
+			Run(func(args mock.Arguments) {
+				// echo the input of the command
+				_, err := io.Copy(args.Get(6).(io.Writer), args.Get(5).(io.Reader))
+				require.NoError(t, err)
+				_, err = args.Get(7).(io.Writer).Write([]byte("done"))
+				require.NoError(t, err)
+			}).
+			Return(fakeExecResult(0), nil)
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			err := client.LeaseShell(context.Background(), host, id, service, 1, cmd,
+				strings.NewReader("hello"), stdout, stderr, false, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, "hello", stdout.String())
+			assert.Equal(t, "done", stderr.String())
+		})
+		pcclient.AssertExpectations(t)
+	})
+
+	t.Run("exit code", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		service := "svc"
+
+		pclient, _, pcclient := createMocks()
+
+		pcclient.On("Exec", mock.Anything, id, service, uint(0), cmd,
+			nil, mock.Anything, nil, true, mock.Anything).
+			Return(fakeExecResult(3), nil)
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			tsq := make(chan ctypes.TerminalSize)
+			defer close(tsq)
+			err := client.LeaseShell(context.Background(), host, id, service, 0, cmd,
+				nil, &bytes.Buffer{}, &bytes.Buffer{}, true, tsq)
+			assert.Equal(t, LeaseShellExitError{ExitCode: 3}, err)
+		})
+		pcclient.AssertExpectations(t)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		service := "svc"
+
+		pclient, _, pcclient := createMocks()
+
+		pcclient.On("Exec", mock.Anything, id, service, uint(5), cmd,
+			nil, mock.Anything, mock.Anything, false, nil).
+			Return(nil, cluster.ErrExecPodIndexOutOfRange)
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			err := client.LeaseShell(context.Background(), host, id, service, 5, cmd,
+				nil, &bytes.Buffer{}, &bytes.Buffer{}, false, nil)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrLeaseShell))
+			assert.Contains(t, err.Error(), cluster.ErrExecPodIndexOutOfRange.Error())
+		})
+		pcclient.AssertExpectations(t)
+	})
+}
+
+func Test_router_ServiceLogs(t *testing.T) {
+	accts := createAccounts(t)
+	id := testutil.LeaseID(t)
+	id.Owner = accts.tenant.String()
+
+	pclient, _, pcclient := createMocks()
+
+	tail := int64(10)
+	since := time.Date(2020, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	expectedOpts := ctypes.ServiceLogOptions{
+		Services:  []string{"web", "db"},
+		Follow:    false,
+		TailLines: &tail,
+		Since:     &since,
+		Previous:  true,
+	}
+
+	logs := []*ctypes.ServiceLog{
+		cluster.NewServiceLog("web", "web-0", 2,
+			ioutil.NopCloser(strings.NewReader("2020-11-05T10:20:30Z started\n"))),
+	}
+
+	pcclient.On("ServiceLogs", mock.Anything, id, mock.MatchedBy(func(opts ctypes.ServiceLogOptions) bool {
+		return assert.ObjectsAreEqual(expectedOpts.Services, opts.Services) &&
+			opts.Follow == expectedOpts.Follow &&
+			opts.TailLines != nil && *opts.TailLines == tail &&
+			opts.Since != nil && opts.Since.Equal(since) &&
+			opts.Previous
+	})).Return(logs, nil)
+
+	withServer(t, accts, pclient, func(host string) {
+		client := accts.client(accts.tcert)
+		result, err := client.ServiceLogs(context.Background(), host, id, expectedOpts)
+		require.NoError(t, err)
+
+		var received []ServiceLogMessage
+		for msg := range result.Stream {
+			received = append(received, msg)
+		}
+
+		require.Len(t, received, 1)
+		assert.Equal(t, "web", received[0].Service)
+		assert.Equal(t, "web-0", received[0].Name)
+		assert.Equal(t, int32(2), received[0].RestartCount)
+		assert.Equal(t, "started", received[0].Message)
+		assert.True(t, time.Date(2020, 11, 5, 10, 20, 30, 0, time.UTC).Equal(received[0].Timestamp))
+	})
+	pcclient.AssertExpectations(t)
+}
+
+func Test_router_LeaseEvents(t *testing.T) {
+	accts := createAccounts(t)
+	id := testutil.LeaseID(t)
+	id.Owner = accts.tenant.String()
+
+	pclient, _, pcclient := createMocks()
+
+	expected := []ctypes.LeaseEvent{
+		{
+			Type:   "Normal",
+			Reason: "Pulled",
+			Note:   "Container image pulled",
+			Object: ctypes.LeaseEventObject{Kind: "Pod", Name: "web-0"},
+			Count:  1,
+		},
+		{
+			Type:   "Warning",
+			Reason: "BackOff",
+			Note:   "Back-off restarting failed container",
+			Object: ctypes.LeaseEventObject{Kind: "Pod", Name: "web-0"},
+			Count:  3,
+		},
+	}
+
+	events := make(chan ctypes.LeaseEvent, len(expected))
+	for _, ev := range expected {
+		events <- ev
+	}
+	close(events)
+
+	pcclient.On("LeaseEvents", mock.Anything, id, true).
+		Return((<-chan ctypes.LeaseEvent)(events), nil)
+
+	withServer(t, accts, pclient, func(host string) {
+		client := accts.client(accts.tcert)
+		result, err := client.LeaseEvents(context.Background(), host, id, true)
+		require.NoError(t, err)
+
+		var received []ctypes.LeaseEvent
+		for ev := range result.Stream {
+			received = append(received, ev)
+		}
+
+		assert.Equal(t, len(expected), len(received))
+		for idx := range expected {
+			assert.Equal(t, expected[idx].Reason, received[idx].Reason)
+			assert.Equal(t, expected[idx].Object, received[idx].Object)
+			assert.Equal(t, expected[idx].Count, received[idx].Count)
+		}
+	})
+	pcclient.AssertExpectations(t)
+}
+
+func Test_router_Authorization(t *testing.T) {
+	t.Run("no client certificate", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		pclient, _, pcclient := createMocks()
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client()
+			status, err := client.LeaseStatus(context.Background(), host, id)
+			assert.Nil(t, status)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "401")
+		})
+		pcclient.AssertNotCalled(t, "LeaseStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("lease of another owner", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		pclient, _, pcclient := createMocks()
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			status, err := client.LeaseStatus(context.Background(), host, id)
+			assert.Nil(t, status)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "403")
+		})
+		pcclient.AssertNotCalled(t, "LeaseStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deployment of another owner", func(t *testing.T) {
+		accts := createAccounts(t)
+		req := &manifest.SubmitRequest{
+			Deployment: testutil.DeploymentID(t),
+		}
+		pclient, pmclient, _ := createMocks()
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(accts.tcert)
+			err := client.SubmitManifest(context.Background(), host, req)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "403")
+		})
+		pmclient.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unpublished client certificate", func(t *testing.T) {
+		accts := createAccounts(t)
+		id := testutil.LeaseID(t)
+		id.Owner = accts.tenant.String()
+		pclient, _, pcclient := createMocks()
+
+		cert, _ := createCertificate(t, accts.tenant)
+
+		withServer(t, accts, pclient, func(host string) {
+			client := accts.client(cert)
+			_, err := client.LeaseStatus(context.Background(), host, id)
+			assert.Error(t, err)
+		})
+		pcclient.AssertNotCalled(t, "LeaseStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("gateway of another provider", func(t *testing.T) {
+		accts := createAccounts(t)
+		pclient, _, _ := createMocks()
+
+		withServer(t, accts, pclient, func(host string) {
+			client := NewClient(accts.qclient, testutil.AccAddress(t), nil)
+			_, err := client.Status(context.Background(), host)
+			assert.True(t, errors.Is(err, ErrProviderCertificate))
+		})
+		pclient.AssertNotCalled(t, "Status", mock.Anything)
+	})
+}
+
+// testAccounts holds a provider and a tenant along with the certificates they published
+type testAccounts struct {
+	qclient  *qmock.QueryClient
+	provider sdk.AccAddress
+	pcert    tls.Certificate
+	tenant   sdk.AccAddress
+	tcert    tls.Certificate
+}
+
+func (a *testAccounts) client(certs ...tls.Certificate) Client {
+	return NewClient(a.qclient, a.provider, certs)
+}
+
+func createAccounts(t testing.TB) *testAccounts {
+	accts := &testAccounts{
+		qclient:  &qmock.QueryClient{},
+		provider: testutil.AccAddress(t),
+		tenant:   testutil.AccAddress(t),
+	}
+
+	var published []certtypes.GenesisCertificate
+	var pcert, tcert certtypes.CertificateResponse
+
+	accts.pcert, pcert = createCertificate(t, accts.provider)
+	accts.tcert, tcert = createCertificate(t, accts.tenant)
+
+	published = append(published,
+		certtypes.GenesisCertificate{Owner: accts.provider.String(), Certificate: pcert.Certificate},
+		certtypes.GenesisCertificate{Owner: accts.tenant.String(), Certificate: tcert.Certificate},
+	)
+
+	accts.qclient.On("Certificates", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *certtypes.QueryCertificatesRequest, _ ...grpc.CallOption) *certtypes.QueryCertificatesResponse {
+			res := &certtypes.QueryCertificatesResponse{}
+			for _, cert := range published {
+				if cert.Owner == req.Filter.Owner {
+					res.Certificates = append(res.Certificates, certtypes.CertificateResponse{Certificate: cert.Certificate})
+				}
+			}
+			return res
+		}, nil)
+
+	return accts
+}
+
+func createCertificate(t testing.TB, owner sdk.AccAddress) (tls.Certificate, certtypes.CertificateResponse) {
+	certPEM, keyPEM, err := cutils.GenerateCertificate(owner, []string{"127.0.0.1"}, time.Hour)
+	require.NoError(t, err)
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	return cert, certtypes.CertificateResponse{
+		Certificate: certtypes.Certificate{
+			State: certtypes.CertificateValid,
+			Cert:  certPEM,
+		},
+	}
+}
+
 func createMocks() (*pmock.Client, *pmmock.Client, *pcmock.Client) {
 	var (
 		pmclient = &pmmock.Client{}
@@ -153,10 +574,12 @@ func createMocks() (*pmock.Client, *pmmock.Client, *pcmock.Client) {
 	return pclient, pmclient, pcclient
 }
 
-func withServer(t testing.TB, pclient provider.Client, fn func(string)) {
+func withServer(t testing.TB, accts *testAccounts, pclient provider.Client, fn func(string)) {
 	t.Helper()
 	router := newRouter(testutil.Logger(t), pclient)
-	server := httptest.NewServer(router)
+	server := httptest.NewUnstartedServer(router)
+	server.TLS = newServerTLSConfig(context.Background(), accts.qclient, []tls.Certificate{accts.pcert})
+	server.StartTLS()
 	defer server.Close()
-	fn("http://" + server.Listener.Addr().String())
+	fn("https://" + server.Listener.Addr().String())
 }
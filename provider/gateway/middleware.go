@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gorilla/context"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/log"
 
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	dquery "github.com/ovrclk/akash/x/deployment/query"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mquery "github.com/ovrclk/akash/x/market/query"
@@ -21,9 +24,11 @@ type contextKey int
 const (
 	leaseContextKey contextKey = iota + 1
 	deploymentContextKey
-	logFollowContextKey
-	tailLinesContextKey
+	logOptionsContextKey
 	serviceContextKey
+	ownerContextKey
+	leaseShellContextKey
+	eventsFollowContextKey
 )
 
 func requestLeaseID(req *http.Request) mtypes.LeaseID {
@@ -34,18 +39,49 @@ func requestDeploymentID(req *http.Request) dtypes.DeploymentID {
 	return context.Get(req, deploymentContextKey).(dtypes.DeploymentID)
 }
 
-func requestLogFollow(req *http.Request) bool {
-	return context.Get(req, logFollowContextKey).(bool)
-}
-
-func requestLogTailLines(req *http.Request) *int64 {
-	return context.Get(req, tailLinesContextKey).(*int64)
+func requestLogOptions(req *http.Request) ctypes.ServiceLogOptions {
+	return context.Get(req, logOptionsContextKey).(ctypes.ServiceLogOptions)
 }
 
 func requestService(req *http.Request) string {
 	return context.Get(req, serviceContextKey).(string)
 }
 
+func requestLeaseShellParams(req *http.Request) leaseShellParams {
+	return context.Get(req, leaseShellContextKey).(leaseShellParams)
+}
+
+func requestEventsFollow(req *http.Request) bool {
+	return context.Get(req, eventsFollowContextKey).(bool)
+}
+
+func requestOwner(req *http.Request) sdk.AccAddress {
+	return context.Get(req, ownerContextKey).(sdk.AccAddress)
+}
+
+// requireOwner requires the request to be made with a client certificate.
+// The certificate has been checked against the chain during the TLS handshake,
+// so its subject names the account the request acts for.
+func requireOwner() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+				http.Error(w, "client certificate required", http.StatusUnauthorized)
+				return
+			}
+
+			owner, err := sdk.AccAddressFromBech32(req.TLS.PeerCertificates[0].Subject.CommonName)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			context.Set(req, ownerContextKey, owner)
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
 func requireDeploymentID(_ log.Logger) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -54,6 +90,10 @@ func requireDeploymentID(_ log.Logger) mux.MiddlewareFunc {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
+			if id.Owner != requestOwner(req).String() {
+				http.Error(w, "deployment is not owned by the client certificate", http.StatusForbidden)
+				return
+			}
 			context.Set(req, deploymentContextKey, id)
 			next.ServeHTTP(w, req)
 		})
@@ -68,6 +108,10 @@ func requireLeaseID(_ log.Logger) mux.MiddlewareFunc {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
+			if id.Owner != requestOwner(req).String() {
+				http.Error(w, "lease is not owned by the client certificate", http.StatusForbidden)
+				return
+			}
 			context.Set(req, leaseContextKey, id)
 			next.ServeHTTP(w, req)
 		})
@@ -160,9 +204,104 @@ func requestLogParams() mux.MiddlewareFunc {
 				tailLines = vl
 			}
 
-			context.Set(req, logFollowContextKey, follow)
-			context.Set(req, tailLinesContextKey, tailLines)
+			var since *time.Time
+			if val = vars.Get("since"); val != "" {
+				var st time.Time
+				st, err = time.Parse(time.RFC3339, val)
+				if err != nil {
+					err = errors.Errorf("parameter \"since\" contains invalid value")
+					return
+				}
+				since = &st
+			}
+
+			var previous bool
+			if val = vars.Get("previous"); val != "" {
+				previous, err = strconv.ParseBool(val)
+				if err != nil {
+					return
+				}
+			}
+
+			context.Set(req, logOptionsContextKey, ctypes.ServiceLogOptions{
+				Services:  vars["service"],
+				Follow:    follow,
+				TailLines: tailLines,
+				Since:     since,
+				Previous:  previous,
+			})
+
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+func requestEventsParams() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var follow bool
+
+			if val := req.URL.Query().Get("follow"); val != "" {
+				var err error
+				if follow, err = strconv.ParseBool(val); err != nil {
+					http.Error(w, "parameter \"follow\" contains invalid value", http.StatusBadRequest)
+					return
+				}
+			}
+
+			context.Set(req, eventsFollowContextKey, follow)
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+type leaseShellParams struct {
+	cmd      []string
+	podIndex uint
+	tty      bool
+	stdin    bool
+}
+
+func requestLeaseShell() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			vars := req.URL.Query()
+
+			params := leaseShellParams{
+				cmd: vars["cmd"],
+			}
+
+			if len(params.cmd) == 0 {
+				http.Error(w, "query must contain \"cmd\" key", http.StatusBadRequest)
+				return
+			}
+
+			var err error
+
+			if val := vars.Get("podIndex"); val != "" {
+				var idx uint64
+				if idx, err = strconv.ParseUint(val, 10, 32); err != nil {
+					http.Error(w, "parameter \"podIndex\" contains invalid value", http.StatusBadRequest)
+					return
+				}
+				params.podIndex = uint(idx)
+			}
+
+			if val := vars.Get("tty"); val != "" {
+				if params.tty, err = strconv.ParseBool(val); err != nil {
+					http.Error(w, "parameter \"tty\" contains invalid value", http.StatusBadRequest)
+					return
+				}
+			}
+
+			if val := vars.Get("stdin"); val != "" {
+				if params.stdin, err = strconv.ParseBool(val); err != nil {
+					http.Error(w, "parameter \"stdin\" contains invalid value", http.StatusBadRequest)
+					return
+				}
+			}
 
+			context.Set(req, leaseShellContextKey, params)
 			next.ServeHTTP(w, req)
 		})
 	}
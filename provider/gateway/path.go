@@ -28,6 +28,22 @@ func serviceStatusPath(id mtypes.LeaseID, service string) string {
 	return mquery.LeasePath(id) + "/service/" + service + "/status"
 }
 
+func leaseManifestPath(id mtypes.LeaseID) string {
+	return mquery.LeasePath(id) + "/manifest"
+}
+
+func leaseLogsPath(id mtypes.LeaseID) string {
+	return mquery.LeasePath(id) + "/logs"
+}
+
 func serviceLogsPath(id mtypes.LeaseID, service string) string {
 	return mquery.LeasePath(id) + "/service/" + service + "/logs"
 }
+
+func leaseShellPath(id mtypes.LeaseID, service string) string {
+	return mquery.LeasePath(id) + "/service/" + service + "/shell"
+}
+
+func leaseEventsPath(id mtypes.LeaseID) string {
+	return mquery.LeasePath(id) + "/kubeevents"
+}
@@ -1,10 +1,11 @@
 package gateway
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,9 +14,12 @@ import (
 
 	"github.com/gorilla/mux"
 
+	maniv "github.com/ovrclk/akash/manifest"
 	"github.com/ovrclk/akash/provider"
 	"github.com/ovrclk/akash/provider/cluster"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
 	"github.com/ovrclk/akash/provider/manifest"
+	"github.com/ovrclk/akash/sdl"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -40,12 +44,17 @@ const (
 )
 
 type wsLogsConfig struct {
-	lid       mtypes.LeaseID
-	service   string
-	follow    bool
-	tailLines *int64
-	log       log.Logger
-	client    cluster.ReadClient
+	lid    mtypes.LeaseID
+	opts   ctypes.ServiceLogOptions
+	log    log.Logger
+	client cluster.ReadClient
+}
+
+type wsEventsConfig struct {
+	lid    mtypes.LeaseID
+	follow bool
+	log    log.Logger
+	client cluster.ReadClient
 }
 
 func newRouter(log log.Logger, pclient provider.Client) *mux.Router {
@@ -58,19 +67,40 @@ func newRouter(log log.Logger, pclient provider.Client) *mux.Router {
 
 	// PUT /deployment/<deployment-id>/manifest
 	drouter := router.PathPrefix(deploymentPathPrefix).Subrouter()
-	drouter.Use(requireDeploymentID(log))
+	drouter.Use(requireOwner(), requireDeploymentID(log))
 	drouter.HandleFunc("/manifest",
 		createManifestHandler(log, pclient.Manifest())).
 		Methods("PUT")
 
 	lrouter := router.PathPrefix(leasePathPrefix).Subrouter()
-	lrouter.Use(requireLeaseID(log))
+	lrouter.Use(requireOwner(), requireLeaseID(log))
 
 	// GET /lease/<lease-id>/status
 	lrouter.HandleFunc("/status",
 		leaseStatusHandler(log, pclient.Cluster())).
 		Methods("GET")
 
+	// GET /lease/<lease-id>/manifest
+	lrouter.HandleFunc("/manifest",
+		leaseManifestHandler(log, pclient.Cluster())).
+		Methods("GET")
+
+	leaseLogRouter := lrouter.PathPrefix("/logs").Subrouter()
+	leaseLogRouter.Use(requestLogParams())
+
+	// GET /lease/<lease-id>/logs
+	leaseLogRouter.HandleFunc("",
+		leaseLogsHandler(log, pclient.Cluster())).
+		Methods("GET")
+
+	eventsRouter := lrouter.PathPrefix("/kubeevents").Subrouter()
+	eventsRouter.Use(requestEventsParams())
+
+	// GET /lease/<lease-id>/kubeevents
+	eventsRouter.HandleFunc("",
+		leaseKubeEventsHandler(log, pclient.Cluster())).
+		Methods("GET")
+
 	srouter := lrouter.PathPrefix("/service/{serviceName}").Subrouter()
 	srouter.Use(requireService())
 
@@ -87,6 +117,14 @@ func newRouter(log log.Logger, pclient provider.Client) *mux.Router {
 		leaseServiceLogsHandler(log, pclient.Cluster())).
 		Methods("GET")
 
+	shellRouter := srouter.PathPrefix("/shell").Subrouter()
+	shellRouter.Use(requestLeaseShell())
+
+	// GET /lease/<lease-id>/service/<service-name>/shell
+	shellRouter.HandleFunc("",
+		leaseShellHandler(log, pclient.Cluster())).
+		Methods("GET")
+
 	return router
 }
 
@@ -121,7 +159,12 @@ func createManifestHandler(_ log.Logger, mclient manifest.Client) http.HandlerFu
 		}
 
 		if err := mclient.Submit(req.Context(), &mreq); err != nil {
-			// TODO: surface unauthorized, etc...
+			if errors.Is(err, manifest.ErrManifestSignature) ||
+				errors.Is(err, manifest.ErrManifestExpired) ||
+				errors.Is(err, manifest.ErrManifestStale) {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -143,6 +186,31 @@ func leaseStatusHandler(log log.Logger, cclient cluster.ReadClient) http.Handler
 	}
 }
 
+func leaseManifestHandler(log log.Logger, cclient cluster.ReadClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		group, err := cclient.LeaseManifest(req.Context(), requestLeaseID(req))
+		if err != nil {
+			if errors.Is(err, cluster.ErrNoManifestForLease) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+			} else {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		version, err := sdl.ManifestVersion(maniv.Manifest{*group})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(log, w, LeaseManifest{
+			Group:   *group,
+			Version: version,
+		})
+	}
+}
+
 func leaseServiceStatusHandler(log log.Logger, cclient cluster.ReadClient) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		status, err := cclient.ServiceStatus(req.Context(), requestLeaseID(req), requestService(req))
@@ -158,7 +226,49 @@ func leaseServiceStatusHandler(log log.Logger, cclient cluster.ReadClient) http.
 	}
 }
 
+func leaseLogsHandler(log log.Logger, cclient cluster.ReadClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serveLogs(w, r, wsLogsConfig{
+			lid:    requestLeaseID(r),
+			opts:   requestLogOptions(r),
+			log:    log,
+			client: cclient,
+		})
+	}
+}
+
 func leaseServiceLogsHandler(log log.Logger, cclient cluster.ReadClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		opts := requestLogOptions(r)
+		opts.Services = []string{requestService(r)}
+
+		serveLogs(w, r, wsLogsConfig{
+			lid:    requestLeaseID(r),
+			opts:   opts,
+			log:    log,
+			client: cclient,
+		})
+	}
+}
+
+func serveLogs(w http.ResponseWriter, r *http.Request, cfg wsLogsConfig) {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+	}
+
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		if _, ok := err.(websocket.HandshakeError); !ok {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	wsLogWriter(r.Context(), ws, cfg)
+}
+
+func leaseKubeEventsHandler(log log.Logger, cclient cluster.ReadClient) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		upgrader := websocket.Upgrader{
 			ReadBufferSize:  1024,
@@ -173,17 +283,70 @@ func leaseServiceLogsHandler(log log.Logger, cclient cluster.ReadClient) http.Ha
 			return
 		}
 
-		wsLogWriter(r.Context(), ws, wsLogsConfig{
-			lid:       requestLeaseID(r),
-			service:   requestService(r),
-			follow:    requestLogFollow(r),
-			tailLines: requestLogTailLines(r),
-			log:       log,
-			client:    cclient,
+		wsEventWriter(r.Context(), ws, wsEventsConfig{
+			lid:    requestLeaseID(r),
+			follow: requestEventsFollow(r),
+			log:    log,
+			client: cclient,
 		})
 	}
 }
 
+func wsEventWriter(ctx context.Context, ws *websocket.Conn, cfg wsEventsConfig) {
+	pingTicker := time.NewTicker(pingPeriod)
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		pingTicker.Stop()
+		cancel()
+		_ = ws.Close()
+	}()
+
+	events, err := cfg.client.LeaseEvents(cctx, cfg.lid, cfg.follow)
+	if err != nil {
+		cfg.log.Error("couldn't fetch events", "err", err)
+		err = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocketInternalServerErrorCode, err.Error()))
+		if err != nil {
+			cfg.log.Error("couldn't push control message through websocket", "err", err)
+		}
+		return
+	}
+
+	_ = ws.SetReadDeadline(time.Now().Add(pongWait))
+	ws.SetPongHandler(func(string) error {
+		_ = ws.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	go func() {
+		for {
+			if _, _, e := ws.ReadMessage(); e != nil {
+				cancel()
+				break
+			}
+		}
+	}()
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				_ = ws.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+
+			if err = ws.WriteJSON(ev); err != nil {
+				return
+			}
+		case <-pingTicker.C:
+			if err = ws.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+				return
+			}
+		}
+	}
+}
+
 func wsLogWriter(ctx context.Context, ws *websocket.Conn, cfg wsLogsConfig) {
 	pingTicker := time.NewTicker(pingPeriod)
 
@@ -194,20 +357,24 @@ func wsLogWriter(ctx context.Context, ws *websocket.Conn, cfg wsLogsConfig) {
 		_ = ws.Close()
 	}()
 
-	logs, err := cfg.client.ServiceLogs(cctx, cfg.lid, cfg.service, cfg.follow, cfg.tailLines)
+	logs, err := cfg.client.ServiceLogs(cctx, cfg.lid, cfg.opts)
 	if err != nil {
-		cfg.log.Error("couldn't fetch logs: %s", err.Error())
+		cfg.log.Error("couldn't fetch logs", "err", err)
 		err = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocketInternalServerErrorCode, err.Error()))
 		if err != nil {
-			cfg.log.Error("couldn't push control message through websocket: %s", err.Error())
+			cfg.log.Error("couldn't push control message through websocket", "err", err)
 		}
 		return
 	}
 
 	if len(logs) == 0 {
+		msg := "lease does not have running pods"
+		if len(cfg.opts.Services) != 0 {
+			msg = "services " + strings.Join(cfg.opts.Services, ",") + " do not have running pods"
+		}
 		_ = ws.WriteMessage(
 			websocket.CloseMessage,
-			websocket.FormatCloseMessage(websocketInternalServerErrorCode, "service "+cfg.service+" does not have running pods"))
+			websocket.FormatCloseMessage(websocketInternalServerErrorCode, msg))
 		return
 	}
 
@@ -235,16 +402,20 @@ func wsLogWriter(ctx context.Context, ws *websocket.Conn, cfg wsLogsConfig) {
 	scanners.Add(len(logs))
 
 	for _, lg := range logs {
-		go func(name string, scan *bufio.Scanner) {
+		go func(lg *ctypes.ServiceLog) {
 			defer scanners.Done()
 
-			for scan.Scan() && ctx.Err() == nil {
+			for lg.Scanner.Scan() && ctx.Err() == nil {
+				ts, msg := cluster.ParseServiceLogLine(lg.Scanner.Text())
 				logch <- ServiceLogMessage{
-					Name:    name,
-					Message: scan.Text(),
+					Name:         lg.Name,
+					Service:      lg.Service,
+					RestartCount: lg.RestartCount,
+					Timestamp:    ts,
+					Message:      msg,
 				}
 			}
-		}(lg.Name, lg.Scanner)
+		}(lg)
 	}
 
 	donech := make(chan struct{})
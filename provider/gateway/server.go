@@ -2,19 +2,58 @@ package gateway
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net"
 	"net/http"
 
-	"github.com/ovrclk/akash/provider"
+	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ovrclk/akash/provider"
+	ctypes "github.com/ovrclk/akash/x/cert/types"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
 )
 
-func NewServer(ctx context.Context, log log.Logger, pclient provider.Client, address string) *http.Server {
+// NewServer returns the gateway server. It serves TLS with the provider certificates and
+// authenticates tenants by the client certificates they published on chain.
+func NewServer(ctx context.Context, log log.Logger, pclient provider.Client, cquery ctypes.QueryClient, address string, certs []tls.Certificate) *http.Server {
 	return &http.Server{
-		Addr:    address,
-		Handler: newRouter(log, pclient),
+		Addr:      address,
+		Handler:   newRouter(log, pclient),
+		TLSConfig: newServerTLSConfig(ctx, cquery, certs),
 		BaseContext: func(_ net.Listener) context.Context {
 			return ctx
 		},
 	}
 }
+
+// newServerTLSConfig requests but does not require client certificates so that the
+// provider status stays public; routes acting on tenant resources check the owner.
+func newServerTLSConfig(ctx context.Context, cquery ctypes.QueryClient, certs []tls.Certificate) *tls.Config {
+	return &tls.Config{
+		Certificates: certs,
+		ClientAuth:   tls.RequestClientCert,
+		MinVersion:   tls.VersionTLS13,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return nil
+			}
+
+			if len(rawCerts) != 1 {
+				return errors.New("tls: expected a single client certificate")
+			}
+
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+
+			if _, err := cutils.VerifyCertificate(ctx, cquery, cert, x509.ExtKeyUsageClientAuth); err != nil {
+				return errors.Wrap(err, "tls: client certificate")
+			}
+
+			return nil
+		},
+	}
+}
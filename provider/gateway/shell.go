@@ -0,0 +1,288 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ovrclk/akash/provider/cluster"
+	ctypes "github.com/ovrclk/akash/provider/cluster/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// Lease shell messages are binary websocket messages. The first byte of a
+// message identifies the stream, the remainder is its payload.
+const (
+	// LeaseShellCodeStdout carries output of the command
+	LeaseShellCodeStdout = 100
+	// LeaseShellCodeStderr carries error output of the command
+	LeaseShellCodeStderr = 101
+	// LeaseShellCodeResult carries the LeaseShellResult once the command finished
+	LeaseShellCodeResult = 102
+	// LeaseShellCodeStdin carries input of the command; an empty payload closes it
+	LeaseShellCodeStdin = 103
+	// LeaseShellCodeTerminalResize carries the cluster.TerminalSize of the client terminal
+	LeaseShellCodeTerminalResize = 104
+)
+
+// LeaseShellResult is the outcome of a command run in a lease container.
+// Message is set when the command could not be run.
+type LeaseShellResult struct {
+	ExitCode int    `json:"exit_code"`
+	Message  string `json:"message,omitempty"`
+}
+
+type wsLeaseShellConfig struct {
+	lid     mtypes.LeaseID
+	service string
+	params  leaseShellParams
+	log     log.Logger
+	client  cluster.ReadClient
+}
+
+func leaseShellHandler(log log.Logger, cclient cluster.ReadClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+		}
+
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			if _, ok := err.(websocket.HandshakeError); !ok {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		wsLeaseShell(r.Context(), ws, wsLeaseShellConfig{
+			lid:     requestLeaseID(r),
+			service: requestService(r),
+			params:  requestLeaseShellParams(r),
+			log:     log,
+			client:  cclient,
+		})
+	}
+}
+
+func wsLeaseShell(ctx context.Context, ws *websocket.Conn, cfg wsLeaseShellConfig) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		_ = ws.Close()
+	}()
+
+	wsw := &wsStreamWriter{ws: ws}
+
+	var stdin io.Reader
+	var stdinw *io.PipeWriter
+	if cfg.params.stdin {
+		stdin, stdinw = io.Pipe()
+	}
+
+	var tsq *terminalSizeQueue
+	if cfg.params.tty {
+		tsq = newTerminalSizeQueue(ctx)
+	}
+
+	_ = ws.SetReadDeadline(time.Now().Add(pongWait))
+	ws.SetPongHandler(func(string) error {
+		_ = ws.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	// input of the command and terminal size changes come from the client. The command is
+	// stopped once the client is gone.
+	go func() {
+		defer func() {
+			cancel()
+			if stdinw != nil {
+				_ = stdinw.Close()
+			}
+		}()
+
+		for {
+			mType, msg, err := ws.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			if mType != websocket.BinaryMessage || len(msg) == 0 {
+				continue
+			}
+
+			switch msg[0] {
+			case LeaseShellCodeStdin:
+				if stdinw == nil {
+					continue
+				}
+
+				if len(msg) == 1 {
+					_ = stdinw.Close()
+					stdinw = nil
+					continue
+				}
+
+				if _, err := stdinw.Write(msg[1:]); err != nil {
+					return
+				}
+			case LeaseShellCodeTerminalResize:
+				if tsq == nil {
+					continue
+				}
+
+				var size ctypes.TerminalSize
+				if err := json.Unmarshal(msg[1:], &size); err != nil {
+					cfg.log.Error("invalid terminal size", "err", err)
+					continue
+				}
+
+				tsq.push(size)
+			}
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(pingPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := wsw.writeControl(websocket.PingMessage); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	var stdout, stderr io.Writer
+	stdout = wsw.stream(LeaseShellCodeStdout)
+	if !cfg.params.tty {
+		stderr = wsw.stream(LeaseShellCodeStderr)
+	}
+
+	var result LeaseShellResult
+
+	var sizes ctypes.TerminalSizeQueue
+	if tsq != nil {
+		sizes = tsq
+	}
+
+	res, err := cfg.client.Exec(ctx, cfg.lid, cfg.service, cfg.params.podIndex, cfg.params.cmd,
+		stdin, stdout, stderr, cfg.params.tty, sizes)
+	if err != nil {
+		cfg.log.Error("lease shell failed", "lease", cfg.lid, "service", cfg.service, "err", err)
+		result.ExitCode = -1
+		result.Message = err.Error()
+	} else {
+		result.ExitCode = res.ExitCode()
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		cfg.log.Error("couldn't encode lease shell result", "err", err)
+		return
+	}
+
+	if err = wsw.write(LeaseShellCodeResult, buf); err != nil {
+		cfg.log.Error("couldn't push lease shell result through websocket", "err", err)
+		return
+	}
+
+	_ = wsw.writeClose(websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// wsStreamWriter serializes the writes of several streams onto a websocket
+type wsStreamWriter struct {
+	ws  *websocket.Conn
+	mtx sync.Mutex
+}
+
+func (w *wsStreamWriter) write(code byte, data []byte) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	msg := make([]byte, 0, len(data)+1)
+	msg = append(msg, code)
+	msg = append(msg, data...)
+
+	return w.ws.WriteMessage(websocket.BinaryMessage, msg)
+}
+
+func (w *wsStreamWriter) writeControl(mType int) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.ws.WriteMessage(mType, []byte{})
+}
+
+func (w *wsStreamWriter) writeClose(data []byte) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.ws.WriteMessage(websocket.CloseMessage, data)
+}
+
+func (w *wsStreamWriter) stream(code byte) io.Writer {
+	return wsStream{w: w, code: code}
+}
+
+type wsStream struct {
+	w    *wsStreamWriter
+	code byte
+}
+
+func (s wsStream) Write(data []byte) (int, error) {
+	if err := s.w.write(s.code, data); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// terminalSizeQueue hands the terminal size changes received from the client
+// to the cluster. Only the latest size is kept.
+type terminalSizeQueue struct {
+	ctx context.Context
+	ch  chan ctypes.TerminalSize
+}
+
+func newTerminalSizeQueue(ctx context.Context) *terminalSizeQueue {
+	return &terminalSizeQueue{
+		ctx: ctx,
+		ch:  make(chan ctypes.TerminalSize, 1),
+	}
+}
+
+func (q *terminalSizeQueue) push(size ctypes.TerminalSize) {
+	for {
+		select {
+		case q.ch <- size:
+			return
+		default:
+		}
+
+		// drop the pending size, it is outdated
+		select {
+		case <-q.ch:
+		default:
+		}
+	}
+}
+
+func (q *terminalSizeQueue) Next() *ctypes.TerminalSize {
+	select {
+	case <-q.ctx.Done():
+		return nil
+	case size := <-q.ch:
+		return &size
+	}
+}
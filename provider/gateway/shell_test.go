@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pcmock "github.com/ovrclk/akash/provider/cluster/mocks"
+	"github.com/ovrclk/akash/testutil"
+)
+
+func TestLeaseShellStopsOnDisconnect(t *testing.T) {
+	id := testutil.LeaseID(t)
+	cmd := []string{"tail", "-f", "/var/log/app.log"}
+
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+
+	cclient := &pcmock.Client{}
+	cclient.On("Exec", mock.Anything, id, "svc", uint(0), cmd,
+		nil, mock.Anything, mock.Anything, false, nil).
+		Run(func(args mock.Arguments) {
+			// a command without input which never exits on its own
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+			close(stopped)
+		}).
+		Return(fakeExecResult(0), nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+
+		wsLeaseShell(context.Background(), ws, wsLeaseShellConfig{
+			lid:     id,
+			service: "svc",
+			params:  leaseShellParams{cmd: cmd},
+			log:     testutil.Logger(t),
+			client:  cclient,
+		})
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("command not started")
+	}
+
+	require.NoError(t, conn.Close())
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("command not stopped once the client disconnected")
+	}
+}
@@ -4,4 +4,8 @@ import "time"
 
 type config struct {
 	ManifestLingerDuration time.Duration `env:"AKASH_MANIFEST_LINGER_DURATION" envDefault:"5m"`
+
+	// ManifestSignatureMaxAge is the number of blocks a signed manifest may be
+	// submitted for, measured from the height it was signed at
+	ManifestSignatureMaxAge int64 `env:"AKASH_MANIFEST_SIGNATURE_MAX_AGE" envDefault:"100"`
 }
@@ -3,10 +3,16 @@ package manifest
 import (
 	"bytes"
 	"context"
+	"strconv"
 	"time"
 
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	lifecycle "github.com/boz/go-lifecycle"
 	"github.com/ovrclk/akash/manifest"
@@ -26,8 +32,52 @@ var (
 	// ErrManifestVersion indicates that the given manifest's version does not
 	// match the blockchain Version value.
 	ErrManifestVersion = errors.New("manifest version validation failed")
+	// ErrManifestExpired indicates that the manifest was not signed within
+	// the accepted range of the current block height.
+	ErrManifestExpired = errors.New("manifest signature expired")
+	// ErrManifestStale indicates that the manifest was signed before the
+	// manifest currently accepted for the deployment.
+	ErrManifestStale = errors.New("manifest signed before the current manifest")
+	// ErrManifestHeightUnknown indicates that the block height the deployment was
+	// queried at is not known, so the age of the manifest signature can not be checked.
+	ErrManifestHeightUnknown = errors.New("block height of the deployment query unknown")
 )
 
+var (
+	manifestPendingGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "provider_manifest_pending_requests",
+		Help: "The number of submitted manifests waiting to be validated",
+	})
+
+	manifestValidationFailureCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_manifest_validation_failures_total",
+		Help: "The number of rejected manifests, by reason",
+	}, []string{"reason"})
+
+	leaseClosedRejectedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "provider_manifest_rejected_leases_closed_total",
+		Help: "The number of leases closed because the provider's policy rejected their manifest",
+	})
+)
+
+// manifestValidationFailureReason names the reason of a manifest rejection for metrics
+func manifestValidationFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrManifestExpired):
+		return "expired"
+	case errors.Is(err, ErrManifestStale):
+		return "stale"
+	case errors.Is(err, ErrManifestHeightUnknown):
+		return "height"
+	case errors.Is(err, ErrManifestVersion):
+		return "version"
+	case errors.Is(err, ErrManifestImageDenied):
+		return "image"
+	default:
+		return "deployment"
+	}
+}
+
 func newManager(h *service, daddr dtypes.DeploymentID) (*manager, error) {
 	session := h.session.ForModule("manifest-manager")
 
@@ -38,6 +88,7 @@ func newManager(h *service, daddr dtypes.DeploymentID) (*manager, error) {
 
 	m := &manager{
 		config:     h.config,
+		images:     h.images,
 		daddr:      daddr,
 		session:    session,
 		bus:        h.bus,
@@ -59,6 +110,7 @@ func newManager(h *service, daddr dtypes.DeploymentID) (*manager, error) {
 // 'manager' facilitates operations around a configured Deployment.
 type manager struct {
 	config  config
+	images  ImagePolicy
 	daddr   dtypes.DeploymentID
 	session session.Session
 	bus     pubsub.Bus
@@ -70,11 +122,15 @@ type manager struct {
 	updatech   chan []byte
 
 	data      *dtypes.DeploymentResponse
+	height    int64
 	requests  []manifestRequest
 	leases    []event.LeaseWon
 	manifests []*manifest.Manifest
 	versions  [][]byte
 
+	// height the accepted manifest was signed at
+	signedHeight int64
+
 	stoptimer *time.Timer
 
 	log log.Logger
@@ -123,6 +179,7 @@ func (m *manager) run(donech chan<- *manager) {
 	defer func() { donech <- m }()
 
 	var runch <-chan runner.Result
+	var closech <-chan runner.Result
 
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -167,13 +224,14 @@ loop:
 		case req := <-m.manifestch:
 			m.log.Info("manifest received")
 
-			// TODO: fail fast if invalid request to prevent DoS
-
+			// requests are validated against the deployment and block height
+			// once they are refreshed
 			m.requests = append(m.requests, req)
-			m.validateRequests()
-			m.emitReceivedEvents()
+			manifestPendingGauge.Inc()
 			m.maybeScheduleStop()
-			runch = m.maybeFetchData(ctx, runch)
+			if runch == nil {
+				runch = m.fetchData(ctx)
+			}
 
 		case version := <-m.updatech:
 			m.log.Info("received version", "version", version)
@@ -187,17 +245,33 @@ loop:
 
 			if err := result.Error(); err != nil {
 				m.log.Error("error fetching data", "err", err)
+				for _, req := range m.requests {
+					req.ch <- err
+				}
+				manifestPendingGauge.Sub(float64(len(m.requests)))
+				m.requests = nil
 				break
 			}
 
-			m.data = result.Value().(*dtypes.DeploymentResponse)
+			data := result.Value().(deploymentData)
+			m.data = data.deployment
+			m.height = data.height
 
 			m.log.Info("data received", "version", m.data.Deployment.Version)
 
-			m.validateRequests()
+			if rejected := m.validateRequests(); rejected && closech == nil {
+				closech = m.closeRejectedLeases()
+			}
 			m.emitReceivedEvents()
 			m.maybeScheduleStop()
 
+		case result := <-closech:
+			closech = nil
+
+			if err := result.Error(); err != nil {
+				m.log.Error("closing leases of rejected manifest", "err", err)
+			}
+
 		}
 	}
 
@@ -206,6 +280,7 @@ loop:
 	for _, req := range m.requests {
 		req.ch <- ErrNotRunning
 	}
+	manifestPendingGauge.Sub(float64(len(m.requests)))
 
 	if m.stoptimer != nil {
 		if m.stoptimer.Stop() {
@@ -217,6 +292,10 @@ loop:
 		<-runch
 	}
 
+	if closech != nil {
+		<-closech
+	}
+
 }
 
 func (m *manager) maybeFetchData(ctx context.Context, runch <-chan runner.Result) <-chan runner.Result {
@@ -233,12 +312,33 @@ func (m *manager) fetchData(ctx context.Context) <-chan runner.Result {
 	})
 }
 
-func (m *manager) doFetchData(_ context.Context) (*dtypes.DeploymentResponse, error) {
-	res, err := m.session.Client().Query().Deployment(context.Background(), &dtypes.QueryDeploymentRequest{ID: m.daddr})
+type deploymentData struct {
+	deployment *dtypes.DeploymentResponse
+	height     int64
+}
+
+func (m *manager) doFetchData(_ context.Context) (deploymentData, error) {
+	var header metadata.MD
+
+	res, err := m.session.Client().Query().Deployment(
+		context.Background(),
+		&dtypes.QueryDeploymentRequest{ID: m.daddr},
+		grpc.Header(&header),
+	)
 	if err != nil {
-		return nil, err
+		return deploymentData{}, err
+	}
+
+	// the block height the query was served at
+	var height int64
+	if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		height, err = strconv.ParseInt(heights[0], 10, 64)
+		if err != nil {
+			return deploymentData{}, err
+		}
 	}
-	return &res.Deployment, nil
+
+	return deploymentData{deployment: &res.Deployment, height: height}, nil
 }
 
 func (m *manager) maybeScheduleStop() bool { // nolint:golint,unparam
@@ -281,18 +381,36 @@ func (m *manager) emitReceivedEvents() {
 	}
 }
 
-func (m *manager) validateRequests() {
+// validateRequests accepts the pending requests which are valid, and returns true when the
+// provider's policy rejected one of them
+func (m *manager) validateRequests() bool {
 	if m.data == nil || len(m.requests) == 0 {
-		return
+		return false
 	}
 
+	rejected := false
+
+	manifestPendingGauge.Sub(float64(len(m.requests)))
+
 	manifests := make([]*manifest.Manifest, 0)
 	for _, req := range m.requests {
 		if err := m.validateRequest(req); err != nil {
 			m.log.Error("invalid manifest", "err", err)
+			manifestValidationFailureCounter.WithLabelValues(manifestValidationFailureReason(err)).Inc()
+			if errors.Is(err, ErrManifestImageDenied) {
+				rejected = true
+			}
 			req.ch <- err
 			continue
 		}
+
+		m.log.Info("manifest accepted", "signer", req.value.Deployment.Owner,
+			"height", req.value.Height, "version", m.data.Deployment.Version)
+
+		if req.value.Height > m.signedHeight {
+			m.signedHeight = req.value.Height
+		}
+
 		manifests = append(manifests, &req.value.Manifest)
 		req.ch <- nil
 	}
@@ -304,9 +422,64 @@ func (m *manager) validateRequests() {
 		// XXX: only one version means only one valid manifest
 		m.manifests = append(m.manifests, manifests[0])
 	}
+
+	return rejected
+}
+
+// closeRejectedLeases closes the leases of the deployment as the provider will not run
+// the workloads of its tenant. They are removed from the manager once closed on chain.
+func (m *manager) closeRejectedLeases() <-chan runner.Result {
+	if len(m.leases) == 0 {
+		return nil
+	}
+
+	leases := make([]mtypes.LeaseID, 0, len(m.leases))
+	for _, lease := range m.leases {
+		leases = append(leases, lease.LeaseID)
+
+		if err := m.bus.Publish(event.ManifestRejected{
+			LeaseID: lease.LeaseID,
+			Reason:  "policy-image",
+		}); err != nil {
+			m.log.Error("publishing event", "err", err, "lease", lease.LeaseID)
+		}
+	}
+
+	return runner.Do(func() runner.Result {
+		var result error
+		for _, lease := range leases {
+			// TODO: retry
+			err := m.session.Client().Tx().Broadcast(&mtypes.MsgCloseBid{
+				BidID: lease.BidID(),
+			})
+			if err != nil {
+				m.log.Error("closing lease", "err", err, "lease", lease)
+				result = err
+				continue
+			}
+			m.log.Info("lease of rejected manifest closed", "lease", lease)
+			leaseClosedRejectedCounter.Inc()
+		}
+		return runner.NewResult(nil, result)
+	})
 }
 
 func (m *manager) validateRequest(req manifestRequest) error {
+	// ensure that the signature was made recently, so that manifests can not
+	// be replayed once they are superseded or after they are withdrawn
+	if m.height <= 0 {
+		return ErrManifestHeightUnknown
+	}
+
+	age := m.height - req.value.Height
+	if age > m.config.ManifestSignatureMaxAge || age < -m.config.ManifestSignatureMaxAge {
+		return ErrManifestExpired
+	}
+
+	if req.value.Height < m.signedHeight {
+		return ErrManifestStale
+	}
+
 	// ensure that an uploaded manifest matches the hash declared on
 	// the Akash Deployment.Version
 	version, err := sdl.ManifestVersion(req.value.Manifest)
@@ -317,8 +490,13 @@ func (m *manager) validateRequest(req manifestRequest) error {
 		return ErrManifestVersion
 	}
 
+	if err := validation.ValidateManifest(req.value.Manifest); err != nil {
+		return err
+	}
+
 	if err := validation.ValidateManifestWithDeployment(&req.value.Manifest, m.data.Groups); err != nil {
 		return err
 	}
-	return nil
+
+	return m.images.Check(&req.value.Manifest)
 }
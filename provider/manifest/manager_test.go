@@ -0,0 +1,228 @@
+package manifest_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	clientmocks "github.com/ovrclk/akash/client/mocks"
+	"github.com/ovrclk/akash/provider/event"
+	"github.com/ovrclk/akash/provider/manifest"
+	"github.com/ovrclk/akash/provider/session"
+	"github.com/ovrclk/akash/pubsub"
+	"github.com/ovrclk/akash/sdl"
+	"github.com/ovrclk/akash/testutil"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+const managerTestOwner = "owner"
+
+type managerTestScaffold struct {
+	service    manifest.Service
+	bus        pubsub.Bus
+	sub        pubsub.Subscriber
+	kr         keyring.Keyring
+	request    *manifest.SubmitRequest
+	lease      mtypes.LeaseID
+	broadcasts chan sdk.Msg
+}
+
+// newManagerTestScaffold runs the manifest service with a won lease for a deployment of
+// the simple SDL. Deployment queries are served at height, without the block height
+// header when height is zero.
+func newManagerTestScaffold(t *testing.T, images manifest.ImagePolicy, height int64) *managerTestScaffold {
+	s := &managerTestScaffold{
+		kr:         keyring.NewInMemory(),
+		broadcasts: make(chan sdk.Msg, 10),
+	}
+
+	info, _, err := s.kr.NewMnemonic(managerTestOwner, keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	sdef, err := sdl.ReadFile("../../sdl/_testdata/simple.yaml")
+	require.NoError(t, err)
+	mani, err := sdef.Manifest()
+	require.NoError(t, err)
+	gspecs, err := sdef.DeploymentGroups()
+	require.NoError(t, err)
+	version, err := sdl.ManifestVersion(mani)
+	require.NoError(t, err)
+
+	did := testutil.DeploymentID(t)
+	did.Owner = info.GetAddress().String()
+
+	deployment := dtypes.DeploymentResponse{
+		Deployment: dtypes.Deployment{
+			DeploymentID: did,
+			State:        dtypes.DeploymentActive,
+			Version:      version,
+		},
+	}
+	for idx, gspec := range gspecs {
+		deployment.Groups = append(deployment.Groups, dtypes.Group{
+			GroupID:   dtypes.MakeGroupID(did, uint32(idx+1)),
+			State:     dtypes.GroupOpen,
+			GroupSpec: *gspec,
+		})
+	}
+
+	provider := testutil.AccAddress(t)
+	group := deployment.Groups[0]
+	s.lease = mtypes.MakeLeaseID(mtypes.MakeBidID(mtypes.MakeOrderID(group.GroupID, 1), provider))
+
+	queryClient := &clientmocks.QueryClient{}
+	queryClient.On("ActiveLeasesForProvider", mock.Anything).Return(mtypes.Leases{}, nil)
+	queryClient.On("Deployment", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		if header, ok := args.Get(2).(grpc.HeaderCallOption); ok && height > 0 {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+	}).Return(&dtypes.QueryDeploymentResponse{Deployment: deployment}, nil)
+
+	txClient := &clientmocks.TxClient{}
+	txClient.On("Broadcast", mock.Anything).Run(func(args mock.Arguments) {
+		s.broadcasts <- args.Get(0).(sdk.Msg)
+	}).Return(nil)
+
+	client := &clientmocks.Client{}
+	client.On("Query").Return(queryClient)
+	client.On("Tx").Return(txClient)
+
+	sess := session.New(testutil.Logger(t), client, &ptypes.Provider{Owner: provider.String()})
+
+	s.bus = pubsub.NewBus()
+	s.sub, err = s.bus.Subscribe()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.service, err = manifest.NewService(ctx, sess, s.bus, images)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cancel()
+		<-s.service.Done()
+		s.sub.Close()
+		s.bus.Close()
+	})
+
+	require.NoError(t, s.bus.Publish(event.LeaseWon{
+		LeaseID: s.lease,
+		Group:   &group,
+		Price:   testutil.AkashCoin(t, 1),
+	}))
+
+	// the lease is handed to its manager before the status is served
+	require.Eventually(t, func() bool {
+		status, err := s.service.Status(context.Background())
+		require.NoError(t, err)
+		return status.Deployments == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.request = &manifest.SubmitRequest{
+		Deployment: did,
+		Manifest:   mani,
+	}
+
+	return s
+}
+
+// submit signs the manifest of the deployment at height and submits it
+func (s *managerTestScaffold) submit(t *testing.T, height int64) error {
+	require.NoError(t, s.request.Sign(s.kr, managerTestOwner, height))
+	return s.service.Submit(context.Background(), s.request)
+}
+
+func TestManagerAcceptsManifest(t *testing.T) {
+	s := newManagerTestScaffold(t, manifest.ImagePolicy{}, 100)
+
+	require.NoError(t, s.submit(t, 95))
+
+	received := waitForEvent(t, s.sub, func(ev pubsub.Event) bool {
+		_, ok := ev.(event.ManifestReceived)
+		return ok
+	}).(event.ManifestReceived)
+	require.Equal(t, s.lease, received.LeaseID)
+}
+
+func TestManagerClosesLeasesOfRejectedImages(t *testing.T) {
+	s := newManagerTestScaffold(t, manifest.ImagePolicy{Deny: []string{"nginx*"}}, 100)
+
+	err := s.submit(t, 100)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, manifest.ErrManifestImageDenied))
+
+	rejected := waitForEvent(t, s.sub, func(ev pubsub.Event) bool {
+		_, ok := ev.(event.ManifestRejected)
+		return ok
+	}).(event.ManifestRejected)
+	require.Equal(t, s.lease, rejected.LeaseID)
+	require.Equal(t, "policy-image", rejected.Reason)
+
+	select {
+	case msg := <-s.broadcasts:
+		require.Equal(t, &mtypes.MsgCloseBid{BidID: s.lease.BidID()}, msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("lease not closed")
+	}
+}
+
+func TestManagerRejectsExpiredManifest(t *testing.T) {
+	s := newManagerTestScaffold(t, manifest.ImagePolicy{}, 300)
+
+	// the default maximum age is 100 blocks
+	err := s.submit(t, 199)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, manifest.ErrManifestExpired))
+
+	err = s.submit(t, 401)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, manifest.ErrManifestExpired))
+
+	require.NoError(t, s.submit(t, 200))
+}
+
+func TestManagerRejectsStaleManifest(t *testing.T) {
+	s := newManagerTestScaffold(t, manifest.ImagePolicy{}, 100)
+
+	require.NoError(t, s.submit(t, 95))
+
+	err := s.submit(t, 90)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, manifest.ErrManifestStale))
+
+	require.NoError(t, s.submit(t, 95))
+}
+
+func TestManagerRejectsManifestWithoutHeight(t *testing.T) {
+	s := newManagerTestScaffold(t, manifest.ImagePolicy{}, 0)
+
+	err := s.submit(t, 100)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, manifest.ErrManifestHeightUnknown))
+}
+
+func waitForEvent(t *testing.T, sub pubsub.Subscriber, match func(pubsub.Event) bool) pubsub.Event {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case ev := <-sub.Events():
+			if match(ev) {
+				return ev
+			}
+		case <-timeout:
+			t.Fatal("event not published")
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ovrclk/akash/manifest"
+	"github.com/ovrclk/akash/util/wildcard"
+)
+
+var (
+	// ErrManifestImageDenied indicates that a service image is not allowed by the provider's
+	// image policy
+	ErrManifestImageDenied = errors.New("manifest image not allowed by provider")
+)
+
+// ImagePolicy restricts the container images of the manifests accepted. Images are not part
+// of orders, so they can only be checked once the manifest is submitted. Patterns are
+// wildcard patterns matched against the image as written in the manifest, where '*' also
+// matches '/': "*xmrig*" matches "docker.io/xmrig/xmrig:latest".
+type ImagePolicy struct {
+	// Allow, when not empty, lists the only images accepted
+	Allow []string
+	// Deny lists the images never accepted
+	Deny []string
+}
+
+// Check returns an error for the first service of the manifest using an image the policy
+// does not allow
+func (p ImagePolicy) Check(m *manifest.Manifest) error {
+	for _, group := range *m {
+		for _, svc := range group.Services {
+			if !p.allows(svc.Image) {
+				return fmt.Errorf("%w: service %v image %v", ErrManifestImageDenied, svc.Name, svc.Image)
+			}
+		}
+	}
+	return nil
+}
+
+func (p ImagePolicy) allows(image string) bool {
+	if len(p.Allow) != 0 && !matchAny(p.Allow, image) {
+		return false
+	}
+	return !matchAny(p.Deny, image)
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if wildcard.Match(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package manifest_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	maniv "github.com/ovrclk/akash/manifest"
+	"github.com/ovrclk/akash/provider/manifest"
+)
+
+func TestImagePolicyCheck(t *testing.T) {
+	m := &maniv.Manifest{{
+		Name: "group",
+		Services: []maniv.Service{
+			{Name: "web", Image: "nginx:1.19"},
+			{Name: "miner", Image: "xmrig/xmrig:latest"},
+		},
+	}}
+
+	tests := []struct {
+		name   string
+		policy manifest.ImagePolicy
+		err    bool
+	}{
+		{name: "no rules", policy: manifest.ImagePolicy{}},
+		{name: "all allowed", policy: manifest.ImagePolicy{Allow: []string{"nginx:*", "xmrig/*"}}},
+		{name: "not allowed", policy: manifest.ImagePolicy{Allow: []string{"nginx:*"}}, err: true},
+		{name: "denied", policy: manifest.ImagePolicy{Deny: []string{"xmrig/*"}}, err: true},
+		{name: "denied tag", policy: manifest.ImagePolicy{Deny: []string{"*:latest"}}, err: true},
+		{name: "denied across segments", policy: manifest.ImagePolicy{Deny: []string{"*xmrig:*"}}, err: true},
+		{name: "allowed across segments", policy: manifest.ImagePolicy{Allow: []string{"*"}}},
+		{name: "deny not matching", policy: manifest.ImagePolicy{Deny: []string{"busybox*"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.Check(m)
+			if !test.err {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, errors.Is(err, manifest.ErrManifestImageDenied))
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 
 	lifecycle "github.com/boz/go-lifecycle"
 	"github.com/caarlos0/env"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	"github.com/ovrclk/akash/provider/event"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
@@ -17,6 +20,11 @@ import (
 // ErrNotRunning is the error when service is not running
 var ErrNotRunning = errors.New("not running")
 
+var manifestManagerGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "provider_manifest_managers",
+	Help: "The number of deployments the manifest service tracks",
+})
+
 // StatusClient is the interface which includes status of service
 type StatusClient interface {
 	Status(context.Context) (*Status, error)
@@ -36,7 +44,7 @@ type Service interface {
 
 // NewHandler creates and returns new Service instance
 // Manage incoming leases and manifests and pair the two together to construct and emit a ManifestReceived event.
-func NewService(ctx context.Context, session session.Session, bus pubsub.Bus) (Service, error) {
+func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, images ImagePolicy) (Service, error) {
 
 	session = session.ForModule("provider-manifest")
 
@@ -60,6 +68,8 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus) (S
 	session.Log().Info("found existing leases", "count", len(leases))
 
 	s := &service{
+		config:    config,
+		images:    images,
 		session:   session,
 		bus:       bus,
 		sub:       sub,
@@ -78,6 +88,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus) (S
 
 type service struct {
 	config  config
+	images  ImagePolicy
 	session session.Session
 	bus     pubsub.Bus
 	sub     pubsub.Subscriber
@@ -99,6 +110,18 @@ type manifestRequest struct {
 
 // Send incoming manifest request.
 func (s *service) Submit(ctx context.Context, mreq *SubmitRequest) error {
+	// fail fast on requests not signed by the deployment owner
+	signer, err := mreq.VerifySignature()
+	if err != nil {
+		s.session.Log().Error("manifest signature verification failed",
+			"err", err, "deployment", mreq.Deployment)
+		manifestValidationFailureCounter.WithLabelValues("signature").Inc()
+		return err
+	}
+
+	s.session.Log().Info("manifest submitted", "deployment", mreq.Deployment,
+		"signer", signer, "height", mreq.Height)
+
 	ch := make(chan error, 1)
 	req := manifestRequest{value: mreq, ch: ch, ctx: ctx}
 	select {
@@ -218,12 +241,14 @@ loop:
 			s.session.Log().Info("manager done", "deployment", manager.daddr)
 
 			delete(s.managers, dquery.DeploymentPath(manager.daddr))
+			manifestManagerGauge.Set(float64(len(s.managers)))
 		}
 	}
 
 	for len(s.managers) > 0 {
 		manager := <-s.managerch
 		delete(s.managers, dquery.DeploymentPath(manager.daddr))
+		manifestManagerGauge.Set(float64(len(s.managers)))
 	}
 
 }
@@ -253,6 +278,7 @@ func (s *service) ensureManger(did dtypes.DeploymentID) (manager *manager, err e
 			return nil, err
 		}
 		s.managers[dquery.DeploymentPath(did)] = manager
+		manifestManagerGauge.Set(float64(len(s.managers)))
 	}
 	return manager, nil
 }
@@ -1,16 +1,116 @@
 package manifest
 
 import (
+	"encoding/json"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ovrclk/akash/manifest"
+	"github.com/ovrclk/akash/sdl"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
+var (
+	// ErrManifestSignature indicates that the submit request is not signed by the deployment owner
+	ErrManifestSignature = errors.New("manifest signature verification failed")
+)
+
 // Status is the data structure
 type Status struct {
 	Deployments uint32 `json:"deployments"`
 }
 
+// SubmitRequest is the manifest of a deployment signed by the deployment owner.
+// Height is the block height at the time of signing and bounds how long the
+// request can be submitted to a provider.
 type SubmitRequest struct {
 	Deployment dtypes.DeploymentID `json:"deployment"`
 	Manifest   manifest.Manifest   `json:"manifest"`
+	Height     int64               `json:"height"`
+	PubKey     []byte              `json:"pubkey"`
+	Signature  []byte              `json:"signature"`
+}
+
+type submitRequestSignDoc struct {
+	Deployment dtypes.DeploymentID `json:"deployment"`
+	Version    []byte              `json:"version"`
+	Height     int64               `json:"height"`
+}
+
+// SignBytes returns the bytes the deployment owner signs. The manifest is
+// covered by its version, which is the hash the deployment declares on chain.
+func (r *SubmitRequest) SignBytes() ([]byte, error) {
+	version, err := sdl.ManifestVersion(r.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := json.Marshal(submitRequestSignDoc{
+		Deployment: r.Deployment,
+		Version:    version,
+		Height:     r.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.SortJSON(buf)
+}
+
+// Sign signs the request at the given height with the key uid of the signer
+func (r *SubmitRequest) Sign(signer keyring.Signer, uid string, height int64) error {
+	r.Height = height
+
+	msg, err := r.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	sig, pubkey, err := signer.Sign(uid, msg)
+	if err != nil {
+		return err
+	}
+
+	r.PubKey = legacy.Cdc.MustMarshalBinaryBare(pubkey)
+	r.Signature = sig
+
+	return nil
+}
+
+// VerifySignature checks that the request is signed by the deployment owner
+// and returns the address of the signer
+func (r *SubmitRequest) VerifySignature() (sdk.AccAddress, error) {
+	if len(r.PubKey) == 0 || len(r.Signature) == 0 || r.Height <= 0 {
+		return nil, ErrManifestSignature
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Deployment.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkey, err := cryptocodec.PubKeyFromBytes(r.PubKey)
+	if err != nil {
+		return nil, ErrManifestSignature
+	}
+
+	signer := sdk.AccAddress(pubkey.Address())
+	if !signer.Equals(owner) {
+		return nil, ErrManifestSignature
+	}
+
+	msg, err := r.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubkey.VerifySignature(msg, r.Signature) {
+		return nil, ErrManifestSignature
+	}
+
+	return signer, nil
 }
@@ -0,0 +1,73 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/provider/manifest"
+	"github.com/ovrclk/akash/testutil"
+)
+
+func newSignedRequest(t *testing.T, kr keyring.Keyring, uid string) *manifest.SubmitRequest {
+	info, _, err := kr.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	mreq := &manifest.SubmitRequest{
+		Deployment: testutil.DeploymentID(t),
+	}
+	mreq.Deployment.Owner = info.GetAddress().String()
+
+	require.NoError(t, mreq.Sign(kr, uid, 10))
+	return mreq
+}
+
+func TestSubmitRequestSignature(t *testing.T) {
+	kr := keyring.NewInMemory()
+
+	t.Run("signed by owner", func(t *testing.T) {
+		mreq := newSignedRequest(t, kr, "owner")
+
+		signer, err := mreq.VerifySignature()
+		require.NoError(t, err)
+		assert.Equal(t, mreq.Deployment.Owner, signer.String())
+		assert.Equal(t, int64(10), mreq.Height)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		mreq := &manifest.SubmitRequest{
+			Deployment: testutil.DeploymentID(t),
+		}
+
+		_, err := mreq.VerifySignature()
+		assert.Equal(t, manifest.ErrManifestSignature, err)
+	})
+
+	t.Run("signed by other account", func(t *testing.T) {
+		mreq := newSignedRequest(t, kr, "other")
+		mreq.Deployment.Owner = testutil.AccAddress(t).String()
+
+		_, err := mreq.VerifySignature()
+		assert.Equal(t, manifest.ErrManifestSignature, err)
+	})
+
+	t.Run("height changed", func(t *testing.T) {
+		mreq := newSignedRequest(t, kr, "height")
+		mreq.Height++
+
+		_, err := mreq.VerifySignature()
+		assert.Equal(t, manifest.ErrManifestSignature, err)
+	})
+
+	t.Run("manifest changed", func(t *testing.T) {
+		mreq := newSignedRequest(t, kr, "manifest")
+		mreq.Manifest = testutil.DefaultManifestGenerator.Manifest(t)
+
+		_, err := mreq.VerifySignature()
+		assert.Equal(t, manifest.ErrManifestSignature, err)
+	})
+}
@@ -50,6 +50,8 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cc
 	clusterConfig.InventoryResourcePollPeriod = cfg.InventoryResourcePollPeriod
 	clusterConfig.InventoryResourceDebugFrequency = cfg.InventoryResourceDebugFrequency
 	clusterConfig.InventoryExternalPortQuantity = cfg.ClusterExternalPortQuantity
+	clusterConfig.InventoryRequestPolicy = cfg.ClusterRequestPolicy
+	clusterConfig.InventoryNodeAttributes = cfg.ClusterNodeAttributes
 
 	cluster, err := cluster.NewService(ctx, session, bus, cclient, clusterConfig)
 	if err != nil {
@@ -66,7 +68,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cc
 		return nil, ErrClusterReadTimedout
 	}
 
-	bidengine, err := bidengine.NewService(ctx, session, cluster, bus, cfg.BPS)
+	bidengine, err := bidengine.NewService(ctx, session, cluster, bus, cfg.BidSettings)
 	if err != nil {
 		errmsg := "creating bidengine service"
 		session.Log().Error(errmsg, "err", err)
@@ -75,7 +77,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cc
 		return nil, errors.Wrap(err, errmsg)
 	}
 
-	manifest, err := manifest.NewService(ctx, session, bus)
+	manifest, err := manifest.NewService(ctx, session, bus, cfg.ManifestImages)
 	if err != nil {
 		session.Log().Error("creating manifest handler", "err", err)
 		cancel()
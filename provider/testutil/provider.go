@@ -18,7 +18,7 @@ import (
 /*
 TestSendManifest for integration testing
 this is similar to cli command exampled below
-akash provider send-manifest --owner <address> \
+akash provider send-manifest --owner <address> --from <key> \
 	--dseq 7 --gseq 1 --oseq 1 \
 	--provider <address> ./../_run/kube/deployment.yaml \
 	--home=/tmp/akash_integration_TestE2EApp_324892307/.akashctl --node=tcp://0.0.0.0:41863
@@ -26,6 +26,7 @@ akash provider send-manifest --owner <address> \
 func TestSendManifest(clientCtx client.Context, id mtypes.BidID, sdlPath string, extraArgs ...string) (sdktest.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--owner=%s", id.Owner),
+		fmt.Sprintf("--from=%s", id.Owner),
 		fmt.Sprintf("--dseq=%v", id.DSeq),
 		fmt.Sprintf("--gseq=%v", id.GSeq),
 		fmt.Sprintf("--oseq=%v", id.OSeq),
@@ -2,7 +2,9 @@ package sdl
 
 import (
 	"sort"
+	"strings"
 
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 
 	"github.com/ovrclk/akash/types"
@@ -10,25 +12,97 @@ import (
 
 type v2PlacementAttributes types.Attributes
 
+// v2PlacementSignedBy lists the auditors that must have signed the placement attributes
+type v2PlacementSignedBy struct {
+	AllOf []string `yaml:"allOf,omitempty"`
+	AnyOf []string `yaml:"anyOf,omitempty"`
+}
+
+// v2PlacementOperators maps operator names accepted in sdl to requirement operators
+var v2PlacementOperators = map[string]string{
+	"eq":     types.AttributeOperatorEqual,
+	"ne":     types.AttributeOperatorNotEqual,
+	"in":     types.AttributeOperatorIn,
+	"not-in": types.AttributeOperatorNotIn,
+	"gt":     types.AttributeOperatorGreater,
+	"gte":    types.AttributeOperatorGreaterOrEqual,
+	"lt":     types.AttributeOperatorLess,
+	"lte":    types.AttributeOperatorLessOrEqual,
+	"glob":   types.AttributeOperatorGlob,
+}
+
+// UnmarshalYAML parses placement attributes. Each attribute is either a plain value
+// required verbatim, or a map of operators to values, for example
+//
+//	attributes:
+//	  tier: gold
+//	  region:
+//	    in: [us-west, us-east]
+//	  cpu-generation:
+//	    gte: 3
 func (sdl *v2PlacementAttributes) UnmarshalYAML(node *yaml.Node) error {
 	var attr v2PlacementAttributes
 
-	var res map[string]string
+	var res map[string]yaml.Node
 
 	if err := node.Decode(&res); err != nil {
 		return err
 	}
 
 	for k, v := range res {
-		attr = append(attr, types.Attribute{
-			Key:   k,
-			Value: v,
-		})
+		if v.Kind == yaml.ScalarNode {
+			attr = append(attr, types.Attribute{
+				Key:   k,
+				Value: v.Value,
+			})
+			continue
+		}
+
+		var ops map[string]yaml.Node
+		if err := v.Decode(&ops); err != nil {
+			return errors.Wrapf(err, "placement attribute %q", k)
+		}
+
+		for name, operand := range ops {
+			op, valid := v2PlacementOperators[name]
+			if !valid {
+				return errors.Errorf("placement attribute %q: unknown operator %q", k, name)
+			}
+
+			var value string
+
+			switch operand.Kind {
+			case yaml.ScalarNode:
+				value = operand.Value
+			case yaml.SequenceNode:
+				if op != types.AttributeOperatorIn && op != types.AttributeOperatorNotIn {
+					return errors.Errorf("placement attribute %q: operator %q does not accept a list", k, name)
+				}
+
+				var values []string
+				if err := operand.Decode(&values); err != nil {
+					return errors.Wrapf(err, "placement attribute %q", k)
+				}
+
+				value = strings.Join(values, ",")
+			default:
+				return errors.Errorf("placement attribute %q: invalid value for operator %q", k, name)
+			}
+
+			attr = append(attr, types.Attribute{
+				Key:      k,
+				Value:    value,
+				Operator: op,
+			})
+		}
 	}
 
-	// keys are unique in attributes parsed from sdl so don't need to use sort.SliceStable
+	// key and operator pairs are unique in attributes parsed from sdl so don't need to use sort.SliceStable
 	sort.Slice(attr, func(i, j int) bool {
-		return attr[i].Key < attr[j].Key
+		if attr[i].Key != attr[j].Key {
+			return attr[i].Key < attr[j].Key
+		}
+		return attr[i].Operator < attr[j].Operator
 	})
 
 	*sdl = attr
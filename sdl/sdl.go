@@ -85,13 +85,12 @@ func Read(buf []byte) (SDL, error) {
 		return nil, err
 	}
 
-	vgroups := make([]dtypes.GroupSpec, 0, len(dgroups))
+	// resource limits are chain parameters; they are checked against the params
+	// in force when the deployment is submitted
 	for _, dgroup := range dgroups {
-		vgroups = append(vgroups, *dgroup)
-	}
-
-	if err := validation.ValidateDeploymentGroups(vgroups); err != nil {
-		return nil, err
+		if err := dgroup.ValidateBasic(); err != nil {
+			return nil, errors.Wrapf(err, "group validation error: %v", dgroup.Name)
+		}
 	}
 
 	m, err := obj.Manifest()
@@ -2,9 +2,12 @@ package sdl
 
 import (
 	"github.com/pkg/errors"
+	"math"
 	"sort"
+	"time"
 
 	"github.com/ovrclk/akash/manifest"
+	atypes "github.com/ovrclk/akash/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
@@ -34,11 +37,52 @@ type v2Dependency struct {
 
 type v2Service struct {
 	Image        string
-	Command      []string       `yaml:",omitempty"`
-	Args         []string       `yaml:",omitempty"`
-	Env          []string       `yaml:",omitempty"`
-	Expose       []v2Expose     `yaml:",omitempty"`
-	Dependencies []v2Dependency `yaml:",omitempty"`
+	Command      []string         `yaml:",omitempty"`
+	Args         []string         `yaml:",omitempty"`
+	Env          []string         `yaml:",omitempty"`
+	Expose       []v2Expose       `yaml:",omitempty"`
+	Dependencies []v2Dependency   `yaml:",omitempty"`
+	Params       *v2ServiceParams `yaml:",omitempty"`
+	Health       *v2ServiceHealth `yaml:",omitempty"`
+}
+
+type v2ServiceStorageParams struct {
+	Mount    string `yaml:"mount"`
+	ReadOnly bool   `yaml:"readOnly,omitempty"`
+}
+
+// storage volume name -> mount
+type v2ServiceParams struct {
+	Storage map[string]v2ServiceStorageParams `yaml:"storage,omitempty"`
+}
+
+type v2ServiceHealth struct {
+	Liveness  *v2HealthCheck `yaml:"liveness,omitempty"`
+	Readiness *v2HealthCheck `yaml:"readiness,omitempty"`
+}
+
+type v2HealthCheck struct {
+	HTTP             *v2HTTPHealthCheck `yaml:"http,omitempty"`
+	TCP              *v2TCPHealthCheck  `yaml:"tcp,omitempty"`
+	Exec             *v2ExecHealthCheck `yaml:"exec,omitempty"`
+	InitialDelay     time.Duration      `yaml:"initialDelay,omitempty"`
+	Interval         time.Duration      `yaml:"interval,omitempty"`
+	Timeout          time.Duration      `yaml:"timeout,omitempty"`
+	SuccessThreshold uint32             `yaml:"successThreshold,omitempty"`
+	FailureThreshold uint32             `yaml:"failureThreshold,omitempty"`
+}
+
+type v2HTTPHealthCheck struct {
+	Path string `yaml:"path"`
+	Port uint16 `yaml:"port"`
+}
+
+type v2TCPHealthCheck struct {
+	Port uint16 `yaml:"port"`
+}
+
+type v2ExecHealthCheck struct {
+	Command []string `yaml:"command"`
 }
 
 type v2ServiceDeployment struct {
@@ -59,7 +103,9 @@ type v2ProfileCompute struct {
 
 type v2ProfilePlacement struct {
 	Attributes v2PlacementAttributes `yaml:"attributes"`
+	SignedBy   v2PlacementSignedBy   `yaml:"signedBy,omitempty"`
 	Pricing    v2PlacementPricing    `yaml:"pricing"`
+	AutoMatch  bool                  `yaml:"automatch,omitempty"`
 }
 
 type v2profiles struct {
@@ -91,11 +137,26 @@ func (sdl *v2) DeploymentGroups() ([]*dtypes.GroupSpec, error) {
 				return nil, errors.Errorf("%v.%v: no pricing for profile %v", svcName, placementName, svcdepl.Profile)
 			}
 
+			svc, ok := sdl.Services[svcName]
+			if !ok {
+				return nil, errors.Errorf("%v.%v: no service profile named %v", svcName, placementName, svcName)
+			}
+
+			exposes, err := v2ServiceExposes(svc)
+			if err != nil {
+				return nil, err
+			}
+
 			group := groups[placementName]
 
 			if group == nil {
 				group = &dtypes.GroupSpec{
-					Name: placementName,
+					Name:      placementName,
+					AutoMatch: infra.AutoMatch,
+					SignedBy: atypes.SignedBy{
+						AllOf: infra.SignedBy.AllOf,
+						AnyOf: infra.SignedBy.AnyOf,
+					},
 				}
 
 				for _, v := range infra.Attributes {
@@ -104,7 +165,10 @@ func (sdl *v2) DeploymentGroups() ([]*dtypes.GroupSpec, error) {
 
 				// keep ordering stable
 				sort.Slice(group.Requirements, func(i, j int) bool {
-					return group.Requirements[i].Key < group.Requirements[j].Key
+					if group.Requirements[i].Key != group.Requirements[j].Key {
+						return group.Requirements[i].Key < group.Requirements[j].Key
+					}
+					return group.Requirements[i].Operator < group.Requirements[j].Operator
 				})
 
 				groups[placementName] = group
@@ -115,11 +179,11 @@ func (sdl *v2) DeploymentGroups() ([]*dtypes.GroupSpec, error) {
 				Price:     price.Value,
 				Count:     svcdepl.Count,
 			}
+			resources.Resources.Endpoints = v2ExposeEndpoints(exposes)
 
+			// the order bid duration is left unset for the chain to apply the
+			// default of the deployment params
 			group.Resources = append(group.Resources, resources)
-
-			// TODO: Make a parameter to configure the duration of orders being bid on
-			group.OrderBidDuration = dtypes.DefaultOrderBiddingDuration
 		}
 	}
 
@@ -175,47 +239,18 @@ func (sdl *v2) Manifest() (manifest.Manifest, error) {
 				Count:     svcdepl.Count,
 			}
 
-			for _, expose := range svc.Expose {
-				for _, to := range expose.To {
-
-					proto, err := manifest.ParseServiceProtocol(expose.Proto)
-					if err != nil {
-						return manifest.Manifest{}, err
-					}
-
-					msvc.Expose = append(msvc.Expose, manifest.ServiceExpose{
-						Service:      to.Service,
-						Port:         expose.Port,
-						ExternalPort: expose.As,
-						Proto:        proto,
-						Global:       to.Global,
-						Hosts:        expose.Accept.Items,
-					})
-				}
+			exposes, err := v2ServiceExposes(svc)
+			if err != nil {
+				return manifest.Manifest{}, err
 			}
 
-			// stable ordering
-			sort.Slice(msvc.Expose, func(i, j int) bool {
-				a, b := msvc.Expose[i], msvc.Expose[j]
-
-				if a.Service != b.Service {
-					return a.Service < b.Service
-				}
+			msvc.Expose = exposes
+			msvc.Resources.Endpoints = v2ExposeEndpoints(exposes)
+			msvc.Params = v2ServiceManifestParams(svc.Params)
 
-				if a.Port != b.Port {
-					return a.Port < b.Port
-				}
-
-				if a.Proto != b.Proto {
-					return a.Proto < b.Proto
-				}
-
-				if a.Global != b.Global {
-					return a.Global
-				}
-
-				return false
-			})
+			if msvc.Health, err = v2ServiceManifestHealth(svc.Health); err != nil {
+				return nil, errors.Wrapf(err, "%v.health", svcName)
+			}
 
 			group.Services = append(group.Services, *msvc)
 
@@ -237,6 +272,159 @@ func (sdl *v2) Manifest() (manifest.Manifest, error) {
 	return result, nil
 }
 
+// v2ServiceManifestParams returns the params of a service with storage ordered by name
+func v2ServiceManifestParams(params *v2ServiceParams) *manifest.ServiceParams {
+	if params == nil || len(params.Storage) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(params.Storage))
+	for name := range params.Storage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := &manifest.ServiceParams{
+		Storage: make([]manifest.StorageParams, 0, len(names)),
+	}
+
+	for _, name := range names {
+		result.Storage = append(result.Storage, manifest.StorageParams{
+			Name:     name,
+			Mount:    params.Storage[name].Mount,
+			ReadOnly: params.Storage[name].ReadOnly,
+		})
+	}
+
+	return result
+}
+
+// v2ServiceManifestHealth returns the health checks of a service with durations in seconds
+func v2ServiceManifestHealth(health *v2ServiceHealth) (*manifest.ServiceHealth, error) {
+	if health == nil || (health.Liveness == nil && health.Readiness == nil) {
+		return nil, nil
+	}
+
+	liveness, err := v2ManifestHealthCheck(health.Liveness)
+	if err != nil {
+		return nil, errors.Wrap(err, "liveness")
+	}
+
+	readiness, err := v2ManifestHealthCheck(health.Readiness)
+	if err != nil {
+		return nil, errors.Wrap(err, "readiness")
+	}
+
+	return &manifest.ServiceHealth{
+		Liveness:  liveness,
+		Readiness: readiness,
+	}, nil
+}
+
+func v2ManifestHealthCheck(check *v2HealthCheck) (*manifest.HealthCheck, error) {
+	if check == nil {
+		return nil, nil
+	}
+
+	result := &manifest.HealthCheck{
+		SuccessThreshold: check.SuccessThreshold,
+		FailureThreshold: check.FailureThreshold,
+	}
+
+	var err error
+	if result.InitialDelay, err = v2DurationSeconds(check.InitialDelay); err != nil {
+		return nil, errors.Wrap(err, "initialDelay")
+	}
+	if result.Interval, err = v2DurationSeconds(check.Interval); err != nil {
+		return nil, errors.Wrap(err, "interval")
+	}
+	if result.Timeout, err = v2DurationSeconds(check.Timeout); err != nil {
+		return nil, errors.Wrap(err, "timeout")
+	}
+
+	if check.HTTP != nil {
+		result.HTTP = &manifest.HTTPHealthCheck{Path: check.HTTP.Path, Port: check.HTTP.Port}
+	}
+	if check.TCP != nil {
+		result.TCP = &manifest.TCPHealthCheck{Port: check.TCP.Port}
+	}
+	if check.Exec != nil {
+		result.Exec = &manifest.ExecHealthCheck{Command: check.Exec.Command}
+	}
+
+	return result, nil
+}
+
+// v2DurationSeconds returns the whole seconds of d
+func v2DurationSeconds(d time.Duration) (uint32, error) {
+	if d < 0 || d%time.Second != 0 || d/time.Second > math.MaxInt32 {
+		return 0, errors.Errorf("%v is not a whole number of seconds", d)
+	}
+	return uint32(d / time.Second), nil
+}
+
+// v2ServiceExposes returns the exposes of a service in stable order
+func v2ServiceExposes(svc v2Service) ([]manifest.ServiceExpose, error) {
+	var exposes []manifest.ServiceExpose
+
+	for _, expose := range svc.Expose {
+		for _, to := range expose.To {
+
+			proto, err := manifest.ParseServiceProtocol(expose.Proto)
+			if err != nil {
+				return nil, err
+			}
+
+			exposes = append(exposes, manifest.ServiceExpose{
+				Service:      to.Service,
+				Port:         expose.Port,
+				ExternalPort: expose.As,
+				Proto:        proto,
+				Global:       to.Global,
+				Hosts:        expose.Accept.Items,
+			})
+		}
+	}
+
+	// stable ordering
+	sort.Slice(exposes, func(i, j int) bool {
+		a, b := exposes[i], exposes[j]
+
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+
+		if a.Port != b.Port {
+			return a.Port < b.Port
+		}
+
+		if a.Proto != b.Proto {
+			return a.Proto < b.Proto
+		}
+
+		if a.Global != b.Global {
+			return a.Global
+		}
+
+		return false
+	})
+
+	return exposes, nil
+}
+
+// v2ExposeEndpoints returns the endpoints required from the provider by global exposes
+func v2ExposeEndpoints(exposes []manifest.ServiceExpose) []atypes.Endpoint {
+	var endpoints []atypes.Endpoint
+
+	for _, expose := range exposes {
+		if expose.Global {
+			endpoints = append(endpoints, expose.Endpoint())
+		}
+	}
+
+	return endpoints
+}
+
 // stable ordering
 func v2DeploymentSvcNames(m map[string]v2Deployment) []string {
 	names := make([]string, 0, len(m))
@@ -1,10 +1,13 @@
 package sdl
 
 import (
+	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"gopkg.in/yaml.v3"
 
 	"github.com/ovrclk/akash/manifest"
@@ -90,6 +93,10 @@ func Test_v1_Parse_simple(t *testing.T) {
 			Storage: &atypes.Storage{
 				Quantity: atypes.NewResourceValue(randStorage),
 			},
+			Endpoints: []atypes.Endpoint{
+				{Kind: atypes.EndpointSharedHTTP},
+				{Kind: atypes.EndpointRandomPort},
+			},
 		},
 	}, group.GetResources()[0])
 
@@ -114,6 +121,10 @@ func Test_v1_Parse_simple(t *testing.T) {
 					Storage: &atypes.Storage{
 						Quantity: atypes.NewResourceValue(1 * unit.Gi),
 					},
+					Endpoints: []atypes.Endpoint{
+						{Kind: atypes.EndpointSharedHTTP},
+						{Kind: atypes.EndpointRandomPort},
+					},
 				},
 				Count: 2,
 				Expose: []manifest.ServiceExpose{
@@ -137,3 +148,315 @@ func Test_v1_Parse_ProfileNameNotServiceName(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, mani.GetGroups(), 1)
 }
+
+func Test_v2_Parse_SignedBy(t *testing.T) {
+	allOf := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	anyOf := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	stream := fmt.Sprintf(`
+version: "2.0"
+services:
+  web:
+    image: nginx
+    expose:
+      - port: 80
+        to:
+          - global: true
+profiles:
+  compute:
+    web:
+      resources:
+        cpu:
+          units: 0.1
+        memory:
+          size: 16Mi
+        storage:
+          size: 128Mi
+  placement:
+    westcoast:
+      attributes:
+        region: us-west
+      signedBy:
+        allOf:
+          - %s
+        anyOf:
+          - %s
+      pricing:
+        web:
+          amount: 1
+          denom: uakt
+deployment:
+  web:
+    westcoast:
+      profile: web
+      count: 1
+`, allOf, anyOf)
+
+	sdl, err := Read([]byte(stream))
+	require.NoError(t, err)
+
+	groups, err := sdl.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	assert.Equal(t, atypes.SignedBy{
+		AllOf: []string{allOf},
+		AnyOf: []string{anyOf},
+	}, groups[0].SignedBy)
+}
+
+func Test_v2_Parse_PlacementOperators(t *testing.T) {
+	const stream = `
+version: "2.0"
+services:
+  web:
+    image: nginx
+    expose:
+      - port: 80
+        to:
+          - global: true
+profiles:
+  compute:
+    web:
+      resources:
+        cpu:
+          units: 0.1
+        memory:
+          size: 16Mi
+        storage:
+          size: 128Mi
+  placement:
+    anywhere:
+      attributes:
+        tier: gold
+        region:
+          in: [us-west, us-east]
+        cpu-generation:
+          gte: 3
+          lt: 6
+        zone:
+          glob: "us-*"
+        host:
+          ne: shared
+      pricing:
+        web:
+          amount: 1
+          denom: uakt
+deployment:
+  web:
+    anywhere:
+      profile: web
+      count: 1
+`
+
+	sdl, err := Read([]byte(stream))
+	require.NoError(t, err)
+
+	groups, err := sdl.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	assert.Equal(t, []atypes.Attribute{
+		{Key: "cpu-generation", Value: "3", Operator: atypes.AttributeOperatorGreaterOrEqual},
+		{Key: "cpu-generation", Value: "6", Operator: atypes.AttributeOperatorLess},
+		{Key: "host", Value: "shared", Operator: atypes.AttributeOperatorNotEqual},
+		{Key: "region", Value: "us-west,us-east", Operator: atypes.AttributeOperatorIn},
+		{Key: "tier", Value: "gold"},
+		{Key: "zone", Value: "us-*", Operator: atypes.AttributeOperatorGlob},
+	}, groups[0].Requirements)
+}
+
+func Test_v2_Parse_PlacementOperatorsInvalid(t *testing.T) {
+	const tmpl = `
+version: "2.0"
+services:
+  web:
+    image: nginx
+    expose:
+      - port: 80
+        to:
+          - global: true
+profiles:
+  compute:
+    web:
+      resources:
+        cpu:
+          units: 0.1
+        memory:
+          size: 16Mi
+        storage:
+          size: 128Mi
+  placement:
+    anywhere:
+      attributes:
+        %s
+      pricing:
+        web:
+          amount: 1
+          denom: uakt
+deployment:
+  web:
+    anywhere:
+      profile: web
+      count: 1
+`
+
+	for _, attr := range []string{
+		"region: {like: us-west}",
+		"region: {gte: [1, 2]}",
+		"cpu-generation: {gte: three}",
+		"zone: {glob: \"us-[\"}",
+	} {
+		_, err := Read([]byte(fmt.Sprintf(tmpl, attr)))
+		assert.Error(t, err, attr)
+	}
+}
+
+func Test_v2_Parse_PersistentStorage(t *testing.T) {
+	const tmpl = `
+version: "2.0"
+services:
+  db:
+    image: postgres
+    expose:
+      - port: 5432
+        to:
+          - global: true
+%s
+profiles:
+  compute:
+    db:
+      resources:
+        cpu:
+          units: 0.5
+        memory:
+          size: 512Mi
+        storage:
+          size: 10Gi
+          attributes:
+            persistent: true
+            class: default
+  placement:
+    anywhere:
+      pricing:
+        db:
+          amount: 1
+          denom: uakt
+deployment:
+  db:
+    anywhere:
+      profile: db
+      count: 1
+`
+
+	sdl, err := Read([]byte(fmt.Sprintf(tmpl, `
+    params:
+      storage:
+        data:
+          mount: /var/lib/postgresql/data
+`)))
+	require.NoError(t, err)
+
+	groups, err := sdl.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	storage := groups[0].Resources[0].Resources.Storage
+	assert.Equal(t, uint64(10*unit.Gi), storage.Quantity.Value())
+	assert.True(t, storage.IsPersistent())
+	assert.Equal(t, "default", storage.Class())
+
+	mani, err := sdl.Manifest()
+	require.NoError(t, err)
+
+	svc := mani.GetGroups()[0].Services[0]
+	assert.Equal(t, &manifest.StorageParams{Name: "data", Mount: "/var/lib/postgresql/data"}, svc.PersistentVolume())
+
+	// persistent storage must be mounted
+	_, err = Read([]byte(fmt.Sprintf(tmpl, "")))
+	require.Error(t, err)
+}
+
+func Test_v2_Parse_HealthChecks(t *testing.T) {
+	const tmpl = `
+version: "2.0"
+services:
+  web:
+    image: nginx
+    expose:
+      - port: 80
+        to:
+          - global: true
+    health:
+%s
+profiles:
+  compute:
+    web:
+      resources:
+        cpu:
+          units: 0.5
+        memory:
+          size: 512Mi
+        storage:
+          size: 1Gi
+  placement:
+    anywhere:
+      pricing:
+        web:
+          amount: 1
+          denom: uakt
+deployment:
+  web:
+    anywhere:
+      profile: web
+      count: 2
+`
+
+	sdl, err := Read([]byte(fmt.Sprintf(tmpl, `
+      liveness:
+        tcp:
+          port: 80
+        interval: 30s
+        failureThreshold: 5
+      readiness:
+        http:
+          path: /healthz
+          port: 80
+        initialDelay: 5s
+        timeout: 2s
+`)))
+	require.NoError(t, err)
+
+	mani, err := sdl.Manifest()
+	require.NoError(t, err)
+
+	svc := mani.GetGroups()[0].Services[0]
+	assert.Equal(t, &manifest.ServiceHealth{
+		Liveness: &manifest.HealthCheck{
+			TCP:              &manifest.TCPHealthCheck{Port: 80},
+			Interval:         30,
+			FailureThreshold: 5,
+		},
+		Readiness: &manifest.HealthCheck{
+			HTTP:         &manifest.HTTPHealthCheck{Path: "/healthz", Port: 80},
+			InitialDelay: 5,
+			Timeout:      2,
+		},
+	}, svc.Health)
+
+	// durations are whole seconds
+	_, err = Read([]byte(fmt.Sprintf(tmpl, `
+      readiness:
+        exec:
+          command: ["true"]
+        interval: 1500ms
+`)))
+	require.Error(t, err)
+
+	// checks have exactly one action
+	_, err = Read([]byte(fmt.Sprintf(tmpl, `
+      readiness:
+        interval: 10s
+`)))
+	require.Error(t, err)
+}
@@ -7,13 +7,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ovrclk/akash/testutil"
+	akeeper "github.com/ovrclk/akash/x/audit/keeper"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dkeeper "github.com/ovrclk/akash/x/deployment/keeper"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
+	emocks "github.com/ovrclk/akash/x/escrow/keeper/mocks"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
+	mhooks "github.com/ovrclk/akash/x/market/hooks"
 	"github.com/ovrclk/akash/x/market/keeper"
 	"github.com/ovrclk/akash/x/market/types"
 	pkeeper "github.com/ovrclk/akash/x/provider/keeper"
@@ -29,12 +38,14 @@ type TestSuite struct {
 	mkeeper keeper.Keeper
 	dkeeper dkeeper.Keeper
 	pkeeper pkeeper.Keeper
+	akeeper akeeper.Keeper
+	ekeeper ekeeper.Keeper
 	bkeeper bankkeeper.Keeper
 }
 
 // SetupTestSuite provides toolkit for accessing stores and keepers
 // for complex data interactions.
-func SetupTestSuite(t testing.TB, codec codec.Marshaler) *TestSuite {
+func SetupTestSuite(t testing.TB, cdc codec.Marshaler) *TestSuite {
 	suite := &TestSuite{
 		t: t,
 	}
@@ -42,20 +53,48 @@ func SetupTestSuite(t testing.TB, codec codec.Marshaler) *TestSuite {
 	mKey := sdk.NewKVStoreKey(types.StoreKey)
 	dKey := sdk.NewKVStoreKey(dtypes.StoreKey)
 	pKey := sdk.NewKVStoreKey(ptypes.StoreKey)
+	aKey := sdk.NewKVStoreKey(atypes.StoreKey)
+	eKey := sdk.NewKVStoreKey(etypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(aKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(eKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
 	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
 
-	suite.mkeeper = keeper.NewKeeper(codec, mKey)
-	suite.dkeeper = dkeeper.NewKeeper(codec, dKey)
-	suite.pkeeper = pkeeper.NewKeeper(codec, pKey)
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), paramsKey, paramsTKey)
+
+	suite.mkeeper = keeper.NewKeeper(cdc, mKey, paramsKeeper.Subspace(types.ModuleName))
+	suite.mkeeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.dkeeper = dkeeper.NewKeeper(cdc, dKey, paramsKeeper.Subspace(dtypes.ModuleName))
+	suite.dkeeper.SetParams(suite.ctx, dtypes.DefaultParams())
+	suite.pkeeper = pkeeper.NewKeeper(cdc, pKey)
+	suite.akeeper = akeeper.NewKeeper(cdc, aKey)
+
+	// escrow funds are moved through a mocked bank
+	bkeeper := &emocks.BankKeeper{}
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.ekeeper = ekeeper.NewKeeper(cdc, eKey, bkeeper)
+
+	hook := mhooks.New(suite.dkeeper, suite.mkeeper)
+	suite.ekeeper.AddOnPaymentClosedHook(hook.OnEscrowPaymentClosed)
+	suite.ekeeper.AddOnPaymentReopenedHook(hook.OnEscrowPaymentReopened)
 
 	return suite
 }
@@ -90,6 +129,16 @@ func (ts *TestSuite) ProviderKeeper() pkeeper.Keeper {
 	return ts.pkeeper
 }
 
+// AuditKeeper key store
+func (ts *TestSuite) AuditKeeper() akeeper.Keeper {
+	return ts.akeeper
+}
+
+// EscrowKeeper key store
+func (ts *TestSuite) EscrowKeeper() ekeeper.Keeper {
+	return ts.ekeeper
+}
+
 // BankKeeper key store
 func (ts *TestSuite) BankKeeper() bankkeeper.Keeper {
 	return ts.bkeeper
@@ -1,11 +1,35 @@
 package types
 
 import (
+	"path"
 	"reflect"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 )
 
+// Operators supported by placement requirements. Values of the set operators
+// are comma separated lists, numeric operators compare values as decimals and
+// glob matches values against a shell pattern.
+const (
+	AttributeOperatorEqual          = ""
+	AttributeOperatorNotEqual       = "ne"
+	AttributeOperatorIn             = "in"
+	AttributeOperatorNotIn          = "not-in"
+	AttributeOperatorGreater        = "gt"
+	AttributeOperatorGreaterOrEqual = "gte"
+	AttributeOperatorLess           = "lt"
+	AttributeOperatorLessOrEqual    = "lte"
+	AttributeOperatorGlob           = "glob"
+)
+
+var (
+	errAttributeOperator = errors.New("unknown attribute operator")
+	errAttributeOperand  = errors.New("invalid attribute operand")
+)
+
 /*
 Attributes purpose of using this type in favor of Cosmos's sdk.Attribute is
 ability to later extend it with operators to support querying on things like
@@ -33,13 +57,115 @@ func (m *Attribute) Equal(rhs *Attribute) bool {
 }
 
 func (m Attribute) SubsetOf(rhs Attribute) bool {
-	if m.Key == rhs.Key && m.Value == rhs.Value {
+	if m.Key == rhs.Key && m.Value == rhs.Value && m.Operator == rhs.Operator {
 		return true
 	}
 
 	return false
 }
 
+// Validate checks that the attribute operator is supported and that the value
+// is a valid operand for it
+func (m Attribute) Validate() error {
+	switch m.Operator {
+	case AttributeOperatorEqual, AttributeOperatorNotEqual:
+	case AttributeOperatorIn, AttributeOperatorNotIn:
+		for _, val := range m.values() {
+			if val == "" {
+				return errors.Wrapf(errAttributeOperand, "%s: empty value in %q", m.Key, m.Value)
+			}
+		}
+	case AttributeOperatorGreater, AttributeOperatorGreaterOrEqual, AttributeOperatorLess, AttributeOperatorLessOrEqual:
+		if _, err := sdk.NewDecFromStr(m.Value); err != nil {
+			return errors.Wrapf(errAttributeOperand, "%s: %q is not a number", m.Key, m.Value)
+		}
+	case AttributeOperatorGlob:
+		if _, err := path.Match(m.Value, ""); err != nil {
+			return errors.Wrapf(errAttributeOperand, "%s: %q is not a valid pattern", m.Key, m.Value)
+		}
+	default:
+		return errors.Wrapf(errAttributeOperator, "%s: %q", m.Key, m.Operator)
+	}
+
+	return nil
+}
+
+// Negated returns true when the operator excludes values rather than selects them
+func (m Attribute) Negated() bool {
+	return m.Operator == AttributeOperatorNotEqual || m.Operator == AttributeOperatorNotIn
+}
+
+// MatchedBy checks if attributes satisfy the requirement m. Negated requirements
+// are satisfied when none of the attributes with the same key has an excluded value,
+// all other requirements need at least one attribute with the same key to match.
+func (m Attribute) MatchedBy(attrs Attributes) bool {
+	negated := m.Negated()
+
+	for _, attr := range attrs {
+		if attr.Key != m.Key {
+			continue
+		}
+
+		if matched := m.matchValue(attr.Value); matched != negated {
+			return !negated
+		}
+	}
+
+	return negated
+}
+
+// matchValue reports whether a single attribute value satisfies the requirement
+func (m Attribute) matchValue(val string) bool {
+	switch m.Operator {
+	case AttributeOperatorEqual:
+		return val == m.Value
+	case AttributeOperatorNotEqual:
+		return val != m.Value
+	case AttributeOperatorIn, AttributeOperatorNotIn:
+		found := false
+		for _, allowed := range m.values() {
+			if val == allowed {
+				found = true
+				break
+			}
+		}
+		return found == (m.Operator == AttributeOperatorIn)
+	case AttributeOperatorGreater, AttributeOperatorGreaterOrEqual, AttributeOperatorLess, AttributeOperatorLessOrEqual:
+		lhs, err := sdk.NewDecFromStr(val)
+		if err != nil {
+			return false
+		}
+		rhs, err := sdk.NewDecFromStr(m.Value)
+		if err != nil {
+			return false
+		}
+
+		switch m.Operator {
+		case AttributeOperatorGreater:
+			return lhs.GT(rhs)
+		case AttributeOperatorGreaterOrEqual:
+			return lhs.GTE(rhs)
+		case AttributeOperatorLess:
+			return lhs.LT(rhs)
+		default:
+			return lhs.LTE(rhs)
+		}
+	case AttributeOperatorGlob:
+		matched, err := path.Match(m.Value, val)
+		return err == nil && matched
+	default:
+		return false
+	}
+}
+
+func (m Attribute) values() []string {
+	vals := strings.Split(m.Value, ",")
+	for i := range vals {
+		vals[i] = strings.TrimSpace(vals[i])
+	}
+	return vals
+}
+
 /*
 AttributesSubsetOf check if a is subset of that
 For example there are two yaml files being converted into these attributes
@@ -96,68 +222,34 @@ func (a Attributes) SubsetOf(that Attributes) bool {
 	return AttributesSubsetOf(a, that)
 }
 
-// type AttributeValue struct {
-// 	Val interface{} `json:"value" yaml:"value"`
-// }
-//
-// func NewAttributeValue(val interface{}) AttributeValue {
-// 	return AttributeValue{Val: val}
-// }
-//
-// func (a *AttributeValue) Reset() {
-// 	a.Val = nil
-// }
-//
-// func (a *AttributeValue) String() string {
-// 	res, _ := yaml.Marshal(&a.Val)
-// 	return string(res)
-// }
-//
-// func (a *AttributeValue) ProtoMessage() {}
-//
-// // Marshal implements the gogo proto custom type interface.
-// func (a AttributeValue) Marshal() ([]byte, error) {
-// 	return nil, nil
-// }
-//
-// // MarshalTo implements the gogo proto custom type interface.
-// func (a *AttributeValue) MarshalTo(data []byte) (n int, err error) {
-// 	return
-// }
-//
-// // Unmarshal implements the gogo proto custom type interface.
-// func (a *AttributeValue) Unmarshal(data []byte) error {
-// 	return nil
-// }
-//
-// // Size implements the gogo proto custom type interface.
-// func (a *AttributeValue) Size() int {
-// 	bz, _ := a.Marshal()
-// 	return len(bz)
-// }
-
-// func (m Attribute) SubsetOf(rhs Attribute) bool {
-// 	if m.Key == rhs.Key {
-// 		switch v := m.Value.Val.(type) {
-// 		case string:
-// 			v2 := rhs.Value.Val.(string)
-// 			if v == v2 {
-// 				return true
-// 			}
-// 		case []string:
-// 			v2 := rhs.Value.Val.([]string)
-// 			// fixme: turn into a func?
-// 			for _, mVal := range v {
-// 				for _, rhsVal := range v2 {
-// 					if mVal == rhsVal {
-// 						return true
-// 					}
-// 				}
-// 			}
-// 		default:
-// 			return false
-// 		}
-// 	}
-//
-// 	return false
-// }
+// AttributesMatch checks if attrs satisfy every requirement in requirements
+func AttributesMatch(requirements, attrs Attributes) bool {
+	for _, req := range requirements {
+		if !req.MatchedBy(attrs) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate checks every attribute in the list
+func (a Attributes) Validate() error {
+	for _, attr := range a {
+		if err := attr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *SignedBy) String() string {
+	res, _ := yaml.Marshal(m)
+	return string(res)
+}
+
+// Empty returns true when no auditor signatures are required
+func (m SignedBy) Empty() bool {
+	return len(m.AllOf) == 0 && len(m.AnyOf) == 0
+}
@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Attribute struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" yaml:"key"`
 	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	// Operator used when the attribute is a placement requirement. Empty means equality
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator,omitempty"`
 }
 
 func (m *Attribute) Reset()      { *m = Attribute{} }
@@ -61,8 +63,49 @@ func (m *Attribute) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Attribute proto.InternalMessageInfo
 
+// SignedBy lists the auditors that must have signed provider attributes
+type SignedBy struct {
+	// AllOf requires signatures from every auditor in the list
+	AllOf []string `protobuf:"bytes,1,rep,name=all_of,json=allOf,proto3" json:"all-of" yaml:"all-of"`
+	// AnyOf requires a signature from at least one auditor in the list
+	AnyOf []string `protobuf:"bytes,2,rep,name=any_of,json=anyOf,proto3" json:"any-of" yaml:"any-of"`
+}
+
+func (m *SignedBy) Reset()      { *m = SignedBy{} }
+func (*SignedBy) ProtoMessage() {}
+func (*SignedBy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b8f964cf66c51d, []int{1}
+}
+func (m *SignedBy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedBy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedBy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedBy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedBy.Merge(m, src)
+}
+func (m *SignedBy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedBy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedBy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedBy proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Attribute)(nil), "akash.base.v1beta1.Attribute")
+	proto.RegisterType((*SignedBy)(nil), "akash.base.v1beta1.SignedBy")
 }
 
 func init() {
@@ -70,21 +113,28 @@ func init() {
 }
 
 var fileDescriptor_90b8f964cf66c51d = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xcc, 0x4e, 0x2c,
-	0xce, 0xd0, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x2c, 0x29, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x02, 0xab, 0xd1, 0x03, 0xa9, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b,
-	0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a, 0xd1, 0x5c, 0x9c, 0x8e, 0x30, 0xcd, 0x42, 0x0a, 0x5c, 0xcc,
-	0xd9, 0xa9, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x7c, 0x9f, 0xee, 0xc9, 0x73, 0x55,
-	0x26, 0xe6, 0xe6, 0x58, 0x29, 0x65, 0xa7, 0x56, 0x2a, 0x05, 0x81, 0xa4, 0x84, 0xd4, 0xb8, 0x58,
-	0xcb, 0x12, 0x73, 0x4a, 0x53, 0x25, 0x98, 0xc0, 0x6a, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0x81, 0xa8,
-	0x01, 0x0b, 0x2b, 0x05, 0x41, 0xa4, 0xad, 0x58, 0x3a, 0x16, 0xc8, 0x33, 0x38, 0xd9, 0xdf, 0x78,
-	0x28, 0xc7, 0xd0, 0xf0, 0x48, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x64, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xf3, 0xcb, 0x8a,
-	0x92, 0x73, 0xb2, 0xf5, 0x21, 0xde, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xd2,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x74, 0xfb, 0xcf, 0xf4, 0x00, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xe3, 0x30,
+	0x18, 0x80, 0xe3, 0xf6, 0x5a, 0xb5, 0xd6, 0xdd, 0xe9, 0x14, 0xdd, 0x10, 0x40, 0x75, 0x2a, 0x0f,
+	0xa8, 0x03, 0x24, 0x2a, 0x4c, 0x74, 0x41, 0xe4, 0x05, 0x2a, 0x85, 0x8d, 0x05, 0x39, 0xc5, 0x49,
+	0xa3, 0x38, 0x71, 0x94, 0xba, 0x15, 0xde, 0x18, 0x19, 0x99, 0x98, 0x79, 0x1c, 0xc6, 0x8e, 0x9d,
+	0x22, 0x48, 0x37, 0xc6, 0x3c, 0x01, 0x8a, 0xdd, 0x22, 0xd8, 0xec, 0xff, 0xfb, 0x3e, 0x5b, 0xb2,
+	0x21, 0x26, 0x09, 0x59, 0xcc, 0xdd, 0x80, 0x2c, 0xa8, 0xbb, 0x1a, 0x07, 0x54, 0x90, 0xb1, 0x4b,
+	0x84, 0x28, 0xe2, 0x60, 0x29, 0xa8, 0x93, 0x17, 0x5c, 0x70, 0xd3, 0x54, 0x8e, 0xd3, 0x38, 0xce,
+	0xce, 0x39, 0xfc, 0x1f, 0xf1, 0x88, 0x2b, 0xec, 0x36, 0x2b, 0x6d, 0xe2, 0x67, 0x00, 0xfb, 0x57,
+	0xfb, 0xda, 0x1c, 0xc2, 0x76, 0x42, 0xa5, 0x05, 0x86, 0x60, 0xd4, 0xf7, 0xfe, 0xd6, 0xa5, 0x0d,
+	0x25, 0x49, 0xd9, 0x04, 0x27, 0x54, 0x62, 0xbf, 0x41, 0xe6, 0x31, 0xec, 0xac, 0x08, 0x5b, 0x52,
+	0xab, 0xa5, 0x9c, 0x7f, 0x75, 0x69, 0xff, 0xd6, 0x8e, 0x1a, 0x63, 0x5f, 0x63, 0xf3, 0x02, 0xf6,
+	0x78, 0x4e, 0x0b, 0x22, 0x78, 0x61, 0xb5, 0x95, 0x3a, 0xa8, 0x4b, 0xfb, 0x40, 0xab, 0x7b, 0x72,
+	0xc2, 0xd3, 0x58, 0xd0, 0x34, 0x17, 0x12, 0xfb, 0x5f, 0xfa, 0xe4, 0xd7, 0xe3, 0x8b, 0x6d, 0xe0,
+	0x7b, 0xd8, 0xbb, 0x8e, 0xa3, 0x8c, 0xde, 0x79, 0xd2, 0x3c, 0x83, 0x5d, 0xc2, 0xd8, 0x2d, 0x0f,
+	0x2d, 0x30, 0x6c, 0x8f, 0xfa, 0xde, 0xd1, 0x47, 0x69, 0x37, 0x93, 0x53, 0x1e, 0xd6, 0xa5, 0xfd,
+	0x47, 0x1f, 0xaa, 0xf7, 0xd8, 0xef, 0x10, 0xc6, 0xa6, 0xa1, 0x6a, 0x32, 0xd9, 0x34, 0xad, 0x6f,
+	0x4d, 0x26, 0x7f, 0x36, 0x99, 0xdc, 0x35, 0x99, 0x9c, 0x86, 0xfa, 0x66, 0xef, 0x72, 0xf3, 0x8e,
+	0x8c, 0x87, 0x0a, 0x19, 0xaf, 0x15, 0x02, 0xeb, 0x0a, 0x81, 0xb7, 0x0a, 0x81, 0xa7, 0x2d, 0x32,
+	0xd6, 0x5b, 0x64, 0x6c, 0xb6, 0xc8, 0xb8, 0x19, 0x44, 0xb1, 0x98, 0x2f, 0x03, 0x67, 0xc6, 0x53,
+	0x97, 0xaf, 0x8a, 0x19, 0x4b, 0x5c, 0xfd, 0x29, 0x42, 0xe6, 0x74, 0x11, 0x74, 0xd5, 0xd3, 0x9e,
+	0x7f, 0x0e, 0x00, 0xa2, 0x8a, 0x8e, 0x53, 0xaa, 0x01, 0x00, 0x00,
 }
 
 func (m *Attribute) Marshal() (dAtA []byte, err error) {
@@ -107,6 +157,13 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -124,6 +181,47 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignedBy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedBy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnyOf) > 0 {
+		for iNdEx := len(m.AnyOf) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnyOf[iNdEx])
+			copy(dAtA[i:], m.AnyOf[iNdEx])
+			i = encodeVarintAttribute(dAtA, i, uint64(len(m.AnyOf[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllOf) > 0 {
+		for iNdEx := len(m.AllOf) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllOf[iNdEx])
+			copy(dAtA[i:], m.AllOf[iNdEx])
+			i = encodeVarintAttribute(dAtA, i, uint64(len(m.AllOf[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAttribute(v)
 	base := offset
@@ -149,6 +247,31 @@ func (m *Attribute) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedBy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllOf) > 0 {
+		for _, s := range m.AllOf {
+			l = len(s)
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	if len(m.AnyOf) > 0 {
+		for _, s := range m.AnyOf {
+			l = len(s)
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -251,6 +374,155 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedBy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedBy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedBy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllOf = append(m.AllOf, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnyOf = append(m.AnyOf, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeMatchedBy(t *testing.T) {
+	attrs := Attributes{
+		NewStringAttribute("region", "us-west"),
+		NewStringAttribute("cpu-generation", "4"),
+		NewStringAttribute("zone", "us-west-2a"),
+	}
+
+	tests := []struct {
+		req     Attribute
+		matched bool
+	}{
+		{Attribute{Key: "region", Value: "us-west"}, true},
+		{Attribute{Key: "region", Value: "us-east"}, false},
+		{Attribute{Key: "tier", Value: "gold"}, false},
+		{Attribute{Key: "region", Value: "us-east", Operator: AttributeOperatorNotEqual}, true},
+		{Attribute{Key: "region", Value: "us-west", Operator: AttributeOperatorNotEqual}, false},
+		{Attribute{Key: "tier", Value: "bronze", Operator: AttributeOperatorNotEqual}, true},
+		{Attribute{Key: "region", Value: "us-east, us-west", Operator: AttributeOperatorIn}, true},
+		{Attribute{Key: "region", Value: "us-east,eu-west", Operator: AttributeOperatorIn}, false},
+		{Attribute{Key: "region", Value: "us-east,eu-west", Operator: AttributeOperatorNotIn}, true},
+		{Attribute{Key: "region", Value: "us-east,us-west", Operator: AttributeOperatorNotIn}, false},
+		{Attribute{Key: "cpu-generation", Value: "3", Operator: AttributeOperatorGreater}, true},
+		{Attribute{Key: "cpu-generation", Value: "4", Operator: AttributeOperatorGreater}, false},
+		{Attribute{Key: "cpu-generation", Value: "4", Operator: AttributeOperatorGreaterOrEqual}, true},
+		{Attribute{Key: "cpu-generation", Value: "4.5", Operator: AttributeOperatorLess}, true},
+		{Attribute{Key: "cpu-generation", Value: "3", Operator: AttributeOperatorLessOrEqual}, false},
+		{Attribute{Key: "region", Value: "3", Operator: AttributeOperatorGreater}, false},
+		{Attribute{Key: "zone", Value: "us-west-*", Operator: AttributeOperatorGlob}, true},
+		{Attribute{Key: "zone", Value: "eu-*", Operator: AttributeOperatorGlob}, false},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.matched, test.req.MatchedBy(attrs), "%s %s %s", test.req.Key, test.req.Operator, test.req.Value)
+	}
+}
+
+func TestAttributesMatch(t *testing.T) {
+	attrs := Attributes{
+		NewStringAttribute("region", "us-west"),
+		NewStringAttribute("cpu-generation", "4"),
+	}
+
+	require.True(t, AttributesMatch(nil, attrs))
+	require.True(t, AttributesMatch(Attributes{
+		{Key: "region", Value: "us-west,us-east", Operator: AttributeOperatorIn},
+		{Key: "cpu-generation", Value: "3", Operator: AttributeOperatorGreaterOrEqual},
+	}, attrs))
+	require.False(t, AttributesMatch(Attributes{
+		{Key: "region", Value: "us-west,us-east", Operator: AttributeOperatorIn},
+		{Key: "cpu-generation", Value: "5", Operator: AttributeOperatorGreaterOrEqual},
+	}, attrs))
+}
+
+func TestAttributeValidate(t *testing.T) {
+	require.NoError(t, Attribute{Key: "region", Value: "us-west"}.Validate())
+	require.NoError(t, Attribute{Key: "region", Value: "a,b", Operator: AttributeOperatorIn}.Validate())
+	require.NoError(t, Attribute{Key: "cpu", Value: "2.5", Operator: AttributeOperatorLess}.Validate())
+	require.NoError(t, Attribute{Key: "zone", Value: "us-*", Operator: AttributeOperatorGlob}.Validate())
+
+	require.Error(t, Attribute{Key: "region", Value: "us-west", Operator: "like"}.Validate())
+	require.Error(t, Attribute{Key: "region", Value: "a,,b", Operator: AttributeOperatorIn}.Validate())
+	require.Error(t, Attribute{Key: "cpu", Value: "fast", Operator: AttributeOperatorGreater}.Validate())
+	require.Error(t, Attribute{Key: "zone", Value: "us-[", Operator: AttributeOperatorGlob}.Validate())
+}
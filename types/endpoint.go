@@ -0,0 +1,12 @@
+package types
+
+// CountRandomPortEndpoints returns the number of endpoints that need a dedicated node port
+func CountRandomPortEndpoints(endpoints []Endpoint) uint {
+	var count uint
+	for _, endpoint := range endpoints {
+		if endpoint.Kind == EndpointRandomPort {
+			count++
+		}
+	}
+	return count
+}
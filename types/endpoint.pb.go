@@ -23,7 +23,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Kind describes how the endpoint is exposed by the provider
+type Endpoint_Kind int32
+
+const (
+	// EndpointSharedHTTP is an HTTP service routed through the provider's shared ingress
+	EndpointSharedHTTP Endpoint_Kind = 0
+	// EndpointRandomPort is a service exposed on a dedicated node port chosen by the provider
+	EndpointRandomPort Endpoint_Kind = 1
+)
+
+var Endpoint_Kind_name = map[int32]string{
+	0: "shared_http",
+	1: "random_port",
+}
+
+var Endpoint_Kind_value = map[string]int32{
+	"shared_http": 0,
+	"random_port": 1,
+}
+
+func (x Endpoint_Kind) String() string {
+	return proto.EnumName(Endpoint_Kind_name, int32(x))
+}
+
+func (Endpoint_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07fb133899333c18, []int{0, 0}
+}
+
+// Endpoint describes a publicly accessible IP service
 type Endpoint struct {
+	Kind Endpoint_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=akash.base.v1beta1.Endpoint_Kind" json:"kind" yaml:"kind"`
 }
 
 func (m *Endpoint) Reset()         { *m = Endpoint{} }
@@ -59,24 +89,39 @@ func (m *Endpoint) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Endpoint proto.InternalMessageInfo
 
+func (m *Endpoint) GetKind() Endpoint_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return EndpointSharedHTTP
+}
+
 func init() {
+	proto.RegisterEnum("akash.base.v1beta1.Endpoint_Kind", Endpoint_Kind_name, Endpoint_Kind_value)
 	proto.RegisterType((*Endpoint)(nil), "akash.base.v1beta1.Endpoint")
 }
 
 func init() { proto.RegisterFile("akash/base/v1beta1/endpoint.proto", fileDescriptor_07fb133899333c18) }
 
 var fileDescriptor_07fb133899333c18 = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
+	// 272 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xcc, 0x4e, 0x2c,
 	0xce, 0xd0, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
 	0xcd, 0x4b, 0x29, 0xc8, 0xcf, 0xcc, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02,
 	0x2b, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb,
-	0x83, 0x58, 0x10, 0x95, 0x4a, 0x02, 0x5c, 0x1c, 0xae, 0x50, 0xbd, 0x56, 0x2c, 0x2f, 0x16, 0xc8,
-	0x33, 0x3a, 0x99, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6c, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7e, 0x59, 0x51, 0x72, 0x4e, 0xb6,
-	0x3e, 0xc4, 0x29, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x13, 0x8d, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x90, 0x43, 0x6e, 0x98, 0xa0, 0x00, 0x00, 0x00,
+	0x83, 0x58, 0x10, 0x95, 0x4a, 0x07, 0x18, 0xb9, 0x38, 0x5c, 0xa1, 0x9a, 0x85, 0xfc, 0xb8, 0x58,
+	0xb2, 0x33, 0xf3, 0x52, 0x24, 0x18, 0x15, 0x18, 0x35, 0xf8, 0x8c, 0x14, 0xf5, 0x30, 0x4d, 0xd1,
+	0x83, 0xa9, 0xd5, 0xf3, 0xce, 0xcc, 0x4b, 0x71, 0x12, 0x7f, 0x75, 0x4f, 0x1e, 0xac, 0xe5, 0xd3,
+	0x3d, 0x79, 0xee, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x10, 0x4f, 0x29, 0x08, 0x2c, 0xa8, 0x14,
+	0xc7, 0xc5, 0x02, 0x52, 0x26, 0xa4, 0xce, 0xc5, 0x5d, 0x9c, 0x91, 0x58, 0x94, 0x9a, 0x12, 0x9f,
+	0x51, 0x52, 0x52, 0x20, 0xc0, 0x20, 0x25, 0xd6, 0x35, 0x57, 0x41, 0x08, 0x66, 0x54, 0x30, 0x58,
+	0xca, 0x23, 0x24, 0x24, 0x00, 0xa4, 0xb0, 0x28, 0x31, 0x2f, 0x25, 0x3f, 0x37, 0xbe, 0x20, 0xbf,
+	0xa8, 0x44, 0x80, 0x11, 0x55, 0x61, 0x10, 0x58, 0x2a, 0x20, 0xbf, 0xa8, 0x44, 0x8a, 0xa5, 0x63,
+	0xb1, 0x1c, 0x83, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0xe6, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
+	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
+	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
+	0xab, 0x9f, 0x5f, 0x56, 0x94, 0x9c, 0x93, 0xad, 0x0f, 0x09, 0xbb, 0x92, 0xca, 0x82, 0xd4, 0xe2,
+	0x24, 0x36, 0x70, 0x10, 0x18, 0x03, 0x06, 0x00, 0xe4, 0x07, 0x72, 0xac, 0x51, 0x01, 0x00, 0x00,
 }
 
 func (this *Endpoint) Equal(that interface{}) bool {
@@ -98,6 +143,9 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Kind != that1.Kind {
+		return false
+	}
 	return true
 }
 func (m *Endpoint) Marshal() (dAtA []byte, err error) {
@@ -120,6 +168,11 @@ func (m *Endpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -140,6 +193,9 @@ func (m *Endpoint) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovEndpoint(uint64(m.Kind))
+	}
 	return n
 }
 
@@ -178,6 +234,25 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Endpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= Endpoint_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndpoint(dAtA[iNdEx:])
@@ -4,6 +4,13 @@ import (
 	"reflect"
 )
 
+// Storage attributes which request a persistent volume instead of ephemeral storage.
+// A persistent volume must name the storage class of the provider it is created in.
+const (
+	StorageAttributePersistent = "persistent"
+	StorageAttributeClass      = "class"
+)
+
 type UnitType int
 
 type Unit interface {
@@ -262,3 +269,33 @@ func (m *Storage) sub(other Unit) error {
 
 	return nil
 }
+
+// IsPersistent returns true when the storage is a persistent volume
+func (m *Storage) IsPersistent() bool {
+	if m == nil {
+		return false
+	}
+
+	for _, attr := range m.Attributes {
+		if attr.Key == StorageAttributePersistent {
+			return attr.Value == "true"
+		}
+	}
+
+	return false
+}
+
+// Class returns the storage class of a persistent volume, or an empty string if it has none
+func (m *Storage) Class() string {
+	if m == nil {
+		return ""
+	}
+
+	for _, attr := range m.Attributes {
+		if attr.Key == StorageAttributeClass {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
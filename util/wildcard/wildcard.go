@@ -0,0 +1,138 @@
+// Package wildcard matches strings against the patterns used in the provider's policies.
+//
+// A pattern matches the whole value. Unlike path.Match, '*' is not limited to a single
+// path segment:
+//
+//	'*'         matches any sequence of characters, including '/'
+//	'?'         matches any single character, including '/'
+//	'[' [ '!' ] { range } ']'
+//	            matches a single character of the class, or not in it when negated
+//	'\\' c      matches character c
+//
+// A range is either a single character c, possibly escaped, or lo '-' hi.
+package wildcard
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrBadPattern indicates a pattern was malformed
+var ErrBadPattern = errors.New("syntax error in pattern")
+
+// Match returns true when value matches pattern in whole. Malformed patterns never match.
+func Match(pattern, value string) bool {
+	re, err := compile(pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(value)
+}
+
+// Validate returns ErrBadPattern when pattern is malformed
+func Validate(pattern string) error {
+	_, err := compile(pattern)
+	return err
+}
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString(`^(?s:`)
+
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			expr.WriteString(`.*`)
+			pattern = pattern[1:]
+		case '?':
+			expr.WriteString(`.`)
+			pattern = pattern[1:]
+		case '[':
+			class, rest, err := compileClass(pattern[1:])
+			if err != nil {
+				return nil, err
+			}
+			expr.WriteString(class)
+			pattern = rest
+		case '\\':
+			if len(pattern) == 1 {
+				return nil, ErrBadPattern
+			}
+			_, n := utf8.DecodeRuneInString(pattern[1:])
+			expr.WriteString(regexp.QuoteMeta(pattern[1 : 1+n]))
+			pattern = pattern[1+n:]
+		default:
+			_, n := utf8.DecodeRuneInString(pattern)
+			expr.WriteString(regexp.QuoteMeta(pattern[:n]))
+			pattern = pattern[n:]
+		}
+	}
+
+	expr.WriteString(`)$`)
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadPattern, err)
+	}
+	return re, nil
+}
+
+// compileClass converts the character class at the start of pattern, after its opening
+// bracket, and returns the rest of pattern
+func compileClass(pattern string) (string, string, error) {
+	var class strings.Builder
+	class.WriteString(`[`)
+
+	if len(pattern) > 0 && pattern[0] == '!' {
+		class.WriteString(`^`)
+		pattern = pattern[1:]
+	}
+
+	for nrange := 0; ; nrange++ {
+		if len(pattern) > 0 && pattern[0] == ']' && nrange > 0 {
+			class.WriteString(`]`)
+			return class.String(), pattern[1:], nil
+		}
+
+		lo, rest, err := getChar(pattern)
+		if err != nil {
+			return "", "", err
+		}
+		pattern = rest
+		fmt.Fprintf(&class, `\x{%x}`, lo)
+
+		if len(pattern) > 0 && pattern[0] == '-' {
+			hi, rest, err := getChar(pattern[1:])
+			if err != nil {
+				return "", "", err
+			}
+			if hi < lo {
+				return "", "", ErrBadPattern
+			}
+			pattern = rest
+			fmt.Fprintf(&class, `-\x{%x}`, hi)
+		}
+	}
+}
+
+// getChar returns the possibly escaped character at the start of pattern and the rest
+// of pattern
+func getChar(pattern string) (rune, string, error) {
+	if len(pattern) == 0 || pattern[0] == '-' || pattern[0] == ']' {
+		return 0, "", ErrBadPattern
+	}
+	if pattern[0] == '\\' {
+		pattern = pattern[1:]
+		if len(pattern) == 0 {
+			return 0, "", ErrBadPattern
+		}
+	}
+	c, n := utf8.DecodeRuneInString(pattern)
+	if c == utf8.RuneError && n == 1 {
+		return 0, "", ErrBadPattern
+	}
+	return c, pattern[n:], nil
+}
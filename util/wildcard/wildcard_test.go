@@ -0,0 +1,59 @@
+package wildcard_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/util/wildcard"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		match   bool
+	}{
+		{"", "", true},
+		{"nginx", "nginx", true},
+		{"nginx", "nginx:1.19", false},
+		{"nginx:*", "nginx:1.19", true},
+		{"*", "docker.io/library/nginx:latest", true},
+		{"*:latest", "xmrig/xmrig:latest", true},
+		{"*xmrig*", "docker.io/xmrig/xmrig:6.5", true},
+		{"xmrig/*", "xmrig/xmrig:latest", true},
+		{"xmrig/*", "xmrig", false},
+		{"*/*", "nginx", false},
+		{"nginx:1.?", "nginx:1.9", true},
+		{"nginx:1.?", "nginx:1.19", false},
+		{"a?c", "a/c", true},
+		{"nginx:1.[0-9]", "nginx:1.7", true},
+		{"nginx:1.[!0-9]", "nginx:1.7", false},
+		{"nginx:1.[!0-9]", "nginx:1.x", true},
+		{"[ab]-[cd]", "b-c", true},
+		{`\*`, "*", true},
+		{`\*`, "a", false},
+		{"a.c", "abc", false},
+		{"tier-(free)", "tier-(free)", true},
+		{"free*", "freemium", true},
+		{"free*", "notfree", false},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.match, wildcard.Match(test.pattern, test.value), "%q %q", test.pattern, test.value)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, pattern := range []string{"", "*", "nginx:*", "[a-z]*", "[!a]", `\[`, "a-b", "a]"} {
+		require.NoError(t, wildcard.Validate(pattern), pattern)
+	}
+
+	for _, pattern := range []string{"[", "[]", "[a", "[z-a]", "[-a]", "[a-]", `\`, `[\`} {
+		err := wildcard.Validate(pattern)
+		require.Error(t, err, pattern)
+		require.True(t, errors.Is(err, wildcard.ErrBadPattern), pattern)
+		require.False(t, wildcard.Match(pattern, pattern), pattern)
+	}
+}
@@ -7,19 +7,20 @@ import (
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
-// ValidateDeploymentGroups does validation for all deployment groups
-func ValidateDeploymentGroups(gspecs []dtypes.GroupSpec) error {
+// ValidateDeploymentGroups does validation for all deployment groups against
+// the deployment module parameters
+func ValidateDeploymentGroups(params dtypes.Params, gspecs []dtypes.GroupSpec) error {
 	rlists := make([]types.ResourceGroup, 0, len(gspecs))
 	for _, group := range gspecs {
 		rlists = append(rlists, group)
 	}
 
-	if err := validateResourceLists(defaultConfig, rlists); err != nil {
+	if err := validateResourceLists(params, rlists); err != nil {
 		return errors.Wrap(err, "validate deployment group")
 	}
 
 	for _, group := range gspecs {
-		err := ValidateDeploymentGroup(group)
+		err := ValidateDeploymentGroup(params, group)
 		if err != nil {
 			return err
 		}
@@ -27,17 +28,18 @@ func ValidateDeploymentGroups(gspecs []dtypes.GroupSpec) error {
 	return nil
 }
 
-// ValidateDeploymentGroup does validation for provided deployment group
-func ValidateDeploymentGroup(gspec dtypes.GroupSpec) error {
+// ValidateDeploymentGroup does validation for provided deployment group against
+// the deployment module parameters
+func ValidateDeploymentGroup(params dtypes.Params, gspec dtypes.GroupSpec) error {
 	if err := gspec.ValidateBasic(); err != nil {
 		return errors.Wrapf(err, "group validation error: %v", gspec.Name)
 	}
 
-	if err := validateResourceList(defaultConfig, gspec); err != nil {
+	if err := validateResourceList(params, gspec); err != nil {
 		return err
 	}
-	if err := validateGroupPricing(defaultConfig, gspec); err != nil {
+	if err := validateGroupPricing(params, gspec); err != nil {
 		return err
 	}
-	return validateOrderBidDuration(defaultConfig, gspec)
+	return validateOrderBidDuration(params, gspec)
 }
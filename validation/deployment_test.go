@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/validation"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/stretchr/testify/require"
@@ -15,13 +16,52 @@ func TestZeroValueGroupSpec(t *testing.T) {
 	gspec := dgroup.GroupSpec
 
 	t.Run("assert nominal test success", func(t *testing.T) {
-		err := validation.ValidateDeploymentGroup(gspec)
+		err := validation.ValidateDeploymentGroup(dtypes.DefaultParams(), gspec)
 		require.NoError(t, err)
 	})
 
 	gspec.OrderBidDuration = int64(0)
 	t.Run("assert error for zero value bid duration", func(t *testing.T) {
-		err := validation.ValidateDeploymentGroup(gspec)
+		err := validation.ValidateDeploymentGroup(dtypes.DefaultParams(), gspec)
+		require.Error(t, err)
+	})
+}
+
+func TestGroupSpecSignedBy(t *testing.T) {
+	did := testutil.DeploymentID(t)
+	dgroup := testutil.DeploymentGroup(t, did, uint32(6))
+	gspec := dgroup.GroupSpec
+
+	gspec.SignedBy.AllOf = []string{testutil.AccAddress(t).String()}
+	t.Run("assert valid auditor address success", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(dtypes.DefaultParams(), gspec)
+		require.NoError(t, err)
+	})
+
+	gspec.SignedBy.AnyOf = []string{"invalid"}
+	t.Run("assert error for invalid auditor address", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(dtypes.DefaultParams(), gspec)
+		require.Error(t, err)
+	})
+}
+
+func TestGroupSpecEndpoints(t *testing.T) {
+	did := testutil.DeploymentID(t)
+	dgroup := testutil.DeploymentGroup(t, did, uint32(6))
+	gspec := dgroup.GroupSpec
+
+	gspec.Resources[0].Resources.Endpoints = []types.Endpoint{
+		{Kind: types.EndpointSharedHTTP},
+		{Kind: types.EndpointRandomPort},
+	}
+	t.Run("assert valid endpoint kinds success", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(dtypes.DefaultParams(), gspec)
+		require.NoError(t, err)
+	})
+
+	gspec.Resources[0].Resources.Endpoints = []types.Endpoint{{Kind: types.Endpoint_Kind(100)}}
+	t.Run("assert error for unknown endpoint kind", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(dtypes.DefaultParams(), gspec)
 		require.Error(t, err)
 	})
 }
@@ -35,7 +75,7 @@ func TestZeroValueGroupSpecs(t *testing.T) {
 	}
 
 	t.Run("assert nominal test success", func(t *testing.T) {
-		err := validation.ValidateDeploymentGroups(gspecs)
+		err := validation.ValidateDeploymentGroups(dtypes.DefaultParams(), gspecs)
 		require.NoError(t, err)
 	})
 
@@ -45,7 +85,40 @@ func TestZeroValueGroupSpecs(t *testing.T) {
 		gspecZeroed = append(gspecZeroed, g)
 	}
 	t.Run("assert error for zero value bid duration", func(t *testing.T) {
-		err := validation.ValidateDeploymentGroups(gspecZeroed)
+		err := validation.ValidateDeploymentGroups(dtypes.DefaultParams(), gspecZeroed)
+		require.Error(t, err)
+	})
+}
+
+func TestGroupSpecUnitPrice(t *testing.T) {
+	did := testutil.DeploymentID(t)
+	dgroup := testutil.DeploymentGroup(t, did, uint32(6))
+	gspec := dgroup.GroupSpec
+
+	price := gspec.Resources[0].Price.Amount.Uint64()
+	for _, resource := range gspec.Resources {
+		if resource.Price.Amount.Uint64() < price {
+			price = resource.Price.Amount.Uint64()
+		}
+	}
+
+	params := dtypes.DefaultParams()
+	params.MinUnitPrice = price
+	t.Run("assert price at the minimum success", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(params, gspec)
+		require.NoError(t, err)
+	})
+
+	params.MinUnitPrice = price + 1
+	t.Run("assert error for price below the minimum", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(params, gspec)
+		require.Error(t, err)
+	})
+
+	params = dtypes.DefaultParams()
+	params.MaxUnitPrice = price - 1
+	t.Run("assert error for price above the maximum", func(t *testing.T) {
+		err := validation.ValidateDeploymentGroup(params, gspec)
 		require.Error(t, err)
 	})
 }
@@ -1,7 +1,11 @@
 package validation
 
 import (
+	"math"
+	"path"
+
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/ovrclk/akash/manifest"
 	"github.com/ovrclk/akash/types"
@@ -21,6 +25,115 @@ func validateManifestGroups(groups []manifest.Group) error {
 	// if err := validateResourceLists(defaultConfig, rlists); err != nil {
 	// 	return fmt.Errorf("manifest groups: %v", err)
 	// }
+	for _, group := range groups {
+		for _, svc := range group.Services {
+			if err := validateServiceStorage(group.Name, svc); err != nil {
+				return err
+			}
+			if err := validateServiceHealth(group.Name, svc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateServiceStorage checks that a service mounts its storage if and only if the
+// storage is a persistent volume
+func validateServiceStorage(group string, svc manifest.Service) error {
+	var params []manifest.StorageParams
+	if svc.Params != nil {
+		params = svc.Params.Storage
+	}
+
+	if !svc.Resources.Storage.IsPersistent() {
+		if len(params) != 0 {
+			return errors.Errorf("invalid manifest: %v.%v: storage mounted without persistent storage", group, svc.Name)
+		}
+		return nil
+	}
+
+	if svc.Resources.Storage.Class() == "" {
+		return errors.Errorf("invalid manifest: %v.%v: persistent storage requires a storage class", group, svc.Name)
+	}
+
+	if len(params) != 1 {
+		return errors.Errorf("invalid manifest: %v.%v: persistent storage must be mounted exactly once", group, svc.Name)
+	}
+
+	if errs := validation.IsDNS1123Label(params[0].Name); len(errs) != 0 {
+		return errors.Errorf("invalid manifest: %v.%v: invalid storage name %q", group, svc.Name, params[0].Name)
+	}
+
+	if !path.IsAbs(params[0].Mount) {
+		return errors.Errorf("invalid manifest: %v.%v: storage mount %q is not an absolute path", group, svc.Name, params[0].Mount)
+	}
+
+	return nil
+}
+
+// validateServiceHealth checks that every health check of a service has exactly one valid
+// action. Liveness checks fail the first time they do not succeed.
+func validateServiceHealth(group string, svc manifest.Service) error {
+	if svc.Health == nil {
+		return nil
+	}
+
+	if check := svc.Health.Liveness; check != nil {
+		if check.SuccessThreshold > 1 {
+			return errors.Errorf("invalid manifest: %v.%v: liveness success threshold must be 1", group, svc.Name)
+		}
+		if err := validateHealthCheck(check); err != nil {
+			return errors.Errorf("invalid manifest: %v.%v: liveness: %v", group, svc.Name, err)
+		}
+	}
+
+	if check := svc.Health.Readiness; check != nil {
+		if err := validateHealthCheck(check); err != nil {
+			return errors.Errorf("invalid manifest: %v.%v: readiness: %v", group, svc.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateHealthCheck(check *manifest.HealthCheck) error {
+	actions := 0
+
+	if check.HTTP != nil {
+		actions++
+		if !path.IsAbs(check.HTTP.Path) {
+			return errors.Errorf("http path %q is not absolute", check.HTTP.Path)
+		}
+		if check.HTTP.Port == 0 {
+			return errors.New("http port required")
+		}
+	}
+
+	if check.TCP != nil {
+		actions++
+		if check.TCP.Port == 0 {
+			return errors.New("tcp port required")
+		}
+	}
+
+	if check.Exec != nil {
+		actions++
+		if len(check.Exec.Command) == 0 {
+			return errors.New("exec command required")
+		}
+	}
+
+	if actions != 1 {
+		return errors.New("exactly one of http, tcp or exec required")
+	}
+
+	for _, value := range []uint32{check.InitialDelay, check.Interval, check.Timeout, check.SuccessThreshold, check.FailureThreshold} {
+		if value > math.MaxInt32 {
+			return errors.Errorf("%v out of range", value)
+		}
+	}
+
 	return nil
 }
 
@@ -232,3 +232,154 @@ func Test_ValidateManifest(t *testing.T) {
 		}
 	}
 }
+
+func Test_ValidateManifestStorage(t *testing.T) {
+	persistent := types.ResourceUnits{
+		Storage: &types.Storage{
+			Quantity: types.NewResourceValue(randStorage),
+			Attributes: types.Attributes{
+				types.NewStringAttribute(types.StorageAttributeClass, "default"),
+				types.NewStringAttribute(types.StorageAttributePersistent, "true"),
+			},
+		},
+	}
+
+	mount := &manifest.ServiceParams{
+		Storage: []manifest.StorageParams{{Name: "data", Mount: "/var/lib/data"}},
+	}
+
+	tests := []struct {
+		name    string
+		ok      bool
+		service manifest.Service
+	}{
+		{
+			name:    "ephemeral",
+			ok:      true,
+			service: manifest.Service{Name: "svc1", Resources: randUnits1},
+		},
+		{
+			name:    "persistent",
+			ok:      true,
+			service: manifest.Service{Name: "svc1", Resources: persistent, Params: mount},
+		},
+		{
+			name:    "persistent-not-mounted",
+			ok:      false,
+			service: manifest.Service{Name: "svc1", Resources: persistent},
+		},
+		{
+			name:    "ephemeral-mounted",
+			ok:      false,
+			service: manifest.Service{Name: "svc1", Resources: randUnits1, Params: mount},
+		},
+		{
+			name: "relative-mount",
+			ok:   false,
+			service: manifest.Service{Name: "svc1", Resources: persistent, Params: &manifest.ServiceParams{
+				Storage: []manifest.StorageParams{{Name: "data", Mount: "data"}},
+			}},
+		},
+		{
+			name: "no-class",
+			ok:   false,
+			service: manifest.Service{Name: "svc1", Params: mount, Resources: types.ResourceUnits{
+				Storage: &types.Storage{
+					Quantity:   types.NewResourceValue(randStorage),
+					Attributes: types.Attributes{types.NewStringAttribute(types.StorageAttributePersistent, "true")},
+				},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		m := manifest.Manifest{{Name: "foo", Services: []manifest.Service{test.service}}}
+		err := validation.ValidateManifest(m)
+		if test.ok {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Error(t, err, test.name)
+		}
+	}
+}
+
+func Test_ValidateManifestHealth(t *testing.T) {
+	http := &manifest.HealthCheck{HTTP: &manifest.HTTPHealthCheck{Path: "/healthz", Port: 8080}}
+
+	tests := []struct {
+		name   string
+		ok     bool
+		health *manifest.ServiceHealth
+	}{
+		{
+			name: "none",
+			ok:   true,
+		},
+		{
+			name:   "http-readiness",
+			ok:     true,
+			health: &manifest.ServiceHealth{Readiness: http},
+		},
+		{
+			name: "tcp-liveness-exec-readiness",
+			ok:   true,
+			health: &manifest.ServiceHealth{
+				Liveness:  &manifest.HealthCheck{TCP: &manifest.TCPHealthCheck{Port: 5432}, FailureThreshold: 3},
+				Readiness: &manifest.HealthCheck{Exec: &manifest.ExecHealthCheck{Command: []string{"pg_isready"}}},
+			},
+		},
+		{
+			name:   "no-action",
+			ok:     false,
+			health: &manifest.ServiceHealth{Readiness: &manifest.HealthCheck{Interval: 10}},
+		},
+		{
+			name: "two-actions",
+			ok:   false,
+			health: &manifest.ServiceHealth{Readiness: &manifest.HealthCheck{
+				HTTP: http.HTTP,
+				TCP:  &manifest.TCPHealthCheck{Port: 8080},
+			}},
+		},
+		{
+			name: "relative-path",
+			ok:   false,
+			health: &manifest.ServiceHealth{Readiness: &manifest.HealthCheck{
+				HTTP: &manifest.HTTPHealthCheck{Path: "healthz", Port: 8080},
+			}},
+		},
+		{
+			name: "no-port",
+			ok:   false,
+			health: &manifest.ServiceHealth{Liveness: &manifest.HealthCheck{
+				TCP: &manifest.TCPHealthCheck{},
+			}},
+		},
+		{
+			name: "no-command",
+			ok:   false,
+			health: &manifest.ServiceHealth{Liveness: &manifest.HealthCheck{
+				Exec: &manifest.ExecHealthCheck{},
+			}},
+		},
+		{
+			name: "liveness-success-threshold",
+			ok:   false,
+			health: &manifest.ServiceHealth{Liveness: &manifest.HealthCheck{
+				HTTP:             http.HTTP,
+				SuccessThreshold: 2,
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		service := manifest.Service{Name: "svc1", Resources: randUnits1, Health: test.health}
+		m := manifest.Manifest{{Name: "foo", Services: []manifest.Service{service}}}
+		err := validation.ValidateManifest(m)
+		if test.ok {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Error(t, err, test.name)
+		}
+	}
+}
@@ -10,13 +10,13 @@ import (
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
-func validateGroupPricing(config ValConfig, gspec dtypes.GroupSpec) error {
+func validateGroupPricing(params dtypes.Params, gspec dtypes.GroupSpec) error {
 	var price sdk.Coin
 
 	mem := sdk.NewInt(0)
 
 	for idx, resource := range gspec.Resources {
-		if err := validateUnitPricing(config, resource); err != nil {
+		if err := validateUnitPricing(params, resource); err != nil {
 			return fmt.Errorf("group %v: %w", gspec.GetName(), err)
 		}
 
@@ -38,7 +38,7 @@ func validateGroupPricing(config ValConfig, gspec dtypes.GroupSpec) error {
 		mem = mem.Add(memCount.Mul(sdk.NewIntFromUint64(uint64(resource.Count))))
 	}
 
-	minprice := mem.Mul(sdk.NewInt(config.MinGroupMemPrice)).Quo(sdk.NewInt(unit.Gi))
+	minprice := mem.Mul(sdk.NewInt(params.MinGroupMemPrice)).Quo(sdk.NewInt(unit.Gi))
 
 	if price.Amount.LT(minprice) {
 		return errors.Errorf("group %v: price too low (%v >= %v fails)", gspec.GetName(), price, minprice)
@@ -46,28 +46,28 @@ func validateGroupPricing(config ValConfig, gspec dtypes.GroupSpec) error {
 	return nil
 }
 
-func validateUnitPricing(config ValConfig, rg dtypes.Resource) error {
+func validateUnitPricing(params dtypes.Params, rg dtypes.Resource) error {
 	if !rg.Price.IsValid() {
 		return errors.Errorf("error: invalid price object")
 	}
 
-	if rg.Price.Amount.GT(sdk.NewIntFromUint64(uint64(config.MaxUnitPrice))) {
-		return errors.Errorf("error: invalid unit price (%v > %v fails)", config.MaxUnitPrice, rg.Price)
+	if rg.Price.Amount.GT(sdk.NewIntFromUint64(params.MaxUnitPrice)) {
+		return errors.Errorf("error: invalid unit price (%v > %v fails)", params.MaxUnitPrice, rg.Price)
 	}
 
-	if rg.Price.Amount.GT(sdk.NewIntFromUint64(uint64(config.MaxUnitPrice))) {
-		return errors.Errorf("error: invalid unit price (%v < %v fails)", config.MinUnitPrice, rg.Price)
+	if rg.Price.Amount.LT(sdk.NewIntFromUint64(params.MinUnitPrice)) {
+		return errors.Errorf("error: invalid unit price (%v < %v fails)", rg.Price, params.MinUnitPrice)
 	}
 
 	return nil
 }
 
-func validateOrderBidDuration(_ ValConfig, rg dtypes.GroupSpec) error {
+func validateOrderBidDuration(params dtypes.Params, rg dtypes.GroupSpec) error {
 	if !(rg.OrderBidDuration > 0) {
 		return errors.Errorf("error: order bid duration must be greater than zero")
 	}
-	if rg.OrderBidDuration > dtypes.MaxBiddingDuration {
-		return errors.Errorf("error: order bid duration must not be greater than %v", dtypes.MaxBiddingDuration)
+	if rg.OrderBidDuration > params.MaxOrderBidDuration {
+		return errors.Errorf("error: order bid duration must not be greater than %v", params.MaxOrderBidDuration)
 	}
 	return nil
 }
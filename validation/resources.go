@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ovrclk/akash/types"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
 var (
@@ -15,17 +16,17 @@ var (
 )
 
 // ValidateResourceList does basic validation for resources list
-func ValidateResourceList(rlist types.ResourceGroup) error {
-	return validateResourceList(defaultConfig, rlist)
+func ValidateResourceList(params dtypes.Params, rlist types.ResourceGroup) error {
+	return validateResourceList(params, rlist)
 }
 
-func validateResourceLists(config ValConfig, rlists []types.ResourceGroup) error {
+func validateResourceLists(params dtypes.Params, rlists []types.ResourceGroup) error {
 	if len(rlists) == 0 {
 		return ErrNoGroupsPresent
 	}
 
-	if count := len(rlists); count > config.MaxGroupCount {
-		return errors.Errorf("error: too many groups (%v > %v)", count, config.MaxGroupCount)
+	if count := len(rlists); count > int(params.MaxGroupCount) {
+		return errors.Errorf("error: too many groups (%v > %v)", count, params.MaxGroupCount)
 	}
 
 	names := make(map[string]bool)
@@ -37,7 +38,7 @@ func validateResourceLists(config ValConfig, rlists []types.ResourceGroup) error
 		}
 		names[rlist.GetName()] = true
 
-		if err := validateResourceList(config, rlist); err != nil {
+		if err := validateResourceList(params, rlist); err != nil {
 			return err
 		}
 	}
@@ -70,21 +71,21 @@ func (u *resourceLimits) mul(count uint32) {
 	u.storage = u.storage.MulRaw(int64(count))
 }
 
-func validateResourceList(config ValConfig, rlist types.ResourceGroup) error {
+func validateResourceList(params dtypes.Params, rlist types.ResourceGroup) error {
 	if rlist.GetName() == "" {
 		return ErrGroupEmptyName
 	}
 
 	units := rlist.GetResources()
 
-	if count := len(units); count > config.MaxGroupUnits {
-		return errors.Errorf("group %v: too many units (%v > %v)", rlist.GetName(), count, config.MaxGroupUnits)
+	if count := len(units); count > int(params.MaxGroupUnits) {
+		return errors.Errorf("group %v: too many units (%v > %v)", rlist.GetName(), count, params.MaxGroupUnits)
 	}
 
 	limits := newLimits()
 
 	for _, resource := range units {
-		gLimits, err := validateResourceGroup(config, resource)
+		gLimits, err := validateResourceGroup(params, resource)
 		if err != nil {
 			return fmt.Errorf("group %v: %w", rlist.GetName(), err)
 		}
@@ -103,33 +104,33 @@ func validateResourceList(config ValConfig, rlist types.ResourceGroup) error {
 		// }
 	}
 
-	if limits.cpu.GT(sdk.NewInt(config.MaxGroupCPU)) || limits.cpu.LTE(sdk.ZeroInt()) {
+	if limits.cpu.GT(sdk.NewIntFromUint64(params.MaxGroupCPU)) || limits.cpu.LTE(sdk.ZeroInt()) {
 		return errors.Errorf("group %v: invalid total cpu (%v > %v > %v fails)",
-			rlist.GetName(), config.MaxGroupCPU, limits.cpu, 0)
+			rlist.GetName(), params.MaxGroupCPU, limits.cpu, 0)
 	}
 
-	if limits.memory.GT(sdk.NewInt(config.MaxGroupMemory)) || limits.memory.LTE(sdk.ZeroInt()) {
+	if limits.memory.GT(sdk.NewIntFromUint64(params.MaxGroupMemory)) || limits.memory.LTE(sdk.ZeroInt()) {
 		return errors.Errorf("group %v: invalid total memory (%v > %v > %v fails)",
-			rlist.GetName(), config.MaxGroupMemory, limits.memory, 0)
+			rlist.GetName(), params.MaxGroupMemory, limits.memory, 0)
 	}
 
-	if limits.storage.GT(sdk.NewInt(config.MaxGroupStorage)) || limits.storage.LTE(sdk.ZeroInt()) {
+	if limits.storage.GT(sdk.NewIntFromUint64(params.MaxGroupStorage)) || limits.storage.LTE(sdk.ZeroInt()) {
 		return errors.Errorf("group %v: invalid total storage (%v > %v > %v fails)",
-			rlist.GetName(), config.MaxGroupStorage, limits.storage, 0)
+			rlist.GetName(), params.MaxGroupStorage, limits.storage, 0)
 	}
 
 	return nil
 }
 
-func validateResourceGroup(config ValConfig, rg types.Resources) (resourceLimits, error) {
-	limits, err := validateResourceUnit(config, rg.Resources)
+func validateResourceGroup(params dtypes.Params, rg types.Resources) (resourceLimits, error) {
+	limits, err := validateResourceUnit(params, rg.Resources)
 	if err != nil {
 		return resourceLimits{}, err
 	}
 
-	if rg.Count > uint32(config.MaxUnitCount) || rg.Count < uint32(config.MinUnitCount) {
+	if rg.Count > params.MaxUnitCount || rg.Count < params.MinUnitCount {
 		return resourceLimits{}, errors.Errorf("error: invalid unit count (%v > %v > %v fails)",
-			config.MaxUnitCount, rg.Count, config.MinUnitCount)
+			params.MaxUnitCount, rg.Count, params.MinUnitCount)
 	}
 
 	// TODO: validate pricing
@@ -140,62 +141,76 @@ func validateResourceGroup(config ValConfig, rg types.Resources) (resourceLimits
 	return limits, nil
 }
 
-func validateResourceUnit(config ValConfig, units types.ResourceUnits) (resourceLimits, error) {
+func validateResourceUnit(params dtypes.Params, units types.ResourceUnits) (resourceLimits, error) {
 	limits := newLimits()
 
-	val, err := validateCPU(config, units.CPU)
+	val, err := validateCPU(params, units.CPU)
 	if err != nil {
 		return resourceLimits{}, err
 	}
 	limits.cpu = limits.cpu.Add(val)
 
-	val, err = validateMemory(config, units.Memory)
+	val, err = validateMemory(params, units.Memory)
 	if err != nil {
 		return resourceLimits{}, err
 	}
 	limits.memory = limits.memory.Add(val)
 
-	val, err = validateStorage(config, units.Storage)
+	val, err = validateStorage(params, units.Storage)
 	if err != nil {
 		return resourceLimits{}, err
 	}
 	limits.storage = limits.storage.Add(val)
 
+	if err := validateEndpoints(units.Endpoints); err != nil {
+		return resourceLimits{}, err
+	}
+
 	return limits, nil
 }
 
-func validateCPU(config ValConfig, u *types.CPU) (sdk.Int, error) {
+func validateCPU(params dtypes.Params, u *types.CPU) (sdk.Int, error) {
 	if u == nil {
 		return sdk.Int{}, errors.Errorf("error: invalid unit cpu, cannot be nil")
 	}
-	if (u.Units.Value() > uint64(config.MaxUnitCPU)) || (u.Units.Value() < uint64(config.MinUnitCPU)) {
+	if (u.Units.Value() > params.MaxUnitCPU) || (u.Units.Value() < params.MinUnitCPU) {
 		return sdk.Int{}, errors.Errorf("error: invalid unit cpu (%v > %v > %v fails)",
-			config.MaxUnitCPU, u.Units.Value(), config.MinUnitCPU)
+			params.MaxUnitCPU, u.Units.Value(), params.MinUnitCPU)
 	}
 
 	return u.Units.Val, nil
 }
 
-func validateMemory(config ValConfig, u *types.Memory) (sdk.Int, error) {
+func validateMemory(params dtypes.Params, u *types.Memory) (sdk.Int, error) {
 	if u == nil {
 		return sdk.Int{}, errors.Errorf("error: invalid unit memory, cannot be nil")
 	}
-	if (u.Quantity.Value() > uint64(config.MaxUnitMemory)) || (u.Quantity.Value() < uint64(config.MinUnitMemory)) {
+	if (u.Quantity.Value() > params.MaxUnitMemory) || (u.Quantity.Value() < params.MinUnitMemory) {
 		return sdk.Int{}, errors.Errorf("error: invalid unit memory (%v > %v > %v fails)",
-			config.MaxUnitMemory, u.Quantity.Value(), config.MinUnitMemory)
+			params.MaxUnitMemory, u.Quantity.Value(), params.MinUnitMemory)
 	}
 
 	return u.Quantity.Val, nil
 }
 
-func validateStorage(config ValConfig, u *types.Storage) (sdk.Int, error) {
+func validateStorage(params dtypes.Params, u *types.Storage) (sdk.Int, error) {
 	if u == nil {
 		return sdk.Int{}, errors.Errorf("error: invalid unit storage, cannot be nil")
 	}
-	if (u.Quantity.Value() > uint64(config.MaxUnitStorage)) || (u.Quantity.Value() < uint64(config.MinUnitStorage)) {
+	if (u.Quantity.Value() > params.MaxUnitStorage) || (u.Quantity.Value() < params.MinUnitStorage) {
 		return sdk.Int{}, errors.Errorf("error: invalid unit storage (%v > %v > %v fails)",
-			config.MaxUnitStorage, u.Quantity.Value(), config.MinUnitStorage)
+			params.MaxUnitStorage, u.Quantity.Value(), params.MinUnitStorage)
 	}
 
 	return u.Quantity.Val, nil
 }
+
+func validateEndpoints(endpoints []types.Endpoint) error {
+	for _, endpoint := range endpoints {
+		if _, valid := types.Endpoint_Kind_name[int32(endpoint.Kind)]; !valid {
+			return errors.Errorf("error: invalid endpoint kind %v", endpoint.Kind)
+		}
+	}
+
+	return nil
+}
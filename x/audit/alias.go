@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+const (
+	// StoreKey represents storekey of audit module
+	StoreKey = types.StoreKey
+	// ModuleName represents current module name
+	ModuleName = types.ModuleName
+)
+
+type (
+	// Keeper defines keeper of audit module
+	Keeper = keeper.Keeper
+)
+
+var (
+	// NewKeeper creates new keeper instance of audit module
+	NewKeeper = keeper.NewKeeper
+)
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// GetQueryCmd returns the query commands for the audit module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Audit query commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		cmdGetProviders(),
+		cmdGetProvider(),
+	)
+
+	return cmd
+}
+
+func cmdGetProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query for the signed attributes of all providers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllProvidersAttributesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllProvidersAttributes(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "providers")
+
+	return cmd
+}
+
+func cmdGetProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [provider] [auditor]",
+		Short: "Query the signed attributes of a provider, optionally limited to a single auditor",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var res *types.QueryProvidersResponse
+			if len(args) == 2 {
+				var auditor sdk.AccAddress
+				if auditor, err = sdk.AccAddressFromBech32(args[1]); err != nil {
+					return err
+				}
+
+				res, err = queryClient.ProviderAuditorAttributes(context.Background(), &types.QueryProviderAuditorRequest{
+					Owner:   owner.String(),
+					Auditor: auditor.String(),
+				})
+			} else {
+				var pageReq *sdkquery.PageRequest
+				if pageReq, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+					return err
+				}
+
+				res, err = queryClient.ProviderAttributes(context.Background(), &types.QueryProviderAttributesRequest{
+					Owner:      owner.String(),
+					Pagination: pageReq,
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "providers")
+
+	return cmd
+}
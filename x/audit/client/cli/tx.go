@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	akashtypes "github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// GetTxCmd returns the transaction commands for audit module
+func GetTxCmd(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Audit transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		cmdAttributes(key),
+	)
+	return cmd
+}
+
+func cmdAttributes(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "attr",
+		Short:                      "Manage provider attributes",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		cmdSignAttributes(key),
+		cmdDeleteAttributes(key),
+	)
+	return cmd
+}
+
+func cmdSignAttributes(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [provider] [key] [value] [[key] [value]...]",
+		Short: fmt.Sprintf("Sign provider attributes as %s auditor", key),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 3 || len(args)%2 == 0 {
+				return errors.New("expected a provider followed by key/value pairs")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			attributes := make(akashtypes.Attributes, 0, (len(args)-1)/2)
+			for i := 1; i < len(args); i += 2 {
+				attributes = append(attributes, akashtypes.NewStringAttribute(args[i], args[i+1]))
+			}
+
+			msg := types.NewMsgSignProviderAttributes(owner, clientCtx.GetFromAddress(), attributes)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func cmdDeleteAttributes(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [provider] [[key]...]",
+		Short: fmt.Sprintf("Delete signed provider attributes as %s auditor; all are deleted when no keys are given", key),
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteProviderAttributes(owner, clientCtx.GetFromAddress(), args[1:])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
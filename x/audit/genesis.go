@@ -0,0 +1,64 @@
+package audit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// ValidateGenesis does validation check of the Genesis and returns error incase of failure
+func ValidateGenesis(data *types.GenesisState) error {
+	for _, prov := range data.Providers {
+		msg := types.MsgSignProviderAttributes{
+			Owner:      prov.Owner,
+			Auditor:    prov.Auditor,
+			Attributes: prov.Attributes,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis initiate genesis state and return updated validator details
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	for _, prov := range data.Providers {
+		owner, err := sdk.AccAddressFromBech32(prov.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		auditor, err := sdk.AccAddressFromBech32(prov.Auditor)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, prov.Attributes); err != nil {
+			panic(err)
+		}
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns genesis state as raw bytes for the audit module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var providers []types.Provider
+
+	k.WithProviders(ctx, func(prov types.Provider) bool {
+		providers = append(providers, prov)
+		return false
+	})
+
+	return &types.GenesisState{
+		Providers: providers,
+	}
+}
+
+// DefaultGenesisState returns default genesis state as raw bytes for the audit
+// module.
+func DefaultGenesisState() *types.GenesisState {
+	return &types.GenesisState{}
+}
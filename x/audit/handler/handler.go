@@ -0,0 +1,29 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// NewHandler returns a handler for "audit" type messages.
+func NewHandler(keeper keeper.Keeper, pkeeper ProviderKeeper) sdk.Handler {
+	ms := NewMsgServerImpl(keeper, pkeeper)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case *types.MsgSignProviderAttributes:
+			res, err := ms.SignProviderAttributes(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDeleteProviderAttributes:
+			res, err := ms.DeleteProviderAttributes(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized audit message type: %T", msg)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdktestdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/audit/handler"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+	pkeeper "github.com/ovrclk/akash/x/provider/keeper"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+type testSuite struct {
+	t       testing.TB
+	ms      sdk.CommitMultiStore
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	pkeeper pkeeper.Keeper
+	handler sdk.Handler
+}
+
+func setupTestSuite(t *testing.T) *testSuite {
+	suite := &testSuite{
+		t: t,
+	}
+
+	aKey := sdk.NewTransientStoreKey(types.StoreKey)
+	pKey := sdk.NewTransientStoreKey(ptypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	suite.ms = store.NewCommitMultiStore(db)
+	suite.ms.MountStoreWithDB(aKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+
+	err := suite.ms.LoadLatestVersion()
+	require.NoError(t, err)
+
+	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
+
+	suite.keeper = keeper.NewKeeper(types.ModuleCdc, aKey)
+	suite.pkeeper = pkeeper.NewKeeper(types.ModuleCdc, pKey)
+
+	suite.handler = handler.NewHandler(suite.keeper, suite.pkeeper)
+
+	return suite
+}
+
+func TestAuditBadMessageType(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	res, err := suite.handler(suite.ctx, sdk.Msg(sdktestdata.NewTestMsg()))
+	require.Nil(t, res)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, sdkerrors.ErrUnknownRequest))
+}
+
+func TestSignProviderAttributesNonExistingProvider(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	msg := types.NewMsgSignProviderAttributes(testutil.AccAddress(t), testutil.AccAddress(t), testutil.Attributes(t))
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrProviderNotFound))
+}
+
+func TestSignProviderAttributesValid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	owner := suite.createProvider()
+	auditor := testutil.AccAddress(t)
+	attrs := testutil.Attributes(t)
+
+	msg := types.NewMsgSignProviderAttributes(owner, auditor, attrs)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	t.Run("ensure event created", func(t *testing.T) {
+		iev, err := types.ParseEvent(testutil.ParseEvent(t, res.Events[1:]))
+		require.NoError(t, err)
+		require.Equal(t, types.NewEventProviderAttributesSigned(owner, auditor), iev)
+	})
+
+	prov, found := suite.keeper.GetProviderAuditorAttributes(suite.ctx, owner, auditor)
+	require.True(t, found)
+	require.Len(t, prov.Attributes, len(attrs))
+}
+
+func TestDeleteProviderAttributesNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	msg := types.NewMsgDeleteProviderAttributes(suite.createProvider(), testutil.AccAddress(t), nil)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrNoAttributes.Error())
+}
+
+func TestDeleteProviderAttributesValid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	owner := suite.createProvider()
+	auditor := testutil.AccAddress(t)
+
+	err := suite.keeper.CreateOrUpdateProviderAttributes(suite.ctx, owner, auditor, testutil.Attributes(t))
+	require.NoError(t, err)
+
+	msg := types.NewMsgDeleteProviderAttributes(owner, auditor, nil)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found := suite.keeper.GetProviderAuditorAttributes(suite.ctx, owner, auditor)
+	require.False(t, found)
+}
+
+func (st *testSuite) createProvider() sdk.AccAddress {
+	st.t.Helper()
+
+	prov := testutil.Provider(st.t)
+	err := st.pkeeper.Create(st.ctx, prov)
+	require.NoError(st.t, err)
+
+	owner, err := sdk.AccAddressFromBech32(prov.Owner)
+	require.NoError(st.t, err)
+
+	return owner
+}
@@ -0,0 +1,12 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+// ProviderKeeper Interface includes provider methods
+type ProviderKeeper interface {
+	Get(ctx sdk.Context, id sdk.Address) (ptypes.Provider, bool)
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+type msgServer struct {
+	keeper   keeper.Keeper
+	provider ProviderKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the audit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k keeper.Keeper, pk ProviderKeeper) types.MsgServer {
+	return &msgServer{
+		keeper:   k,
+		provider: pk,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (ms msgServer) SignProviderAttributes(goCtx context.Context, msg *types.MsgSignProviderAttributes) (*types.MsgSignProviderAttributesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	auditor, err := sdk.AccAddressFromBech32(msg.Auditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := ms.provider.Get(ctx, owner); !ok {
+		return nil, errors.Wrapf(types.ErrProviderNotFound, "id: %s", msg.Owner)
+	}
+
+	if err := ms.keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, msg.Attributes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignProviderAttributesResponse{}, nil
+}
+
+func (ms msgServer) DeleteProviderAttributes(goCtx context.Context, msg *types.MsgDeleteProviderAttributes) (*types.MsgDeleteProviderAttributesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	auditor, err := sdk.AccAddressFromBech32(msg.Auditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.DeleteProviderAttributes(ctx, owner, auditor, msg.Keys); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteProviderAttributesResponse{}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// AllProvidersAttributes returns the signed attributes of all providers
+func (k Querier) AllProvidersAttributes(c context.Context, req *types.QueryAllProvidersAttributesRequest) (*types.QueryProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.skey), providerPrefix)
+
+	return k.paginate(store, req.Pagination)
+}
+
+// ProviderAttributes returns the attributes signed for a provider by all auditors
+func (k Querier) ProviderAttributes(c context.Context, req *types.QueryProviderAttributesRequest) (*types.QueryProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.skey), providerPrefixKey(owner))
+
+	return k.paginate(store, req.Pagination)
+}
+
+// ProviderAuditorAttributes returns the attributes signed for a provider by an auditor
+func (k Querier) ProviderAuditorAttributes(c context.Context, req *types.QueryProviderAuditorRequest) (*types.QueryProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	auditor, err := sdk.AccAddressFromBech32(req.Auditor)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	provider, found := k.GetProviderAuditorAttributes(ctx, owner, auditor)
+	if !found {
+		return nil, types.ErrNoAttributes
+	}
+
+	return &types.QueryProvidersResponse{
+		Providers: types.Providers{provider},
+	}, nil
+}
+
+func (k Querier) paginate(store prefix.Store, page *sdkquery.PageRequest) (*types.QueryProvidersResponse, error) {
+	var providers types.Providers
+
+	pageRes, err := sdkquery.Paginate(store, page, func(key []byte, value []byte) error {
+		var provider types.Provider
+
+		err := k.cdc.UnmarshalBinaryBare(value, &provider)
+		if err != nil {
+			return err
+		}
+
+		providers = append(providers, provider)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProvidersResponse{
+		Providers:  providers,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/types"
+	atypes "github.com/ovrclk/akash/x/audit/types"
+)
+
+// Keeper of the audit store
+type Keeper struct {
+	skey sdk.StoreKey
+	cdc  codec.BinaryMarshaler
+}
+
+// NewKeeper creates and returns an instance for audit keeper
+func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey) Keeper {
+	return Keeper{
+		skey: skey,
+		cdc:  cdc,
+	}
+}
+
+// Codec returns keeper codec
+func (k Keeper) Codec() codec.BinaryMarshaler {
+	return k.cdc
+}
+
+// GetProviderAttributes returns the attributes signed for a provider by all auditors
+func (k Keeper) GetProviderAttributes(ctx sdk.Context, owner sdk.Address) (atypes.Providers, bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, providerPrefixKey(owner))
+	defer iter.Close()
+
+	var providers atypes.Providers
+	for ; iter.Valid(); iter.Next() {
+		var val atypes.Provider
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		providers = append(providers, val)
+	}
+
+	return providers, len(providers) != 0
+}
+
+// GetProviderAuditorAttributes returns the attributes signed for a provider by the given auditor
+func (k Keeper) GetProviderAuditorAttributes(ctx sdk.Context, owner, auditor sdk.Address) (atypes.Provider, bool) {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(owner, auditor)
+
+	buf := store.Get(key)
+	if buf == nil {
+		return atypes.Provider{}, false
+	}
+
+	var val atypes.Provider
+	k.cdc.MustUnmarshalBinaryBare(buf, &val)
+	return val, true
+}
+
+// WithProviders iterates the signed attributes of all providers
+func (k Keeper) WithProviders(ctx sdk.Context, fn func(atypes.Provider) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, providerPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val atypes.Provider
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// CreateOrUpdateProviderAttributes signs attributes for a provider on behalf of an auditor.
+// Attributes signed earlier by the same auditor are kept unless overwritten by key.
+func (k Keeper) CreateOrUpdateProviderAttributes(ctx sdk.Context, owner, auditor sdk.AccAddress, attributes types.Attributes) error {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(owner, auditor)
+
+	prov := atypes.Provider{
+		Owner:   owner.String(),
+		Auditor: auditor.String(),
+	}
+
+	if buf := store.Get(key); buf != nil {
+		k.cdc.MustUnmarshalBinaryBare(buf, &prov)
+	}
+
+	values := make(map[string]string, len(prov.Attributes)+len(attributes))
+	for _, attr := range prov.Attributes {
+		values[attr.Key] = attr.Value
+	}
+	for _, attr := range attributes {
+		values[attr.Key] = attr.Value
+	}
+
+	prov.Attributes = attributesFromMap(values)
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&prov))
+
+	ctx.EventManager().EmitEvent(
+		atypes.NewEventProviderAttributesSigned(owner, auditor).ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// DeleteProviderAttributes removes attributes an auditor signed for a provider.
+// All attributes are removed when no keys are given.
+func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, owner, auditor sdk.AccAddress, keys []string) error {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(owner, auditor)
+
+	buf := store.Get(key)
+	if buf == nil {
+		return atypes.ErrNoAttributes
+	}
+
+	var prov atypes.Provider
+	k.cdc.MustUnmarshalBinaryBare(buf, &prov)
+
+	values := make(map[string]string, len(prov.Attributes))
+	if len(keys) != 0 {
+		for _, attr := range prov.Attributes {
+			values[attr.Key] = attr.Value
+		}
+		for _, attrKey := range keys {
+			delete(values, attrKey)
+		}
+	}
+
+	if len(values) == 0 {
+		store.Delete(key)
+	} else {
+		prov.Attributes = attributesFromMap(values)
+		store.Set(key, k.cdc.MustMarshalBinaryBare(&prov))
+	}
+
+	ctx.EventManager().EmitEvent(
+		atypes.NewEventProviderAttributesDeleted(owner, auditor).ToSDKEvent(),
+	)
+
+	return nil
+}
+
+func attributesFromMap(values map[string]string) types.Attributes {
+	attributes := make(types.Attributes, 0, len(values))
+	for key, value := range values {
+		attributes = append(attributes, types.NewStringAttribute(key, value))
+	}
+
+	// keep ordering stable
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+
+	return attributes
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/testutil"
+	akashtypes "github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+func TestProviderAttributesCreate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	auditor := testutil.AccAddress(t)
+
+	attrs := akashtypes.Attributes{
+		akashtypes.NewStringAttribute("region", "us-west"),
+		akashtypes.NewStringAttribute("tier", "gold"),
+	}
+
+	err := keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, attrs)
+	require.NoError(t, err)
+
+	prov, found := keeper.GetProviderAuditorAttributes(ctx, owner, auditor)
+	require.True(t, found)
+	require.Equal(t, owner.String(), prov.Owner)
+	require.Equal(t, auditor.String(), prov.Auditor)
+	require.Equal(t, attrs, prov.Attributes)
+
+	provs, found := keeper.GetProviderAttributes(ctx, owner)
+	require.True(t, found)
+	require.Len(t, provs, 1)
+}
+
+func TestProviderAttributesMerge(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	auditor := testutil.AccAddress(t)
+
+	err := keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, akashtypes.Attributes{
+		akashtypes.NewStringAttribute("tier", "silver"),
+		akashtypes.NewStringAttribute("region", "us-west"),
+	})
+	require.NoError(t, err)
+
+	err = keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, akashtypes.Attributes{
+		akashtypes.NewStringAttribute("tier", "gold"),
+	})
+	require.NoError(t, err)
+
+	prov, found := keeper.GetProviderAuditorAttributes(ctx, owner, auditor)
+	require.True(t, found)
+	require.Equal(t, akashtypes.Attributes{
+		akashtypes.NewStringAttribute("region", "us-west"),
+		akashtypes.NewStringAttribute("tier", "gold"),
+	}, prov.Attributes)
+}
+
+func TestProviderAttributesMultipleAuditors(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	attrs := testutil.Attributes(t)
+
+	for i := 0; i < 3; i++ {
+		err := keeper.CreateOrUpdateProviderAttributes(ctx, owner, testutil.AccAddress(t), attrs)
+		require.NoError(t, err)
+	}
+
+	err := keeper.CreateOrUpdateProviderAttributes(ctx, testutil.AccAddress(t), testutil.AccAddress(t), attrs)
+	require.NoError(t, err)
+
+	provs, found := keeper.GetProviderAttributes(ctx, owner)
+	require.True(t, found)
+	require.Len(t, provs, 3)
+
+	count := 0
+	keeper.WithProviders(ctx, func(types.Provider) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 4, count)
+}
+
+func TestProviderAttributesGetNonExisting(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+
+	_, found := keeper.GetProviderAttributes(ctx, owner)
+	require.False(t, found)
+
+	prov, found := keeper.GetProviderAuditorAttributes(ctx, owner, testutil.AccAddress(t))
+	require.False(t, found)
+	require.Equal(t, types.Provider{}, prov)
+}
+
+func TestProviderAttributesDeleteKeys(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	auditor := testutil.AccAddress(t)
+
+	err := keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, akashtypes.Attributes{
+		akashtypes.NewStringAttribute("region", "us-west"),
+		akashtypes.NewStringAttribute("tier", "gold"),
+	})
+	require.NoError(t, err)
+
+	err = keeper.DeleteProviderAttributes(ctx, owner, auditor, []string{"tier"})
+	require.NoError(t, err)
+
+	prov, found := keeper.GetProviderAuditorAttributes(ctx, owner, auditor)
+	require.True(t, found)
+	require.Equal(t, akashtypes.Attributes{
+		akashtypes.NewStringAttribute("region", "us-west"),
+	}, prov.Attributes)
+
+	err = keeper.DeleteProviderAttributes(ctx, owner, auditor, []string{"region"})
+	require.NoError(t, err)
+
+	_, found = keeper.GetProviderAuditorAttributes(ctx, owner, auditor)
+	require.False(t, found)
+}
+
+func TestProviderAttributesDeleteAll(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	auditor := testutil.AccAddress(t)
+
+	err := keeper.CreateOrUpdateProviderAttributes(ctx, owner, auditor, testutil.Attributes(t))
+	require.NoError(t, err)
+
+	err = keeper.DeleteProviderAttributes(ctx, owner, auditor, nil)
+	require.NoError(t, err)
+
+	_, found := keeper.GetProviderAuditorAttributes(ctx, owner, auditor)
+	require.False(t, found)
+}
+
+func TestProviderAttributesDeleteNonExisting(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	err := keeper.DeleteProviderAttributes(ctx, testutil.AccAddress(t), testutil.AccAddress(t), nil)
+	require.EqualError(t, err, types.ErrNoAttributes.Error())
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	providerPrefix = []byte{0x01}
+)
+
+func providerKey(owner, auditor sdk.Address) []byte {
+	buf := bytes.NewBuffer(providerPrefix)
+	buf.Write(owner.Bytes())
+	buf.Write(auditor.Bytes())
+	return buf.Bytes()
+}
+
+func providerPrefixKey(owner sdk.Address) []byte {
+	buf := bytes.NewBuffer(providerPrefix)
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	errProviderNotFound uint32 = iota + 1
+	errInvalidAddress
+	errAttributes
+	errDuplicateAttributes
+	errNoAttributes
+)
+
+var (
+	// ErrProviderNotFound provider not found
+	ErrProviderNotFound = sdkerrors.Register(ModuleName, errProviderNotFound, "invalid provider: address not found")
+
+	// ErrInvalidAddress invalid owner or auditor address
+	ErrInvalidAddress = sdkerrors.Register(ModuleName, errInvalidAddress, "invalid address")
+
+	// ErrAttributes error code for signed attribute problems
+	ErrAttributes = sdkerrors.Register(ModuleName, errAttributes, "attribute specification error")
+
+	// ErrDuplicateAttributes duplicates are prohibited
+	ErrDuplicateAttributes = sdkerrors.Register(ModuleName, errDuplicateAttributes, "attributes cannot have duplicates")
+
+	// ErrNoAttributes no attributes have been signed by the auditor
+	ErrNoAttributes = sdkerrors.Register(ModuleName, errNoAttributes, "no signed attributes found")
+)
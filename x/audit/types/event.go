@@ -0,0 +1,118 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+const (
+	evActionAttributesSigned  = "provider-attributes-signed"
+	evActionAttributesDeleted = "provider-attributes-deleted"
+	evOwnerKey                = "owner"
+	evAuditorKey              = "auditor"
+)
+
+// EventProviderAttributesSigned struct
+type EventProviderAttributesSigned struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	Owner   sdk.AccAddress          `json:"owner"`
+	Auditor sdk.AccAddress          `json:"auditor"`
+}
+
+func NewEventProviderAttributesSigned(owner, auditor sdk.AccAddress) EventProviderAttributesSigned {
+	return EventProviderAttributesSigned{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: evActionAttributesSigned,
+		},
+		Owner:   owner,
+		Auditor: auditor,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderAttributesSigned struct
+func (ev EventProviderAttributesSigned) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionAttributesSigned),
+		}, ProviderEVAttributes(ev.Owner, ev.Auditor)...)...,
+	)
+}
+
+// EventProviderAttributesDeleted struct
+type EventProviderAttributesDeleted struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	Owner   sdk.AccAddress          `json:"owner"`
+	Auditor sdk.AccAddress          `json:"auditor"`
+}
+
+func NewEventProviderAttributesDeleted(owner, auditor sdk.AccAddress) EventProviderAttributesDeleted {
+	return EventProviderAttributesDeleted{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: evActionAttributesDeleted,
+		},
+		Owner:   owner,
+		Auditor: auditor,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderAttributesDeleted struct
+func (ev EventProviderAttributesDeleted) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionAttributesDeleted),
+		}, ProviderEVAttributes(ev.Owner, ev.Auditor)...)...,
+	)
+}
+
+// ProviderEVAttributes returns event attribues for given provider and auditor
+func ProviderEVAttributes(owner, auditor sdk.AccAddress) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(evOwnerKey, owner.String()),
+		sdk.NewAttribute(evAuditorKey, auditor.String()),
+	}
+}
+
+// ParseEVProvider returns provider and auditor details for given event attributes
+func ParseEVProvider(attrs []sdk.Attribute) (sdk.AccAddress, sdk.AccAddress, error) {
+	owner, err := sdkutil.GetAccAddress(attrs, evOwnerKey)
+	if err != nil {
+		return sdk.AccAddress{}, sdk.AccAddress{}, err
+	}
+
+	auditor, err := sdkutil.GetAccAddress(attrs, evAuditorKey)
+	if err != nil {
+		return sdk.AccAddress{}, sdk.AccAddress{}, err
+	}
+
+	return owner, auditor, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+	switch ev.Action {
+	case evActionAttributesSigned:
+		owner, auditor, err := ParseEVProvider(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return NewEventProviderAttributesSigned(owner, auditor), nil
+	case evActionAttributesDeleted:
+		owner, auditor, err := ParseEVProvider(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return NewEventProviderAttributesDeleted(owner, auditor), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "audit"
+
+	// StoreKey is the store key string for audit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for audit
+	RouterKey = ModuleName
+)
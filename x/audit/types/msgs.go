@@ -0,0 +1,124 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ovrclk/akash/types"
+)
+
+const (
+	MsgTypeSignProviderAttributes   = "audit-sign-provider-attributes"
+	MsgTypeDeleteProviderAttributes = "audit-delete-provider-attributes"
+)
+
+var (
+	_, _ sdk.Msg = &MsgSignProviderAttributes{}, &MsgDeleteProviderAttributes{}
+)
+
+// NewMsgSignProviderAttributes creates a new MsgSignProviderAttributes instance
+func NewMsgSignProviderAttributes(owner, auditor sdk.AccAddress, attributes types.Attributes) *MsgSignProviderAttributes {
+	return &MsgSignProviderAttributes{
+		Owner:      owner.String(),
+		Auditor:    auditor.String(),
+		Attributes: attributes,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSignProviderAttributes) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSignProviderAttributes) Type() string { return MsgTypeSignProviderAttributes }
+
+// ValidateBasic does basic validation of owner, auditor and attributes
+func (msg MsgSignProviderAttributes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgSignProviderAttributes: Invalid Owner Address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Auditor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgSignProviderAttributes: Invalid Auditor Address")
+	}
+	if len(msg.Attributes) == 0 {
+		return sdkerrors.Wrap(ErrAttributes, "MsgSignProviderAttributes: no attributes to sign")
+	}
+	return validateAttributes(msg.Attributes)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSignProviderAttributes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSignProviderAttributes) GetSigners() []sdk.AccAddress {
+	auditor, err := sdk.AccAddressFromBech32(msg.Auditor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{auditor}
+}
+
+// NewMsgDeleteProviderAttributes creates a new MsgDeleteProviderAttributes instance
+func NewMsgDeleteProviderAttributes(owner, auditor sdk.AccAddress, keys []string) *MsgDeleteProviderAttributes {
+	return &MsgDeleteProviderAttributes{
+		Owner:   owner.String(),
+		Auditor: auditor.String(),
+		Keys:    keys,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDeleteProviderAttributes) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDeleteProviderAttributes) Type() string { return MsgTypeDeleteProviderAttributes }
+
+// ValidateBasic does basic validation of owner and auditor
+func (msg MsgDeleteProviderAttributes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgDeleteProviderAttributes: Invalid Owner Address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Auditor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgDeleteProviderAttributes: Invalid Auditor Address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeleteProviderAttributes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeleteProviderAttributes) GetSigners() []sdk.AccAddress {
+	auditor, err := sdk.AccAddressFromBech32(msg.Auditor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{auditor}
+}
+
+func validateAttributes(attrs types.Attributes) error {
+	store := make(map[string]bool)
+
+	for i := range attrs {
+		if attrs[i].Key == "" {
+			return sdkerrors.Wrap(ErrAttributes, "attribute key cannot be empty")
+		}
+
+		if attrs[i].Operator != types.AttributeOperatorEqual {
+			return sdkerrors.Wrapf(ErrAttributes, "%s: signed attributes cannot have operators", attrs[i].Key)
+		}
+
+		if _, ok := store[attrs[i].Key]; ok {
+			return ErrDuplicateAttributes
+		}
+
+		store[attrs[i].Key] = true
+	}
+
+	return nil
+}
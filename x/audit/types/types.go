@@ -0,0 +1,36 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// String implements the Stringer interface for a Provider object.
+func (p Provider) String() string {
+	return fmt.Sprintf(`Provider
+	Owner:   %s
+	Auditor: %s
+	Attributes: %v
+	`, p.Owner, p.Auditor, p.Attributes)
+}
+
+// Providers is the collection of Provider
+type Providers []Provider
+
+// String implements the Stringer interface for a Providers object.
+func (obj Providers) String() string {
+	var buf bytes.Buffer
+
+	const sep = "\n\n"
+
+	for _, p := range obj {
+		buf.WriteString(p.String())
+		buf.WriteString(sep)
+	}
+
+	if len(obj) > 0 {
+		buf.Truncate(buf.Len() - len(sep))
+	}
+
+	return buf.String()
+}
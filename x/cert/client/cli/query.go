@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+const (
+	flagOwner  = "owner"
+	flagSerial = "serial"
+	flagState  = "state"
+)
+
+// GetQueryCmd returns the query commands for the cert module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Certificate query commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		cmdGetCertificates(),
+	)
+
+	return cmd
+}
+
+func cmdGetCertificates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query for all certificates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filter, err := certFiltersFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Certificates(context.Background(), &types.QueryCertificatesRequest{
+				Filter:     filter,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "certificates")
+	cmd.Flags().String(flagOwner, "", "filter certificates by owner")
+	cmd.Flags().String(flagSerial, "", "filter certificates by serial number, requires owner")
+	cmd.Flags().String(flagState, "", "filter certificates by state: valid|revoked")
+
+	return cmd
+}
+
+func certFiltersFromFlags(cmd *cobra.Command) (types.CertificateFilter, error) {
+	var filter types.CertificateFilter
+	var err error
+
+	if filter.Owner, err = cmd.Flags().GetString(flagOwner); err != nil {
+		return filter, err
+	}
+
+	if filter.Serial, err = cmd.Flags().GetString(flagSerial); err != nil {
+		return filter, err
+	}
+
+	if filter.State, err = cmd.Flags().GetString(flagState); err != nil {
+		return filter, err
+	}
+
+	return filter, filter.Validate()
+}
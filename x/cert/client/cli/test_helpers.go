@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdktest "github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+)
+
+// TxCreateCertificateExec is used for testing create certificate tx.
+// The certificate is stored in the home directory of the client context.
+func TxCreateCertificateExec(clientCtx client.Context, from fmt.Stringer, extraArgs ...string) (sdktest.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--from=%s", from.String()),
+	}
+
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmdCreate(), args)
+}
+
+// TxRevokeCertificateExec is used for testing revoke certificate tx
+func TxRevokeCertificateExec(clientCtx client.Context, from fmt.Stringer, extraArgs ...string) (sdktest.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--from=%s", from.String()),
+	}
+
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmdRevoke(), args)
+}
+
+// QueryCertificatesExec is used for testing certificates query
+func QueryCertificatesExec(clientCtx client.Context, args ...string) (sdktest.BufferWriter, error) {
+	return clitestutil.ExecTestCLICmd(clientCtx, cmdGetCertificates(), args)
+}
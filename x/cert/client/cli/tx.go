@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/cert/types"
+	"github.com/ovrclk/akash/x/cert/utils"
+)
+
+const (
+	flagValidity = "validity"
+)
+
+// GetTxCmd returns the transaction commands for cert module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Certificate transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		cmdCreate(),
+		cmdRevoke(),
+	)
+	return cmd
+}
+
+func cmdCreate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [host...]",
+		Short: "Generate a certificate for the account, store it in the home directory and publish it",
+		Long: "Generate a self-signed certificate and key for the account and publish the certificate.\n" +
+			"Providers pass the hosts their gateway is reachable at so that the certificate can serve TLS.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validity, err := cmd.Flags().GetDuration(flagValidity)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+			path := utils.CertificateFile(clientCtx.HomeDir, owner)
+
+			if _, err := os.Stat(path); err == nil {
+				return errors.Errorf("certificate file %s already exists", path)
+			}
+
+			certPEM, keyPEM, err := utils.GenerateCertificate(owner, args, validity)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateCertificate(owner, certPEM)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// keep the key before publishing so a published certificate is never left without it
+			if err := ioutil.WriteFile(path, append(certPEM, keyPEM...), 0600); err != nil {
+				return err
+			}
+
+			if err := tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg); err != nil {
+				_ = os.Remove(path)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Duration(flagValidity, utils.DefaultValidity, "certificate validity period")
+
+	return cmd
+}
+
+func cmdRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [serial]",
+		Short: "Revoke a certificate of the account, by default the one stored in the home directory",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			var serial string
+			if len(args) == 1 {
+				serial = args[0]
+			} else {
+				cert, err := utils.LoadAccountCertificate(clientCtx.HomeDir, owner)
+				if err != nil {
+					return err
+				}
+				serial = cert.Leaf.SerialNumber.String()
+			}
+
+			msg := types.NewMsgRevokeCertificate(types.CertificateID{
+				Owner:  owner.String(),
+				Serial: serial,
+			})
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
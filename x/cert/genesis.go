@@ -0,0 +1,75 @@
+package cert
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// ValidateGenesis does validation check of the Genesis and returns error incase of failure
+func ValidateGenesis(data *types.GenesisState) error {
+	for _, cert := range data.Certificates {
+		msg := types.MsgCreateCertificate{
+			Owner: cert.Owner,
+			Cert:  cert.Certificate.Cert,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if cert.Certificate.State == types.CertificateStateInvalid {
+			return types.ErrInvalidState
+		}
+	}
+	return nil
+}
+
+// InitGenesis initiate genesis state and return updated validator details
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	for _, cert := range data.Certificates {
+		owner, err := sdk.AccAddressFromBech32(cert.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := keeper.CreateCertificate(ctx, owner, cert.Certificate.Cert); err != nil {
+			panic(err)
+		}
+
+		if cert.Certificate.State == types.CertificateRevoked {
+			x509cert, err := types.ParseAndValidateCertificate(owner, cert.Certificate.Cert)
+			if err != nil {
+				panic(err)
+			}
+
+			if err := keeper.RevokeCertificate(ctx, types.ToCertificateID(owner, x509cert.SerialNumber)); err != nil {
+				panic(err)
+			}
+		}
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns genesis state as raw bytes for the cert module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var certificates []types.GenesisCertificate
+
+	k.WithCertificates(ctx, func(id types.CertificateID, cert types.Certificate) bool {
+		certificates = append(certificates, types.GenesisCertificate{
+			Owner:       id.Owner,
+			Certificate: cert,
+		})
+		return false
+	})
+
+	return &types.GenesisState{
+		Certificates: certificates,
+	}
+}
+
+// DefaultGenesisState returns default genesis state as raw bytes for the cert
+// module.
+func DefaultGenesisState() *types.GenesisState {
+	return &types.GenesisState{}
+}
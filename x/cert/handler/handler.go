@@ -0,0 +1,29 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// NewHandler returns a handler for "cert" type messages.
+func NewHandler(keeper keeper.Keeper) sdk.Handler {
+	ms := NewMsgServerImpl(keeper)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case *types.MsgCreateCertificate:
+			res, err := ms.CreateCertificate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeCertificate:
+			res, err := ms.RevokeCertificate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cert message type: %T", msg)
+		}
+	}
+}
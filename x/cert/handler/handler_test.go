@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdktestdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/cert/handler"
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+	"github.com/ovrclk/akash/x/cert/utils"
+)
+
+type testSuite struct {
+	t       testing.TB
+	ms      sdk.CommitMultiStore
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	handler sdk.Handler
+}
+
+func setupTestSuite(t *testing.T) *testSuite {
+	suite := &testSuite{
+		t: t,
+	}
+
+	cKey := sdk.NewTransientStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	suite.ms = store.NewCommitMultiStore(db)
+	suite.ms.MountStoreWithDB(cKey, sdk.StoreTypeIAVL, db)
+
+	err := suite.ms.LoadLatestVersion()
+	require.NoError(t, err)
+
+	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
+
+	suite.keeper = keeper.NewKeeper(types.ModuleCdc, cKey)
+
+	suite.handler = handler.NewHandler(suite.keeper)
+
+	return suite
+}
+
+func TestCertBadMessageType(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	res, err := suite.handler(suite.ctx, sdk.Msg(sdktestdata.NewTestMsg()))
+	require.Nil(t, res)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, sdkerrors.ErrUnknownRequest))
+}
+
+func TestCreateCertificateValid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	owner := testutil.AccAddress(t)
+	certPEM, serial := suite.createCertificate(owner)
+
+	msg := types.NewMsgCreateCertificate(owner, certPEM)
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	id := types.CertificateID{Owner: owner.String(), Serial: serial}
+
+	t.Run("ensure event created", func(t *testing.T) {
+		iev, err := types.ParseEvent(testutil.ParseEvent(t, res.Events))
+		require.NoError(t, err)
+		require.Equal(t, types.NewEventCertificateCreated(id), iev)
+	})
+
+	cert, found := suite.keeper.GetCertificateByID(suite.ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.CertificateValid, cert.State)
+}
+
+func TestCreateCertificateInvalid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	certPEM, _ := suite.createCertificate(testutil.AccAddress(t))
+
+	msg := types.NewMsgCreateCertificate(testutil.AccAddress(t), certPEM)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.NewMsgCreateCertificate(testutil.AccAddress(t), []byte("not a certificate"))
+	require.Error(t, msg.ValidateBasic())
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrInvalidCertificateValue))
+}
+
+func TestRevokeCertificateNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	msg := types.NewMsgRevokeCertificate(types.CertificateID{
+		Owner:  testutil.AccAddress(t).String(),
+		Serial: "1",
+	})
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrCertificateNotFound.Error())
+}
+
+func TestRevokeCertificateValid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	owner := testutil.AccAddress(t)
+	certPEM, serial := suite.createCertificate(owner)
+
+	err := suite.keeper.CreateCertificate(suite.ctx, owner, certPEM)
+	require.NoError(t, err)
+
+	id := types.CertificateID{Owner: owner.String(), Serial: serial}
+	msg := types.NewMsgRevokeCertificate(id)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	t.Run("ensure event created", func(t *testing.T) {
+		iev, err := types.ParseEvent(testutil.ParseEvent(t, res.Events[1:]))
+		require.NoError(t, err)
+		require.Equal(t, types.NewEventCertificateRevoked(id), iev)
+	})
+
+	cert, found := suite.keeper.GetCertificateByID(suite.ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.CertificateRevoked, cert.State)
+}
+
+func (st *testSuite) createCertificate(owner sdk.AccAddress) ([]byte, string) {
+	st.t.Helper()
+
+	certPEM, _, err := utils.GenerateCertificate(owner, nil, time.Hour)
+	require.NoError(st.t, err)
+
+	blk, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(blk.Bytes)
+	require.NoError(st.t, err)
+
+	return certPEM, cert.SerialNumber.String()
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+type msgServer struct {
+	keeper keeper.Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the cert MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k keeper.Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (ms msgServer) CreateCertificate(goCtx context.Context, msg *types.MsgCreateCertificate) (*types.MsgCreateCertificateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.CreateCertificate(ctx, owner, msg.Cert); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateCertificateResponse{}, nil
+}
+
+func (ms msgServer) RevokeCertificate(goCtx context.Context, msg *types.MsgRevokeCertificate) (*types.MsgRevokeCertificateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.RevokeCertificate(ctx, msg.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeCertificateResponse{}, nil
+}
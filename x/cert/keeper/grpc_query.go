@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Certificates returns certificates based on filters
+func (k Querier) Certificates(c context.Context, req *types.QueryCertificatesRequest) (*types.QueryCertificatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.Filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Filter.Serial != "" {
+		id := types.CertificateID{
+			Owner:  req.Filter.Owner,
+			Serial: req.Filter.Serial,
+		}
+
+		var certificates types.CertificatesResponse
+
+		cert, found := k.GetCertificateByID(ctx, id)
+		if found && cert.IsState(req.Filter.State) {
+			certificates = append(certificates, types.CertificateResponse{
+				Certificate: cert,
+				Serial:      id.Serial,
+			})
+		}
+
+		return &types.QueryCertificatesResponse{
+			Certificates: certificates,
+		}, nil
+	}
+
+	// keys of the owner store hold the serial number only
+	var store prefix.Store
+	var keySerial func([]byte) *big.Int
+
+	if req.Filter.Owner != "" {
+		owner, _ := sdk.AccAddressFromBech32(req.Filter.Owner)
+		store = prefix.NewStore(ctx.KVStore(k.skey), certificatePrefixKey(owner))
+		keySerial = func(key []byte) *big.Int {
+			return new(big.Int).SetBytes(key)
+		}
+	} else {
+		store = prefix.NewStore(ctx.KVStore(k.skey), certificatePrefix)
+		keySerial = func(key []byte) *big.Int {
+			_, serial := parseCertificateKey(key)
+			return serial
+		}
+	}
+
+	var certificates types.CertificatesResponse
+
+	pageRes, err := sdkquery.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var cert types.Certificate
+
+		err := k.cdc.UnmarshalBinaryBare(value, &cert)
+		if err != nil {
+			return false, err
+		}
+
+		if !cert.IsState(req.Filter.State) {
+			return false, nil
+		}
+
+		if accumulate {
+			certificates = append(certificates, types.CertificateResponse{
+				Certificate: cert,
+				Serial:      keySerial(key).String(),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCertificatesResponse{
+		Certificates: certificates,
+		Pagination:   pageRes,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// Keeper of the cert store
+type Keeper struct {
+	skey sdk.StoreKey
+	cdc  codec.BinaryMarshaler
+}
+
+// NewKeeper creates and returns an instance for cert keeper
+func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey) Keeper {
+	return Keeper{
+		skey: skey,
+		cdc:  cdc,
+	}
+}
+
+// Codec returns keeper codec
+func (k Keeper) Codec() codec.BinaryMarshaler {
+	return k.cdc
+}
+
+// CreateCertificate publishes a certificate for the owner.
+// The certificate subject must name the owner and its serial number must not have been used by the owner before.
+func (k Keeper) CreateCertificate(ctx sdk.Context, owner sdk.AccAddress, pemCert []byte) error {
+	cert, err := types.ParseAndValidateCertificate(owner, pemCert)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.skey)
+	key := certificateKey(owner, cert.SerialNumber)
+
+	if store.Has(key) {
+		return types.ErrCertificateExists
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&types.Certificate{
+		State: types.CertificateValid,
+		Cert:  pemCert,
+	}))
+
+	ctx.EventManager().EmitEvent(
+		types.NewEventCertificateCreated(types.ToCertificateID(owner, cert.SerialNumber)).ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// RevokeCertificate marks a valid certificate as revoked
+func (k Keeper) RevokeCertificate(ctx sdk.Context, id types.CertificateID) error {
+	owner, serial, err := parseCertificateID(id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.skey)
+	key := certificateKey(owner, serial)
+
+	buf := store.Get(key)
+	if buf == nil {
+		return types.ErrCertificateNotFound
+	}
+
+	var cert types.Certificate
+	k.cdc.MustUnmarshalBinaryBare(buf, &cert)
+
+	if cert.State == types.CertificateRevoked {
+		return types.ErrCertificateAlreadyRevoked
+	}
+
+	cert.State = types.CertificateRevoked
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&cert))
+
+	ctx.EventManager().EmitEvent(
+		types.NewEventCertificateRevoked(id).ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// GetCertificateByID returns the certificate with given id
+func (k Keeper) GetCertificateByID(ctx sdk.Context, id types.CertificateID) (types.Certificate, bool) {
+	owner, serial, err := parseCertificateID(id)
+	if err != nil {
+		return types.Certificate{}, false
+	}
+
+	buf := ctx.KVStore(k.skey).Get(certificateKey(owner, serial))
+	if buf == nil {
+		return types.Certificate{}, false
+	}
+
+	var cert types.Certificate
+	k.cdc.MustUnmarshalBinaryBare(buf, &cert)
+	return cert, true
+}
+
+// WithCertificates iterates all certificates
+func (k Keeper) WithCertificates(ctx sdk.Context, fn func(id types.CertificateID, cert types.Certificate) bool) {
+	k.withCertificates(ctx, certificatePrefix, fn)
+}
+
+// WithOwner iterates all certificates published by the owner
+func (k Keeper) WithOwner(ctx sdk.Context, owner sdk.Address, fn func(id types.CertificateID, cert types.Certificate) bool) {
+	k.withCertificates(ctx, certificatePrefixKey(owner), fn)
+}
+
+func (k Keeper) withCertificates(ctx sdk.Context, pfx []byte, fn func(types.CertificateID, types.Certificate) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, pfx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Certificate
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+
+		owner, serial := parseCertificateKey(iter.Key()[len(certificatePrefix):])
+		if stop := fn(types.ToCertificateID(owner, serial), val); stop {
+			break
+		}
+	}
+}
+
+func parseCertificateID(id types.CertificateID) (sdk.AccAddress, *big.Int, error) {
+	owner, err := sdk.AccAddressFromBech32(id.Owner)
+	if err != nil {
+		return nil, nil, types.ErrInvalidAddress
+	}
+
+	serial, err := types.ParseSerial(id.Serial)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return owner, serial, nil
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/cert/keeper"
+	ctypes "github.com/ovrclk/akash/x/cert/types"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
+)
+
+func TestCertificateCreate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+
+	certPEM, serial := createCertificate(t, owner)
+
+	err := keeper.CreateCertificate(ctx, owner, certPEM)
+	require.NoError(t, err)
+
+	cert, found := keeper.GetCertificateByID(ctx, ctypes.CertificateID{Owner: owner.String(), Serial: serial})
+	require.True(t, found)
+	require.Equal(t, ctypes.CertificateValid, cert.State)
+	require.Equal(t, certPEM, cert.Cert)
+
+	err = keeper.CreateCertificate(ctx, owner, certPEM)
+	require.EqualError(t, err, ctypes.ErrCertificateExists.Error())
+}
+
+func TestCertificateCreateOtherOwner(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	certPEM, _ := createCertificate(t, testutil.AccAddress(t))
+
+	err := keeper.CreateCertificate(ctx, testutil.AccAddress(t), certPEM)
+	require.Error(t, err)
+	require.True(t, ctypes.ErrInvalidCertificateValue.Is(err))
+}
+
+func TestCertificateRevoke(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+
+	certPEM, serial := createCertificate(t, owner)
+	id := ctypes.CertificateID{Owner: owner.String(), Serial: serial}
+
+	err := keeper.RevokeCertificate(ctx, id)
+	require.EqualError(t, err, ctypes.ErrCertificateNotFound.Error())
+
+	require.NoError(t, keeper.CreateCertificate(ctx, owner, certPEM))
+	require.NoError(t, keeper.RevokeCertificate(ctx, id))
+
+	cert, found := keeper.GetCertificateByID(ctx, id)
+	require.True(t, found)
+	require.Equal(t, ctypes.CertificateRevoked, cert.State)
+
+	err = keeper.RevokeCertificate(ctx, id)
+	require.EqualError(t, err, ctypes.ErrCertificateAlreadyRevoked.Error())
+}
+
+func TestCertificateIterate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+
+	serials := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		certPEM, serial := createCertificate(t, owner)
+		require.NoError(t, keeper.CreateCertificate(ctx, owner, certPEM))
+		serials[serial] = true
+	}
+
+	other := testutil.AccAddress(t)
+	certPEM, _ := createCertificate(t, other)
+	require.NoError(t, keeper.CreateCertificate(ctx, other, certPEM))
+
+	count := 0
+	keeper.WithOwner(ctx, owner, func(id ctypes.CertificateID, _ ctypes.Certificate) bool {
+		require.Equal(t, owner.String(), id.Owner)
+		require.True(t, serials[id.Serial])
+		count++
+		return false
+	})
+	require.Equal(t, 3, count)
+
+	count = 0
+	keeper.WithCertificates(ctx, func(ctypes.CertificateID, ctypes.Certificate) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 4, count)
+}
+
+func TestGRPCQueryCertificates(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, types.NewInterfaceRegistry())
+	ctypes.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: k})
+	qclient := ctypes.NewQueryClient(queryHelper)
+
+	owner := testutil.AccAddress(t)
+
+	valid, validSerial := createCertificate(t, owner)
+	require.NoError(t, k.CreateCertificate(ctx, owner, valid))
+
+	revoked, revokedSerial := createCertificate(t, owner)
+	require.NoError(t, k.CreateCertificate(ctx, owner, revoked))
+	require.NoError(t, k.RevokeCertificate(ctx, ctypes.CertificateID{Owner: owner.String(), Serial: revokedSerial}))
+
+	other := testutil.AccAddress(t)
+	otherPEM, _ := createCertificate(t, other)
+	require.NoError(t, k.CreateCertificate(ctx, other, otherPEM))
+
+	tests := []struct {
+		msg     string
+		filter  ctypes.CertificateFilter
+		serials []string
+		expErr  bool
+	}{
+		{
+			msg:     "all certificates",
+			filter:  ctypes.CertificateFilter{},
+			serials: nil,
+		},
+		{
+			msg:     "owner certificates",
+			filter:  ctypes.CertificateFilter{Owner: owner.String()},
+			serials: []string{validSerial, revokedSerial},
+		},
+		{
+			msg:     "owner valid certificates",
+			filter:  ctypes.CertificateFilter{Owner: owner.String(), State: "valid"},
+			serials: []string{validSerial},
+		},
+		{
+			msg:     "owner certificate by serial",
+			filter:  ctypes.CertificateFilter{Owner: owner.String(), Serial: revokedSerial},
+			serials: []string{revokedSerial},
+		},
+		{
+			msg:     "revoked certificate by serial filtered as valid",
+			filter:  ctypes.CertificateFilter{Owner: owner.String(), Serial: revokedSerial, State: "valid"},
+			serials: []string{},
+		},
+		{
+			msg:    "serial without owner",
+			filter: ctypes.CertificateFilter{Serial: validSerial},
+			expErr: true,
+		},
+		{
+			msg:    "invalid state",
+			filter: ctypes.CertificateFilter{State: "expired"},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			res, err := qclient.Certificates(context.Background(), &ctypes.QueryCertificatesRequest{Filter: tc.filter})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.serials == nil {
+				require.Len(t, res.Certificates, 3)
+				return
+			}
+
+			serials := make([]string, 0, len(res.Certificates))
+			for _, cert := range res.Certificates {
+				serials = append(serials, cert.Serial)
+			}
+			require.ElementsMatch(t, tc.serials, serials)
+		})
+	}
+}
+
+func createCertificate(t testing.TB, owner sdk.AccAddress) ([]byte, string) {
+	t.Helper()
+
+	certPEM, _, err := cutils.GenerateCertificate(owner, nil, time.Hour)
+	require.NoError(t, err)
+
+	blk, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(blk.Bytes)
+	require.NoError(t, err)
+
+	return certPEM, cert.SerialNumber.String()
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(ctypes.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	return ctx, keeper.NewKeeper(ctypes.ModuleCdc, key)
+}
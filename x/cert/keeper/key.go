@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	certificatePrefix = []byte{0x01}
+)
+
+func certificateKey(owner sdk.Address, serial *big.Int) []byte {
+	buf := bytes.NewBuffer(certificatePrefix)
+	buf.Write(owner.Bytes())
+	buf.Write(serial.Bytes())
+	return buf.Bytes()
+}
+
+func certificatePrefixKey(owner sdk.Address) []byte {
+	buf := bytes.NewBuffer(certificatePrefix)
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
+
+// parseCertificateKey returns owner and serial number of a key stripped of certificatePrefix
+func parseCertificateKey(key []byte) (sdk.AccAddress, *big.Int) {
+	return sdk.AccAddress(key[:sdk.AddrLen]), new(big.Int).SetBytes(key[sdk.AddrLen:])
+}
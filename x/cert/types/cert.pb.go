@@ -0,0 +1,1688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/cert/v1beta1/cert.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// State is an enum which refers to state of certificate
+type Certificate_State int32
+
+const (
+	// Prefix should start with 0 in enum. So declaring dummy state
+	CertificateStateInvalid Certificate_State = 0
+	// CertificateValid denotes state for certificate which can be used for authentication
+	CertificateValid Certificate_State = 1
+	// CertificateRevoked denotes state for certificate revoked by its owner
+	CertificateRevoked Certificate_State = 2
+)
+
+var Certificate_State_name = map[int32]string{
+	0: "invalid",
+	1: "valid",
+	2: "revoked",
+}
+
+var Certificate_State_value = map[string]int32{
+	"invalid": 0,
+	"valid":   1,
+	"revoked": 2,
+}
+
+func (x Certificate_State) String() string {
+	return proto.EnumName(Certificate_State_name, int32(x))
+}
+
+func (Certificate_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{1, 0}
+}
+
+// CertificateID stores owner and serial number of a certificate
+type CertificateID struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Serial string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial" yaml:"serial"`
+}
+
+func (m *CertificateID) Reset()      { *m = CertificateID{} }
+func (*CertificateID) ProtoMessage() {}
+func (*CertificateID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{0}
+}
+func (m *CertificateID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertificateID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CertificateID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateID.Merge(m, src)
+}
+func (m *CertificateID) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateID) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateID proto.InternalMessageInfo
+
+func (m *CertificateID) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CertificateID) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+// Certificate stores the PEM encoded X.509 certificate published by an account
+type Certificate struct {
+	State Certificate_State `protobuf:"varint,1,opt,name=state,proto3,enum=akash.cert.v1beta1.Certificate_State" json:"state" yaml:"state"`
+	Cert  []byte            `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert" yaml:"cert"`
+}
+
+func (m *Certificate) Reset()         { *m = Certificate{} }
+func (m *Certificate) String() string { return proto.CompactTextString(m) }
+func (*Certificate) ProtoMessage()    {}
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{1}
+}
+func (m *Certificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Certificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Certificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Certificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Certificate.Merge(m, src)
+}
+func (m *Certificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Certificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Certificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Certificate proto.InternalMessageInfo
+
+func (m *Certificate) GetState() Certificate_State {
+	if m != nil {
+		return m.State
+	}
+	return CertificateStateInvalid
+}
+
+func (m *Certificate) GetCert() []byte {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+// CertificateFilter defines filters used to filter certificates
+type CertificateFilter struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Serial string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial" yaml:"serial"`
+	State  string `protobuf:"bytes,3,opt,name=state,proto3" json:"state" yaml:"state"`
+}
+
+func (m *CertificateFilter) Reset()         { *m = CertificateFilter{} }
+func (m *CertificateFilter) String() string { return proto.CompactTextString(m) }
+func (*CertificateFilter) ProtoMessage()    {}
+func (*CertificateFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{2}
+}
+func (m *CertificateFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertificateFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CertificateFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateFilter.Merge(m, src)
+}
+func (m *CertificateFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateFilter proto.InternalMessageInfo
+
+func (m *CertificateFilter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CertificateFilter) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *CertificateFilter) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+// MsgCreateCertificate defines an SDK message for publishing a certificate
+type MsgCreateCertificate struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Cert  []byte `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert" yaml:"cert"`
+}
+
+func (m *MsgCreateCertificate) Reset()         { *m = MsgCreateCertificate{} }
+func (m *MsgCreateCertificate) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCertificate) ProtoMessage()    {}
+func (*MsgCreateCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{3}
+}
+func (m *MsgCreateCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCertificate.Merge(m, src)
+}
+func (m *MsgCreateCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCertificate proto.InternalMessageInfo
+
+func (m *MsgCreateCertificate) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCreateCertificate) GetCert() []byte {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+// MsgCreateCertificateResponse defines the Msg/CreateCertificate response type.
+type MsgCreateCertificateResponse struct {
+}
+
+func (m *MsgCreateCertificateResponse) Reset()         { *m = MsgCreateCertificateResponse{} }
+func (m *MsgCreateCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCertificateResponse) ProtoMessage()    {}
+func (*MsgCreateCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{4}
+}
+func (m *MsgCreateCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCertificateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCertificateResponse.Merge(m, src)
+}
+func (m *MsgCreateCertificateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCertificateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCertificateResponse proto.InternalMessageInfo
+
+// MsgRevokeCertificate defines an SDK message for revoking a certificate
+type MsgRevokeCertificate struct {
+	ID CertificateID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+}
+
+func (m *MsgRevokeCertificate) Reset()         { *m = MsgRevokeCertificate{} }
+func (m *MsgRevokeCertificate) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCertificate) ProtoMessage()    {}
+func (*MsgRevokeCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{5}
+}
+func (m *MsgRevokeCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCertificate.Merge(m, src)
+}
+func (m *MsgRevokeCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCertificate proto.InternalMessageInfo
+
+func (m *MsgRevokeCertificate) GetID() CertificateID {
+	if m != nil {
+		return m.ID
+	}
+	return CertificateID{}
+}
+
+// MsgRevokeCertificateResponse defines the Msg/RevokeCertificate response type.
+type MsgRevokeCertificateResponse struct {
+}
+
+func (m *MsgRevokeCertificateResponse) Reset()         { *m = MsgRevokeCertificateResponse{} }
+func (m *MsgRevokeCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCertificateResponse) ProtoMessage()    {}
+func (*MsgRevokeCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bba68168592156e, []int{6}
+}
+func (m *MsgRevokeCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCertificateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCertificateResponse.Merge(m, src)
+}
+func (m *MsgRevokeCertificateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCertificateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCertificateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("akash.cert.v1beta1.Certificate_State", Certificate_State_name, Certificate_State_value)
+	proto.RegisterType((*CertificateID)(nil), "akash.cert.v1beta1.CertificateID")
+	proto.RegisterType((*Certificate)(nil), "akash.cert.v1beta1.Certificate")
+	proto.RegisterType((*CertificateFilter)(nil), "akash.cert.v1beta1.CertificateFilter")
+	proto.RegisterType((*MsgCreateCertificate)(nil), "akash.cert.v1beta1.MsgCreateCertificate")
+	proto.RegisterType((*MsgCreateCertificateResponse)(nil), "akash.cert.v1beta1.MsgCreateCertificateResponse")
+	proto.RegisterType((*MsgRevokeCertificate)(nil), "akash.cert.v1beta1.MsgRevokeCertificate")
+	proto.RegisterType((*MsgRevokeCertificateResponse)(nil), "akash.cert.v1beta1.MsgRevokeCertificateResponse")
+}
+
+func init() { proto.RegisterFile("akash/cert/v1beta1/cert.proto", fileDescriptor_6bba68168592156e) }
+
+var fileDescriptor_6bba68168592156e = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6d, 0xb7, 0x69, 0xe9, 0xa5, 0x45, 0xe9, 0x29, 0xa2, 0xc5, 0xa5, 0xbe, 0x70, 0x80,
+	0x14, 0x09, 0xc9, 0x6e, 0xd3, 0x2d, 0x03, 0x43, 0x1a, 0x21, 0x65, 0xa8, 0x84, 0x8c, 0xd4, 0x81,
+	0xcd, 0x89, 0x0f, 0xf7, 0x14, 0x37, 0x17, 0xd9, 0x47, 0xa0, 0x48, 0x88, 0x15, 0x65, 0x62, 0x83,
+	0x25, 0x52, 0x25, 0xbe, 0x00, 0x1f, 0xa3, 0x63, 0x47, 0xa6, 0x13, 0x4a, 0x06, 0x50, 0xc6, 0x7c,
+	0x02, 0xe4, 0x3b, 0x47, 0x35, 0x72, 0x80, 0x76, 0x61, 0xf3, 0x7b, 0xef, 0x7f, 0xf7, 0xff, 0xdd,
+	0x7b, 0xd6, 0x03, 0xbb, 0x5e, 0xd7, 0x8b, 0x4f, 0x9c, 0x0e, 0x89, 0xb8, 0x33, 0xd8, 0x6f, 0x13,
+	0xee, 0xed, 0xcb, 0xc0, 0xee, 0x47, 0x8c, 0x33, 0x08, 0x65, 0xd9, 0x96, 0x99, 0xb4, 0x6c, 0x96,
+	0x03, 0x16, 0x30, 0x59, 0x76, 0x92, 0x2f, 0xa5, 0xc4, 0xef, 0xc1, 0xc6, 0x21, 0x89, 0x38, 0x7d,
+	0x49, 0x3b, 0x1e, 0x27, 0xad, 0x26, 0x74, 0x40, 0x81, 0xbd, 0xee, 0x91, 0x68, 0x5b, 0xaf, 0xe8,
+	0xd5, 0xb5, 0xc6, 0xdd, 0xa9, 0x40, 0x2a, 0x31, 0x13, 0x68, 0xfd, 0xcc, 0x3b, 0x0d, 0xeb, 0x58,
+	0x86, 0xd8, 0x55, 0x69, 0x78, 0x00, 0x56, 0x62, 0x12, 0x51, 0x2f, 0xdc, 0x36, 0xe4, 0x89, 0x9d,
+	0xa9, 0x40, 0x69, 0x66, 0x26, 0xd0, 0x86, 0x3a, 0xa2, 0x62, 0xec, 0xa6, 0x85, 0xfa, 0xad, 0xcf,
+	0xe7, 0x48, 0xfb, 0x79, 0x8e, 0x34, 0xfc, 0xc9, 0x00, 0xc5, 0x0c, 0x01, 0x3c, 0x06, 0x85, 0x98,
+	0x7b, 0x9c, 0x48, 0xff, 0xdb, 0xb5, 0x47, 0x76, 0xfe, 0x29, 0x76, 0x46, 0x6f, 0x3f, 0x4f, 0xc4,
+	0x0a, 0x53, 0x9e, 0xbb, 0xc2, 0x94, 0x21, 0x76, 0x55, 0x1a, 0x3e, 0x06, 0xcb, 0xc9, 0x1d, 0x12,
+	0x72, 0xbd, 0xb1, 0x35, 0x15, 0x48, 0xc6, 0x33, 0x81, 0x8a, 0x4a, 0x9e, 0x44, 0xd8, 0x95, 0x49,
+	0xfc, 0x0e, 0x14, 0xe4, 0xbd, 0xb0, 0x0a, 0x56, 0x69, 0x6f, 0xe0, 0x85, 0xd4, 0x2f, 0x69, 0xe6,
+	0xce, 0x70, 0x54, 0xd9, 0xca, 0x78, 0x4b, 0x49, 0x4b, 0x95, 0x21, 0x02, 0x05, 0xa5, 0xd3, 0xcd,
+	0xf2, 0x70, 0x54, 0x29, 0x65, 0x74, 0xc7, 0x52, 0xf0, 0x00, 0xac, 0x46, 0x64, 0xc0, 0xba, 0xc4,
+	0x2f, 0x19, 0xe6, 0x9d, 0xe1, 0xa8, 0x02, 0x33, 0x12, 0x57, 0x55, 0xcc, 0xe5, 0x0f, 0x5f, 0x2c,
+	0xad, 0xbe, 0x2c, 0x3b, 0xf3, 0x55, 0x07, 0x9b, 0x19, 0xc9, 0x53, 0x1a, 0x72, 0x12, 0xfd, 0x9f,
+	0xf9, 0x24, 0x2e, 0x6a, 0x0a, 0x4b, 0x57, 0x2e, 0x7f, 0x6b, 0x6f, 0x8a, 0xfc, 0x16, 0x94, 0x8f,
+	0xe2, 0xe0, 0x30, 0x22, 0x1e, 0x27, 0xd9, 0xa1, 0xde, 0x18, 0xfa, 0x26, 0xd3, 0x4a, 0xbd, 0x2d,
+	0x70, 0x6f, 0x91, 0xb7, 0x4b, 0xe2, 0x3e, 0xeb, 0xc5, 0x04, 0xf7, 0x24, 0x9b, 0x6a, 0x74, 0x96,
+	0xed, 0x19, 0x30, 0xa8, 0x2f, 0xc1, 0x8a, 0xb5, 0xfb, 0xff, 0xf8, 0xdb, 0x5a, 0xcd, 0xc6, 0xee,
+	0x85, 0x40, 0xda, 0x58, 0x20, 0xa3, 0xd5, 0x9c, 0x0a, 0x64, 0x50, 0x7f, 0x26, 0xd0, 0x9a, 0x62,
+	0xa2, 0x3e, 0x76, 0x0d, 0xea, 0xff, 0xc6, 0x93, 0xf3, 0x9b, 0xf3, 0xd4, 0x7e, 0xe8, 0x60, 0xe9,
+	0x28, 0x0e, 0x20, 0x03, 0x9b, 0xf9, 0x86, 0x55, 0x17, 0x81, 0x2c, 0x7a, 0x9e, 0xb9, 0x77, 0x5d,
+	0xe5, 0xdc, 0x38, 0x31, 0xcc, 0x77, 0xe1, 0x4f, 0x86, 0x39, 0xa5, 0xb9, 0x77, 0x5d, 0xe5, 0xdc,
+	0xb0, 0xf1, 0xe4, 0x62, 0x6c, 0xe9, 0x97, 0x63, 0x4b, 0xff, 0x3e, 0xb6, 0xf4, 0x8f, 0x13, 0x4b,
+	0xbb, 0x9c, 0x58, 0xda, 0xb7, 0x89, 0xa5, 0xbd, 0x78, 0x18, 0x50, 0x7e, 0xf2, 0xaa, 0x6d, 0x77,
+	0xd8, 0xa9, 0xc3, 0x06, 0x51, 0x27, 0xec, 0x3a, 0x6a, 0xb1, 0xbd, 0x51, 0xab, 0x8d, 0x9f, 0xf5,
+	0x49, 0xdc, 0x5e, 0x91, 0xab, 0xea, 0xe0, 0xd7, 0x00, 0xb8, 0x96, 0x9a, 0xd7, 0xf5, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateCertificate defines a method to publish a certificate for an account
+	CreateCertificate(ctx context.Context, in *MsgCreateCertificate, opts ...grpc.CallOption) (*MsgCreateCertificateResponse, error)
+	// RevokeCertificate defines a method to revoke a published certificate
+	RevokeCertificate(ctx context.Context, in *MsgRevokeCertificate, opts ...grpc.CallOption) (*MsgRevokeCertificateResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateCertificate(ctx context.Context, in *MsgCreateCertificate, opts ...grpc.CallOption) (*MsgCreateCertificateResponse, error) {
+	out := new(MsgCreateCertificateResponse)
+	err := c.cc.Invoke(ctx, "/akash.cert.v1beta1.Msg/CreateCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeCertificate(ctx context.Context, in *MsgRevokeCertificate, opts ...grpc.CallOption) (*MsgRevokeCertificateResponse, error) {
+	out := new(MsgRevokeCertificateResponse)
+	err := c.cc.Invoke(ctx, "/akash.cert.v1beta1.Msg/RevokeCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateCertificate defines a method to publish a certificate for an account
+	CreateCertificate(context.Context, *MsgCreateCertificate) (*MsgCreateCertificateResponse, error)
+	// RevokeCertificate defines a method to revoke a published certificate
+	RevokeCertificate(context.Context, *MsgRevokeCertificate) (*MsgRevokeCertificateResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateCertificate(ctx context.Context, req *MsgCreateCertificate) (*MsgCreateCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCertificate not implemented")
+}
+func (*UnimplementedMsgServer) RevokeCertificate(ctx context.Context, req *MsgRevokeCertificate) (*MsgRevokeCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCertificate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.cert.v1beta1.Msg/CreateCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCertificate(ctx, req.(*MsgCreateCertificate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCertificate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.cert.v1beta1.Msg/RevokeCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCertificate(ctx, req.(*MsgRevokeCertificate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.cert.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCertificate",
+			Handler:    _Msg_CreateCertificate_Handler,
+		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _Msg_RevokeCertificate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/cert/v1beta1/cert.proto",
+}
+
+func (m *CertificateID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Serial) > 0 {
+		i -= len(m.Serial)
+		copy(dAtA[i:], m.Serial)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Serial)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Certificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Certificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Certificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cert) > 0 {
+		i -= len(m.Cert)
+		copy(dAtA[i:], m.Cert)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Cert)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintCert(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CertificateFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Serial) > 0 {
+		i -= len(m.Serial)
+		copy(dAtA[i:], m.Serial)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Serial)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cert) > 0 {
+		i -= len(m.Cert)
+		copy(dAtA[i:], m.Cert)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Cert)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCert(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCertificateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCertificateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCertificateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCertificateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCertificateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCertificateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCert(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCert(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CertificateID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	l = len(m.Serial)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	return n
+}
+
+func (m *Certificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovCert(uint64(m.State))
+	}
+	l = len(m.Cert)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	return n
+}
+
+func (m *CertificateFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	l = len(m.Serial)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	l = len(m.Cert)
+	if l > 0 {
+		n += 1 + l + sovCert(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCertificateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCert(uint64(l))
+	return n
+}
+
+func (m *MsgRevokeCertificateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovCert(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCert(x uint64) (n int) {
+	return sovCert(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CertificateID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Serial", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Serial = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Certificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Certificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Certificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Certificate_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cert = append(m.Cert[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cert == nil {
+				m.Cert = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CertificateFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Serial", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Serial = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cert = append(m.Cert[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cert == nil {
+				m.Cert = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCertificateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCertificateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCertificateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeCertificateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeCertificateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeCertificateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCert(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCert
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCert
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCert
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCert
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCert        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCert          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCert = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	errCertificateNotFound uint32 = iota + 1
+	errInvalidAddress
+	errCertificateExists
+	errCertificateAlreadyRevoked
+	errInvalidSerialNumber
+	errInvalidCertificateValue
+	errInvalidState
+)
+
+var (
+	// ErrCertificateNotFound certificate not found
+	ErrCertificateNotFound = sdkerrors.Register(ModuleName, errCertificateNotFound, "certificate not found")
+
+	// ErrInvalidAddress invalid owner address
+	ErrInvalidAddress = sdkerrors.Register(ModuleName, errInvalidAddress, "invalid address")
+
+	// ErrCertificateExists certificate with the same serial number has already been published by the owner
+	ErrCertificateExists = sdkerrors.Register(ModuleName, errCertificateExists, "certificate exists")
+
+	// ErrCertificateAlreadyRevoked certificate has already been revoked
+	ErrCertificateAlreadyRevoked = sdkerrors.Register(ModuleName, errCertificateAlreadyRevoked, "certificate already revoked")
+
+	// ErrInvalidSerialNumber invalid serial number
+	ErrInvalidSerialNumber = sdkerrors.Register(ModuleName, errInvalidSerialNumber, "invalid serial number")
+
+	// ErrInvalidCertificateValue certificate could not be parsed or does not belong to its owner
+	ErrInvalidCertificateValue = sdkerrors.Register(ModuleName, errInvalidCertificateValue, "invalid certificate value")
+
+	// ErrInvalidState invalid certificate state
+	ErrInvalidState = sdkerrors.Register(ModuleName, errInvalidState, "invalid state")
+)
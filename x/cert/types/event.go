@@ -0,0 +1,118 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+const (
+	evActionCertificateCreated = "certificate-created"
+	evActionCertificateRevoked = "certificate-revoked"
+	evOwnerKey                 = "owner"
+	evSerialKey                = "serial"
+)
+
+// EventCertificateCreated struct
+type EventCertificateCreated struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      CertificateID           `json:"id"`
+}
+
+func NewEventCertificateCreated(id CertificateID) EventCertificateCreated {
+	return EventCertificateCreated{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: evActionCertificateCreated,
+		},
+		ID: id,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventCertificateCreated struct
+func (ev EventCertificateCreated) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionCertificateCreated),
+		}, CertificateEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventCertificateRevoked struct
+type EventCertificateRevoked struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      CertificateID           `json:"id"`
+}
+
+func NewEventCertificateRevoked(id CertificateID) EventCertificateRevoked {
+	return EventCertificateRevoked{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: evActionCertificateRevoked,
+		},
+		ID: id,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventCertificateRevoked struct
+func (ev EventCertificateRevoked) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionCertificateRevoked),
+		}, CertificateEVAttributes(ev.ID)...)...,
+	)
+}
+
+// CertificateEVAttributes returns event attribues for given certificate id
+func CertificateEVAttributes(id CertificateID) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(evOwnerKey, id.Owner),
+		sdk.NewAttribute(evSerialKey, id.Serial),
+	}
+}
+
+// ParseEVCertificateID returns certificate id for given event attributes
+func ParseEVCertificateID(attrs []sdk.Attribute) (CertificateID, error) {
+	owner, err := sdkutil.GetAccAddress(attrs, evOwnerKey)
+	if err != nil {
+		return CertificateID{}, err
+	}
+
+	serial, err := sdkutil.GetString(attrs, evSerialKey)
+	if err != nil {
+		return CertificateID{}, err
+	}
+
+	return CertificateID{
+		Owner:  owner.String(),
+		Serial: serial,
+	}, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+	switch ev.Action {
+	case evActionCertificateCreated:
+		id, err := ParseEVCertificateID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return NewEventCertificateCreated(id), nil
+	case evActionCertificateRevoked:
+		id, err := ParseEVCertificateID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return NewEventCertificateRevoked(id), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "cert"
+
+	// StoreKey is the store key string for cert
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for cert
+	RouterKey = ModuleName
+)
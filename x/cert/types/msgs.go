@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MsgTypeCreateCertificate = "cert-create-certificate"
+	MsgTypeRevokeCertificate = "cert-revoke-certificate"
+)
+
+var (
+	_, _ sdk.Msg = &MsgCreateCertificate{}, &MsgRevokeCertificate{}
+)
+
+// NewMsgCreateCertificate creates a new MsgCreateCertificate instance
+func NewMsgCreateCertificate(owner sdk.AccAddress, cert []byte) *MsgCreateCertificate {
+	return &MsgCreateCertificate{
+		Owner: owner.String(),
+		Cert:  cert,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCreateCertificate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgCreateCertificate) Type() string { return MsgTypeCreateCertificate }
+
+// ValidateBasic does basic validation of owner and certificate
+func (msg MsgCreateCertificate) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgCreateCertificate: Invalid Owner Address")
+	}
+	if _, err := ParseAndValidateCertificate(owner, msg.Cert); err != nil {
+		return sdkerrors.Wrap(err, "MsgCreateCertificate")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateCertificate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateCertificate) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRevokeCertificate creates a new MsgRevokeCertificate instance
+func NewMsgRevokeCertificate(id CertificateID) *MsgRevokeCertificate {
+	return &MsgRevokeCertificate{
+		ID: id,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRevokeCertificate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgRevokeCertificate) Type() string { return MsgTypeRevokeCertificate }
+
+// ValidateBasic does basic validation of certificate id
+func (msg MsgRevokeCertificate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ID.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgRevokeCertificate: Invalid Owner Address")
+	}
+	if _, err := ParseSerial(msg.ID.Serial); err != nil {
+		return sdkerrors.Wrap(err, "MsgRevokeCertificate")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeCertificate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeCertificate) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PemBlkTypeCertificate is the PEM block type of an X.509 certificate
+	PemBlkTypeCertificate = "CERTIFICATE"
+	// PemBlkTypeECPrivateKey is the PEM block type of an EC private key
+	PemBlkTypeECPrivateKey = "EC PRIVATE KEY"
+)
+
+// String implements the Stringer interface for a CertificateID object.
+func (id CertificateID) String() string {
+	return fmt.Sprintf("%s/%s", id.Owner, id.Serial)
+}
+
+// ToCertificateID returns the CertificateID for given owner and serial number
+func ToCertificateID(owner sdk.Address, serial *big.Int) CertificateID {
+	return CertificateID{
+		Owner:  owner.String(),
+		Serial: serial.String(),
+	}
+}
+
+// ParseSerial parses a decimal certificate serial number
+func ParseSerial(val string) (*big.Int, error) {
+	serial, ok := new(big.Int).SetString(val, 10)
+	if !ok || serial.Sign() <= 0 {
+		return nil, errors.Wrapf(ErrInvalidSerialNumber, "%q", val)
+	}
+	return serial, nil
+}
+
+// ParseAndValidateCertificate decodes a PEM encoded X.509 certificate and checks
+// that its subject common name is the given owner address
+func ParseAndValidateCertificate(owner sdk.Address, pemIn []byte) (*x509.Certificate, error) {
+	blk, rest := pem.Decode(pemIn)
+	if blk == nil || len(bytes.TrimSpace(rest)) != 0 {
+		return nil, errors.Wrap(ErrInvalidCertificateValue, "expected a single PEM block")
+	}
+
+	if blk.Type != PemBlkTypeCertificate {
+		return nil, errors.Wrapf(ErrInvalidCertificateValue, "unexpected PEM block type %q", blk.Type)
+	}
+
+	cert, err := x509.ParseCertificate(blk.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCertificateValue, err.Error())
+	}
+
+	if cert.Subject.CommonName != owner.String() {
+		return nil, errors.Wrapf(ErrInvalidCertificateValue, "subject common name %q does not match owner", cert.Subject.CommonName)
+	}
+
+	if cert.SerialNumber == nil || cert.SerialNumber.Sign() <= 0 {
+		return nil, ErrInvalidSerialNumber
+	}
+
+	return cert, nil
+}
+
+// IsState checks if the certificate is in the state named by the filter
+func (obj Certificate) IsState(state string) bool {
+	return state == "" || obj.State.String() == state
+}
+
+// Validate checks the filter values
+func (filter CertificateFilter) Validate() error {
+	if filter.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(filter.Owner); err != nil {
+			return ErrInvalidAddress
+		}
+	}
+
+	if filter.Serial != "" {
+		if filter.Owner == "" {
+			return errors.Wrap(ErrInvalidSerialNumber, "serial number filter requires owner")
+		}
+		if _, err := ParseSerial(filter.Serial); err != nil {
+			return err
+		}
+	}
+
+	if filter.State != "" {
+		if _, ok := Certificate_State_value[filter.State]; !ok {
+			return errors.Wrapf(ErrInvalidState, "%q", filter.State)
+		}
+	}
+
+	return nil
+}
+
+// CertificatesResponse is the collection of CertificateResponse
+type CertificatesResponse []CertificateResponse
+
+// String implements the Stringer interface for a CertificatesResponse object.
+func (obj CertificatesResponse) String() string {
+	var buf bytes.Buffer
+
+	const sep = "\n\n"
+
+	for _, c := range obj {
+		buf.WriteString(c.String())
+		buf.WriteString(sep)
+	}
+
+	if len(obj) > 0 {
+		buf.Truncate(buf.Len() - len(sep))
+	}
+
+	return buf.String()
+}
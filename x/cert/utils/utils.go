@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// DefaultValidity is the lifetime of generated certificates
+const DefaultValidity = 365 * 24 * time.Hour
+
+var (
+	// ErrCertificateNotPublished is returned when the peer certificate is not published and valid on chain
+	ErrCertificateNotPublished = errors.New("certificate is not published or has been revoked")
+
+	serialLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+)
+
+// CertificateFile returns the path of the file holding the certificate and key of an account
+func CertificateFile(home string, owner sdk.Address) string {
+	return filepath.Join(home, owner.String()+".pem")
+}
+
+// GenerateCertificate creates a self-signed certificate for the owner with its private key, both PEM encoded.
+// Hosts are added as DNS or IP subject alternative names so that the certificate can also serve TLS.
+func GenerateCertificate(owner sdk.Address, hosts []string, validity time.Duration) ([]byte, []byte, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, serialLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	// serial numbers must be positive
+	serial.Add(serial, big.NewInt(1))
+
+	now := time.Now().UTC()
+
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: owner.String(),
+		},
+		NotBefore:             now,
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: types.PemBlkTypeCertificate, Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: types.PemBlkTypeECPrivateKey, Bytes: keyDer})
+
+	return certPEM, keyPEM, nil
+}
+
+// LoadCertificate reads a certificate and its private key from a PEM file
+func LoadCertificate(path string) (tls.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	cert, err := tls.X509KeyPair(data, data)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrapf(err, "load certificate %s", path)
+	}
+
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return cert, nil
+}
+
+// LoadAccountCertificate reads the certificate of an account from the client home directory
+func LoadAccountCertificate(home string, owner sdk.Address) (tls.Certificate, error) {
+	return LoadCertificate(CertificateFile(home, owner))
+}
+
+// VerifyCertificate checks that a self-signed peer certificate is currently valid and has been
+// published on chain by the account named in its subject. It returns the certificate owner.
+func VerifyCertificate(ctx context.Context, qclient types.QueryClient, cert *x509.Certificate, usage x509.ExtKeyUsage) (sdk.AccAddress, error) {
+	owner, err := sdk.AccAddressFromBech32(cert.Subject.CommonName)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{usage},
+	}); err != nil {
+		return nil, err
+	}
+
+	res, err := qclient.Certificates(ctx, &types.QueryCertificatesRequest{
+		Filter: types.CertificateFilter{
+			Owner:  owner.String(),
+			Serial: cert.SerialNumber.String(),
+			State:  types.CertificateValid.String(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, published := range res.Certificates {
+		blk, _ := pem.Decode(published.Certificate.Cert)
+		if blk != nil && bytes.Equal(blk.Bytes, cert.Raw) {
+			return owner, nil
+		}
+	}
+
+	return nil, ErrCertificateNotPublished
+}
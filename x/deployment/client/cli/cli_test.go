@@ -54,6 +54,7 @@ func (s *IntegrationTestSuite) TestDeployment() {
 		val.ClientCtx,
 		val.Address,
 		deploymentPath,
+		fmt.Sprintf("--deposit=%s", sdk.NewInt64Coin(s.cfg.BondDenom, 5000000)),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
@@ -120,6 +121,32 @@ func (s *IntegrationTestSuite) TestDeployment() {
 	s.Require().NoError(err)
 	s.Require().NotEqual(deployment.Deployment.Version, deploymentV2.Deployment.Version)
 
+	// test depositing into the deployment
+	_, err = cli.TxDepositDeploymentExec(
+		val.ClientCtx,
+		sdk.NewInt64Coin(s.cfg.BondDenom, 1000),
+		val.Address,
+		fmt.Sprintf("--dseq=%v", createdDep.Deployment.DeploymentID.DSeq),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	resp, err = cli.QueryDeploymentExec(val.ClientCtx.WithOutputFormat("json"), createdDep.Deployment.DeploymentID)
+	s.Require().NoError(err)
+
+	var deposited types.DeploymentResponse
+	err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), &deposited)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		deploymentV2.EscrowAccount.Balance.Add(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)),
+		deposited.EscrowAccount.Balance,
+	)
+
 	// test query deployments with wrong owner value
 	_, err = cli.QueryDeploymentsExec(
 		val.ClientCtx.WithOutputFormat("json"),
@@ -172,6 +199,7 @@ func (s *IntegrationTestSuite) TestGroup() {
 		val.ClientCtx,
 		val.Address,
 		deploymentPath,
+		fmt.Sprintf("--deposit=%s", sdk.NewInt64Coin(s.cfg.BondDenom, 5000000)),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
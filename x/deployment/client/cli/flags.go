@@ -46,6 +46,20 @@ func DeploymentIDFromFlags(flags *pflag.FlagSet, defaultOwner string) (types.Dep
 	return id, nil
 }
 
+// AddDepositFlags add flags for deposit
+func AddDepositFlags(flags *pflag.FlagSet, dflt sdk.Coin) {
+	flags.String("deposit", dflt.String(), "Deposit amount")
+}
+
+// DepositFromFlags returns deposit with given flags and error if occurred
+func DepositFromFlags(flags *pflag.FlagSet) (sdk.Coin, error) {
+	val, err := flags.GetString("deposit")
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.ParseCoin(val)
+}
+
 // AddGroupIDFlags add flags for Group
 func AddGroupIDFlags(flags *pflag.FlagSet) {
 	AddDeploymentIDFlags(flags)
@@ -46,6 +46,7 @@ func (s *GRPCRestTestSuite) SetupSuite() {
 		val.ClientCtx,
 		val.Address,
 		deploymentPath,
+		fmt.Sprintf("--deposit=%s", sdk.NewInt64Coin(s.cfg.BondDenom, 5000000)),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		cmdDeployments(),
 		cmdDeployment(),
 		getGroupCmd(),
+		cmdParams(),
 	)
 
 	return cmd
@@ -159,3 +160,31 @@ func cmdGetGroup() *cobra.Command {
 
 	return cmd
 }
+
+func cmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the deployment module parameters",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeploymentParams(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
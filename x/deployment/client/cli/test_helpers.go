@@ -36,6 +36,19 @@ func TxUpdateDeploymentExec(clientCtx client.Context, from fmt.Stringer, filePat
 	return testutilcli.ExecTestCLICmd(clientCtx, cmdUpdate(key), args...)
 }
 
+// TxDepositDeploymentExec is used for testing deposit deployment tx
+// requires --dseq, --fees
+func TxDepositDeploymentExec(clientCtx client.Context, amount fmt.Stringer, from fmt.Stringer, extraArgs ...string) (sdktest.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--from=%s", from.String()),
+		amount.String(),
+	}
+
+	args = append(args, extraArgs...)
+
+	return testutilcli.ExecTestCLICmd(clientCtx, cmdDeposit(key), args...)
+}
+
 // TxCloseDeploymentExec is used for testing close deployment tx
 // requires --dseq, --fees
 func TxCloseDeploymentExec(clientCtx client.Context, from fmt.Stringer, extraArgs ...string) (sdktest.BufferWriter, error) {
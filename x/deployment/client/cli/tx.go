@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ovrclk/akash/sdl"
 	"github.com/ovrclk/akash/x/deployment/types"
@@ -13,6 +14,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	// DefaultDeposit is the default deposit used to fund a deployment's escrow account
+	DefaultDeposit = sdk.NewInt64Coin("uakt", 5000000)
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(key string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +30,7 @@ func GetTxCmd(key string) *cobra.Command {
 	}
 	cmd.AddCommand(
 		cmdCreate(key),
+		cmdDeposit(key),
 		cmdUpdate(key),
 		cmdClose(key),
 		cmdGroupClose(key),
@@ -70,10 +77,16 @@ func cmdCreate(key string) *cobra.Command {
 				return err
 			}
 
+			deposit, err := DepositFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateDeployment{
 				ID:      id,
 				Version: version,
 				Groups:  make([]types.GroupSpec, 0, len(groups)),
+				Deposit: deposit,
 			}
 
 			for _, group := range groups {
@@ -84,6 +97,49 @@ func cmdCreate(key string) *cobra.Command {
 				return err
 			}
 
+			if err := validateGroups(clientCtx, msg.Groups); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDeploymentIDFlags(cmd.Flags())
+	AddDepositFlags(cmd.Flags(), DefaultDeposit)
+
+	return cmd
+}
+
+func cmdDeposit(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [amount]",
+		Short: fmt.Sprintf("Deposit funds into the escrow account of a %s", key),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			id, err := DeploymentIDFromFlags(cmd.Flags(), clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositDeployment(id, amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -164,6 +220,10 @@ func cmdUpdate(key string) *cobra.Command {
 				msg.Groups = append(msg.Groups, *group)
 			}
 
+			if err := validateGroups(clientCtx, msg.Groups); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
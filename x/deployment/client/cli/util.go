@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/ovrclk/akash/validation"
+	"github.com/ovrclk/akash/x/deployment/types"
 )
 
 func currentBlockHeight(ctx client.Context) (uint64, error) {
@@ -17,3 +20,25 @@ func currentBlockHeight(ctx client.Context) (uint64, error) {
 	}
 	return uint64(status.SyncInfo.LatestBlockHeight), nil
 }
+
+// validateGroups checks the groups against the deployment params currently in force on chain.
+// Offline transactions can not query the chain and are left to be validated on delivery.
+// The defaults of the params are applied to copies, the chain applies them on delivery.
+func validateGroups(ctx client.Context, groups []types.GroupSpec) error {
+	if ctx.GenerateOnly || ctx.Offline {
+		return nil
+	}
+
+	res, err := types.NewQueryClient(ctx).DeploymentParams(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	specs := make([]types.GroupSpec, 0, len(groups))
+	for _, group := range groups {
+		group.SetDefaults(res.Params)
+		specs = append(specs, group)
+	}
+
+	return validation.ValidateDeploymentGroups(res.Params, specs)
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/ovrclk/akash/x/deployment/query"
 )
 
-// RegisterRoutes registers all query routes
+// RegisterRoutes registers all query and tx routes
 func RegisterRoutes(ctx client.Context, r *mux.Router, ns string) {
 	// Get all deployments
 	r.HandleFunc(fmt.Sprintf("/%s/list", ns), listDeploymentsHandler(ctx, ns)).Methods("GET")
@@ -20,6 +20,9 @@ func RegisterRoutes(ctx client.Context, r *mux.Router, ns string) {
 
 	// Get single group info
 	r.HandleFunc(fmt.Sprintf("/%s/group/info", ns), getGroupHandler(ctx, ns)).Methods("GET")
+
+	// Generate deposit transaction
+	r.HandleFunc(fmt.Sprintf("/%s/deposit", ns), depositDeploymentHandler(ctx)).Methods("POST")
 }
 
 func listDeploymentsHandler(ctx client.Context, ns string) http.HandlerFunc {
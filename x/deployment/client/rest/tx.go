@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/ovrclk/akash/x/deployment/types"
+)
+
+// DepositReq defines the properties of a deployment deposit request's body.
+type DepositReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	DSeq    uint64       `json:"dseq" yaml:"dseq"`
+	Amount  sdk.Coin     `json:"amount" yaml:"amount"`
+}
+
+// depositDeploymentHandler returns an HTTP REST handler for generating a
+// MsgDepositDeployment transaction for a deployment owned by the sender.
+func depositDeploymentHandler(ctx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DepositReq
+		if !rest.ReadRESTReq(w, r, ctx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgDepositDeployment(types.DeploymentID{
+			Owner: owner.String(),
+			DSeq:  req.DSeq,
+		}, req.Amount)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(ctx, w, req.BaseReq, msg)
+	}
+}
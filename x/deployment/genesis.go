@@ -11,6 +11,9 @@ import (
 
 // ValidateGenesis does validation check of the Genesis and return error incase of failure
 func ValidateGenesis(data *types.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
 	for _, record := range data.Deployments {
 		if err := record.Deployment.ID().Validate(); err != nil {
 			return errors.Wrap(err, types.ErrInvalidDeployment.Error())
@@ -22,11 +25,15 @@ func ValidateGenesis(data *types.GenesisState) error {
 // DefaultGenesisState returns default genesis state as raw bytes for the deployment
 // module.
 func DefaultGenesisState() *types.GenesisState {
-	return &types.GenesisState{}
+	return &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
 }
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
+
 	for _, record := range data.Deployments {
 		if err := keeper.Create(ctx, record.Deployment, record.Groups); err != nil {
 			return nil
@@ -46,5 +53,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		})
 		return false
 	})
-	return &types.GenesisState{Deployments: records}
+	return &types.GenesisState{
+		Deployments: records,
+		Params:      k.GetParams(ctx),
+	}
 }
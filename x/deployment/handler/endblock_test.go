@@ -29,8 +29,9 @@ func TestEndBlock(t *testing.T) {
 			grps = append(grps, g.GroupSpec)
 		}
 		m := &types.MsgCreateDeployment{
-			ID:     d.ID(),
-			Groups: grps,
+			ID:      d.ID(),
+			Groups:  grps,
+			Deposit: testutil.AkashCoin(s.t, 1000),
 		}
 		_, err := s.handler(s.ctx, m)
 		assert.NoError(s.t, err)
@@ -81,8 +82,9 @@ func TestEndBlockGroups(t *testing.T) {
 			grps = append(grps, g.GroupSpec)
 		}
 		m := &types.MsgCreateDeployment{
-			ID:     d.ID(),
-			Groups: grps,
+			ID:      d.ID(),
+			Groups:  grps,
+			Deposit: testutil.AkashCoin(s.t, 1000),
 		}
 		_, err := s.handler(s.ctx, m)
 		assert.NoError(s.t, err)
@@ -9,8 +9,8 @@ import (
 )
 
 // NewHandler returns a handler for "deployment" type messages
-func NewHandler(keeper keeper.Keeper, mkeeper MarketKeeper) sdk.Handler {
-	ms := NewMsgServerImpl(keeper, mkeeper)
+func NewHandler(keeper keeper.Keeper, mkeeper MarketKeeper, ekeeper EscrowKeeper) sdk.Handler {
+	ms := NewMsgServerImpl(keeper, mkeeper, ekeeper)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
@@ -18,6 +18,10 @@ func NewHandler(keeper keeper.Keeper, mkeeper MarketKeeper) sdk.Handler {
 			res, err := ms.CreateDeployment(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgDepositDeployment:
+			res, err := ms.DepositDeployment(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgUpdateDeployment:
 			res, err := ms.UpdateDeployment(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
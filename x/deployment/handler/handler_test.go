@@ -4,20 +4,29 @@ import (
 	"crypto/sha256"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdktestdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ovrclk/akash/testutil"
+	akashtypes "github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
 	"github.com/ovrclk/akash/x/deployment/handler"
 	"github.com/ovrclk/akash/x/deployment/keeper"
 	"github.com/ovrclk/akash/x/deployment/types"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
+	emocks "github.com/ovrclk/akash/x/escrow/keeper/mocks"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	mkeeper "github.com/ovrclk/akash/x/market/keeper"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
@@ -28,6 +37,7 @@ type testSuite struct {
 	ctx     sdk.Context
 	mkeeper mkeeper.Keeper
 	dkeeper keeper.Keeper
+	ekeeper ekeeper.Keeper
 	handler sdk.Handler
 }
 
@@ -38,21 +48,41 @@ func setupTestSuite(t *testing.T) *testSuite {
 
 	dKey := sdk.NewKVStoreKey(types.StoreKey)
 	mKey := sdk.NewKVStoreKey(mtypes.StoreKey)
+	eKey := sdk.NewKVStoreKey(etypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(eKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
 
 	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
 
-	suite.mkeeper = mkeeper.NewKeeper(types.ModuleCdc, mKey)
-	suite.dkeeper = keeper.NewKeeper(types.ModuleCdc, dKey)
+	paramsKeeper := paramskeeper.NewKeeper(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey)
 
-	suite.handler = handler.NewHandler(suite.dkeeper, suite.mkeeper)
+	suite.mkeeper = mkeeper.NewKeeper(types.ModuleCdc, mKey, paramsKeeper.Subspace(mtypes.ModuleName))
+	suite.mkeeper.SetParams(suite.ctx, mtypes.DefaultParams())
+	suite.dkeeper = keeper.NewKeeper(types.ModuleCdc, dKey, paramsKeeper.Subspace(types.ModuleName))
+	suite.dkeeper.SetParams(suite.ctx, types.DefaultParams())
+
+	bkeeper := &emocks.BankKeeper{}
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.ekeeper = ekeeper.NewKeeper(types.ModuleCdc, eKey, bkeeper)
+
+	suite.handler = handler.NewHandler(suite.dkeeper, suite.mkeeper, suite.ekeeper)
 
 	return suite
 }
@@ -72,8 +102,9 @@ func TestCreateDeployment(t *testing.T) {
 	deployment, groups := suite.createDeployment()
 
 	msg := &types.MsgCreateDeployment{
-		ID:     deployment.ID(),
-		Groups: make([]types.GroupSpec, 0, len(groups)),
+		ID:      deployment.ID(),
+		Groups:  make([]types.GroupSpec, 0, len(groups)),
+		Deposit: testutil.AkashCoin(t, 1000),
 	}
 
 	for _, group := range groups {
@@ -101,6 +132,60 @@ func TestCreateDeployment(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestCreateDeploymentParamLimits(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := &types.MsgCreateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{groups[0].GroupSpec},
+		Deposit: testutil.AkashCoin(t, 1000),
+	}
+
+	params := types.DefaultParams()
+	params.MaxOrderBidDuration = msg.Groups[0].OrderBidDuration - 1
+	suite.dkeeper.SetParams(suite.ctx, params)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidGroups))
+	require.Nil(t, res)
+
+	// limits raised through governance apply without a new binary
+	params.MaxOrderBidDuration = msg.Groups[0].OrderBidDuration
+	suite.dkeeper.SetParams(suite.ctx, params)
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestCreateDeploymentDefaultOrderBidDuration(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := &types.MsgCreateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{groups[0].GroupSpec},
+		Deposit: testutil.AkashCoin(t, 1000),
+	}
+	msg.Groups[0].OrderBidDuration = 0
+
+	params := types.DefaultParams()
+	params.DefaultOrderBidDuration = 4242
+	suite.dkeeper.SetParams(suite.ctx, params)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	dgroups := suite.dkeeper.GetGroups(suite.ctx, deployment.ID())
+	require.Len(t, dgroups, 1)
+	require.Equal(t, int64(4242), dgroups[0].GroupSpec.OrderBidDuration)
+}
+
 func TestCreateDeploymentEmptyGroups(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -138,6 +223,7 @@ func TestUpdateDeploymentExisting(t *testing.T) {
 		ID:      deployment.ID(),
 		Groups:  make([]types.GroupSpec, 0, len(groups)),
 		Version: testutil.DefaultDeploymentVersion[:],
+		Deposit: testutil.AkashCoin(t, 1000),
 	}
 
 	for _, group := range groups {
@@ -180,6 +266,220 @@ func TestUpdateDeploymentExisting(t *testing.T) {
 	})
 }
 
+func TestDepositDeploymentNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment := testutil.Deployment(suite.t)
+
+	msg := &types.MsgDepositDeployment{
+		ID:     deployment.ID(),
+		Amount: testutil.AkashCoin(t, 1000),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrDeploymentNotFound.Error())
+}
+
+func TestDepositDeploymentExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := &types.MsgCreateDeployment{
+		ID:      deployment.ID(),
+		Groups:  make([]types.GroupSpec, 0, len(groups)),
+		Deposit: testutil.AkashCoin(t, 1000),
+	}
+
+	for _, group := range groups {
+		msg.Groups = append(msg.Groups, group.GroupSpec)
+	}
+
+	_, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	msgDeposit := &types.MsgDepositDeployment{
+		ID:     deployment.ID(),
+		Amount: testutil.AkashCoin(t, 500),
+	}
+
+	res, err := suite.handler(suite.ctx, msgDeposit)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	t.Run("ensure event deposited", func(t *testing.T) {
+		iev := testutil.ParseDeploymentEvent(t, res.Events[1:])
+		require.IsType(t, types.EventDeploymentDeposited{}, iev)
+
+		dev := iev.(types.EventDeploymentDeposited)
+
+		require.Equal(t, msg.ID, dev.ID)
+		require.Equal(t, msgDeposit.Amount, dev.Amount)
+	})
+
+	account, err := suite.ekeeper.GetAccount(suite.ctx, types.EscrowAccountForDeployment(deployment.ID()))
+	require.NoError(t, err)
+	require.Equal(t, testutil.AkashCoin(t, 1500), account.Balance)
+
+	msgDeposit.Amount = sdk.NewInt64Coin("foo", 500)
+	res, err = suite.handler(suite.ctx, msgDeposit)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrInvalidDeposit))
+
+	_, err = suite.handler(suite.ctx, &types.MsgCloseDeployment{ID: deployment.ID()})
+	require.NoError(t, err)
+
+	msgDeposit.Amount = testutil.AkashCoin(t, 500)
+	res, err = suite.handler(suite.ctx, msgDeposit)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrDeploymentClosed.Error())
+}
+
+func TestUpdateDeploymentGroups(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment := testutil.Deployment(suite.t)
+	spec := suite.groupSpec("db", 100, 1)
+
+	_, err := suite.handler(suite.ctx, &types.MsgCreateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{spec},
+		Version: testutil.DefaultDeploymentVersion[:],
+		Deposit: testutil.AkashCoin(t, 1000),
+	})
+	require.NoError(t, err)
+
+	scaled := suite.groupSpec("db", 100, 2)
+	web := suite.groupSpec("web", 50, 1)
+
+	_, err = suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{scaled, web},
+		Version: testutil.DefaultDeploymentVersion[:],
+	})
+	require.NoError(t, err)
+
+	groups := suite.dkeeper.GetGroups(suite.ctx, deployment.ID())
+	require.Len(t, groups, 2)
+	assert.Equal(t, types.GroupOpen, groups[0].State)
+	assert.Equal(t, scaled, groups[0].GroupSpec)
+	assert.Equal(t, uint32(2), groups[1].ID().GSeq)
+	assert.Equal(t, types.GroupOpen, groups[1].State)
+	assert.Equal(t, web, groups[1].GroupSpec)
+
+	// dropping a group closes it
+	_, err = suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{web},
+		Version: testutil.DefaultDeploymentVersion[:],
+	})
+	require.NoError(t, err)
+
+	groups = suite.dkeeper.GetGroups(suite.ctx, deployment.ID())
+	require.Len(t, groups, 2)
+	assert.Equal(t, types.GroupClosed, groups[0].State)
+	assert.Equal(t, types.GroupOpen, groups[1].State)
+
+	t.Run("duplicate group names", func(t *testing.T) {
+		_, err := suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+			ID:      deployment.ID(),
+			Groups:  []types.GroupSpec{web, web},
+			Version: testutil.DefaultDeploymentVersion[:],
+		})
+		require.True(t, errors.Is(err, types.ErrInvalidGroups))
+	})
+
+	t.Run("price denomination mismatch", func(t *testing.T) {
+		other := suite.groupSpec("web", 50, 1)
+		other.Resources[0].Price = sdk.NewInt64Coin("foo", 10)
+
+		_, err := suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+			ID:      deployment.ID(),
+			Groups:  []types.GroupSpec{other},
+			Version: testutil.DefaultDeploymentVersion[:],
+		})
+		require.True(t, errors.Is(err, types.ErrInvalidGroups))
+	})
+}
+
+func TestUpdateDeploymentLeasedGroup(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment := testutil.Deployment(suite.t)
+	spec := suite.groupSpec("web", 100, 2)
+
+	_, err := suite.handler(suite.ctx, &types.MsgCreateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{spec},
+		Version: testutil.DefaultDeploymentVersion[:],
+		Deposit: testutil.AkashCoin(t, 1000),
+	})
+	require.NoError(t, err)
+
+	gid := types.MakeGroupID(deployment.ID(), 1)
+	lid := suite.createLease(gid, spec)
+
+	// scaling down fits within the leased resources
+	smaller := suite.groupSpec("web", 100, 1)
+
+	_, err = suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{smaller},
+		Version: testutil.DefaultDeploymentVersion[:],
+	})
+	require.NoError(t, err)
+
+	group, found := suite.dkeeper.GetGroup(suite.ctx, gid)
+	require.True(t, found)
+	assert.Equal(t, types.GroupMatched, group.State)
+	assert.Equal(t, smaller, group.GroupSpec)
+
+	lease, found := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, found)
+	assert.Equal(t, mtypes.LeaseActive, lease.State)
+
+	// scaling up past the leased resources is rejected and the lease keeps running
+	larger := suite.groupSpec("web", 100, 3)
+
+	_, err = suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{larger},
+		Version: testutil.DefaultDeploymentVersion[:],
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrGroupSpecExceedsLease))
+
+	group, found = suite.dkeeper.GetGroup(suite.ctx, gid)
+	require.True(t, found)
+	assert.Equal(t, types.GroupMatched, group.State)
+	assert.Equal(t, smaller, group.GroupSpec)
+
+	lease, found = suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, found)
+	assert.Equal(t, mtypes.LeaseActive, lease.State)
+
+	payment, err := suite.ekeeper.GetPayment(suite.ctx, types.EscrowAccountForDeployment(deployment.ID()), mtypes.EscrowPaymentForLease(lid))
+	require.NoError(t, err)
+	assert.Equal(t, etypes.PaymentOpen, payment.State)
+
+	// closing the group lets the larger spec be ordered as a new group
+	_, err = suite.handler(suite.ctx, &types.MsgCloseGroup{ID: gid})
+	require.NoError(t, err)
+
+	_, err = suite.handler(suite.ctx, &types.MsgUpdateDeployment{
+		ID:      deployment.ID(),
+		Groups:  []types.GroupSpec{larger},
+		Version: testutil.DefaultDeploymentVersion[:],
+	})
+	require.NoError(t, err)
+
+	group, found = suite.dkeeper.GetGroup(suite.ctx, types.MakeGroupID(deployment.ID(), 2))
+	require.True(t, found)
+	assert.Equal(t, types.GroupOpen, group.State)
+	assert.Equal(t, larger, group.GroupSpec)
+}
+
 func TestCloseDeploymentNonExisting(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -200,8 +500,9 @@ func TestCloseDeploymentExisting(t *testing.T) {
 	deployment, groups := suite.createDeployment()
 
 	msg := &types.MsgCreateDeployment{
-		ID:     deployment.ID(),
-		Groups: make([]types.GroupSpec, 0, len(groups)),
+		ID:      deployment.ID(),
+		Groups:  make([]types.GroupSpec, 0, len(groups)),
+		Deposit: testutil.AkashCoin(t, 1000),
 	}
 
 	for _, group := range groups {
@@ -274,3 +575,52 @@ func (st *testSuite) createDeployment() (types.Deployment, []types.Group) {
 
 	return deployment, groups
 }
+
+func (st *testSuite) groupSpec(name string, cpu uint64, count uint32) types.GroupSpec {
+	st.t.Helper()
+
+	return types.GroupSpec{
+		Name: name,
+		Resources: []types.Resource{
+			{
+				Resources: akashtypes.ResourceUnits{
+					CPU:     &akashtypes.CPU{Units: akashtypes.NewResourceValue(cpu)},
+					Memory:  &akashtypes.Memory{Quantity: akashtypes.NewResourceValue(128 * unit.Mi)},
+					Storage: &akashtypes.Storage{Quantity: akashtypes.NewResourceValue(128 * unit.Mi)},
+				},
+				Count: count,
+				Price: testutil.AkashCoin(st.t, 10),
+			},
+		},
+		OrderBidDuration: types.DefaultOrderBiddingDuration,
+	}
+}
+
+func (st *testSuite) createLease(gid types.GroupID, spec types.GroupSpec) mtypes.LeaseID {
+	st.t.Helper()
+
+	order, err := st.mkeeper.CreateOrder(st.ctx, gid, spec)
+	require.NoError(st.t, err)
+
+	provider := testutil.AccAddress(st.t)
+
+	bid, err := st.mkeeper.CreateBid(st.ctx, order.ID(), provider, spec.Price())
+	require.NoError(st.t, err)
+
+	st.mkeeper.CreateLease(st.ctx, bid)
+	st.mkeeper.OnOrderMatched(st.ctx, order)
+	st.mkeeper.OnBidMatched(st.ctx, bid)
+	st.dkeeper.OnLeaseCreated(st.ctx, gid)
+
+	lid := mtypes.LeaseID(bid.ID())
+
+	require.NoError(st.t, st.ekeeper.PaymentCreate(
+		st.ctx,
+		types.EscrowAccountForDeployment(gid.DeploymentID()),
+		mtypes.EscrowPaymentForLease(lid),
+		provider,
+		bid.Price,
+	))
+
+	return lid
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/x/deployment/types"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -10,4 +11,15 @@ import (
 type MarketKeeper interface {
 	CreateOrder(ctx sdk.Context, id types.GroupID, spec types.GroupSpec) (mtypes.Order, error)
 	OnGroupClosed(ctx sdk.Context, id types.GroupID)
+	WithOrdersForGroup(ctx sdk.Context, id types.GroupID, fn func(mtypes.Order) bool)
+	LeaseForOrder(ctx sdk.Context, oid mtypes.OrderID) (mtypes.Lease, bool)
+}
+
+// EscrowKeeper Interface includes escrow methods
+type EscrowKeeper interface {
+	AccountCreate(ctx sdk.Context, id etypes.AccountID, owner sdk.AccAddress, deposit sdk.Coin) error
+	AccountDeposit(ctx sdk.Context, id etypes.AccountID, amount sdk.Coin) error
+	AccountClose(ctx sdk.Context, id etypes.AccountID) error
+	PaymentClose(ctx sdk.Context, id etypes.AccountID, pid string) error
+	GetAccount(ctx sdk.Context, id etypes.AccountID) (etypes.Account, error)
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"bytes"
 	"context"
+	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -10,17 +11,19 @@ import (
 	"github.com/ovrclk/akash/validation"
 	"github.com/ovrclk/akash/x/deployment/keeper"
 	"github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
 type msgServer struct {
 	deployment keeper.Keeper
 	market     MarketKeeper
+	escrow     EscrowKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the deployment MsgServer interface
 // for the provided Keeper.
-func NewMsgServerImpl(k keeper.Keeper, mkeeper MarketKeeper) types.MsgServer {
-	return &msgServer{deployment: k, market: mkeeper}
+func NewMsgServerImpl(k keeper.Keeper, mkeeper MarketKeeper, ekeeper EscrowKeeper) types.MsgServer {
+	return &msgServer{deployment: k, market: mkeeper, escrow: ekeeper}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -38,7 +41,12 @@ func (ms msgServer) CreateDeployment(goCtx context.Context, msg *types.MsgCreate
 		Version:      msg.Version,
 	}
 
-	if err := validation.ValidateDeploymentGroups(msg.Groups); err != nil {
+	params := ms.deployment.GetParams(ctx)
+	for idx := range msg.Groups {
+		msg.Groups[idx].SetDefaults(params)
+	}
+
+	if err := validation.ValidateDeploymentGroups(params, msg.Groups); err != nil {
 		return nil, errors.Wrap(types.ErrInvalidGroups, err.Error())
 	}
 
@@ -52,6 +60,15 @@ func (ms msgServer) CreateDeployment(goCtx context.Context, msg *types.MsgCreate
 		})
 	}
 
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.escrow.AccountCreate(ctx, types.EscrowAccountForDeployment(deployment.ID()), owner, msg.Deposit); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDeposit, err.Error())
+	}
+
 	if err := ms.deployment.Create(ctx, deployment, groups); err != nil {
 		return nil, errors.Wrap(types.ErrInternal, err.Error())
 	}
@@ -59,6 +76,31 @@ func (ms msgServer) CreateDeployment(goCtx context.Context, msg *types.MsgCreate
 	return &types.MsgCreateDeploymentResponse{}, nil
 }
 
+func (ms msgServer) DepositDeployment(goCtx context.Context, msg *types.MsgDepositDeployment) (*types.MsgDepositDeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deployment, found := ms.deployment.GetDeployment(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrDeploymentNotFound
+	}
+
+	if deployment.State == types.DeploymentClosed {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	// reopens leases which ran out of funds within the grace period
+	if err := ms.escrow.AccountDeposit(ctx, types.EscrowAccountForDeployment(deployment.ID()), msg.Amount); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDeposit, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewEventDeploymentDeposited(deployment.ID(), msg.Amount).
+			ToSDKEvent(),
+	)
+
+	return &types.MsgDepositDeploymentResponse{}, nil
+}
+
 func (ms msgServer) UpdateDeployment(goCtx context.Context, msg *types.MsgUpdateDeployment) (*types.MsgUpdateDeploymentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -67,6 +109,16 @@ func (ms msgServer) UpdateDeployment(goCtx context.Context, msg *types.MsgUpdate
 		return nil, types.ErrDeploymentNotFound
 	}
 
+	if deployment.State == types.DeploymentClosed {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	if len(msg.Groups) > 0 {
+		if err := ms.updateGroups(ctx, deployment, msg.Groups); err != nil {
+			return nil, err
+		}
+	}
+
 	if !bytes.Equal(msg.Version, deployment.Version) {
 		deployment.Version = msg.Version
 	}
@@ -101,6 +153,11 @@ func (ms msgServer) CloseDeployment(goCtx context.Context, msg *types.MsgCloseDe
 		ms.market.OnGroupClosed(ctx, group.ID())
 	}
 
+	// settle outstanding payments and refund the remaining deposit
+	if err := ms.escrow.AccountClose(ctx, types.EscrowAccountForDeployment(deployment.ID())); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+
 	return &types.MsgCloseDeploymentResponse{}, nil
 }
 
@@ -118,12 +175,164 @@ func (ms msgServer) CloseGroup(goCtx context.Context, msg *types.MsgCloseGroup)
 		return nil, err
 	}
 
-	// Update the Group's state
-	err = ms.deployment.OnCloseGroup(ctx, group)
-	if err != nil {
+	if err := ms.closeGroup(ctx, group); err != nil {
 		return nil, err
 	}
-	ms.market.OnGroupClosed(ctx, group.ID())
 
 	return &types.MsgCloseGroupResponse{}, nil
 }
+
+// updateGroups applies the given group specs to the deployment's groups, matching them by name.
+// Groups without a spec are closed and specs without a group are added as new groups.  Changed
+// groups which are not leased yet are ordered again.  Leased groups may only change to specs
+// which fit within their lease, so that the running workload is never torn down before a
+// replacement exists; other specs are rejected and the tenant has to close the group and add
+// it again.
+func (ms msgServer) updateGroups(ctx sdk.Context, deployment types.Deployment, specs []types.GroupSpec) error {
+	params := ms.deployment.GetParams(ctx)
+	for idx := range specs {
+		specs[idx].SetDefaults(params)
+	}
+
+	if err := validation.ValidateDeploymentGroups(params, specs); err != nil {
+		return errors.Wrap(types.ErrInvalidGroups, err.Error())
+	}
+
+	account, err := ms.escrow.GetAccount(ctx, types.EscrowAccountForDeployment(deployment.ID()))
+	if err != nil {
+		return errors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	names := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		if names[spec.Name] {
+			return errors.Wrapf(types.ErrInvalidGroups, "duplicate group name: %v", spec.Name)
+		}
+		names[spec.Name] = true
+
+		for _, resource := range spec.Resources {
+			if resource.Price.Denom != account.Balance.Denom {
+				return errors.Wrapf(types.ErrInvalidGroups, "price denomination must be %v", account.Balance.Denom)
+			}
+		}
+	}
+
+	gseq := uint32(0)
+	groups := make(map[string]types.Group)
+
+	for _, group := range ms.deployment.GetGroups(ctx, deployment.ID()) {
+		if group.ID().GSeq > gseq {
+			gseq = group.ID().GSeq
+		}
+
+		if group.State == types.GroupClosed {
+			continue
+		}
+
+		if !names[group.GroupSpec.Name] {
+			// drop groups which are no longer part of the deployment
+			if err := ms.closeGroup(ctx, group); err != nil {
+				return err
+			}
+			continue
+		}
+
+		groups[group.GroupSpec.Name] = group
+	}
+
+	for _, spec := range specs {
+		group, found := groups[spec.Name]
+		if !found {
+			gseq++
+			group = types.Group{
+				GroupID:   types.MakeGroupID(deployment.ID(), gseq),
+				State:     types.GroupOpen,
+				GroupSpec: spec,
+			}
+			if err := ms.deployment.CreateGroup(ctx, group); err != nil {
+				return errors.Wrap(types.ErrInternal, err.Error())
+			}
+			continue
+		}
+
+		if reflect.DeepEqual(group.GroupSpec, spec) {
+			continue
+		}
+
+		switch group.State {
+		case types.GroupInsufficientFunds:
+			return errors.Wrapf(types.ErrGroupInsufficientFunds, "group %v", group.ID())
+		case types.GroupMatched:
+			if order, found := ms.activeLeaseOrder(ctx, group.ID()); !found || !spec.FitsWithin(order.Spec) {
+				return errors.Wrapf(types.ErrGroupSpecExceedsLease,
+					"group %v: close the group and add it again to order other resources", group.ID())
+			}
+
+			group.GroupSpec = spec
+			if err := ms.deployment.UpdateGroup(ctx, group); err != nil {
+				return errors.Wrap(types.ErrInternal, err.Error())
+			}
+			continue
+		}
+
+		// close pending orders so that a new order is created for the new spec
+		ms.market.OnGroupClosed(ctx, group.ID())
+
+		group.GroupSpec = spec
+		group.State = types.GroupOpen
+
+		if err := ms.deployment.UpdateGroup(ctx, group); err != nil {
+			return errors.Wrap(types.ErrInternal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (ms msgServer) closeGroup(ctx sdk.Context, group types.Group) error {
+	// settle and stop paying for the group's lease
+	if err := ms.closeGroupPayments(ctx, group.ID()); err != nil {
+		return err
+	}
+
+	// Update the Group's state
+	if err := ms.deployment.OnCloseGroup(ctx, group); err != nil {
+		return err
+	}
+	ms.market.OnGroupClosed(ctx, group.ID())
+
+	return nil
+}
+
+// activeLeaseOrder returns the order of the group's active lease
+func (ms msgServer) activeLeaseOrder(ctx sdk.Context, id types.GroupID) (mtypes.Order, bool) {
+	var (
+		result mtypes.Order
+		found  bool
+	)
+	ms.market.WithOrdersForGroup(ctx, id, func(order mtypes.Order) bool {
+		if lease, ok := ms.market.LeaseForOrder(ctx, order.ID()); ok && lease.State == mtypes.LeaseActive {
+			result, found = order, true
+		}
+		return found
+	})
+	return result, found
+}
+
+func (ms msgServer) closeGroupPayments(ctx sdk.Context, id types.GroupID) error {
+	var err error
+	ms.market.WithOrdersForGroup(ctx, id, func(order mtypes.Order) bool {
+		lease, found := ms.market.LeaseForOrder(ctx, order.ID())
+		if !found {
+			return false
+		}
+		switch lease.State {
+		case mtypes.LeaseActive, mtypes.LeaseInsufficientFunds:
+		default:
+			return false
+		}
+		err = ms.escrow.PaymentClose(ctx, types.EscrowAccountForDeployment(id.DeploymentID()), mtypes.EscrowPaymentForLease(lease.ID()))
+		return err != nil
+	})
+	return err
+}
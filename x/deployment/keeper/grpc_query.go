@@ -10,11 +10,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ovrclk/akash/x/deployment/types"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 )
 
+// EscrowKeeper Interface includes escrow methods used by the querier
+type EscrowKeeper interface {
+	GetAccount(ctx sdk.Context, id etypes.AccountID) (etypes.Account, error)
+}
+
 // Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
 type Querier struct {
 	Keeper
+	EscrowKeeper EscrowKeeper
 }
 
 var _ types.QueryServer = Querier{}
@@ -48,9 +55,15 @@ func (k Querier) Deployments(c context.Context, req *types.QueryDeploymentsReque
 		// filter deployments with provided filters
 		if req.Filters.Accept(deployment, stateVal) {
 			if accumulate {
+				account, err := k.EscrowKeeper.GetAccount(ctx, types.EscrowAccountForDeployment(deployment.ID()))
+				if err != nil {
+					return false, err
+				}
+
 				value := types.DeploymentResponse{
-					Deployment: deployment,
-					Groups:     k.GetGroups(ctx, deployment.ID()),
+					Deployment:    deployment,
+					Groups:        k.GetGroups(ctx, deployment.ID()),
+					EscrowAccount: account,
 				}
 				deployments = append(deployments, value)
 			}
@@ -87,9 +100,15 @@ func (k Querier) Deployment(c context.Context, req *types.QueryDeploymentRequest
 		return nil, types.ErrDeploymentNotFound
 	}
 
+	account, err := k.EscrowKeeper.GetAccount(ctx, types.EscrowAccountForDeployment(req.ID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	value := types.DeploymentResponse{
-		Deployment: deployment,
-		Groups:     k.GetGroups(ctx, req.ID),
+		Deployment:    deployment,
+		Groups:        k.GetGroups(ctx, req.ID),
+		EscrowAccount: account,
 	}
 
 	return &types.QueryDeploymentResponse{Deployment: value}, nil
@@ -114,3 +133,14 @@ func (k Querier) Group(c context.Context, req *types.QueryGroupRequest) (*types.
 
 	return &types.QueryGroupResponse{Group: group}, nil
 }
+
+// DeploymentParams returns the deployment module parameters
+func (k Querier) DeploymentParams(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
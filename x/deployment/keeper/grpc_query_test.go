@@ -11,15 +11,19 @@ import (
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ovrclk/akash/app"
 	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/testutil/state"
 	"github.com/ovrclk/akash/x/deployment/keeper"
 	"github.com/ovrclk/akash/x/deployment/types"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 )
 
 type grpcTestSuite struct {
-	t      *testing.T
-	app    *app.AkashApp
-	ctx    sdk.Context
-	keeper keeper.Keeper
+	t       *testing.T
+	app     *app.AkashApp
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	ekeeper ekeeper.Keeper
 
 	queryClient types.QueryClient
 }
@@ -30,8 +34,11 @@ func setupTest(t *testing.T) *grpcTestSuite {
 	}
 
 	suite.app = app.Setup(false)
-	suite.ctx, suite.keeper = setupKeeper(t)
-	querier := keeper.Querier{Keeper: suite.keeper}
+	ssuite := state.SetupTestSuite(t, suite.app.AppCodec())
+	suite.ctx = ssuite.Context()
+	suite.keeper = ssuite.DeploymentKeeper()
+	suite.ekeeper = ssuite.EscrowKeeper()
+	querier := keeper.Querier{Keeper: suite.keeper, EscrowKeeper: suite.ekeeper}
 
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, querier)
@@ -47,6 +54,7 @@ func TestGRPCQueryDeployment(t *testing.T) {
 	deployment, groups := suite.createDeployment()
 	err := suite.keeper.Create(suite.ctx, deployment, groups)
 	require.NoError(t, err)
+	account := suite.createEscrowAccount(deployment.ID())
 
 	var (
 		req           *types.QueryDeploymentRequest
@@ -87,8 +95,9 @@ func TestGRPCQueryDeployment(t *testing.T) {
 			func() {
 				req = &types.QueryDeploymentRequest{ID: deployment.DeploymentID}
 				expDeployment = types.DeploymentResponse{
-					Deployment: deployment,
-					Groups:     groups,
+					Deployment:    deployment,
+					Groups:        groups,
+					EscrowAccount: account,
 				}
 			},
 			true,
@@ -123,11 +132,13 @@ func TestGRPCQueryDeployments(t *testing.T) {
 	deployment, groups := suite.createDeployment()
 	err := suite.keeper.Create(suite.ctx, deployment, groups)
 	require.NoError(t, err)
+	suite.createEscrowAccount(deployment.ID())
 
 	deployment2, groups2 := suite.createDeployment()
 	deployment2.State = types.DeploymentClosed
 	err = suite.keeper.Create(suite.ctx, deployment2, groups2)
 	require.NoError(t, err)
+	suite.createEscrowAccount(deployment2.ID())
 
 	var req *types.QueryDeploymentsRequest
 
@@ -259,6 +270,18 @@ func TestGRPCQueryGroup(t *testing.T) {
 	}
 }
 
+func TestGRPCQueryDeploymentParams(t *testing.T) {
+	suite := setupTest(t)
+
+	params := types.DefaultParams()
+	params.MaxUnitCPU *= 2
+	suite.keeper.SetParams(suite.ctx, params)
+
+	res, err := suite.queryClient.DeploymentParams(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
 func (suite *grpcTestSuite) createDeployment() (types.Deployment, []types.Group) {
 	suite.t.Helper()
 
@@ -281,3 +304,19 @@ func (suite *grpcTestSuite) createDeployment() (types.Deployment, []types.Group)
 
 	return deployment, groups
 }
+
+func (suite *grpcTestSuite) createEscrowAccount(id types.DeploymentID) etypes.Account {
+	suite.t.Helper()
+
+	owner, err := sdk.AccAddressFromBech32(id.Owner)
+	require.NoError(suite.t, err)
+
+	aid := types.EscrowAccountForDeployment(id)
+	err = suite.ekeeper.AccountCreate(suite.ctx, aid, owner, testutil.AkashCoin(suite.t, 1000))
+	require.NoError(suite.t, err)
+
+	account, err := suite.ekeeper.GetAccount(suite.ctx, aid)
+	require.NoError(suite.t, err)
+
+	return account
+}
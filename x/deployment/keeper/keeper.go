@@ -3,21 +3,28 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/ovrclk/akash/x/deployment/types"
 )
 
 // Keeper of the deployment store
 type Keeper struct {
-	skey sdk.StoreKey
-	cdc  codec.BinaryMarshaler
+	skey   sdk.StoreKey
+	cdc    codec.BinaryMarshaler
+	pspace paramtypes.Subspace
 }
 
 // NewKeeper creates and returns an instance for deployment keeper
-func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey, pspace paramtypes.Subspace) Keeper {
+	if !pspace.HasKeyTable() {
+		pspace = pspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		skey: skey,
-		cdc:  cdc,
+		skey:   skey,
+		cdc:    cdc,
+		pspace: pspace,
 	}
 }
 
@@ -26,6 +33,17 @@ func (k Keeper) Codec() codec.BinaryMarshaler {
 	return k.cdc
 }
 
+// GetParams returns the deployment module parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.pspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the deployment module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
 // GetDeployment returns deployment details with provided DeploymentID
 func (k Keeper) GetDeployment(ctx sdk.Context, id types.DeploymentID) (types.Deployment, bool) {
 	store := ctx.KVStore(k.skey)
@@ -131,6 +149,36 @@ func (k Keeper) UpdateDeployment(ctx sdk.Context, deployment types.Deployment) e
 	return nil
 }
 
+// CreateGroup adds a new group to an existing deployment
+func (k Keeper) CreateGroup(ctx sdk.Context, group types.Group) error {
+	store := ctx.KVStore(k.skey)
+
+	if !store.Has(deploymentKey(group.ID().DeploymentID())) {
+		return types.ErrDeploymentNotFound
+	}
+
+	key := groupKey(group.ID())
+
+	if store.Has(key) {
+		return types.ErrInvalidGroupID
+	}
+
+	k.updateGroup(ctx, group)
+	return nil
+}
+
+// UpdateGroup updates group details
+func (k Keeper) UpdateGroup(ctx sdk.Context, group types.Group) error {
+	store := ctx.KVStore(k.skey)
+
+	if !store.Has(groupKey(group.ID())) {
+		return types.ErrGroupNotFound
+	}
+
+	k.updateGroup(ctx, group)
+	return nil
+}
+
 // OnCloseGroup provides shutdown API for a Group
 func (k Keeper) OnCloseGroup(ctx sdk.Context, group types.Group) error {
 	store := ctx.KVStore(k.skey)
@@ -224,10 +272,22 @@ func (k Keeper) OnLeaseInsufficientFunds(ctx sdk.Context, id types.GroupID) {
 	k.updateGroup(ctx, group)
 }
 
-// OnLeaseClosed updates group state to group opened
+// OnLeaseReopened updates group state from group insufficient funds back to group matched
+func (k Keeper) OnLeaseReopened(ctx sdk.Context, id types.GroupID) {
+	group, found := k.GetGroup(ctx, id)
+	if !found || group.State != types.GroupInsufficientFunds {
+		return
+	}
+	group.State = types.GroupMatched
+	k.updateGroup(ctx, group)
+}
+
+// OnLeaseClosed updates group state from group matched to group opened
 func (k Keeper) OnLeaseClosed(ctx sdk.Context, id types.GroupID) {
-	// TODO: assert state transition
-	group, _ := k.GetGroup(ctx, id)
+	group, found := k.GetGroup(ctx, id)
+	if !found || group.State != types.GroupMatched {
+		return
+	}
 	group.State = types.GroupOpen
 	k.updateGroup(ctx, group)
 }
@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -180,6 +182,25 @@ func Test_OnInsufficientFunds(t *testing.T) {
 	})
 }
 
+func Test_OnLeaseReopened(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	groups := createActiveDeployment(t, ctx, keeper)
+
+	keeper.OnLeaseReopened(ctx, groups[0].ID())
+
+	group, ok := keeper.GetGroup(ctx, groups[0].ID())
+	assert.True(t, ok)
+	assert.Equal(t, types.GroupMatched, group.State)
+
+	keeper.OnLeaseInsufficientFunds(ctx, groups[0].ID())
+	keeper.OnLeaseReopened(ctx, groups[0].ID())
+
+	group, ok = keeper.GetGroup(ctx, groups[0].ID())
+	assert.True(t, ok)
+	assert.Equal(t, types.GroupMatched, group.State)
+}
+
 func Test_OnLeaseClosed(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 
@@ -200,6 +221,19 @@ func Test_OnLeaseClosed(t *testing.T) {
 	})
 }
 
+func Test_OnLeaseClosedKeepsInsufficientFunds(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	groups := createActiveDeployment(t, ctx, keeper)
+
+	keeper.OnLeaseInsufficientFunds(ctx, groups[0].ID())
+	keeper.OnLeaseClosed(ctx, groups[0].ID())
+
+	group, ok := keeper.GetGroup(ctx, groups[0].ID())
+	assert.True(t, ok)
+	assert.Equal(t, types.GroupInsufficientFunds, group.State)
+}
+
 func Test_OnDeploymentClosed(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 
@@ -348,11 +382,18 @@ func createDeploymentsWithState(t testing.TB, ctx sdk.Context, gState types.Grou
 func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	pKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	ptKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(ptKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
+	pspace := paramstypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), pKey, ptKey, types.ModuleName)
+	k := keeper.NewKeeper(types.ModuleCdc, key, pspace)
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k
 }
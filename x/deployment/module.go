@@ -21,6 +21,7 @@ import (
 	"github.com/ovrclk/akash/x/deployment/query"
 	"github.com/ovrclk/akash/x/deployment/simulation"
 	"github.com/ovrclk/akash/x/deployment/types"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -106,15 +107,23 @@ type AppModule struct {
 	AppModuleBasic
 	keeper     keeper.Keeper
 	mkeeper    handler.MarketKeeper
+	ekeeper    ekeeper.Keeper
 	coinKeeper bankkeeper.Keeper
 }
 
 // NewAppModule creates a new AppModule Object
-func NewAppModule(cdc codec.Marshaler, k keeper.Keeper, mkeeper handler.MarketKeeper, bankKeeper bankkeeper.Keeper) AppModule {
+func NewAppModule(
+	cdc codec.Marshaler,
+	k keeper.Keeper,
+	mkeeper handler.MarketKeeper,
+	ekeeper ekeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
 		mkeeper:        mkeeper,
+		ekeeper:        ekeeper,
 		coinKeeper:     bankKeeper,
 	}
 }
@@ -129,7 +138,7 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the deployment module
 func (am AppModule) Route() sdk.Route {
-	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.mkeeper))
+	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.mkeeper, am.ekeeper))
 }
 
 // QuerierRoute returns the deployment module's querier route name.
@@ -144,8 +153,8 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 
 // RegisterServices registers the module's services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper, am.mkeeper))
-	querier := keeper.Querier{Keeper: am.keeper}
+	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper, am.mkeeper, am.ekeeper))
+	querier := keeper.Querier{Keeper: am.keeper, EscrowKeeper: am.ekeeper}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 }
 
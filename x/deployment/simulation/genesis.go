@@ -13,7 +13,9 @@ type GenesisDeployment struct {
 
 // RandomizedGenState generates a random GenesisState for supply
 func RandomizedGenState(simState *module.SimulationState) {
-	deploymentGenesis := &types.GenesisState{}
+	deploymentGenesis := &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(deploymentGenesis)
 }
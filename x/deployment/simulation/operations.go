@@ -119,12 +119,24 @@ func SimulateMsgCreateDeployment(ak govtypes.AccountKeeper, bk bankkeeper.Keeper
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
 
-		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if len(groupSpecs) == 0 || len(groupSpecs[0].Resources) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateDeployment, "no groups found"), nil, nil
+		}
+
+		depositDenom := groupSpecs[0].Resources[0].Price.Denom
+		depositAmount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(depositDenom))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateDeployment, "insufficient funds for deposit"), nil, nil
+		}
+
+		deposit := sdk.NewCoin(depositDenom, depositAmount)
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(sdk.NewCoins(deposit)))
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateDeployment, "unable to generate fees"), nil, err
 		}
 
-		msg := types.NewMsgCreateDeployment(dID, make([]types.GroupSpec, 0, len(groupSpecs)), sdlSum)
+		msg := types.NewMsgCreateDeployment(dID, make([]types.GroupSpec, 0, len(groupSpecs)), sdlSum, deposit)
 
 		for _, spec := range groupSpecs {
 			msg.Groups = append(msg.Groups, *spec)
@@ -6,9 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/ovrclk/akash/x/escrow/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -57,7 +59,7 @@ func (x Deployment_State) String() string {
 }
 
 func (Deployment_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{7, 0}
+	return fileDescriptor_bfe50ba12f1404bf, []int{9, 0}
 }
 
 // MsgCreateDeployment defines an SDK message for creating deployment
@@ -65,6 +67,7 @@ type MsgCreateDeployment struct {
 	ID      DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
 	Groups  []GroupSpec  `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups" yaml:"groups"`
 	Version []byte       `protobuf:"bytes,3,opt,name=version,proto3" json:"version" yaml:"version"`
+	Deposit types.Coin   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit" yaml:"deposit"`
 }
 
 func (m *MsgCreateDeployment) Reset()         { *m = MsgCreateDeployment{} }
@@ -121,6 +124,13 @@ func (m *MsgCreateDeployment) GetVersion() []byte {
 	return nil
 }
 
+func (m *MsgCreateDeployment) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
 // MsgCreateDeploymentResponse defines the Msg/CreateDeployment response type.
 type MsgCreateDeploymentResponse struct {
 }
@@ -158,6 +168,96 @@ func (m *MsgCreateDeploymentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateDeploymentResponse proto.InternalMessageInfo
 
+// MsgDepositDeployment defines an SDK message for depositing funds into a deployment
+type MsgDepositDeployment struct {
+	ID     DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Amount types.Coin   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgDepositDeployment) Reset()         { *m = MsgDepositDeployment{} }
+func (m *MsgDepositDeployment) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositDeployment) ProtoMessage()    {}
+func (*MsgDepositDeployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe50ba12f1404bf, []int{2}
+}
+func (m *MsgDepositDeployment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositDeployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositDeployment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositDeployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositDeployment.Merge(m, src)
+}
+func (m *MsgDepositDeployment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositDeployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositDeployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositDeployment proto.InternalMessageInfo
+
+func (m *MsgDepositDeployment) GetID() DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return DeploymentID{}
+}
+
+func (m *MsgDepositDeployment) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgDepositDeploymentResponse defines the Msg/DepositDeployment response type.
+type MsgDepositDeploymentResponse struct {
+}
+
+func (m *MsgDepositDeploymentResponse) Reset()         { *m = MsgDepositDeploymentResponse{} }
+func (m *MsgDepositDeploymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositDeploymentResponse) ProtoMessage()    {}
+func (*MsgDepositDeploymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe50ba12f1404bf, []int{3}
+}
+func (m *MsgDepositDeploymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositDeploymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositDeploymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositDeploymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositDeploymentResponse.Merge(m, src)
+}
+func (m *MsgDepositDeploymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositDeploymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositDeploymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositDeploymentResponse proto.InternalMessageInfo
+
 // MsgUpdateDeployment defines an SDK message for updating deployment
 type MsgUpdateDeployment struct {
 	ID      DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
@@ -169,7 +269,7 @@ func (m *MsgUpdateDeployment) Reset()         { *m = MsgUpdateDeployment{} }
 func (m *MsgUpdateDeployment) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDeployment) ProtoMessage()    {}
 func (*MsgUpdateDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{2}
+	return fileDescriptor_bfe50ba12f1404bf, []int{4}
 }
 func (m *MsgUpdateDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +327,7 @@ func (m *MsgUpdateDeploymentResponse) Reset()         { *m = MsgUpdateDeployment
 func (m *MsgUpdateDeploymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDeploymentResponse) ProtoMessage()    {}
 func (*MsgUpdateDeploymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{3}
+	return fileDescriptor_bfe50ba12f1404bf, []int{5}
 }
 func (m *MsgUpdateDeploymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +365,7 @@ func (m *MsgCloseDeployment) Reset()         { *m = MsgCloseDeployment{} }
 func (m *MsgCloseDeployment) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseDeployment) ProtoMessage()    {}
 func (*MsgCloseDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{4}
+	return fileDescriptor_bfe50ba12f1404bf, []int{6}
 }
 func (m *MsgCloseDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +409,7 @@ func (m *MsgCloseDeploymentResponse) Reset()         { *m = MsgCloseDeploymentRe
 func (m *MsgCloseDeploymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseDeploymentResponse) ProtoMessage()    {}
 func (*MsgCloseDeploymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{5}
+	return fileDescriptor_bfe50ba12f1404bf, []int{7}
 }
 func (m *MsgCloseDeploymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +447,7 @@ type DeploymentID struct {
 func (m *DeploymentID) Reset()      { *m = DeploymentID{} }
 func (*DeploymentID) ProtoMessage() {}
 func (*DeploymentID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{6}
+	return fileDescriptor_bfe50ba12f1404bf, []int{8}
 }
 func (m *DeploymentID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +501,7 @@ func (m *Deployment) Reset()         { *m = Deployment{} }
 func (m *Deployment) String() string { return proto.CompactTextString(m) }
 func (*Deployment) ProtoMessage()    {}
 func (*Deployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{7}
+	return fileDescriptor_bfe50ba12f1404bf, []int{9}
 }
 func (m *Deployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,15 +553,16 @@ func (m *Deployment) GetVersion() []byte {
 
 // DeploymentResponse represents details of deployment along with group details
 type DeploymentResponse struct {
-	Deployment Deployment `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment" yaml:"deployment"`
-	Groups     []Group    `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups" yaml:"groups"`
-	Version    []byte     `protobuf:"bytes,3,opt,name=version,proto3" json:"version" yaml:"version"`
+	Deployment    Deployment     `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment" yaml:"deployment"`
+	Groups        []Group        `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups" yaml:"groups"`
+	Version       []byte         `protobuf:"bytes,3,opt,name=version,proto3" json:"version" yaml:"version"`
+	EscrowAccount types1.Account `protobuf:"bytes,4,opt,name=escrow_account,json=escrowAccount,proto3" json:"escrow_account" yaml:"escrow_account"`
 }
 
 func (m *DeploymentResponse) Reset()      { *m = DeploymentResponse{} }
 func (*DeploymentResponse) ProtoMessage() {}
 func (*DeploymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{8}
+	return fileDescriptor_bfe50ba12f1404bf, []int{10}
 }
 func (m *DeploymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,6 +612,13 @@ func (m *DeploymentResponse) GetVersion() []byte {
 	return nil
 }
 
+func (m *DeploymentResponse) GetEscrowAccount() types1.Account {
+	if m != nil {
+		return m.EscrowAccount
+	}
+	return types1.Account{}
+}
+
 // DeploymentFilters defines filters used to filter deployments
 type DeploymentFilters struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
@@ -522,7 +630,7 @@ func (m *DeploymentFilters) Reset()         { *m = DeploymentFilters{} }
 func (m *DeploymentFilters) String() string { return proto.CompactTextString(m) }
 func (*DeploymentFilters) ProtoMessage()    {}
 func (*DeploymentFilters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bfe50ba12f1404bf, []int{9}
+	return fileDescriptor_bfe50ba12f1404bf, []int{11}
 }
 func (m *DeploymentFilters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,6 +684,8 @@ func init() {
 	proto.RegisterEnum("akash.deployment.v1beta1.Deployment_State", Deployment_State_name, Deployment_State_value)
 	proto.RegisterType((*MsgCreateDeployment)(nil), "akash.deployment.v1beta1.MsgCreateDeployment")
 	proto.RegisterType((*MsgCreateDeploymentResponse)(nil), "akash.deployment.v1beta1.MsgCreateDeploymentResponse")
+	proto.RegisterType((*MsgDepositDeployment)(nil), "akash.deployment.v1beta1.MsgDepositDeployment")
+	proto.RegisterType((*MsgDepositDeploymentResponse)(nil), "akash.deployment.v1beta1.MsgDepositDeploymentResponse")
 	proto.RegisterType((*MsgUpdateDeployment)(nil), "akash.deployment.v1beta1.MsgUpdateDeployment")
 	proto.RegisterType((*MsgUpdateDeploymentResponse)(nil), "akash.deployment.v1beta1.MsgUpdateDeploymentResponse")
 	proto.RegisterType((*MsgCloseDeployment)(nil), "akash.deployment.v1beta1.MsgCloseDeployment")
@@ -591,54 +701,64 @@ func init() {
 }
 
 var fileDescriptor_bfe50ba12f1404bf = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xde, 0xdd, 0x16, 0xf8, 0xf1, 0x52, 0xf8, 0x95, 0x91, 0x98, 0xba, 0x4a, 0x67, 0xb3, 0x12,
-	0xa9, 0x44, 0xbb, 0xa1, 0x68, 0x4c, 0xb8, 0x59, 0x1b, 0x4d, 0x0f, 0x5c, 0x96, 0x90, 0x18, 0x35,
-	0x31, 0x4b, 0x77, 0x2c, 0x1b, 0xda, 0xee, 0xb2, 0xbb, 0x54, 0xf0, 0xe0, 0x59, 0x39, 0x79, 0x34,
-	0x26, 0x24, 0x24, 0x7e, 0x01, 0x3f, 0x06, 0x47, 0x8e, 0x9c, 0x26, 0xa6, 0x5c, 0x4c, 0x8f, 0xfd,
-	0x04, 0x66, 0x67, 0xb6, 0xdd, 0xa5, 0xa5, 0xfc, 0x31, 0x91, 0x93, 0xb7, 0xce, 0x33, 0xcf, 0xfb,
-	0x3e, 0x33, 0xcf, 0x3e, 0xd3, 0x19, 0xb8, 0x6f, 0x6c, 0x1a, 0xde, 0x86, 0x66, 0x12, 0xa7, 0x66,
-	0xef, 0xd6, 0x49, 0xc3, 0xd7, 0x9a, 0x8b, 0xeb, 0xc4, 0x37, 0x16, 0x63, 0x50, 0xde, 0x71, 0x6d,
-	0xdf, 0x46, 0x19, 0x46, 0xcd, 0xc7, 0xf0, 0x90, 0x2a, 0xcf, 0x54, 0xed, 0xaa, 0xcd, 0x48, 0x5a,
-	0xf0, 0x8b, 0xf3, 0xe5, 0xb9, 0xa1, 0xad, 0xab, 0xae, 0xbd, 0xed, 0x70, 0x96, 0xfa, 0x59, 0x82,
-	0x1b, 0x2b, 0x5e, 0xf5, 0x99, 0x4b, 0x0c, 0x9f, 0x94, 0x7a, 0x5c, 0xb4, 0x06, 0x92, 0x65, 0x66,
-	0x44, 0x45, 0xcc, 0x4d, 0x14, 0xee, 0xe5, 0x87, 0x49, 0xe7, 0xa3, 0x8a, 0x72, 0xa9, 0x38, 0x7b,
-	0x48, 0xb1, 0xd0, 0xa2, 0x58, 0x2a, 0x97, 0xda, 0x14, 0x4b, 0x96, 0xd9, 0xa1, 0x78, 0x7c, 0xd7,
-	0xa8, 0xd7, 0x96, 0x55, 0xcb, 0x54, 0x75, 0xc9, 0x32, 0xd1, 0x1b, 0x18, 0x65, 0xea, 0x5e, 0x46,
-	0x52, 0x12, 0xb9, 0x89, 0xc2, 0xdd, 0xe1, 0xad, 0x5f, 0x04, 0xbc, 0x55, 0x87, 0x54, 0x8a, 0x38,
-	0xe8, 0xdb, 0xa6, 0x38, 0x2c, 0xed, 0x50, 0x3c, 0xc9, 0xbb, 0xf2, 0xb1, 0xaa, 0x87, 0x13, 0xe8,
-	0x09, 0x8c, 0x35, 0x89, 0xeb, 0x59, 0x76, 0x23, 0x93, 0x50, 0xc4, 0x5c, 0xaa, 0x38, 0xdb, 0xa6,
-	0xb8, 0x0b, 0x75, 0x28, 0x9e, 0xe2, 0x65, 0x21, 0xa0, 0xea, 0xdd, 0xa9, 0xe5, 0xe4, 0xaf, 0x03,
-	0x2c, 0xa8, 0xb3, 0x70, 0xfb, 0x0c, 0x2b, 0x74, 0xe2, 0x39, 0x76, 0xc3, 0x23, 0x5d, 0xab, 0xd6,
-	0x1c, 0xf3, 0x9f, 0x55, 0xdc, 0xaa, 0x7e, 0x2b, 0x7a, 0x56, 0x6d, 0x01, 0x0a, 0x9c, 0xac, 0xd9,
-	0xde, 0xdf, 0x37, 0x2a, 0x5c, 0xd1, 0x1d, 0x90, 0x07, 0x25, 0x7b, 0x0b, 0xfa, 0x08, 0xa9, 0x78,
-	0x5b, 0xa4, 0xc1, 0x88, 0xfd, 0xbe, 0x41, 0x5c, 0xb6, 0x9a, 0xf1, 0xe2, 0xad, 0x36, 0xc5, 0x1c,
-	0xe8, 0x50, 0x9c, 0xe2, 0xed, 0xd9, 0x50, 0xd5, 0x39, 0x8c, 0x96, 0x20, 0x69, 0x7a, 0x64, 0x2b,
-	0x23, 0x29, 0x62, 0x2e, 0x59, 0xc4, 0x2d, 0x8a, 0x93, 0xa5, 0x55, 0xb2, 0xd5, 0xa6, 0x98, 0xe1,
-	0x1d, 0x8a, 0x27, 0x78, 0x59, 0x30, 0x52, 0x75, 0x06, 0x2e, 0xff, 0xf7, 0xf5, 0x00, 0x0b, 0x6c,
-	0x75, 0xdf, 0x12, 0x00, 0x31, 0x27, 0x7c, 0x98, 0x8c, 0x36, 0xfe, 0xf6, 0xca, 0xa6, 0xcc, 0x87,
-	0xa6, 0x9c, 0xda, 0xd3, 0x59, 0xf6, 0xa4, 0xa2, 0x4e, 0x65, 0x13, 0xbd, 0x86, 0x11, 0xcf, 0x37,
-	0x7c, 0xc2, 0x36, 0x31, 0x55, 0x58, 0xb8, 0x8c, 0x5a, 0x7e, 0x35, 0xa8, 0xe0, 0x06, 0xb1, 0xe2,
-	0xc8, 0x20, 0x36, 0x54, 0x75, 0x0e, 0xff, 0x71, 0xa0, 0xd4, 0x0f, 0x30, 0xc2, 0x34, 0xd0, 0x3c,
-	0x8c, 0x59, 0x8d, 0xa6, 0x51, 0xb3, 0xcc, 0xb4, 0x20, 0xcb, 0x7b, 0xfb, 0xca, 0xcd, 0x68, 0x19,
-	0x8c, 0x51, 0xe6, 0xb3, 0x48, 0x81, 0x51, 0xa3, 0xe2, 0x5b, 0x4d, 0x92, 0x16, 0xe5, 0x99, 0xbd,
-	0x7d, 0x25, 0x1d, 0xf1, 0x9e, 0x32, 0x3c, 0x60, 0x54, 0x82, 0x24, 0x98, 0x69, 0xa9, 0x9f, 0xc1,
-	0x12, 0x62, 0xca, 0xc9, 0x4f, 0xdf, 0xb3, 0x42, 0x18, 0x9d, 0x03, 0x09, 0xd0, 0x60, 0x66, 0x50,
-	0x1d, 0x20, 0xb2, 0x26, 0xfc, 0x42, 0x73, 0x97, 0xf1, 0x8c, 0x7f, 0x9f, 0x36, 0xc5, 0xb1, 0xfa,
-	0x0e, 0xc5, 0xd3, 0x61, 0x40, 0x7a, 0x98, 0xaa, 0xc7, 0x08, 0xe8, 0x65, 0xdf, 0x79, 0xc7, 0x17,
-	0x9c, 0xf7, 0x6b, 0x38, 0xeb, 0x51, 0x7e, 0x7f, 0x88, 0x30, 0x1d, 0x6d, 0xf0, 0xb9, 0x55, 0xf3,
-	0x89, 0xeb, 0x5d, 0xcf, 0x29, 0x0a, 0x54, 0x78, 0x6c, 0x13, 0x91, 0xca, 0x79, 0x51, 0xe4, 0x5f,
-	0xb5, 0x70, 0x9c, 0x80, 0xc4, 0x8a, 0x57, 0x45, 0x3b, 0x90, 0x1e, 0xb8, 0xdd, 0x1e, 0x0e, 0xf7,
-	0xf6, 0x8c, 0x1b, 0x40, 0x7e, 0x7c, 0x25, 0x7a, 0x2f, 0x40, 0x3b, 0x90, 0x1e, 0xb8, 0x2c, 0xce,
-	0x57, 0xee, 0xa7, 0x5f, 0xa0, 0x3c, 0xec, 0xff, 0x17, 0x6d, 0xc3, 0xff, 0xfd, 0x7f, 0xbe, 0x0f,
-	0xce, 0xdf, 0xc3, 0x69, 0xb6, 0xfc, 0xe8, 0x2a, 0xec, 0x9e, 0xec, 0x3b, 0x00, 0x36, 0xc5, 0xf2,
-	0x89, 0xe6, 0x2f, 0xee, 0xc1, 0x88, 0xb2, 0x76, 0x49, 0x62, 0x57, 0xa7, 0x58, 0x3a, 0x6c, 0x65,
-	0xc5, 0xa3, 0x56, 0x56, 0xfc, 0xd9, 0xca, 0x8a, 0x5f, 0x4e, 0xb2, 0xc2, 0xd1, 0x49, 0x56, 0x38,
-	0x3e, 0xc9, 0x0a, 0xaf, 0x16, 0xaa, 0x96, 0xbf, 0xb1, 0xbd, 0x9e, 0xaf, 0xd8, 0x75, 0xcd, 0x6e,
-	0xba, 0x95, 0xda, 0xa6, 0xc6, 0x9f, 0x41, 0x3b, 0xf1, 0x87, 0x90, 0xbf, 0xeb, 0x10, 0x6f, 0x7d,
-	0x94, 0xbd, 0x80, 0x96, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x83, 0x51, 0x5d, 0xf5, 0x84, 0x09,
-	0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xda, 0x4e, 0x42, 0xdf, 0x7c, 0xe0, 0x0c, 0x01, 0xb9, 0x4b, 0xb3, 0x63, 0x96, 0x8a,
+	0x84, 0x0a, 0x76, 0xd5, 0x94, 0x82, 0x94, 0x5b, 0x5d, 0x0b, 0x94, 0x43, 0x2e, 0x1b, 0x15, 0x21,
+	0x40, 0xaa, 0x36, 0xbb, 0x83, 0xbb, 0xaa, 0xbd, 0xb3, 0xd9, 0x59, 0xbb, 0x09, 0x48, 0x9c, 0xa1,
+	0x27, 0x6e, 0x20, 0xa4, 0x4a, 0x95, 0xf8, 0x03, 0xfc, 0x0b, 0x7a, 0xec, 0x05, 0x89, 0xd3, 0x08,
+	0x9c, 0x0b, 0xf2, 0xd1, 0xbf, 0x00, 0xed, 0xcc, 0x78, 0x77, 0x63, 0xe7, 0xcb, 0x48, 0xed, 0xa5,
+	0x37, 0xef, 0xf3, 0x3e, 0xef, 0xd7, 0x33, 0xef, 0xeb, 0x19, 0x78, 0xdf, 0x7d, 0xe8, 0xb2, 0x07,
+	0xb6, 0x4f, 0xa2, 0x0e, 0x3d, 0xea, 0x92, 0x30, 0xb1, 0xfb, 0x37, 0xf7, 0x49, 0xe2, 0xde, 0x2c,
+	0x40, 0x56, 0x14, 0xd3, 0x84, 0xa2, 0xba, 0xa0, 0x5a, 0x05, 0x5c, 0x51, 0xf5, 0xb5, 0x36, 0x6d,
+	0x53, 0x41, 0xb2, 0xd3, 0x5f, 0x92, 0xaf, 0x5f, 0x3f, 0x33, 0x74, 0x3b, 0xa6, 0xbd, 0x48, 0xb1,
+	0x1a, 0x92, 0x45, 0x98, 0x17, 0xd3, 0x47, 0x19, 0x23, 0x39, 0x8a, 0x08, 0x53, 0x0c, 0xc3, 0xa3,
+	0xac, 0x4b, 0x99, 0xbd, 0xef, 0x32, 0x92, 0x11, 0x3c, 0x1a, 0x84, 0xd2, 0x6e, 0xfe, 0x53, 0x86,
+	0x37, 0x76, 0x59, 0xfb, 0x6e, 0x4c, 0xdc, 0x84, 0xb4, 0xb2, 0x6c, 0xe8, 0x1e, 0x94, 0x03, 0xbf,
+	0xae, 0x35, 0xb4, 0xcd, 0xc5, 0xad, 0xf7, 0xac, 0xb3, 0x8a, 0xb7, 0x72, 0x8f, 0x9d, 0x56, 0x73,
+	0xfd, 0x19, 0xc7, 0xa5, 0x01, 0xc7, 0xe5, 0x9d, 0xd6, 0x90, 0xe3, 0x72, 0xe0, 0x8f, 0x38, 0xbe,
+	0x72, 0xe4, 0x76, 0x3b, 0xdb, 0x66, 0xe0, 0x9b, 0x4e, 0x39, 0xf0, 0xd1, 0xd7, 0x30, 0x2f, 0xea,
+	0x67, 0xf5, 0x72, 0xa3, 0xb2, 0xb9, 0xb8, 0xf5, 0xee, 0xd9, 0xa1, 0x3f, 0x4b, 0x79, 0x7b, 0x11,
+	0xf1, 0x9a, 0x38, 0x8d, 0x3b, 0xe4, 0x58, 0xb9, 0x8e, 0x38, 0x5e, 0x96, 0x51, 0xe5, 0xb7, 0xe9,
+	0x28, 0x03, 0xfa, 0x04, 0x16, 0xfa, 0x24, 0x66, 0x01, 0x0d, 0xeb, 0x95, 0x86, 0xb6, 0xb9, 0xd4,
+	0x5c, 0x1f, 0x72, 0x3c, 0x86, 0x46, 0x1c, 0xaf, 0x48, 0x37, 0x05, 0x98, 0xce, 0xd8, 0x84, 0x3e,
+	0x87, 0x05, 0x9f, 0x44, 0x94, 0x05, 0x49, 0xbd, 0x2a, 0x5a, 0xbe, 0x6a, 0x49, 0xdd, 0xac, 0x54,
+	0xb7, 0xac, 0xa4, 0xbb, 0x34, 0x08, 0x9b, 0xef, 0xa8, 0x6a, 0xc6, 0x1e, 0x79, 0x5c, 0x05, 0x98,
+	0xce, 0xd8, 0xb4, 0x5d, 0xfd, 0xf7, 0x29, 0x2e, 0x99, 0xeb, 0xf0, 0xf6, 0x29, 0x12, 0x3b, 0x84,
+	0x45, 0x34, 0x64, 0xc4, 0xfc, 0x43, 0x83, 0xb5, 0x5d, 0xd6, 0x6e, 0x49, 0x9f, 0x17, 0x7f, 0x06,
+	0x0e, 0xcc, 0xbb, 0x5d, 0xda, 0x0b, 0x93, 0x7a, 0xf9, 0xa2, 0x5e, 0x33, 0xe5, 0xa5, 0x43, 0xae,
+	0xbc, 0xfc, 0x36, 0x1d, 0x65, 0x50, 0x8d, 0x1a, 0x70, 0xed, 0xb4, 0x46, 0xb2, 0x4e, 0x7f, 0x94,
+	0xc3, 0x76, 0x2f, 0xf2, 0x5f, 0xe1, 0x61, 0x3b, 0x31, 0x14, 0x93, 0x52, 0x64, 0x52, 0x1d, 0x00,
+	0x4a, 0x67, 0xa6, 0x43, 0xd9, 0x8b, 0x17, 0x4a, 0x55, 0x74, 0x0d, 0xf4, 0xe9, 0x94, 0x59, 0x41,
+	0xdf, 0xc3, 0x52, 0x31, 0x2c, 0xb2, 0x61, 0x8e, 0x3e, 0x0a, 0x49, 0x2c, 0xaa, 0xb9, 0xd2, 0xbc,
+	0x3a, 0xe4, 0x58, 0x02, 0x23, 0x8e, 0x97, 0x64, 0x78, 0xf1, 0x69, 0x3a, 0x12, 0x46, 0xb7, 0xa0,
+	0xea, 0x33, 0x72, 0x20, 0x86, 0xae, 0xda, 0xc4, 0x03, 0x8e, 0xab, 0xad, 0x3d, 0x72, 0x30, 0xe4,
+	0x58, 0xe0, 0x23, 0x8e, 0x17, 0xd5, 0x1a, 0x31, 0x72, 0x60, 0x3a, 0x02, 0xdc, 0x7e, 0xed, 0x97,
+	0xa7, 0xb8, 0x24, 0xaa, 0xfb, 0xb5, 0x02, 0x50, 0x50, 0x22, 0x81, 0xe5, 0xbc, 0xf1, 0xfb, 0x33,
+	0x8b, 0xb2, 0xa1, 0x44, 0x39, 0xd1, 0xd3, 0x69, 0xf2, 0x2c, 0xe5, 0x91, 0x76, 0x7c, 0xf4, 0x15,
+	0xcc, 0xb1, 0xc4, 0x4d, 0x88, 0x68, 0x62, 0x65, 0xeb, 0xc6, 0x65, 0xb2, 0x59, 0x7b, 0xa9, 0x87,
+	0x14, 0x48, 0x38, 0xe7, 0x02, 0x89, 0x4f, 0xd3, 0x91, 0xf0, 0xff, 0x1e, 0x28, 0xf3, 0x5b, 0x98,
+	0x13, 0x39, 0xd0, 0x06, 0x2c, 0x04, 0x61, 0xdf, 0xed, 0x04, 0x7e, 0xad, 0xa4, 0xeb, 0x8f, 0x9f,
+	0x34, 0xde, 0xca, 0xcb, 0x10, 0x8c, 0x1d, 0x69, 0x45, 0x0d, 0x98, 0x77, 0xbd, 0x24, 0xe8, 0x93,
+	0x9a, 0xa6, 0xaf, 0x3d, 0x7e, 0xd2, 0xa8, 0xe5, 0xbc, 0x3b, 0x02, 0x4f, 0x19, 0x5e, 0x3a, 0x09,
+	0x7e, 0xad, 0x3c, 0xc9, 0x10, 0x13, 0xe2, 0xeb, 0xd5, 0x1f, 0x7e, 0x33, 0x4a, 0x6a, 0x74, 0x7e,
+	0xae, 0x00, 0x9a, 0x9e, 0x19, 0xd4, 0x05, 0xc8, 0xa5, 0x51, 0x27, 0x74, 0xfd, 0x32, 0x9a, 0xc9,
+	0xf3, 0x19, 0x72, 0x5c, 0xf0, 0x1f, 0x71, 0xbc, 0x9a, 0xfd, 0xcf, 0x2a, 0xcc, 0x74, 0x0a, 0x04,
+	0xf4, 0xc5, 0xc4, 0xbe, 0xe3, 0x0b, 0xf6, 0xfd, 0x25, 0x5c, 0x2c, 0x3d, 0x58, 0x91, 0x97, 0xf3,
+	0x7d, 0xd7, 0xf3, 0xc4, 0x7f, 0xae, 0xbc, 0x5f, 0xd6, 0x55, 0x69, 0xd2, 0x98, 0x95, 0x75, 0x47,
+	0x92, 0x9a, 0xb6, 0x2a, 0x6c, 0xc2, 0x79, 0xc4, 0xf1, 0x9b, 0x32, 0xd3, 0x49, 0xdc, 0x74, 0x96,
+	0x25, 0xa0, 0xfc, 0x0b, 0x6b, 0xf3, 0xbb, 0x06, 0xab, 0xb9, 0xae, 0x9f, 0x06, 0x9d, 0x84, 0xc4,
+	0xec, 0xe5, 0x2c, 0x6f, 0x9a, 0x45, 0x6e, 0x4b, 0x25, 0xcf, 0x72, 0xde, 0x06, 0xc8, 0x61, 0xda,
+	0xfa, 0xb3, 0x0a, 0x95, 0x5d, 0xd6, 0x46, 0x87, 0x50, 0x9b, 0x7a, 0x96, 0x7c, 0x78, 0xf6, 0x91,
+	0x9e, 0x72, 0xc5, 0xea, 0xb7, 0x67, 0xa2, 0x67, 0x73, 0xfb, 0x1d, 0xac, 0x4e, 0x5d, 0x62, 0xc8,
+	0x3a, 0x37, 0xd6, 0x14, 0x5f, 0xff, 0x78, 0x36, 0x7e, 0x96, 0xfc, 0x10, 0x6a, 0x53, 0x17, 0xe4,
+	0xf9, 0x6d, 0x4f, 0xd2, 0xf5, 0xdb, 0x33, 0xd1, 0xb3, 0xcc, 0x3d, 0x78, 0x7d, 0xf2, 0xc2, 0xf9,
+	0xe0, 0x7c, 0x01, 0x4f, 0xb2, 0xf5, 0x8f, 0x66, 0x61, 0x67, 0x69, 0xbf, 0x01, 0x10, 0x26, 0xb1,
+	0x93, 0x68, 0xe3, 0xe2, 0x18, 0x82, 0xa8, 0xdb, 0x97, 0x24, 0x8e, 0xf3, 0x34, 0x5b, 0xcf, 0x06,
+	0x86, 0xf6, 0x7c, 0x60, 0x68, 0x7f, 0x0f, 0x0c, 0xed, 0xa7, 0x63, 0xa3, 0xf4, 0xfc, 0xd8, 0x28,
+	0xfd, 0x75, 0x6c, 0x94, 0xbe, 0xbc, 0xd1, 0x0e, 0x92, 0x07, 0xbd, 0x7d, 0xcb, 0xa3, 0x5d, 0x9b,
+	0xf6, 0x63, 0xaf, 0xf3, 0xd0, 0x96, 0x0f, 0xeb, 0xc3, 0xe2, 0x03, 0x5c, 0x3c, 0xab, 0xf7, 0xe7,
+	0xc5, 0xbb, 0xf9, 0xd6, 0x7f, 0x03, 0x00, 0x13, 0x17, 0xc9, 0xe4, 0xfc, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -655,6 +775,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// CreateDeployment defines a method to create new deployment given proper inputs.
 	CreateDeployment(ctx context.Context, in *MsgCreateDeployment, opts ...grpc.CallOption) (*MsgCreateDeploymentResponse, error)
+	// DepositDeployment defines a method to deposit funds into a deployment's escrow account.
+	DepositDeployment(ctx context.Context, in *MsgDepositDeployment, opts ...grpc.CallOption) (*MsgDepositDeploymentResponse, error)
 	// UpdateDeployment defines a method to update a deployment given proper inputs.
 	UpdateDeployment(ctx context.Context, in *MsgUpdateDeployment, opts ...grpc.CallOption) (*MsgUpdateDeploymentResponse, error)
 	// CloseDeployment defines a method to close a deployment given proper inputs.
@@ -680,6 +802,15 @@ func (c *msgClient) CreateDeployment(ctx context.Context, in *MsgCreateDeploymen
 	return out, nil
 }
 
+func (c *msgClient) DepositDeployment(ctx context.Context, in *MsgDepositDeployment, opts ...grpc.CallOption) (*MsgDepositDeploymentResponse, error) {
+	out := new(MsgDepositDeploymentResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta1.Msg/DepositDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateDeployment(ctx context.Context, in *MsgUpdateDeployment, opts ...grpc.CallOption) (*MsgUpdateDeploymentResponse, error) {
 	out := new(MsgUpdateDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta1.Msg/UpdateDeployment", in, out, opts...)
@@ -711,6 +842,8 @@ func (c *msgClient) CloseGroup(ctx context.Context, in *MsgCloseGroup, opts ...g
 type MsgServer interface {
 	// CreateDeployment defines a method to create new deployment given proper inputs.
 	CreateDeployment(context.Context, *MsgCreateDeployment) (*MsgCreateDeploymentResponse, error)
+	// DepositDeployment defines a method to deposit funds into a deployment's escrow account.
+	DepositDeployment(context.Context, *MsgDepositDeployment) (*MsgDepositDeploymentResponse, error)
 	// UpdateDeployment defines a method to update a deployment given proper inputs.
 	UpdateDeployment(context.Context, *MsgUpdateDeployment) (*MsgUpdateDeploymentResponse, error)
 	// CloseDeployment defines a method to close a deployment given proper inputs.
@@ -726,6 +859,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateDeployment(ctx context.Context, req *MsgCreateDeployment) (*MsgCreateDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeployment not implemented")
 }
+func (*UnimplementedMsgServer) DepositDeployment(ctx context.Context, req *MsgDepositDeployment) (*MsgDepositDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositDeployment not implemented")
+}
 func (*UnimplementedMsgServer) UpdateDeployment(ctx context.Context, req *MsgUpdateDeployment) (*MsgUpdateDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeployment not implemented")
 }
@@ -758,6 +894,24 @@ func _Msg_CreateDeployment_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DepositDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositDeployment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta1.Msg/DepositDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositDeployment(ctx, req.(*MsgDepositDeployment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateDeployment)
 	if err := dec(in); err != nil {
@@ -820,6 +974,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDeployment",
 			Handler:    _Msg_CreateDeployment_Handler,
 		},
+		{
+			MethodName: "DepositDeployment",
+			Handler:    _Msg_DepositDeployment_Handler,
+		},
 		{
 			MethodName: "UpdateDeployment",
 			Handler:    _Msg_UpdateDeployment_Handler,
@@ -857,6 +1015,16 @@ func (m *MsgCreateDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeployment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -914,6 +1082,72 @@ func (m *MsgCreateDeploymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDepositDeployment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositDeployment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeployment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeployment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositDeploymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositDeploymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositDeploymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateDeployment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1147,6 +1381,16 @@ func (m *DeploymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.EscrowAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeployment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -1252,6 +1496,8 @@ func (m *MsgCreateDeployment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDeployment(uint64(l))
 	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovDeployment(uint64(l))
 	return n
 }
 
@@ -1264,6 +1510,28 @@ func (m *MsgCreateDeploymentResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDepositDeployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovDeployment(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovDeployment(uint64(l))
+	return n
+}
+
+func (m *MsgDepositDeploymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateDeployment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1366,6 +1634,8 @@ func (m *DeploymentResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDeployment(uint64(l))
 	}
+	l = m.EscrowAccount.Size()
+	n += 1 + l + sovDeployment(uint64(l))
 	return n
 }
 
@@ -1525,6 +1795,39 @@ func (m *MsgCreateDeployment) Unmarshal(dAtA []byte) error {
 				m.Version = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeployment(dAtA[iNdEx:])
@@ -1602,6 +1905,178 @@ func (m *MsgCreateDeploymentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDepositDeployment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeployment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositDeployment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeployment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositDeploymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeployment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositDeploymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositDeploymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeployment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2321,6 +2796,39 @@ func (m *DeploymentResponse) Unmarshal(dAtA []byte) error {
 				m.Version = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeployment(dAtA[iNdEx:])
@@ -21,6 +21,9 @@ const (
 	errGroupClosed
 	errGroupNotOpen
 	errGroupSpec
+	errInvalidDeposit
+	errGroupInsufficientFunds
+	errGroupSpecExceedsLease
 )
 
 var (
@@ -56,4 +59,10 @@ var (
 	ErrGroupNotOpen = sdkerrors.Register(ModuleName, errGroupNotOpen, "Group not open")
 	// ErrGroupSpecInvalid indicates a GroupSpec has invalid configuration
 	ErrGroupSpecInvalid = sdkerrors.Register(ModuleName, errGroupSpec, "GroupSpec invalid")
+	// ErrInvalidDeposit indicates an invalid escrow deposit
+	ErrInvalidDeposit = sdkerrors.Register(ModuleName, errInvalidDeposit, "Deposit invalid")
+	// ErrGroupInsufficientFunds indicates the Group's lease ran out of funds
+	ErrGroupInsufficientFunds = sdkerrors.Register(ModuleName, errGroupInsufficientFunds, "Group has insufficient funds")
+	// ErrGroupSpecExceedsLease indicates the new GroupSpec of a leased Group does not fit its lease
+	ErrGroupSpecExceedsLease = sdkerrors.Register(ModuleName, errGroupSpecExceedsLease, "GroupSpec does not fit the group's lease")
 )
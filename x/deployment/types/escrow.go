@@ -0,0 +1,43 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	etypes "github.com/ovrclk/akash/x/escrow/types"
+)
+
+// EscrowScope is the escrow account scope used for deployments
+const EscrowScope = "deployment"
+
+// EscrowAccountForDeployment returns the ID of the escrow account funding the deployment
+func EscrowAccountForDeployment(id DeploymentID) etypes.AccountID {
+	return etypes.AccountID{
+		Scope: EscrowScope,
+		XID:   id.String(),
+	}
+}
+
+// DeploymentIDFromEscrowAccount returns the ID of the deployment funded by the escrow account
+func DeploymentIDFromEscrowAccount(id etypes.AccountID) (DeploymentID, bool) {
+	if id.Scope != EscrowScope {
+		return DeploymentID{}, false
+	}
+
+	parts := strings.Split(id.XID, "/")
+	if len(parts) != 2 {
+		return DeploymentID{}, false
+	}
+
+	dseq, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return DeploymentID{}, false
+	}
+
+	did := DeploymentID{
+		Owner: parts[0],
+		DSeq:  dseq,
+	}
+
+	return did, did.Validate() == nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"errors"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,15 +10,23 @@ import (
 )
 
 const (
-	evActionDeploymentCreated = "deployment-created"
-	evActionDeploymentUpdated = "deployment-updated"
-	evActionDeploymentClosed  = "deployment-closed"
-	evActionGroupClosed       = "group-closed"
-	evOwnerKey                = "owner"
-	evDSeqKey                 = "dseq"
-	evGSeqKey                 = "gseq"
-	evVersionKey              = "version"
-	encodedVersionHexLen      = 64
+	evActionDeploymentCreated   = "deployment-created"
+	evActionDeploymentUpdated   = "deployment-updated"
+	evActionDeploymentClosed    = "deployment-closed"
+	evActionDeploymentDeposited = "deployment-deposited"
+	evActionGroupClosed         = "group-closed"
+	evOwnerKey                  = "owner"
+	evDSeqKey                   = "dseq"
+	evGSeqKey                   = "gseq"
+	evVersionKey                = "version"
+	evAmountDenomKey            = "amount-denom"
+	evAmountKey                 = "amount"
+	encodedVersionHexLen        = 64
+)
+
+var (
+	// ErrParsingAmount error code for parsing event amount
+	ErrParsingAmount = errors.New("error parsing amount")
 )
 
 // EventDeploymentCreated struct
@@ -108,6 +117,57 @@ func (ev EventDeploymentClosed) ToSDKEvent() sdk.Event {
 	)
 }
 
+// EventDeploymentDeposited struct
+type EventDeploymentDeposited struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      DeploymentID            `json:"id"`
+	Amount  sdk.Coin                `json:"amount"`
+}
+
+// NewEventDeploymentDeposited initializes deposit event.
+func NewEventDeploymentDeposited(id DeploymentID, amount sdk.Coin) EventDeploymentDeposited {
+	return EventDeploymentDeposited{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: evActionDeploymentDeposited,
+		},
+		ID:     id,
+		Amount: amount,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventDeploymentDeposited struct
+func (ev EventDeploymentDeposited) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionDeploymentDeposited),
+			sdk.NewAttribute(evAmountDenomKey, ev.Amount.Denom),
+			sdk.NewAttribute(evAmountKey, ev.Amount.Amount.String()),
+		}, DeploymentIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// ParseEVAmount returns the deposited amount for given event attributes
+func ParseEVAmount(attrs []sdk.Attribute) (sdk.Coin, error) {
+	denom, err := sdkutil.GetString(attrs, evAmountDenomKey)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amounts, err := sdkutil.GetString(attrs, evAmountKey)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount, ok := sdk.NewIntFromString(amounts)
+	if !ok {
+		return sdk.Coin{}, ErrParsingAmount
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
 // DeploymentIDEVAttributes returns event attribues for given DeploymentID
 func DeploymentIDEVAttributes(id DeploymentID) []sdk.Attribute {
 	return []sdk.Attribute{
@@ -242,6 +302,16 @@ func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
 			return nil, err
 		}
 		return NewEventDeploymentClosed(did), nil
+	case evActionDeploymentDeposited:
+		did, err := ParseEVDeploymentID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := ParseEVAmount(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return NewEventDeploymentDeposited(did, amount), nil
 	case evActionGroupClosed:
 		gid, err := ParseEVGroupID(ev.Attributes)
 		if err != nil {
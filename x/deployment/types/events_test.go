@@ -324,6 +324,58 @@ var TEPS = []testEventParsing{
 		},
 		expErr: errWildcard,
 	},
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionDeploymentDeposited,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evAmountDenomKey,
+					Value: "uakt",
+				},
+				{
+					Key:   evAmountKey,
+					Value: "1000",
+				},
+			},
+		},
+		expErr: nil,
+	},
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionDeploymentDeposited,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evAmountDenomKey,
+					Value: "uakt",
+				},
+				{
+					Key:   evAmountKey,
+					Value: "abc",
+				},
+			},
+		},
+		expErr: ErrParsingAmount,
+	},
 }
 
 func TestEventParsing(t *testing.T) {
@@ -79,6 +79,7 @@ func (m *GenesisDeployment) GetGroups() []Group {
 // GenesisState stores slice of genesis deployment instance
 type GenesisState struct {
 	Deployments []GenesisDeployment `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments" yaml:"deployments"`
+	Params      Params              `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -121,6 +122,13 @@ func (m *GenesisState) GetDeployments() []GenesisDeployment {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisDeployment)(nil), "akash.deployment.v1beta1.GenesisDeployment")
 	proto.RegisterType((*GenesisState)(nil), "akash.deployment.v1beta1.GenesisState")
@@ -131,27 +139,29 @@ func init() {
 }
 
 var fileDescriptor_8ea837e5a570e958 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xcc, 0x4e, 0x2c,
-	0xce, 0xd0, 0x4f, 0x49, 0x2d, 0xc8, 0xc9, 0xaf, 0xcc, 0x4d, 0xcd, 0x2b, 0xd1, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x00, 0xab, 0xd3, 0x43, 0xa8, 0xd3, 0x83, 0xaa, 0x93, 0x12, 0x49, 0xcf,
-	0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x34, 0x71, 0x9a, 0x8b, 0x64, 0x04,
-	0x44, 0xa9, 0x0a, 0x6e, 0x27, 0x14, 0xe5, 0x97, 0x16, 0x40, 0x54, 0x29, 0xdd, 0x61, 0xe4, 0x12,
-	0x74, 0x87, 0x38, 0xc9, 0x05, 0xae, 0x52, 0x28, 0x97, 0x8b, 0x0b, 0xa1, 0x4f, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0xdb, 0x48, 0x45, 0x0f, 0x97, 0x5b, 0xf5, 0x10, 0x3a, 0x9d, 0xd4, 0x4f, 0xdc, 0x93,
-	0x67, 0x78, 0x75, 0x4f, 0x1e, 0x49, 0xff, 0xa7, 0x7b, 0xf2, 0x82, 0x95, 0x89, 0xb9, 0x39, 0x56,
-	0x4a, 0x08, 0x31, 0xa5, 0x20, 0x24, 0x05, 0x42, 0x11, 0x5c, 0x6c, 0x60, 0x37, 0x15, 0x4b, 0x30,
-	0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xe3, 0xb6, 0xca, 0x1d, 0xa4, 0xce, 0x49, 0x1e, 0x6a, 0x0b,
-	0x54, 0xdb, 0xa7, 0x7b, 0xf2, 0xbc, 0x10, 0x1b, 0x20, 0x7c, 0xa5, 0x20, 0xa8, 0x84, 0x52, 0x07,
-	0x23, 0x17, 0x0f, 0xd4, 0x7b, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x15, 0x5c, 0xdc, 0x08, 0x53,
-	0x8b, 0x25, 0x18, 0xc1, 0xf6, 0x69, 0xe3, 0xb1, 0x0f, 0x3d, 0x6c, 0x9c, 0x34, 0xa1, 0x76, 0x23,
-	0x9b, 0xf3, 0xe9, 0x9e, 0xbc, 0x10, 0xba, 0x17, 0x8b, 0x95, 0x82, 0x90, 0x95, 0x38, 0xb9, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x56, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7e, 0x59, 0x51, 0x72, 0x4e, 0xb6, 0x3e, 0x24, 0xee, 0x2a,
-	0x90, 0x63, 0xaf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x6d, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0x25, 0xf9, 0x82, 0x61, 0x02, 0x00, 0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xb1, 0x6e, 0xf2, 0x30,
+	0x14, 0x85, 0x63, 0x7e, 0x89, 0xc1, 0xfc, 0x1d, 0x88, 0x3a, 0x44, 0x0c, 0x31, 0xb2, 0x68, 0x0b,
+	0xad, 0x94, 0x08, 0xba, 0x75, 0x8c, 0x90, 0x58, 0xab, 0x74, 0x69, 0xbb, 0x19, 0x6a, 0x05, 0x04,
+	0xc1, 0x51, 0x6c, 0x10, 0xbc, 0x45, 0x1f, 0x8b, 0x91, 0xb1, 0x43, 0x15, 0x55, 0x64, 0xeb, 0xc8,
+	0x13, 0x54, 0xd8, 0x56, 0x1d, 0x21, 0xb9, 0x5b, 0x9c, 0xfb, 0x9d, 0x7b, 0xee, 0xb9, 0xba, 0xf0,
+	0x9a, 0xcc, 0x09, 0x9f, 0x86, 0x6f, 0x34, 0x5b, 0xb0, 0x6d, 0x4a, 0x97, 0x22, 0x5c, 0xf7, 0xc7,
+	0x54, 0x90, 0x7e, 0x98, 0xd0, 0x25, 0xe5, 0x33, 0x1e, 0x64, 0x39, 0x13, 0xcc, 0xf5, 0x24, 0x17,
+	0x18, 0x2e, 0xd0, 0x5c, 0xeb, 0x32, 0x61, 0x09, 0x93, 0x50, 0x78, 0xfa, 0x52, 0x7c, 0xab, 0x67,
+	0xed, 0x5b, 0x69, 0xa1, 0xd0, 0x8e, 0x7d, 0x84, 0x9c, 0xad, 0x32, 0x4d, 0x5d, 0x59, 0xa9, 0x8c,
+	0xe4, 0x24, 0xd5, 0x73, 0xe2, 0x4f, 0x00, 0x9b, 0x23, 0x35, 0xf9, 0xf0, 0x17, 0x75, 0x53, 0x08,
+	0x8d, 0xd0, 0x03, 0x6d, 0xd0, 0x6d, 0x0c, 0x3a, 0x81, 0x2d, 0x52, 0x60, 0x94, 0xd1, 0xcd, 0xae,
+	0x40, 0xce, 0x77, 0x81, 0x2a, 0xfa, 0x63, 0x81, 0x9a, 0x5b, 0x92, 0x2e, 0x1e, 0xb0, 0xf9, 0x87,
+	0xe3, 0x0a, 0xe0, 0x3e, 0xc3, 0xba, 0x1c, 0x9d, 0x7b, 0xb5, 0xf6, 0xbf, 0x6e, 0x63, 0x80, 0xec,
+	0x56, 0xa3, 0x13, 0x17, 0x21, 0xed, 0xa2, 0x65, 0xc7, 0x02, 0x5d, 0x28, 0x07, 0xf5, 0xc6, 0xb1,
+	0x2e, 0xe0, 0x12, 0xc0, 0xff, 0x3a, 0xde, 0x93, 0x20, 0x82, 0xba, 0x1b, 0xd8, 0x30, 0x5d, 0xb9,
+	0x07, 0xa4, 0xdf, 0xdd, 0x1f, 0x7e, 0xe7, 0xbb, 0x89, 0x7a, 0xda, 0xbb, 0xda, 0xe7, 0x58, 0x20,
+	0xf7, 0x3c, 0x22, 0xc7, 0x71, 0x15, 0x71, 0x5f, 0x60, 0x5d, 0x6d, 0xde, 0xab, 0xc9, 0x7d, 0xb6,
+	0xed, 0xa6, 0x8f, 0x92, 0x33, 0x29, 0x95, 0xce, 0xa4, 0x54, 0x6f, 0x1c, 0xeb, 0x42, 0x34, 0xdc,
+	0x1d, 0x7c, 0xb0, 0x3f, 0xf8, 0xe0, 0xeb, 0xe0, 0x83, 0xf7, 0xd2, 0x77, 0xf6, 0xa5, 0xef, 0x7c,
+	0x94, 0xbe, 0xf3, 0x7a, 0x9b, 0xcc, 0xc4, 0x74, 0x35, 0x0e, 0x26, 0x2c, 0x0d, 0xd9, 0x3a, 0x9f,
+	0x2c, 0xe6, 0xa1, 0xba, 0x8b, 0x4d, 0xf5, 0x32, 0xc4, 0x36, 0xa3, 0x7c, 0x5c, 0x97, 0x17, 0x71,
+	0xff, 0x33, 0x00, 0x32, 0xd7, 0x97, 0xf1, 0xe3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisDeployment) Marshal() (dAtA []byte, err error) {
@@ -221,6 +231,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Deployments) > 0 {
 		for iNdEx := len(m.Deployments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -278,6 +298,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -470,6 +492,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -217,6 +217,9 @@ type GroupSpec struct {
 	Requirements     []types.Attribute `protobuf:"bytes,2,rep,name=requirements,proto3" json:"requirements" yaml:"requirements"`
 	Resources        []Resource        `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources" yaml:"resources"`
 	OrderBidDuration int64             `protobuf:"varint,4,opt,name=order_bid_duration,json=orderBidDuration,proto3" json:"order-bid-duration" yaml:"order-bid-duration"`
+	// AutoMatch enables automatic matching of the lowest priced bid when the order opens for matching
+	AutoMatch bool           `protobuf:"varint,5,opt,name=auto_match,json=autoMatch,proto3" json:"auto-match" yaml:"auto-match"`
+	SignedBy  types.SignedBy `protobuf:"bytes,6,opt,name=signed_by,json=signedBy,proto3" json:"signed-by" yaml:"signed-by"`
 }
 
 func (m *GroupSpec) Reset()         { *m = GroupSpec{} }
@@ -389,63 +392,68 @@ func init() {
 }
 
 var fileDescriptor_92581ef27257da99 = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8e, 0x13, 0xa7, 0xbb, 0x99, 0xec, 0xfe, 0x7e, 0x66, 0xa0, 0x6c, 0x36, 0xd5, 0x66, 0xdc,
-	0x81, 0x4a, 0x11, 0x55, 0x13, 0x35, 0xbd, 0xed, 0x0d, 0x37, 0x62, 0xb5, 0x87, 0x52, 0xe4, 0x15,
-	0x1c, 0x10, 0x52, 0x70, 0x3c, 0xb3, 0xde, 0x51, 0x13, 0x8f, 0xd7, 0x63, 0x2f, 0xec, 0x9d, 0x43,
-	0x95, 0x13, 0xe2, 0xc4, 0x25, 0x52, 0x25, 0xee, 0xfc, 0x1d, 0x3d, 0xf6, 0x08, 0x17, 0x0b, 0x65,
-	0x2f, 0x28, 0x17, 0xa4, 0xfc, 0x05, 0x68, 0x66, 0xec, 0x3a, 0x86, 0x56, 0x15, 0xa7, 0xe4, 0xbd,
-	0xf7, 0x7d, 0xdf, 0x3c, 0xbf, 0xf7, 0x79, 0x0c, 0x3e, 0xf6, 0x9e, 0x79, 0xe2, 0x62, 0x48, 0x68,
-	0x34, 0xe3, 0xd7, 0x73, 0x1a, 0x26, 0xc3, 0xab, 0x87, 0x53, 0x9a, 0x78, 0x0f, 0x87, 0x41, 0xcc,
-	0xd3, 0x68, 0x10, 0xc5, 0x3c, 0xe1, 0xb0, 0xa3, 0x50, 0x83, 0x12, 0x35, 0xc8, 0x51, 0xdd, 0x0f,
-	0x02, 0x1e, 0x70, 0x05, 0x1a, 0xca, 0x7f, 0x1a, 0xdf, 0xbd, 0xab, 0x55, 0xa7, 0x9e, 0xa0, 0xaf,
-	0xf5, 0x62, 0x2a, 0x78, 0x1a, 0xfb, 0x34, 0x87, 0xe0, 0x37, 0x40, 0xbc, 0x24, 0x89, 0xd9, 0x34,
-	0x4d, 0x0a, 0x4c, 0xcf, 0xe7, 0x62, 0xce, 0x45, 0x15, 0xe4, 0x73, 0x16, 0xea, 0x3a, 0x0e, 0xc0,
-	0xfe, 0x13, 0x11, 0x3c, 0x9e, 0x71, 0x41, 0x4f, 0x64, 0xb7, 0xf0, 0x0b, 0x50, 0x67, 0xa4, 0x63,
-	0xd8, 0x46, 0xbf, 0x3d, 0xba, 0x3b, 0x78, 0x5b, 0xd3, 0x03, 0x05, 0x3e, 0x1d, 0x3b, 0x47, 0x2f,
-	0x33, 0x54, 0x5b, 0x65, 0xa8, 0x7e, 0x3a, 0x5e, 0x67, 0xa8, 0xce, 0xc8, 0x26, 0x43, 0xad, 0x6b,
-	0x6f, 0x3e, 0x3b, 0xc6, 0x8c, 0x60, 0xb7, 0xce, 0xc8, 0xb1, 0xf9, 0xe7, 0x0b, 0x54, 0xc3, 0x07,
-	0xe0, 0x76, 0xe5, 0x20, 0x97, 0x8a, 0x88, 0x87, 0x82, 0xe2, 0x5f, 0x0d, 0xb0, 0x93, 0xab, 0xc1,
-	0x21, 0x68, 0xf2, 0xef, 0x42, 0x1a, 0xab, 0xf3, 0x5b, 0xce, 0xe1, 0x3a, 0x43, 0x3a, 0xb1, 0xc9,
-	0xd0, 0x9e, 0x56, 0x55, 0x21, 0x76, 0x75, 0x1a, 0x3e, 0x02, 0x26, 0x11, 0xf4, 0xb2, 0x53, 0xb7,
-	0x8d, 0xbe, 0xe9, 0xa0, 0x55, 0x86, 0xcc, 0xf1, 0x19, 0xbd, 0x5c, 0x67, 0x48, 0xe5, 0x37, 0x19,
-	0x6a, 0x6b, 0x9a, 0x8c, 0xb0, 0xab, 0x92, 0x92, 0x14, 0x48, 0x52, 0xc3, 0x36, 0xfa, 0xfb, 0x9a,
-	0x74, 0x92, 0x93, 0x82, 0x0a, 0x29, 0xd0, 0x24, 0xf9, 0x73, 0xbc, 0xfb, 0xf3, 0x0b, 0x54, 0x53,
-	0x4f, 0xf2, 0x53, 0x03, 0xb4, 0x54, 0xc3, 0x67, 0x11, 0xf5, 0xe1, 0x7d, 0x60, 0x86, 0xde, 0x9c,
-	0xe6, 0x1d, 0x1f, 0x48, 0x11, 0x19, 0x97, 0x22, 0x32, 0xc2, 0xae, 0x4a, 0xc2, 0x10, 0xec, 0xc5,
-	0xf4, 0x32, 0x65, 0x31, 0x95, 0xc3, 0x14, 0x9d, 0xba, 0xdd, 0xe8, 0xb7, 0x47, 0x47, 0xf9, 0x98,
-	0xe5, 0x8e, 0x5e, 0x0f, 0xf8, 0xd3, 0x62, 0x91, 0xce, 0x7d, 0x39, 0xe2, 0x75, 0x86, 0x2a, 0xd4,
-	0x4d, 0x86, 0xde, 0xd7, 0xfa, 0xdb, 0x59, 0xec, 0x56, 0x40, 0x30, 0x00, 0xad, 0xc2, 0x33, 0xa2,
-	0xd3, 0x50, 0x87, 0xe1, 0xb7, 0xef, 0xd4, 0xcd, 0xa1, 0xce, 0xbd, 0xfc, 0xc4, 0x92, 0xbc, 0xc9,
-	0x90, 0x55, 0x1c, 0x97, 0xa7, 0xb0, 0x5b, 0x96, 0x61, 0x0a, 0x20, 0x8f, 0x09, 0x8d, 0x27, 0x53,
-	0x46, 0x26, 0x24, 0x8d, 0xbd, 0x84, 0xf1, 0xb0, 0x63, 0xda, 0x46, 0xbf, 0xe1, 0x9c, 0xac, 0x32,
-	0x64, 0x3d, 0x95, 0x55, 0x87, 0x91, 0x71, 0x5e, 0x5b, 0x67, 0x48, 0x33, 0x1e, 0x4c, 0x19, 0x79,
-	0x50, 0x30, 0x36, 0x19, 0x3a, 0xcc, 0xd7, 0xfc, 0xaf, 0x1a, 0x76, 0x2d, 0xfe, 0x0f, 0x91, 0xe3,
-	0xdd, 0xe7, 0xc5, 0x52, 0x7e, 0x30, 0x41, 0x53, 0x1b, 0xf8, 0x5b, 0xb0, 0xab, 0xde, 0xbb, 0xc9,
-	0x7f, 0xb1, 0x31, 0xce, 0x6d, 0x5c, 0x38, 0xf1, 0x4d, 0x5e, 0xde, 0x51, 0xb2, 0xa7, 0x04, 0x7e,
-	0x05, 0x9a, 0x22, 0xf1, 0x12, 0xaa, 0x5c, 0xf7, 0xbf, 0xd1, 0xbd, 0x77, 0xc8, 0x0f, 0xce, 0x24,
-	0x58, 0x9b, 0x59, 0xf1, 0x4a, 0x33, 0xab, 0x10, 0xbb, 0x3a, 0x0d, 0x27, 0x00, 0xe8, 0xce, 0x45,
-	0x44, 0x7d, 0xe5, 0xce, 0xf6, 0xe8, 0xa3, 0x77, 0x88, 0x4b, 0x0f, 0x3a, 0x77, 0xf2, 0x7d, 0x99,
-	0x92, 0x58, 0x3a, 0x4f, 0x46, 0xd8, 0x6d, 0x05, 0x05, 0x0e, 0xff, 0x6e, 0x80, 0xa6, 0x6a, 0x06,
-	0x62, 0xb0, 0xc3, 0xc2, 0x2b, 0x6f, 0xc6, 0x88, 0x55, 0xeb, 0xde, 0x5e, 0x2c, 0xed, 0xf7, 0xb4,
-	0x9a, 0x2c, 0x9e, 0xea, 0x02, 0x3c, 0x00, 0x26, 0x8f, 0x68, 0x68, 0x19, 0xdd, 0xfd, 0xc5, 0xd2,
-	0xd6, 0x96, 0x7f, 0x1a, 0xd1, 0x10, 0x1e, 0x81, 0x1d, 0xb5, 0x09, 0x4a, 0xac, 0x7a, 0xd7, 0x5a,
-	0x2c, 0xed, 0x3d, 0x5d, 0xd3, 0x39, 0x59, 0x9e, 0x7b, 0x89, 0x7f, 0x41, 0x89, 0xd5, 0xd8, 0x2a,
-	0x3f, 0xd1, 0x39, 0x38, 0x02, 0x90, 0x85, 0x22, 0x3d, 0x3f, 0x67, 0x3e, 0xa3, 0x61, 0x32, 0x39,
-	0x4f, 0x43, 0x22, 0x2c, 0xb3, 0xdb, 0x5d, 0x2c, 0xed, 0x0f, 0xf5, 0xf8, 0xb7, 0xca, 0x9f, 0xc9,
-	0x2a, 0xbc, 0x03, 0x6e, 0xf9, 0xf2, 0xe6, 0x20, 0x56, 0xb3, 0xfb, 0xff, 0xc5, 0xd2, 0x6e, 0x2b,
-	0x9c, 0xba, 0x4c, 0x48, 0xd7, 0x7c, 0xfe, 0x4b, 0xaf, 0x96, 0xdf, 0x32, 0x7f, 0x19, 0x60, 0xb7,
-	0xb0, 0x31, 0xfc, 0x66, 0xdb, 0xfd, 0x55, 0x2b, 0x54, 0x5e, 0xb5, 0x82, 0xf0, 0x65, 0xc8, 0x12,
-	0x51, 0x0e, 0x33, 0x0d, 0x59, 0x52, 0x0e, 0x53, 0x46, 0x15, 0xcb, 0x0f, 0x41, 0xd3, 0xe7, 0x69,
-	0x98, 0x28, 0x17, 0xec, 0xeb, 0xf5, 0xaa, 0x44, 0xb9, 0x5e, 0x15, 0x62, 0x57, 0xa7, 0xe1, 0xe7,
-	0xa0, 0x19, 0xc5, 0xcc, 0xa7, 0xf9, 0x66, 0x0f, 0x07, 0xfa, 0x6a, 0xae, 0xf6, 0xf2, 0x98, 0xb3,
-	0x50, 0x5f, 0xaa, 0x52, 0x4f, 0xe1, 0x4b, 0x3d, 0x15, 0x62, 0x57, 0xa7, 0xf5, 0x13, 0x3b, 0xe3,
-	0x97, 0xab, 0x9e, 0xf1, 0x6a, 0xd5, 0x33, 0xfe, 0x58, 0xf5, 0x8c, 0x1f, 0x6f, 0x7a, 0xb5, 0x57,
-	0x37, 0xbd, 0xda, 0x6f, 0x37, 0xbd, 0xda, 0xd7, 0x9f, 0x04, 0x2c, 0xb9, 0x48, 0xa7, 0x03, 0x9f,
-	0xcf, 0x87, 0xfc, 0x2a, 0xf6, 0x67, 0xcf, 0x86, 0xfa, 0x83, 0xf1, 0xfd, 0xf6, 0xb7, 0x2a, 0xb9,
-	0x8e, 0xa8, 0x98, 0xde, 0x52, 0x5f, 0x83, 0x47, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x43, 0xff,
-	0xcb, 0xc0, 0xcc, 0x06, 0x00, 0x00,
+	// 963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x25, 0x2a, 0x96, 0x4e, 0x76, 0xcb, 0x5c, 0x9b, 0x5a, 0x56, 0x6a, 0x1d, 0x73, 0x6d,
+	0x00, 0xa1, 0x81, 0xa5, 0x46, 0xd9, 0xbc, 0x85, 0x11, 0x6a, 0x78, 0x70, 0x5d, 0xd0, 0x68, 0x87,
+	0xa2, 0x80, 0x42, 0x91, 0x67, 0xfa, 0x10, 0x89, 0x47, 0xf3, 0x48, 0xb7, 0xda, 0x3b, 0x04, 0x9a,
+	0x3a, 0x76, 0x11, 0x10, 0xa0, 0x7b, 0xff, 0x45, 0x81, 0x8c, 0x19, 0xdb, 0x85, 0x28, 0xe4, 0xa5,
+	0xd0, 0x52, 0x40, 0xbf, 0xa0, 0xb8, 0x3b, 0xd2, 0x94, 0x5a, 0x07, 0x41, 0x27, 0xe9, 0x7d, 0xef,
+	0xfb, 0xde, 0x1d, 0xdf, 0xbb, 0xef, 0x0e, 0x7c, 0xea, 0xbc, 0x70, 0xf8, 0x45, 0xcf, 0x23, 0xe1,
+	0x98, 0x4d, 0x27, 0x24, 0x88, 0x7b, 0x57, 0x8f, 0x47, 0x24, 0x76, 0x1e, 0xf7, 0xfc, 0x88, 0x25,
+	0x61, 0x37, 0x8c, 0x58, 0xcc, 0x60, 0x53, 0xb2, 0xba, 0x05, 0xab, 0x9b, 0xb1, 0x5a, 0x1f, 0xfa,
+	0xcc, 0x67, 0x92, 0xd4, 0x13, 0xff, 0x14, 0xbf, 0xf5, 0x40, 0x55, 0x1d, 0x39, 0x9c, 0xdc, 0xd4,
+	0x8b, 0x08, 0x67, 0x49, 0xe4, 0x92, 0x8c, 0x82, 0x6f, 0xa1, 0x38, 0x71, 0x1c, 0xd1, 0x51, 0x12,
+	0xe7, 0x9c, 0xb6, 0xcb, 0xf8, 0x84, 0xf1, 0x4d, 0x92, 0xcb, 0x68, 0xa0, 0xf2, 0xd8, 0x07, 0x3b,
+	0x27, 0xdc, 0x7f, 0x36, 0x66, 0x9c, 0x1c, 0x89, 0xdd, 0xc2, 0xaf, 0x40, 0x99, 0x7a, 0x4d, 0xcd,
+	0xd4, 0x3a, 0x8d, 0xfe, 0x83, 0xee, 0xdb, 0x36, 0xdd, 0x95, 0xe4, 0xe3, 0x81, 0xb5, 0xff, 0x3a,
+	0x45, 0xa5, 0x45, 0x8a, 0xca, 0xc7, 0x83, 0x65, 0x8a, 0xca, 0xd4, 0x5b, 0xa5, 0xa8, 0x3e, 0x75,
+	0x26, 0xe3, 0x43, 0x4c, 0x3d, 0x6c, 0x97, 0xa9, 0x77, 0xa8, 0xff, 0xf5, 0x0a, 0x95, 0xf0, 0x2e,
+	0xb8, 0xb7, 0xb1, 0x90, 0x4d, 0x78, 0xc8, 0x02, 0x4e, 0xf0, 0xaf, 0x1a, 0xd8, 0xca, 0xaa, 0xc1,
+	0x1e, 0xa8, 0xb2, 0xef, 0x03, 0x12, 0xc9, 0xf5, 0xeb, 0xd6, 0xde, 0x32, 0x45, 0x0a, 0x58, 0xa5,
+	0x68, 0x5b, 0x55, 0x95, 0x21, 0xb6, 0x15, 0x0c, 0x9f, 0x00, 0xdd, 0xe3, 0xe4, 0xb2, 0x59, 0x36,
+	0xb5, 0x8e, 0x6e, 0xa1, 0x45, 0x8a, 0xf4, 0xc1, 0x19, 0xb9, 0x5c, 0xa6, 0x48, 0xe2, 0xab, 0x14,
+	0x35, 0x94, 0x4c, 0x44, 0xd8, 0x96, 0xa0, 0x10, 0xf9, 0x42, 0x54, 0x31, 0xb5, 0xce, 0x8e, 0x12,
+	0x1d, 0x65, 0x22, 0x7f, 0x43, 0xe4, 0x2b, 0x91, 0xf8, 0x39, 0xac, 0xfd, 0xfc, 0x0a, 0x95, 0xe4,
+	0x97, 0xfc, 0xa6, 0x83, 0xba, 0xdc, 0xf0, 0x59, 0x48, 0x5c, 0xf8, 0x08, 0xe8, 0x81, 0x33, 0x21,
+	0xd9, 0x8e, 0x77, 0x45, 0x11, 0x11, 0x17, 0x45, 0x44, 0x84, 0x6d, 0x09, 0xc2, 0x00, 0x6c, 0x47,
+	0xe4, 0x32, 0xa1, 0x11, 0x11, 0xcd, 0xe4, 0xcd, 0xb2, 0x59, 0xe9, 0x34, 0xfa, 0xfb, 0x59, 0x9b,
+	0xc5, 0x8c, 0x6e, 0x1a, 0xfc, 0x34, 0x1f, 0xa4, 0xf5, 0x48, 0xb4, 0x78, 0x99, 0xa2, 0x0d, 0xe9,
+	0x2a, 0x45, 0x1f, 0xa8, 0xfa, 0xeb, 0x28, 0xb6, 0x37, 0x48, 0xd0, 0x07, 0xf5, 0xfc, 0xcc, 0xf0,
+	0x66, 0x45, 0x2e, 0x86, 0xdf, 0x3e, 0x53, 0x3b, 0xa3, 0x5a, 0x0f, 0xb3, 0x15, 0x0b, 0xf1, 0x2a,
+	0x45, 0x46, 0xbe, 0x5c, 0x06, 0x61, 0xbb, 0x48, 0xc3, 0x04, 0x40, 0x16, 0x79, 0x24, 0x1a, 0x8e,
+	0xa8, 0x37, 0xf4, 0x92, 0xc8, 0x89, 0x29, 0x0b, 0x9a, 0xba, 0xa9, 0x75, 0x2a, 0xd6, 0xd1, 0x22,
+	0x45, 0xc6, 0xa9, 0xc8, 0x5a, 0xd4, 0x1b, 0x64, 0xb9, 0x65, 0x8a, 0x94, 0xe2, 0x60, 0x44, 0xbd,
+	0x83, 0x5c, 0xb1, 0x4a, 0xd1, 0x5e, 0x36, 0xe6, 0xff, 0xe4, 0xb0, 0x6d, 0xb0, 0x7f, 0x15, 0x81,
+	0xa7, 0x00, 0x38, 0x49, 0xcc, 0x86, 0x13, 0x27, 0x76, 0x2f, 0x9a, 0x55, 0x53, 0xeb, 0xd4, 0xac,
+	0xcf, 0x17, 0x29, 0xaa, 0x3f, 0x4d, 0x62, 0x76, 0x22, 0xc0, 0x65, 0x8a, 0x24, 0xe5, 0x40, 0x52,
+	0x56, 0x29, 0xba, 0xab, 0xea, 0x17, 0x18, 0xb6, 0xeb, 0x4e, 0xce, 0x86, 0xcf, 0x41, 0x9d, 0x53,
+	0x3f, 0x20, 0xde, 0x70, 0x34, 0x6d, 0xde, 0x91, 0x26, 0xf8, 0xf8, 0xb6, 0xe9, 0x9c, 0x49, 0x92,
+	0x35, 0x2d, 0x5a, 0xa5, 0x64, 0x07, 0xa3, 0x69, 0xd1, 0xaa, 0x1b, 0x08, 0xdb, 0x35, 0x9e, 0x09,
+	0x0e, 0x6b, 0x2f, 0xf3, 0x73, 0xf4, 0xa3, 0x0e, 0xaa, 0xca, 0x73, 0xcf, 0x41, 0x4d, 0x5e, 0x15,
+	0xc3, 0xff, 0xe3, 0x3c, 0x9c, 0x39, 0x2f, 0x37, 0xcf, 0x6d, 0xf6, 0xdb, 0x92, 0x65, 0x8f, 0x3d,
+	0xf8, 0x0d, 0xa8, 0xf2, 0xd8, 0x89, 0x89, 0x34, 0xca, 0x7b, 0xfd, 0x87, 0xef, 0x28, 0xdf, 0x3d,
+	0x13, 0x64, 0xe5, 0x3f, 0xa9, 0x2b, 0xfc, 0x27, 0x43, 0x6c, 0x2b, 0x18, 0x0e, 0x01, 0x50, 0x3b,
+	0xe7, 0x21, 0x71, 0xa5, 0xa1, 0x1a, 0xfd, 0x4f, 0xde, 0x51, 0x5c, 0xd8, 0xc6, 0xba, 0x9f, 0xf5,
+	0x4d, 0x17, 0xc2, 0xc2, 0x2c, 0x22, 0xc2, 0x76, 0xdd, 0xcf, 0x79, 0xf8, 0x0f, 0x0d, 0x54, 0xe5,
+	0x66, 0x20, 0x06, 0x5b, 0x34, 0xb8, 0x72, 0xc6, 0xd4, 0x33, 0x4a, 0xad, 0x7b, 0xb3, 0xb9, 0x79,
+	0x57, 0x55, 0x13, 0xc9, 0x63, 0x95, 0x80, 0xbb, 0x40, 0x67, 0x21, 0x09, 0x0c, 0xad, 0xb5, 0x33,
+	0x9b, 0x9b, 0xca, 0xa5, 0xa7, 0x21, 0x09, 0xe0, 0x3e, 0xd8, 0x92, 0x87, 0x87, 0x78, 0x46, 0xb9,
+	0x65, 0xcc, 0xe6, 0xe6, 0xb6, 0xca, 0x29, 0x4c, 0xa4, 0xe5, 0x59, 0x20, 0x9e, 0x51, 0x59, 0x4b,
+	0x9f, 0x28, 0x0c, 0xf6, 0x01, 0xa4, 0x01, 0x4f, 0xce, 0xcf, 0xa9, 0x4b, 0x49, 0x10, 0x0f, 0xcf,
+	0x93, 0xc0, 0xe3, 0x86, 0xde, 0x6a, 0xcd, 0xe6, 0xe6, 0x47, 0xaa, 0xfd, 0x6b, 0xe9, 0x2f, 0x44,
+	0x16, 0xde, 0x07, 0x77, 0x5c, 0x71, 0xd9, 0x79, 0x46, 0xb5, 0xf5, 0xfe, 0x6c, 0x6e, 0x36, 0x24,
+	0x4f, 0xde, 0x7f, 0x5e, 0x4b, 0x7f, 0xf9, 0x4b, 0xbb, 0x94, 0x5d, 0x8c, 0x7f, 0x6b, 0xa0, 0x96,
+	0x3b, 0x0f, 0x7e, 0xb7, 0x6e, 0xd8, 0xcd, 0xa3, 0xb0, 0x71, 0xfe, 0x72, 0xc1, 0xd7, 0x01, 0x8d,
+	0x79, 0xd1, 0xcc, 0x24, 0xa0, 0x71, 0xd1, 0x4c, 0x11, 0x6d, 0xb8, 0xb4, 0x07, 0xaa, 0x2e, 0x4b,
+	0x82, 0x58, 0x9e, 0x82, 0x1d, 0x35, 0x5e, 0x09, 0x14, 0xe3, 0x95, 0x21, 0xb6, 0x15, 0x0c, 0xbf,
+	0x04, 0xd5, 0x30, 0xa2, 0x2e, 0xc9, 0x26, 0xbb, 0xd7, 0x55, 0xaf, 0xc9, 0xe6, 0x5e, 0x9e, 0x31,
+	0x1a, 0xa8, 0x77, 0x40, 0xd4, 0x93, 0xfc, 0xa2, 0x9e, 0x0c, 0xb1, 0xad, 0x60, 0xf5, 0xc5, 0xd6,
+	0xe0, 0xf5, 0xa2, 0xad, 0xbd, 0x59, 0xb4, 0xb5, 0x3f, 0x17, 0x6d, 0xed, 0xa7, 0xeb, 0x76, 0xe9,
+	0xcd, 0x75, 0xbb, 0xf4, 0xfb, 0x75, 0xbb, 0xf4, 0xed, 0x67, 0x3e, 0x8d, 0x2f, 0x92, 0x51, 0xd7,
+	0x65, 0x93, 0x1e, 0xbb, 0x8a, 0xdc, 0xf1, 0x8b, 0x9e, 0x7a, 0xe3, 0x7e, 0x58, 0x7f, 0x5e, 0xe3,
+	0x69, 0x48, 0xf8, 0xe8, 0x8e, 0x7c, 0xc0, 0x9e, 0xfc, 0x33, 0x00, 0x8f, 0xec, 0xdb, 0x2f, 0x7f,
+	0x07, 0x00, 0x00,
 }
 
 func (m *MsgCloseGroup) Marshal() (dAtA []byte, err error) {
@@ -564,6 +572,26 @@ func (m *GroupSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SignedBy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGroup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.AutoMatch {
+		i--
+		if m.AutoMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.OrderBidDuration != 0 {
 		i = encodeVarintGroup(dAtA, i, uint64(m.OrderBidDuration))
 		i--
@@ -778,6 +806,11 @@ func (m *GroupSpec) Size() (n int) {
 	if m.OrderBidDuration != 0 {
 		n += 1 + sovGroup(uint64(m.OrderBidDuration))
 	}
+	if m.AutoMatch {
+		n += 2
+	}
+	l = m.SignedBy.Size()
+	n += 1 + l + sovGroup(uint64(l))
 	return n
 }
 
@@ -1229,6 +1262,59 @@ func (m *GroupSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoMatch = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGroup(dAtA[iNdEx:])
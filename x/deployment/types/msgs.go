@@ -5,22 +5,24 @@ import (
 )
 
 const (
-	MsgTypeCreateDeployment = "create-deployment"
-	MsgTypeUpdateDeployment = "update-deployment"
-	MsgTypeCloseDeployment  = "close-deployment"
-	MsgTypeCloseGroup       = "close-group"
+	MsgTypeCreateDeployment  = "create-deployment"
+	MsgTypeDepositDeployment = "deposit-deployment"
+	MsgTypeUpdateDeployment  = "update-deployment"
+	MsgTypeCloseDeployment   = "close-deployment"
+	MsgTypeCloseGroup        = "close-group"
 )
 
 var (
-	_, _, _, _ sdk.Msg = &MsgCreateDeployment{}, &MsgUpdateDeployment{}, &MsgCloseDeployment{}, &MsgCloseGroup{}
+	_, _, _, _, _ sdk.Msg = &MsgCreateDeployment{}, &MsgDepositDeployment{}, &MsgUpdateDeployment{}, &MsgCloseDeployment{}, &MsgCloseGroup{}
 )
 
 // NewMsgCreateDeployment creates a new MsgCreateDeployment instance
-func NewMsgCreateDeployment(id DeploymentID, groups []GroupSpec, version []byte) *MsgCreateDeployment {
+func NewMsgCreateDeployment(id DeploymentID, groups []GroupSpec, version []byte, deposit sdk.Coin) *MsgCreateDeployment {
 	return &MsgCreateDeployment{
 		ID:      id,
 		Groups:  groups,
 		Version: version,
+		Deposit: deposit,
 	}
 }
 
@@ -56,11 +58,59 @@ func (msg MsgCreateDeployment) ValidateBasic() error {
 	if len(msg.Version) == 0 {
 		return ErrEmptyVersion
 	}
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return ErrInvalidDeposit
+	}
 	for _, gs := range msg.Groups {
 		err := gs.ValidateBasic()
 		if err != nil {
 			return err
 		}
+		for _, resource := range gs.Resources {
+			if resource.Price.Denom != msg.Deposit.Denom {
+				return ErrInvalidDeposit
+			}
+		}
+	}
+	return nil
+}
+
+// NewMsgDepositDeployment creates a new MsgDepositDeployment instance
+func NewMsgDepositDeployment(id DeploymentID, amount sdk.Coin) *MsgDepositDeployment {
+	return &MsgDepositDeployment{
+		ID:     id,
+		Amount: amount,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDepositDeployment) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDepositDeployment) Type() string { return MsgTypeDepositDeployment }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDepositDeployment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDepositDeployment) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation like check owner and amount
+func (msg MsgDepositDeployment) ValidateBasic() error {
+	if err := msg.ID.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrInvalidDeposit
 	}
 	return nil
 }
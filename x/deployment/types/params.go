@@ -0,0 +1,177 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/ovrclk/akash/types/unit"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Parameter store keys
+var (
+	KeyMaxUnitCPU          = []byte("MaxUnitCPU")
+	KeyMaxUnitMemory       = []byte("MaxUnitMemory")
+	KeyMaxUnitStorage      = []byte("MaxUnitStorage")
+	KeyMaxUnitCount        = []byte("MaxUnitCount")
+	KeyMaxUnitPrice        = []byte("MaxUnitPrice")
+	KeyMinUnitCPU          = []byte("MinUnitCPU")
+	KeyMinUnitMemory       = []byte("MinUnitMemory")
+	KeyMinUnitStorage      = []byte("MinUnitStorage")
+	KeyMinUnitCount        = []byte("MinUnitCount")
+	KeyMinUnitPrice        = []byte("MinUnitPrice")
+	KeyMaxGroupCount       = []byte("MaxGroupCount")
+	KeyMaxGroupUnits       = []byte("MaxGroupUnits")
+	KeyMaxGroupCPU         = []byte("MaxGroupCPU")
+	KeyMaxGroupMemory      = []byte("MaxGroupMemory")
+	KeyMaxGroupStorage     = []byte("MaxGroupStorage")
+	KeyMinGroupMemPrice    = []byte("MinGroupMemPrice")
+	KeyMaxGroupMemPrice    = []byte("MaxGroupMemPrice")
+	KeyMaxOrderBidDuration = []byte("MaxOrderBidDuration")
+
+	KeyDefaultOrderBidDuration = []byte("DefaultOrderBidDuration")
+)
+
+// ParamKeyTable returns the parameter key table for the deployment module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default deployment module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxUnitCPU:     500,
+		MaxUnitMemory:  unit.Gi,
+		MaxUnitStorage: unit.Gi,
+		MaxUnitCount:   10,
+		MaxUnitPrice:   10000,
+
+		MinUnitCPU:     10,
+		MinUnitMemory:  unit.Ki,
+		MinUnitStorage: unit.Ki,
+		MinUnitCount:   1,
+		MinUnitPrice:   1,
+
+		MaxGroupCount: 10,
+		MaxGroupUnits: 10,
+
+		MaxGroupCPU:     1000,
+		MaxGroupMemory:  unit.Gi,
+		MaxGroupStorage: 5 * unit.Gi,
+
+		MinGroupMemPrice: 50,
+		MaxGroupMemPrice: 1048576,
+
+		MaxOrderBidDuration:     DefaultOrderBiddingDuration * int64(30),
+		DefaultOrderBidDuration: DefaultOrderBiddingDuration,
+	}
+}
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxUnitCPU, &p.MaxUnitCPU, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxUnitMemory, &p.MaxUnitMemory, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxUnitStorage, &p.MaxUnitStorage, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxUnitCount, &p.MaxUnitCount, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxUnitPrice, &p.MaxUnitPrice, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinUnitCPU, &p.MinUnitCPU, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinUnitMemory, &p.MinUnitMemory, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinUnitStorage, &p.MinUnitStorage, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinUnitCount, &p.MinUnitCount, validateUint32),
+		paramtypes.NewParamSetPair(KeyMinUnitPrice, &p.MinUnitPrice, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxGroupCount, &p.MaxGroupCount, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxGroupUnits, &p.MaxGroupUnits, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxGroupCPU, &p.MaxGroupCPU, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxGroupMemory, &p.MaxGroupMemory, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxGroupStorage, &p.MaxGroupStorage, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinGroupMemPrice, &p.MinGroupMemPrice, validateInt64),
+		paramtypes.NewParamSetPair(KeyMaxGroupMemPrice, &p.MaxGroupMemPrice, validateInt64),
+		paramtypes.NewParamSetPair(KeyMaxOrderBidDuration, &p.MaxOrderBidDuration, validateInt64),
+		paramtypes.NewParamSetPair(KeyDefaultOrderBidDuration, &p.DefaultOrderBidDuration, validateInt64),
+	}
+}
+
+// Validate checks that every limit is positive and that minimums do not exceed maximums
+func (p Params) Validate() error {
+	for _, pair := range p.ParamSetPairs() {
+		if err := pair.ValidatorFn(valueOf(pair.Value)); err != nil {
+			return errors.Wrapf(err, "param %s", pair.Key)
+		}
+	}
+
+	for _, check := range []struct {
+		name     string
+		min, max uint64
+	}{
+		{"unit cpu", p.MinUnitCPU, p.MaxUnitCPU},
+		{"unit memory", p.MinUnitMemory, p.MaxUnitMemory},
+		{"unit storage", p.MinUnitStorage, p.MaxUnitStorage},
+		{"unit count", uint64(p.MinUnitCount), uint64(p.MaxUnitCount)},
+		{"unit price", p.MinUnitPrice, p.MaxUnitPrice},
+		{"group memory price", uint64(p.MinGroupMemPrice), uint64(p.MaxGroupMemPrice)},
+		{"order bid duration", uint64(p.DefaultOrderBidDuration), uint64(p.MaxOrderBidDuration)},
+	} {
+		if check.min > check.max {
+			return errors.Errorf("invalid %s limits (%v > %v)", check.name, check.min, check.max)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func valueOf(ptr interface{}) interface{} {
+	switch v := ptr.(type) {
+	case *uint64:
+		return *v
+	case *uint32:
+		return *v
+	case *int64:
+		return *v
+	default:
+		return ptr
+	}
+}
+
+func validateUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("value must be positive")
+	}
+	return nil
+}
+
+func validateUint32(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("value must be positive")
+	}
+	return nil
+}
+
+func validateInt64(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("value must be positive")
+	}
+	return nil
+}
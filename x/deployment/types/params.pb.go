@@ -0,0 +1,982 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the limits deployment groups are validated against
+type Params struct {
+	MaxUnitCPU      uint64 `protobuf:"varint,1,opt,name=max_unit_cpu,json=maxUnitCpu,proto3" json:"max_unit_cpu" yaml:"max_unit_cpu"`
+	MaxUnitMemory   uint64 `protobuf:"varint,2,opt,name=max_unit_memory,json=maxUnitMemory,proto3" json:"max_unit_memory" yaml:"max_unit_memory"`
+	MaxUnitStorage  uint64 `protobuf:"varint,3,opt,name=max_unit_storage,json=maxUnitStorage,proto3" json:"max_unit_storage" yaml:"max_unit_storage"`
+	MaxUnitCount    uint32 `protobuf:"varint,4,opt,name=max_unit_count,json=maxUnitCount,proto3" json:"max_unit_count" yaml:"max_unit_count"`
+	MaxUnitPrice    uint64 `protobuf:"varint,5,opt,name=max_unit_price,json=maxUnitPrice,proto3" json:"max_unit_price" yaml:"max_unit_price"`
+	MinUnitCPU      uint64 `protobuf:"varint,6,opt,name=min_unit_cpu,json=minUnitCpu,proto3" json:"min_unit_cpu" yaml:"min_unit_cpu"`
+	MinUnitMemory   uint64 `protobuf:"varint,7,opt,name=min_unit_memory,json=minUnitMemory,proto3" json:"min_unit_memory" yaml:"min_unit_memory"`
+	MinUnitStorage  uint64 `protobuf:"varint,8,opt,name=min_unit_storage,json=minUnitStorage,proto3" json:"min_unit_storage" yaml:"min_unit_storage"`
+	MinUnitCount    uint32 `protobuf:"varint,9,opt,name=min_unit_count,json=minUnitCount,proto3" json:"min_unit_count" yaml:"min_unit_count"`
+	MinUnitPrice    uint64 `protobuf:"varint,10,opt,name=min_unit_price,json=minUnitPrice,proto3" json:"min_unit_price" yaml:"min_unit_price"`
+	MaxGroupCount   uint32 `protobuf:"varint,11,opt,name=max_group_count,json=maxGroupCount,proto3" json:"max_group_count" yaml:"max_group_count"`
+	MaxGroupUnits   uint32 `protobuf:"varint,12,opt,name=max_group_units,json=maxGroupUnits,proto3" json:"max_group_units" yaml:"max_group_units"`
+	MaxGroupCPU     uint64 `protobuf:"varint,13,opt,name=max_group_cpu,json=maxGroupCpu,proto3" json:"max_group_cpu" yaml:"max_group_cpu"`
+	MaxGroupMemory  uint64 `protobuf:"varint,14,opt,name=max_group_memory,json=maxGroupMemory,proto3" json:"max_group_memory" yaml:"max_group_memory"`
+	MaxGroupStorage uint64 `protobuf:"varint,15,opt,name=max_group_storage,json=maxGroupStorage,proto3" json:"max_group_storage" yaml:"max_group_storage"`
+	// MinGroupMemPrice is the lowest price per Gi of memory a group may offer
+	MinGroupMemPrice int64 `protobuf:"varint,16,opt,name=min_group_mem_price,json=minGroupMemPrice,proto3" json:"min_group_mem_price" yaml:"min_group_mem_price"`
+	MaxGroupMemPrice int64 `protobuf:"varint,17,opt,name=max_group_mem_price,json=maxGroupMemPrice,proto3" json:"max_group_mem_price" yaml:"max_group_mem_price"`
+	// MaxOrderBidDuration is the longest number of blocks an order of the group may stay open for bids
+	MaxOrderBidDuration int64 `protobuf:"varint,18,opt,name=max_order_bid_duration,json=maxOrderBidDuration,proto3" json:"max_order_bid_duration" yaml:"max_order_bid_duration"`
+	// DefaultOrderBidDuration is the number of blocks an order stays open for bids when its group
+	// does not set a duration
+	DefaultOrderBidDuration int64 `protobuf:"varint,19,opt,name=default_order_bid_duration,json=defaultOrderBidDuration,proto3" json:"default_order_bid_duration" yaml:"default_order_bid_duration"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe50954b0fed1b39, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxUnitCPU() uint64 {
+	if m != nil {
+		return m.MaxUnitCPU
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnitMemory() uint64 {
+	if m != nil {
+		return m.MaxUnitMemory
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnitStorage() uint64 {
+	if m != nil {
+		return m.MaxUnitStorage
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnitCount() uint32 {
+	if m != nil {
+		return m.MaxUnitCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnitPrice() uint64 {
+	if m != nil {
+		return m.MaxUnitPrice
+	}
+	return 0
+}
+
+func (m *Params) GetMinUnitCPU() uint64 {
+	if m != nil {
+		return m.MinUnitCPU
+	}
+	return 0
+}
+
+func (m *Params) GetMinUnitMemory() uint64 {
+	if m != nil {
+		return m.MinUnitMemory
+	}
+	return 0
+}
+
+func (m *Params) GetMinUnitStorage() uint64 {
+	if m != nil {
+		return m.MinUnitStorage
+	}
+	return 0
+}
+
+func (m *Params) GetMinUnitCount() uint32 {
+	if m != nil {
+		return m.MinUnitCount
+	}
+	return 0
+}
+
+func (m *Params) GetMinUnitPrice() uint64 {
+	if m != nil {
+		return m.MinUnitPrice
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGroupCount() uint32 {
+	if m != nil {
+		return m.MaxGroupCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGroupUnits() uint32 {
+	if m != nil {
+		return m.MaxGroupUnits
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGroupCPU() uint64 {
+	if m != nil {
+		return m.MaxGroupCPU
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGroupMemory() uint64 {
+	if m != nil {
+		return m.MaxGroupMemory
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGroupStorage() uint64 {
+	if m != nil {
+		return m.MaxGroupStorage
+	}
+	return 0
+}
+
+func (m *Params) GetMinGroupMemPrice() int64 {
+	if m != nil {
+		return m.MinGroupMemPrice
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGroupMemPrice() int64 {
+	if m != nil {
+		return m.MaxGroupMemPrice
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderBidDuration() int64 {
+	if m != nil {
+		return m.MaxOrderBidDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultOrderBidDuration() int64 {
+	if m != nil {
+		return m.DefaultOrderBidDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "akash.deployment.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta1/params.proto", fileDescriptor_fe50954b0fed1b39)
+}
+
+var fileDescriptor_fe50954b0fed1b39 = []byte{
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x6b, 0xdb, 0x4a,
+	0x14, 0xb5, 0x5e, 0xf2, 0xf2, 0x5e, 0x27, 0x71, 0x3e, 0x94, 0x34, 0x19, 0x02, 0xf5, 0xa4, 0x82,
+	0x42, 0x68, 0xa9, 0x45, 0x28, 0x81, 0x92, 0xee, 0x9c, 0x40, 0x57, 0xa1, 0xa9, 0x4a, 0x28, 0x2d,
+	0x2d, 0x66, 0x6c, 0xa9, 0xce, 0x10, 0x6b, 0x24, 0x64, 0x29, 0xc8, 0xbb, 0xfe, 0x84, 0x2e, 0xbb,
+	0xec, 0xcf, 0xe9, 0x32, 0xcb, 0xae, 0x86, 0xe2, 0xec, 0xb4, 0xd4, 0xa6, 0xdb, 0x32, 0xa3, 0xaf,
+	0x19, 0x59, 0xce, 0xce, 0xba, 0xe7, 0xf8, 0x9c, 0xab, 0x7b, 0xee, 0x45, 0xe0, 0x09, 0xbe, 0xc6,
+	0x93, 0x2b, 0xd3, 0x76, 0xfc, 0xb1, 0x37, 0x75, 0x1d, 0x1a, 0x9a, 0x37, 0x47, 0x03, 0x27, 0xc4,
+	0x47, 0xa6, 0x8f, 0x03, 0xec, 0x4e, 0xba, 0x7e, 0xe0, 0x85, 0x9e, 0x0e, 0x05, 0xad, 0x5b, 0xd1,
+	0xba, 0x39, 0x6d, 0x7f, 0x67, 0xe4, 0x8d, 0x3c, 0x41, 0x32, 0xf9, 0xaf, 0x8c, 0x6f, 0xfc, 0x69,
+	0x83, 0x95, 0x0b, 0x21, 0xa0, 0xbf, 0x07, 0x6b, 0x2e, 0x8e, 0xfb, 0x11, 0x25, 0x61, 0x7f, 0xe8,
+	0x47, 0x50, 0x3b, 0xd0, 0x0e, 0x97, 0x7b, 0xc7, 0x33, 0x86, 0xc0, 0x39, 0x8e, 0x2f, 0x29, 0x09,
+	0x4f, 0x2f, 0x2e, 0x13, 0x86, 0x14, 0x56, 0xca, 0xd0, 0xf6, 0x14, 0xbb, 0xe3, 0x13, 0x43, 0xae,
+	0x1a, 0x16, 0x70, 0xf3, 0xbf, 0xf8, 0x91, 0x7e, 0x09, 0x36, 0x4a, 0xd0, 0x75, 0x5c, 0x2f, 0x98,
+	0xc2, 0x7f, 0x84, 0xf6, 0xf3, 0x84, 0xa1, 0x3a, 0x94, 0x32, 0xb4, 0x5b, 0x13, 0xcc, 0x00, 0xc3,
+	0x6a, 0xe7, 0x9a, 0xe7, 0xe2, 0x59, 0xff, 0x00, 0x36, 0x4b, 0xca, 0x24, 0xf4, 0x02, 0x3c, 0x72,
+	0xe0, 0x92, 0xd0, 0x35, 0x13, 0x86, 0xe6, 0xb0, 0x94, 0xa1, 0xbd, 0x9a, 0x70, 0x8e, 0x18, 0xd6,
+	0x7a, 0xae, 0xfc, 0x2e, 0x2b, 0xe8, 0x6f, 0xc1, 0x7a, 0xf5, 0x3a, 0x5e, 0x44, 0x43, 0xb8, 0x7c,
+	0xa0, 0x1d, 0xb6, 0x7b, 0xcf, 0x12, 0x86, 0x6a, 0x48, 0xca, 0xd0, 0xc3, 0xfa, 0x00, 0x78, 0xdd,
+	0xb0, 0xd6, 0x8a, 0x11, 0xf0, 0x47, 0x45, 0xd2, 0x0f, 0xc8, 0xd0, 0x81, 0xff, 0x8a, 0x5e, 0x55,
+	0x49, 0x81, 0x34, 0x48, 0x8a, 0x7a, 0x25, 0x79, 0xc1, 0x1f, 0x45, 0x60, 0x84, 0x56, 0x81, 0xad,
+	0x48, 0x81, 0x11, 0x2a, 0x07, 0x46, 0x68, 0x53, 0x60, 0x84, 0x2a, 0x81, 0x11, 0x2a, 0x07, 0x46,
+	0xa8, 0x3c, 0x7c, 0xf8, 0x9f, 0x14, 0x18, 0xa1, 0x0b, 0x02, 0x23, 0xb4, 0x1e, 0x18, 0xa1, 0xb5,
+	0xc0, 0x08, 0x55, 0x46, 0x0f, 0xff, 0x97, 0x02, 0x23, 0x74, 0x51, 0x60, 0x84, 0xce, 0x05, 0x46,
+	0x68, 0x3d, 0xb0, 0xf2, 0x75, 0x44, 0x60, 0x0f, 0xa4, 0xc0, 0x14, 0x44, 0x9a, 0xae, 0x52, 0xe7,
+	0xd3, 0xcd, 0x47, 0x50, 0x06, 0x46, 0xa8, 0x34, 0x7e, 0x08, 0xa4, 0xc0, 0x14, 0xa4, 0x41, 0xb2,
+	0x0c, 0x8c, 0xd0, 0x2a, 0xb0, 0xfc, 0x10, 0x46, 0x81, 0x17, 0xf9, 0x79, 0x9b, 0xab, 0xa2, 0xcd,
+	0xf2, 0x10, 0x24, 0x48, 0x3d, 0x04, 0x09, 0xc8, 0x0e, 0xe1, 0x35, 0x2f, 0x64, 0x9d, 0x2a, 0xb2,
+	0xdc, 0x7d, 0x02, 0xd7, 0x9a, 0x64, 0x05, 0xd4, 0x24, 0x2b, 0x00, 0x49, 0x96, 0x77, 0x3c, 0xd1,
+	0x3f, 0x81, 0xb6, 0xe4, 0xec, 0x47, 0xb0, 0x2d, 0xde, 0xff, 0xe5, 0x8c, 0xa1, 0xd5, 0xf3, 0xa2,
+	0x01, 0xb1, 0x60, 0x2a, 0x2f, 0x65, 0x68, 0x67, 0xae, 0x71, 0xbe, 0x62, 0xab, 0x65, 0xdb, 0x7e,
+	0x54, 0x5c, 0x6f, 0x06, 0xe7, 0x4b, 0xb6, 0xae, 0x5e, 0xaf, 0x8c, 0xa9, 0xd7, 0x2b, 0x23, 0xd9,
+	0xf5, 0x0a, 0xdd, 0x7c, 0xcf, 0x3e, 0x83, 0xad, 0x8a, 0x54, 0x2c, 0xda, 0x86, 0xd0, 0x3e, 0x4a,
+	0x18, 0x9a, 0x07, 0x53, 0x86, 0x60, 0x5d, 0xbc, 0x5c, 0xb5, 0x8d, 0x42, 0xbd, 0xd8, 0x35, 0x1b,
+	0x6c, 0xf3, 0x98, 0xcb, 0x1e, 0xf2, 0xed, 0xd8, 0x3c, 0xd0, 0x0e, 0x97, 0x7a, 0xc7, 0x09, 0x43,
+	0x4d, 0x70, 0xca, 0xd0, 0x7e, 0xb5, 0x22, 0x35, 0xd0, 0xb0, 0xf8, 0xf2, 0x17, 0xaf, 0x90, 0xed,
+	0x0a, 0x77, 0xc1, 0x71, 0x9d, 0x09, 0xb7, 0x24, 0x17, 0x1c, 0xdf, 0xe3, 0x82, 0xe3, 0x26, 0x17,
+	0x1c, 0xab, 0x2e, 0x3e, 0xd8, 0xe5, 0x4c, 0x2f, 0xb0, 0x9d, 0xa0, 0x3f, 0x20, 0x76, 0xdf, 0x8e,
+	0x02, 0x1c, 0x12, 0x8f, 0x42, 0x5d, 0x18, 0xbd, 0x4a, 0x18, 0x5a, 0xc0, 0x48, 0x19, 0x7a, 0x54,
+	0x79, 0xcd, 0xe3, 0x86, 0xc5, 0x3b, 0x7c, 0xc3, 0xeb, 0x3d, 0x62, 0x9f, 0xe5, 0x55, 0xfd, 0xab,
+	0x06, 0xf6, 0x6d, 0xe7, 0x0b, 0x8e, 0xc6, 0x61, 0x93, 0xed, 0xb6, 0xb0, 0x3d, 0x4d, 0x18, 0xba,
+	0x87, 0x95, 0x32, 0xf4, 0x38, 0xb3, 0x5e, 0xcc, 0x31, 0xac, 0xbd, 0x1c, 0xac, 0xb7, 0x70, 0xb2,
+	0xfc, 0xfd, 0x07, 0x6a, 0xf5, 0xce, 0x7e, 0xce, 0x3a, 0xda, 0xed, 0xac, 0xa3, 0xfd, 0x9e, 0x75,
+	0xb4, 0x6f, 0x77, 0x9d, 0xd6, 0xed, 0x5d, 0xa7, 0xf5, 0xeb, 0xae, 0xd3, 0xfa, 0xf8, 0x74, 0x44,
+	0xc2, 0xab, 0x68, 0xd0, 0x1d, 0x7a, 0xae, 0xe9, 0xdd, 0x04, 0xc3, 0xf1, 0xb5, 0x99, 0x7d, 0x7c,
+	0x63, 0xf9, 0xf3, 0x1b, 0x4e, 0x7d, 0x67, 0x32, 0x58, 0x11, 0x9f, 0xd1, 0x17, 0x7f, 0x07, 0x00,
+	0xa6, 0x75, 0xe4, 0x93, 0x9f, 0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DefaultOrderBidDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultOrderBidDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxOrderBidDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrderBidDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxGroupMemPrice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGroupMemPrice))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MinGroupMemPrice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinGroupMemPrice))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxGroupStorage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGroupStorage))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxGroupMemory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGroupMemory))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxGroupCPU != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGroupCPU))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxGroupUnits != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGroupUnits))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxGroupCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGroupCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinUnitPrice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinUnitPrice))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinUnitCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinUnitCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinUnitStorage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinUnitStorage))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinUnitMemory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinUnitMemory))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinUnitCPU != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinUnitCPU))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxUnitPrice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnitPrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxUnitCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnitCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxUnitStorage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnitStorage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxUnitMemory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnitMemory))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxUnitCPU != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnitCPU))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUnitCPU != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnitCPU))
+	}
+	if m.MaxUnitMemory != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnitMemory))
+	}
+	if m.MaxUnitStorage != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnitStorage))
+	}
+	if m.MaxUnitCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnitCount))
+	}
+	if m.MaxUnitPrice != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnitPrice))
+	}
+	if m.MinUnitCPU != 0 {
+		n += 1 + sovParams(uint64(m.MinUnitCPU))
+	}
+	if m.MinUnitMemory != 0 {
+		n += 1 + sovParams(uint64(m.MinUnitMemory))
+	}
+	if m.MinUnitStorage != 0 {
+		n += 1 + sovParams(uint64(m.MinUnitStorage))
+	}
+	if m.MinUnitCount != 0 {
+		n += 1 + sovParams(uint64(m.MinUnitCount))
+	}
+	if m.MinUnitPrice != 0 {
+		n += 1 + sovParams(uint64(m.MinUnitPrice))
+	}
+	if m.MaxGroupCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxGroupCount))
+	}
+	if m.MaxGroupUnits != 0 {
+		n += 1 + sovParams(uint64(m.MaxGroupUnits))
+	}
+	if m.MaxGroupCPU != 0 {
+		n += 1 + sovParams(uint64(m.MaxGroupCPU))
+	}
+	if m.MaxGroupMemory != 0 {
+		n += 1 + sovParams(uint64(m.MaxGroupMemory))
+	}
+	if m.MaxGroupStorage != 0 {
+		n += 1 + sovParams(uint64(m.MaxGroupStorage))
+	}
+	if m.MinGroupMemPrice != 0 {
+		n += 2 + sovParams(uint64(m.MinGroupMemPrice))
+	}
+	if m.MaxGroupMemPrice != 0 {
+		n += 2 + sovParams(uint64(m.MaxGroupMemPrice))
+	}
+	if m.MaxOrderBidDuration != 0 {
+		n += 2 + sovParams(uint64(m.MaxOrderBidDuration))
+	}
+	if m.DefaultOrderBidDuration != 0 {
+		n += 2 + sovParams(uint64(m.DefaultOrderBidDuration))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnitCPU", wireType)
+			}
+			m.MaxUnitCPU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnitCPU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnitMemory", wireType)
+			}
+			m.MaxUnitMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnitMemory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnitStorage", wireType)
+			}
+			m.MaxUnitStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnitStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnitCount", wireType)
+			}
+			m.MaxUnitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnitCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnitPrice", wireType)
+			}
+			m.MaxUnitPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnitPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnitCPU", wireType)
+			}
+			m.MinUnitCPU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnitCPU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnitMemory", wireType)
+			}
+			m.MinUnitMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnitMemory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnitStorage", wireType)
+			}
+			m.MinUnitStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnitStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnitCount", wireType)
+			}
+			m.MinUnitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnitCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnitPrice", wireType)
+			}
+			m.MinUnitPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnitPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupCount", wireType)
+			}
+			m.MaxGroupCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupUnits", wireType)
+			}
+			m.MaxGroupUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupUnits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupCPU", wireType)
+			}
+			m.MaxGroupCPU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupCPU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupMemory", wireType)
+			}
+			m.MaxGroupMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupMemory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupStorage", wireType)
+			}
+			m.MaxGroupStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGroupMemPrice", wireType)
+			}
+			m.MinGroupMemPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGroupMemPrice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupMemPrice", wireType)
+			}
+			m.MaxGroupMemPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupMemPrice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderBidDuration", wireType)
+			}
+			m.MaxOrderBidDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderBidDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultOrderBidDuration", wireType)
+			}
+			m.DefaultOrderBidDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultOrderBidDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/x/deployment/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.MaxGroupCount = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinUnitCPU = params.MaxUnitCPU + 1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxOrderBidDuration = -1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DefaultOrderBidDuration = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DefaultOrderBidDuration = params.MaxOrderBidDuration + 1
+	require.Error(t, params.Validate())
+}
@@ -316,6 +316,88 @@ func (m *QueryGroupResponse) GetGroup() Group {
 	return Group{}
 }
 
+// QueryParamsRequest is request type for the Query/DeploymentParams RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e9d5676377f9641, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/DeploymentParams RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e9d5676377f9641, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryDeploymentsRequest)(nil), "akash.deployment.v1beta1.QueryDeploymentsRequest")
 	proto.RegisterType((*QueryDeploymentsResponse)(nil), "akash.deployment.v1beta1.QueryDeploymentsResponse")
@@ -323,6 +405,8 @@ func init() {
 	proto.RegisterType((*QueryDeploymentResponse)(nil), "akash.deployment.v1beta1.QueryDeploymentResponse")
 	proto.RegisterType((*QueryGroupRequest)(nil), "akash.deployment.v1beta1.QueryGroupRequest")
 	proto.RegisterType((*QueryGroupResponse)(nil), "akash.deployment.v1beta1.QueryGroupResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "akash.deployment.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "akash.deployment.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -330,42 +414,46 @@ func init() {
 }
 
 var fileDescriptor_5e9d5676377f9641 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
-	0x1c, 0xc6, 0x73, 0x29, 0x01, 0xe9, 0x9f, 0x89, 0x03, 0x41, 0x14, 0x90, 0x03, 0x11, 0xb4, 0x25,
-	0x0d, 0x3e, 0x12, 0x46, 0xc4, 0x62, 0x45, 0xad, 0x2a, 0x16, 0xea, 0x11, 0xb1, 0x38, 0xc9, 0xd5,
-	0xb5, 0xe2, 0xf8, 0x5c, 0xdf, 0xa5, 0x22, 0x2b, 0x4f, 0x00, 0xe2, 0x19, 0x90, 0x10, 0x03, 0xcf,
-	0xd1, 0x31, 0x12, 0x0b, 0x53, 0x41, 0x09, 0x33, 0xcf, 0x80, 0x7c, 0x77, 0x8e, 0x0d, 0xa9, 0x89,
-	0xc3, 0x66, 0xc9, 0xdf, 0xff, 0xfb, 0x7e, 0xdf, 0xff, 0xce, 0x86, 0x07, 0xce, 0xc8, 0xe1, 0x27,
-	0x64, 0x48, 0x43, 0x9f, 0x4d, 0xc7, 0x34, 0x10, 0xe4, 0xac, 0xd3, 0xa7, 0xc2, 0xe9, 0x90, 0xd3,
-	0x09, 0x8d, 0xa6, 0x66, 0x18, 0x31, 0xc1, 0x70, 0x4d, 0xaa, 0xcc, 0x54, 0x65, 0x6a, 0x55, 0xfd,
-	0xa6, 0xcb, 0x5c, 0x26, 0x45, 0x24, 0x7e, 0x52, 0xfa, 0xfa, 0x5d, 0x97, 0x31, 0xd7, 0xa7, 0xc4,
-	0x09, 0x3d, 0xe2, 0x04, 0x01, 0x13, 0x8e, 0xf0, 0x58, 0xc0, 0xf5, 0xdb, 0xd6, 0x80, 0xf1, 0x31,
-	0xe3, 0xa4, 0xef, 0x70, 0xaa, 0x62, 0x96, 0xa1, 0xa1, 0xe3, 0x7a, 0x81, 0x14, 0x6b, 0xed, 0xa3,
-	0x5c, 0xbe, 0x0c, 0x8c, 0x92, 0xe6, 0x57, 0x71, 0x23, 0x36, 0x09, 0x95, 0xaa, 0xf9, 0x05, 0xc1,
-	0xed, 0xa3, 0x38, 0xb3, 0xb7, 0xd4, 0x71, 0x9b, 0x9e, 0x4e, 0x28, 0x17, 0xf8, 0x05, 0x5c, 0x3b,
-	0xf6, 0x7c, 0x41, 0x23, 0x5e, 0x43, 0xf7, 0xd0, 0x6e, 0xb5, 0xbb, 0x67, 0xe6, 0x15, 0x37, 0xd3,
-	0xf1, 0x7d, 0x35, 0x62, 0x5d, 0x39, 0xbf, 0x68, 0x94, 0xec, 0xc4, 0x01, 0xef, 0x03, 0xa4, 0x6d,
-	0x6a, 0x65, 0xe9, 0xb7, 0x6d, 0xaa, 0xea, 0x66, 0x5c, 0xdd, 0x54, 0x1b, 0x4e, 0x0c, 0x5f, 0x3a,
-	0x2e, 0xd5, 0x20, 0x76, 0x66, 0xb2, 0x39, 0x43, 0x50, 0x5b, 0x05, 0xe6, 0x21, 0x0b, 0x38, 0xc5,
-	0x23, 0xa8, 0xa6, 0x6c, 0x31, 0xf5, 0xd6, 0x6e, 0xb5, 0xdb, 0x2e, 0x42, 0x9d, 0x58, 0x58, 0x77,
-	0x62, 0xec, 0xcf, 0xdf, 0x1b, 0x37, 0x56, 0xdf, 0x71, 0x3b, 0xeb, 0x8e, 0x0f, 0x2e, 0x69, 0xb4,
-	0xb3, 0xb6, 0x91, 0xb2, 0xfa, 0xa3, 0xd2, 0x6b, 0xb8, 0xf5, 0x57, 0xa3, 0xe4, 0x04, 0x2c, 0x28,
-	0x7b, 0x43, 0xbd, 0xfc, 0xed, 0x22, 0x35, 0x0e, 0x7b, 0x16, 0xc4, 0x05, 0xe6, 0x17, 0x8d, 0xf2,
-	0x61, 0xcf, 0x2e, 0x7b, 0xc3, 0xe6, 0x78, 0xe5, 0x80, 0x97, 0xeb, 0xb2, 0x01, 0x52, 0x37, 0x1d,
-	0xb3, 0xd9, 0xb6, 0xd4, 0x21, 0x67, 0x5c, 0x9a, 0x36, 0x5c, 0x97, 0x71, 0x07, 0xf1, 0x25, 0x4b,
-	0x7a, 0x3c, 0xcf, 0xf4, 0xb8, 0x9f, 0x1f, 0x20, 0x67, 0x2e, 0xa9, 0x70, 0x04, 0x38, 0xeb, 0xa9,
-	0xe9, 0x9f, 0x41, 0x45, 0xde, 0x64, 0xed, 0xdb, 0x58, 0xe3, 0xab, 0x59, 0xd5, 0x4c, 0xf7, 0xd7,
-	0x16, 0x54, 0xa4, 0x27, 0xfe, 0x84, 0xa0, 0x9a, 0xb9, 0x4b, 0xb8, 0x93, 0xef, 0x93, 0xf3, 0xa1,
-	0xd4, 0xbb, 0x9b, 0x8c, 0x28, 0xfa, 0x66, 0xf7, 0xed, 0xd7, 0x9f, 0x1f, 0xca, 0x6d, 0xdc, 0x22,
-	0x05, 0x3e, 0x69, 0x4e, 0x7c, 0x8f, 0x0b, 0xfc, 0x11, 0x01, 0xa4, 0x5e, 0xf8, 0x49, 0xe1, 0xd8,
-	0x04, 0xb4, 0xb3, 0xc1, 0xc4, 0xff, 0x71, 0x7a, 0xc1, 0x31, 0xc3, 0xef, 0x11, 0x54, 0xe4, 0xce,
-	0xf1, 0xde, 0x9a, 0xc0, 0xec, 0x2d, 0xa9, 0xb7, 0x8b, 0x89, 0x35, 0xd8, 0x63, 0x09, 0xb6, 0x83,
-	0x1f, 0x92, 0x7f, 0xff, 0xe8, 0x14, 0x93, 0xd5, 0x3b, 0x9f, 0x1b, 0x68, 0x36, 0x37, 0xd0, 0x8f,
-	0xb9, 0x81, 0xde, 0x2d, 0x8c, 0xd2, 0x6c, 0x61, 0x94, 0xbe, 0x2d, 0x8c, 0xd2, 0xab, 0x96, 0xeb,
-	0x89, 0x93, 0x49, 0xdf, 0x1c, 0xb0, 0x31, 0x61, 0x67, 0xd1, 0xc0, 0x1f, 0x69, 0xc7, 0x37, 0x59,
-	0x4f, 0x31, 0x0d, 0x29, 0xef, 0x5f, 0x95, 0x7f, 0xcd, 0xa7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x0e, 0xcc, 0xf5, 0x90, 0x28, 0x06, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0xf3, 0xfd, 0xa6, 0x48, 0x2f, 0x0b, 0x5c, 0x2b, 0x88, 0x02, 0x72, 0x8a, 0x45, 0x7f,
+	0x90, 0xa6, 0x3e, 0x12, 0x46, 0x04, 0x43, 0x14, 0xb5, 0xaa, 0x58, 0x5a, 0x4b, 0x2c, 0x88, 0xe5,
+	0x92, 0x5c, 0x5d, 0x2b, 0x89, 0xcf, 0xf5, 0x39, 0x15, 0x59, 0x19, 0x99, 0x40, 0x4c, 0xfc, 0x01,
+	0x48, 0x88, 0x81, 0xbf, 0xa3, 0x63, 0x24, 0x16, 0xa6, 0x82, 0x12, 0xfe, 0x10, 0xe4, 0xbb, 0x73,
+	0xec, 0x90, 0x9a, 0x38, 0x6c, 0x51, 0xee, 0xf3, 0x3e, 0x3f, 0xde, 0x7b, 0x77, 0x86, 0x07, 0xa4,
+	0x47, 0xf8, 0x19, 0xee, 0x52, 0xaf, 0xcf, 0x46, 0x03, 0xea, 0x06, 0xf8, 0xa2, 0xde, 0xa6, 0x01,
+	0xa9, 0xe3, 0xf3, 0x21, 0xf5, 0x47, 0xa6, 0xe7, 0xb3, 0x80, 0xa1, 0x92, 0x40, 0x99, 0x31, 0xca,
+	0x54, 0xa8, 0xf2, 0x86, 0xcd, 0x6c, 0x26, 0x40, 0x38, 0xfc, 0x25, 0xf1, 0xe5, 0x7b, 0x36, 0x63,
+	0x76, 0x9f, 0x62, 0xe2, 0x39, 0x98, 0xb8, 0x2e, 0x0b, 0x48, 0xe0, 0x30, 0x97, 0xab, 0xd3, 0x6a,
+	0x87, 0xf1, 0x01, 0xe3, 0xb8, 0x4d, 0x38, 0x95, 0x32, 0x33, 0x51, 0x8f, 0xd8, 0x8e, 0x2b, 0xc0,
+	0x0a, 0xfb, 0x30, 0xd5, 0x5f, 0xc2, 0x8c, 0x84, 0xa6, 0x47, 0xb1, 0x7d, 0x36, 0xf4, 0x14, 0x6a,
+	0x2b, 0x15, 0xe5, 0x11, 0x9f, 0x0c, 0x94, 0x47, 0xe3, 0xab, 0x06, 0x77, 0x4e, 0x42, 0x6b, 0xad,
+	0x19, 0x90, 0x5b, 0xf4, 0x7c, 0x48, 0x79, 0x80, 0x9e, 0xc3, 0x8d, 0x53, 0xa7, 0x1f, 0x50, 0x9f,
+	0x97, 0xb4, 0x4d, 0x6d, 0xb7, 0xd8, 0xd8, 0x33, 0xd3, 0xfa, 0x63, 0xc6, 0xe5, 0x07, 0xb2, 0xa4,
+	0xf9, 0xff, 0xe5, 0x55, 0x25, 0x67, 0x45, 0x0c, 0xe8, 0x00, 0x20, 0x0e, 0x5d, 0xca, 0x0b, 0xbe,
+	0x6d, 0x53, 0x76, 0xc8, 0x0c, 0x3b, 0x64, 0xca, 0x41, 0x44, 0x84, 0xc7, 0xc4, 0xa6, 0xca, 0x88,
+	0x95, 0xa8, 0x34, 0xc6, 0x1a, 0x94, 0x16, 0x0d, 0x73, 0x8f, 0xb9, 0x9c, 0xa2, 0x1e, 0x14, 0x63,
+	0x6f, 0xa1, 0xeb, 0xff, 0x76, 0x8b, 0x8d, 0x5a, 0x16, 0xd7, 0x11, 0x45, 0xf3, 0x6e, 0x68, 0xfb,
+	0xcb, 0x8f, 0xca, 0xfa, 0xe2, 0x19, 0xb7, 0x92, 0xec, 0xe8, 0xf0, 0x9a, 0x44, 0x3b, 0x4b, 0x13,
+	0x49, 0xaa, 0xb9, 0x48, 0xaf, 0xe0, 0xf6, 0x1f, 0x89, 0xa2, 0x09, 0x34, 0x21, 0xef, 0x74, 0x55,
+	0xf3, 0xb7, 0xb3, 0xc4, 0x38, 0x6a, 0x35, 0x21, 0x0c, 0x30, 0xb9, 0xaa, 0xe4, 0x8f, 0x5a, 0x56,
+	0xde, 0xe9, 0x1a, 0x83, 0x85, 0x01, 0xcf, 0xda, 0x65, 0x01, 0xc4, 0x6c, 0x4a, 0x66, 0xb5, 0x6e,
+	0xc9, 0x21, 0x27, 0x58, 0x0c, 0x0b, 0x6e, 0x09, 0xb9, 0xc3, 0x70, 0x17, 0xa3, 0x1c, 0x4f, 0x13,
+	0x39, 0xee, 0xa7, 0x0b, 0x88, 0x9a, 0x6b, 0x22, 0x9c, 0x00, 0x4a, 0x72, 0x2a, 0xf7, 0x4f, 0xa0,
+	0x20, 0x16, 0x5e, 0xf1, 0x56, 0x96, 0xf0, 0x2a, 0xaf, 0xb2, 0xc6, 0xd8, 0x50, 0x94, 0xc7, 0xe2,
+	0x32, 0x28, 0x9f, 0xc6, 0x0b, 0x58, 0x9f, 0xfb, 0x57, 0x29, 0x3d, 0x83, 0x35, 0x79, 0x69, 0x94,
+	0xd4, 0x66, 0xba, 0x94, 0xac, 0x54, 0x5a, 0xaa, 0xaa, 0xf1, 0xb6, 0x00, 0x05, 0xc1, 0x8b, 0x3e,
+	0x6b, 0x50, 0x4c, 0x2c, 0x2e, 0xaa, 0xa7, 0x33, 0xa5, 0xdc, 0xca, 0x72, 0x63, 0x95, 0x12, 0x19,
+	0xc0, 0x68, 0xbc, 0xf9, 0xf6, 0xeb, 0x43, 0xbe, 0x86, 0xaa, 0x38, 0xc3, 0x33, 0xc3, 0x71, 0xdf,
+	0xe1, 0x01, 0xfa, 0xa4, 0x01, 0xc4, 0x5c, 0xe8, 0x51, 0x66, 0xd9, 0xc8, 0x68, 0x7d, 0x85, 0x8a,
+	0x7f, 0xf3, 0xe9, 0xb8, 0xa7, 0x0c, 0xbd, 0xd7, 0xa0, 0x20, 0x06, 0x8c, 0xf6, 0x96, 0x08, 0x26,
+	0x57, 0xb2, 0x5c, 0xcb, 0x06, 0x56, 0xc6, 0xf6, 0x85, 0xb1, 0x1d, 0xb4, 0x85, 0xff, 0xfe, 0xf8,
+	0x2a, 0x4f, 0x1f, 0x35, 0xb8, 0x19, 0xc7, 0x93, 0x3b, 0x81, 0x96, 0x29, 0xce, 0xad, 0x62, 0x79,
+	0x3f, 0x23, 0x5a, 0x19, 0xdc, 0x15, 0x06, 0x0d, 0xb4, 0x89, 0x97, 0xbc, 0xfb, 0xcd, 0xd6, 0xe5,
+	0x44, 0xd7, 0xc6, 0x13, 0x5d, 0xfb, 0x39, 0xd1, 0xb5, 0x77, 0x53, 0x3d, 0x37, 0x9e, 0xea, 0xb9,
+	0xef, 0x53, 0x3d, 0xf7, 0xb2, 0x6a, 0x3b, 0xc1, 0xd9, 0xb0, 0x6d, 0x76, 0xd8, 0x00, 0xb3, 0x0b,
+	0xbf, 0xd3, 0xef, 0x29, 0xb2, 0xd7, 0x49, 0xba, 0x60, 0xe4, 0x51, 0xde, 0x5e, 0x13, 0x9f, 0x8f,
+	0xc7, 0xbf, 0x07, 0x00, 0x01, 0x2c, 0x18, 0x0b, 0x58, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -386,6 +474,8 @@ type QueryClient interface {
 	Deployment(ctx context.Context, in *QueryDeploymentRequest, opts ...grpc.CallOption) (*QueryDeploymentResponse, error)
 	// Group queries group details
 	Group(ctx context.Context, in *QueryGroupRequest, opts ...grpc.CallOption) (*QueryGroupResponse, error)
+	// DeploymentParams queries the deployment module parameters
+	DeploymentParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -423,6 +513,15 @@ func (c *queryClient) Group(ctx context.Context, in *QueryGroupRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) DeploymentParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta1.Query/DeploymentParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Deployments queries deployments
@@ -431,6 +530,8 @@ type QueryServer interface {
 	Deployment(context.Context, *QueryDeploymentRequest) (*QueryDeploymentResponse, error)
 	// Group queries group details
 	Group(context.Context, *QueryGroupRequest) (*QueryGroupResponse, error)
+	// DeploymentParams queries the deployment module parameters
+	DeploymentParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -446,6 +547,9 @@ func (*UnimplementedQueryServer) Deployment(ctx context.Context, req *QueryDeplo
 func (*UnimplementedQueryServer) Group(ctx context.Context, req *QueryGroupRequest) (*QueryGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Group not implemented")
 }
+func (*UnimplementedQueryServer) DeploymentParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -505,6 +609,24 @@ func _Query_Group_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeploymentParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeploymentParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta1.Query/DeploymentParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeploymentParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "akash.deployment.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -521,6 +643,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Group",
 			Handler:    _Query_Group_Handler,
 		},
+		{
+			MethodName: "DeploymentParams",
+			Handler:    _Query_DeploymentParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "akash/deployment/v1beta1/query.proto",
@@ -752,6 +878,62 @@ func (m *QueryGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -841,6 +1023,26 @@ func (m *QueryGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1436,6 +1638,145 @@ func (m *QueryGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
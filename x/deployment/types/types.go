@@ -5,30 +5,49 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 
 	"github.com/ovrclk/akash/types"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 )
 
-// DefaultOrderBiddingDuration is the default time limit for an Order being active.
+// DefaultOrderBiddingDuration is the genesis value of the DefaultOrderBidDuration param, the
+// time limit for an Order being active when its group does not set one.
 // After the duration, the Order is automatically closed.
 // ( 24(hr) * 3600(seconds per hour) ) / 7s-Block
 const DefaultOrderBiddingDuration = int64(12342)
 
-// MaxBiddingDuration is roughly 30 days of block height
-const MaxBiddingDuration = DefaultOrderBiddingDuration * int64(30)
-
 // ID method returns DeploymentID details of specific deployment
 func (obj Deployment) ID() DeploymentID {
 	return obj.DeploymentID
 }
 
-// ValidateBasic asserts non-zero values
-// TODO: This is causing an import cycle. I think there is some pattern here I'm missing tho..
+// ValidateBasic checks the group spec for errors that do not depend on the deployment
+// params; limits are checked by validation.ValidateDeploymentGroup
 func (g GroupSpec) ValidateBasic() error {
-	// return validation.ValidateDeploymentGroup(g)
+	if err := types.Attributes(g.Requirements).Validate(); err != nil {
+		return errors.Wrap(err, "invalid requirement")
+	}
+
+	for _, auditors := range [][]string{g.SignedBy.AllOf, g.SignedBy.AnyOf} {
+		for _, auditor := range auditors {
+			if _, err := sdk.AccAddressFromBech32(auditor); err != nil {
+				return errors.Wrapf(err, "invalid auditor address %q", auditor)
+			}
+		}
+	}
+
 	return nil
 }
 
+// SetDefaults sets the settings the group spec leaves unset to the defaults of the params
+// in force on chain
+func (g *GroupSpec) SetDefaults(params Params) {
+	if g.OrderBidDuration == 0 {
+		g.OrderBidDuration = params.DefaultOrderBidDuration
+	}
+}
+
 // GetResources method returns resources list in group
 func (g GroupSpec) GetResources() []types.Resources {
 	resources := make([]types.Resources, 0, len(g.Resources))
@@ -62,7 +81,143 @@ func (g GroupSpec) Price() sdk.Coin {
 
 // MatchAttributes method compares provided attributes with specific group attributes
 func (g GroupSpec) MatchAttributes(attrs []types.Attribute) bool {
-	return types.AttributesSubsetOf(g.Requirements, attrs)
+	return types.AttributesMatch(g.Requirements, attrs)
+}
+
+// MatchSignedAttributes method checks that the group requirements have been signed by the
+// auditors the group requires signatures from. Every auditor in SignedBy.AllOf, and at least
+// one auditor in SignedBy.AnyOf, must have signed all of the group requirements. Negated
+// requirements only count as signed when the auditor has signed the attribute key.
+func (g GroupSpec) MatchSignedAttributes(signed []atypes.Provider) bool {
+	if g.SignedBy.Empty() {
+		return true
+	}
+
+	auditors := make(map[string]types.Attributes, len(signed))
+	for _, prov := range signed {
+		auditors[prov.Auditor] = prov.Attributes
+	}
+
+	for _, auditor := range g.SignedBy.AllOf {
+		attrs, ok := auditors[auditor]
+		if !ok || !g.matchSigned(attrs) {
+			return false
+		}
+	}
+
+	if len(g.SignedBy.AnyOf) == 0 {
+		return true
+	}
+
+	for _, auditor := range g.SignedBy.AnyOf {
+		if attrs, ok := auditors[auditor]; ok && g.matchSigned(attrs) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g GroupSpec) matchSigned(attrs types.Attributes) bool {
+	keys := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		keys[attr.Key] = true
+	}
+
+	for _, req := range g.Requirements {
+		if !keys[req.Key] || !req.MatchedBy(attrs) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FitsWithin returns true when every requirement of the group spec is also required by
+// other and each of its resources fits within a distinct resource of other, so that
+// resources leased for other can serve it.
+func (g GroupSpec) FitsWithin(other GroupSpec) bool {
+	if !types.AttributesSubsetOf(g.Requirements, other.Requirements) {
+		return false
+	}
+
+	return resourcesFitWithin(g.Resources, other.Resources, make([]bool, len(other.Resources)))
+}
+
+// resourcesFitWithin returns true when each of resources can be matched with a distinct
+// resource of others which is not used yet and that it fits within
+func resourcesFitWithin(resources, others []Resource, used []bool) bool {
+	if len(resources) == 0 {
+		return true
+	}
+
+	for idx, other := range others {
+		if used[idx] || !resources[0].fitsWithin(other) {
+			continue
+		}
+
+		used[idx] = true
+		if resourcesFitWithin(resources[1:], others, used) {
+			return true
+		}
+		used[idx] = false
+	}
+
+	return false
+}
+
+// fitsWithin returns true when the resource asks for no more replicas than other, of units
+// no larger than those of other, with the same kinds of storage and no other endpoints,
+// for at least the price of other.
+func (r Resource) fitsWithin(other Resource) bool {
+	if r.Count > other.Count {
+		return false
+	}
+
+	if r.Price.Denom != other.Price.Denom || r.Price.IsLT(other.Price) {
+		return false
+	}
+
+	units, ounits := r.Resources, other.Resources
+
+	if units.CPU != nil {
+		if ounits.CPU == nil ||
+			units.CPU.Units.Val.GT(ounits.CPU.Units.Val) ||
+			!types.AttributesSubsetOf(units.CPU.Attributes, ounits.CPU.Attributes) {
+			return false
+		}
+	}
+
+	if units.Memory != nil {
+		if ounits.Memory == nil ||
+			units.Memory.Quantity.Val.GT(ounits.Memory.Quantity.Val) ||
+			!types.AttributesSubsetOf(units.Memory.Attributes, ounits.Memory.Attributes) {
+			return false
+		}
+	}
+
+	if units.Storage != nil {
+		// persistent and ephemeral storage are provisioned differently
+		if ounits.Storage == nil ||
+			units.Storage.Quantity.Val.GT(ounits.Storage.Quantity.Val) ||
+			!types.AttributesSubsetOf(units.Storage.Attributes, ounits.Storage.Attributes) ||
+			!types.AttributesSubsetOf(ounits.Storage.Attributes, units.Storage.Attributes) {
+			return false
+		}
+	}
+
+	endpoints := make(map[types.Endpoint_Kind]int, len(ounits.Endpoints))
+	for _, endpoint := range ounits.Endpoints {
+		endpoints[endpoint.Kind]++
+	}
+	for _, endpoint := range units.Endpoints {
+		if endpoints[endpoint.Kind] == 0 {
+			return false
+		}
+		endpoints[endpoint.Kind]--
+	}
+
+	return true
 }
 
 // ID method returns GroupID details of specific group
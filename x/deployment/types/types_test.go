@@ -11,7 +11,9 @@ import (
 
 	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/testutil"
+	atypes "github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/validation"
+	audittypes "github.com/ovrclk/akash/x/audit/types"
 	"github.com/ovrclk/akash/x/deployment/types"
 )
 
@@ -128,7 +130,7 @@ func TestGroupSpecValidation(t *testing.T) {
 				Name:             testutil.Name(t, "groupspec"),
 				Requirements:     testutil.Attributes(t),
 				Resources:        testutil.Resources(t),
-				OrderBidDuration: types.MaxBiddingDuration * int64(2),
+				OrderBidDuration: types.DefaultParams().MaxOrderBidDuration * int64(2),
 			},
 			expErr: types.ErrInvalidGroups,
 		},
@@ -155,7 +157,7 @@ func TestGroupSpecValidation(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		err := validation.ValidateDeploymentGroup(test.gspec)
+		err := validation.ValidateDeploymentGroup(types.DefaultParams(), test.gspec)
 		if test.expErr != nil {
 			assert.Error(t, err)
 			continue
@@ -163,3 +165,129 @@ func TestGroupSpecValidation(t *testing.T) {
 		assert.Equal(t, test.expErr, err, test.desc)
 	}
 }
+
+func TestGroupSpecFitsWithin(t *testing.T) {
+	spec := testutil.GroupSpec(t)
+	spec.Resources[0].Count = 2
+
+	smaller := spec
+	smaller.Resources = []types.Resource{spec.Resources[0]}
+	smaller.Resources[0].Count = 1
+
+	assert.True(t, spec.FitsWithin(spec))
+	assert.True(t, smaller.FitsWithin(spec))
+	assert.False(t, spec.FitsWithin(smaller))
+
+	relaxed := spec
+	relaxed.Requirements = nil
+	assert.True(t, relaxed.FitsWithin(spec))
+	assert.False(t, spec.FitsWithin(relaxed))
+}
+
+func TestGroupSpecFitsWithinPerResource(t *testing.T) {
+	resource := func(cpu, memory, storage uint64, count uint32, price int64, endpoints ...atypes.Endpoint_Kind) types.Resource {
+		res := types.Resource{
+			Resources: atypes.ResourceUnits{
+				CPU:     &atypes.CPU{Units: atypes.NewResourceValue(cpu)},
+				Memory:  &atypes.Memory{Quantity: atypes.NewResourceValue(memory)},
+				Storage: &atypes.Storage{Quantity: atypes.NewResourceValue(storage)},
+			},
+			Count: count,
+			Price: sdk.NewInt64Coin("uakt", price),
+		}
+		for _, kind := range endpoints {
+			res.Resources.Endpoints = append(res.Resources.Endpoints, atypes.Endpoint{Kind: kind})
+		}
+		return res
+	}
+
+	leased := types.GroupSpec{
+		Resources: []types.Resource{
+			resource(100, 1024, 2048, 2, 10, atypes.EndpointSharedHTTP),
+			resource(400, 4096, 2048, 1, 20),
+		},
+	}
+
+	spec := func(resources ...types.Resource) types.GroupSpec {
+		return types.GroupSpec{Resources: resources}
+	}
+
+	// resources are matched regardless of their order
+	assert.True(t, spec(leased.Resources[1], leased.Resources[0]).FitsWithin(leased))
+
+	// more replicas of a resource
+	assert.False(t, spec(resource(100, 1024, 2048, 3, 10, atypes.EndpointSharedHTTP)).FitsWithin(leased))
+
+	// the same totals with a unit larger than any leased
+	assert.False(t, spec(resource(200, 2048, 4096, 1, 10, atypes.EndpointSharedHTTP)).FitsWithin(leased))
+
+	// an endpoint which is not leased
+	assert.False(t, spec(resource(100, 1024, 2048, 1, 10, atypes.EndpointSharedHTTP, atypes.EndpointRandomPort)).FitsWithin(leased))
+	assert.False(t, spec(resource(400, 4096, 2048, 1, 20, atypes.EndpointSharedHTTP)).FitsWithin(leased))
+
+	// a lower price
+	assert.False(t, spec(resource(400, 4096, 2048, 1, 19)).FitsWithin(leased))
+	assert.False(t, spec(
+		types.Resource{
+			Resources: leased.Resources[1].Resources,
+			Count:     1,
+			Price:     sdk.NewInt64Coin("uatom", 20),
+		}).FitsWithin(leased))
+
+	// a distinct leased resource is needed for each resource
+	assert.False(t, spec(leased.Resources[1], resource(300, 1024, 1024, 1, 20)).FitsWithin(leased))
+	assert.True(t, spec(resource(300, 1024, 1024, 1, 20), leased.Resources[0]).FitsWithin(leased))
+
+	// other storage attributes
+	persistent := resource(400, 4096, 2048, 1, 20)
+	persistent.Resources.Storage.Attributes = []atypes.Attribute{atypes.NewStringAttribute("persistent", "true")}
+	assert.False(t, spec(persistent).FitsWithin(leased))
+	assert.False(t, spec(leased.Resources[1]).FitsWithin(spec(persistent)))
+}
+
+func TestGroupSpecMatchSignedAttributes(t *testing.T) {
+	spec := testutil.GroupSpec(t)
+	spec.Requirements = []atypes.Attribute{
+		atypes.NewStringAttribute("region", "us-west"),
+		atypes.NewStringAttribute("tier", "gold"),
+	}
+
+	auditor1 := testutil.AccAddress(t).String()
+	auditor2 := testutil.AccAddress(t).String()
+	signed := []audittypes.Provider{
+		{Auditor: auditor1, Attributes: spec.Requirements},
+	}
+
+	assert.True(t, spec.MatchSignedAttributes(nil))
+
+	spec.SignedBy = atypes.SignedBy{AllOf: []string{auditor1}}
+	assert.True(t, spec.MatchSignedAttributes(signed))
+	assert.False(t, spec.MatchSignedAttributes(nil))
+
+	spec.SignedBy = atypes.SignedBy{AllOf: []string{auditor1, auditor2}}
+	assert.False(t, spec.MatchSignedAttributes(signed))
+
+	spec.SignedBy = atypes.SignedBy{AnyOf: []string{auditor1, auditor2}}
+	assert.True(t, spec.MatchSignedAttributes(signed))
+
+	spec.SignedBy = atypes.SignedBy{AnyOf: []string{auditor2}}
+	assert.False(t, spec.MatchSignedAttributes(signed))
+
+	partial := []audittypes.Provider{
+		{Auditor: auditor1, Attributes: spec.Requirements[1:]},
+	}
+	spec.SignedBy = atypes.SignedBy{AllOf: []string{auditor1}}
+	assert.False(t, spec.MatchSignedAttributes(partial))
+
+	// negated requirements are not satisfied by attributes the auditor never signed
+	spec.Requirements = []atypes.Attribute{
+		{Key: "tier", Value: "bronze", Operator: atypes.AttributeOperatorNotEqual},
+	}
+	assert.True(t, spec.MatchAttributes(nil))
+	assert.False(t, spec.MatchSignedAttributes([]audittypes.Provider{
+		{Auditor: auditor1, Attributes: []atypes.Attribute{atypes.NewStringAttribute("region", "us-west")}},
+	}))
+	assert.True(t, spec.MatchSignedAttributes([]audittypes.Provider{
+		{Auditor: auditor1, Attributes: []atypes.Attribute{atypes.NewStringAttribute("tier", "gold")}},
+	}))
+}
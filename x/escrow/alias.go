@@ -0,0 +1,23 @@
+package escrow
+
+import (
+	"github.com/ovrclk/akash/x/escrow/keeper"
+	"github.com/ovrclk/akash/x/escrow/types"
+)
+
+const (
+	// StoreKey represents storekey of escrow module
+	StoreKey = types.StoreKey
+	// ModuleName represents current module name
+	ModuleName = types.ModuleName
+)
+
+type (
+	// Keeper defines keeper of escrow module
+	Keeper = keeper.Keeper
+)
+
+var (
+	// NewKeeper creates new keeper instance of escrow module
+	NewKeeper = keeper.NewKeeper
+)
@@ -0,0 +1,66 @@
+package escrow
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/escrow/keeper"
+	"github.com/ovrclk/akash/x/escrow/types"
+)
+
+// ValidateGenesis does validation check of the Genesis and returns error incase of failure
+func ValidateGenesis(data *types.GenesisState) error {
+	for _, account := range data.Accounts {
+		if err := account.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, payment := range data.Payments {
+		if err := payment.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DefaultGenesisState returns default genesis state as raw bytes for the escrow
+// module.
+func DefaultGenesisState() *types.GenesisState {
+	return &types.GenesisState{}
+}
+
+// InitGenesis initiate genesis state and return updated validator details
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	for _, account := range data.Accounts {
+		if err := keeper.SaveAccount(ctx, account); err != nil {
+			panic(err)
+		}
+	}
+	for _, payment := range data.Payments {
+		if err := keeper.SavePayment(ctx, payment); err != nil {
+			panic(err)
+		}
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns genesis state for the escrow module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var accounts []types.Account
+	var payments []types.Payment
+
+	k.WithAccounts(ctx, func(account types.Account) bool {
+		accounts = append(accounts, account)
+		return false
+	})
+
+	k.WithPayments(ctx, func(payment types.Payment) bool {
+		payments = append(payments, payment)
+		return false
+	})
+
+	return &types.GenesisState{
+		Accounts: accounts,
+		Payments: payments,
+	}
+}
@@ -0,0 +1,603 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/escrow/types"
+)
+
+// BankKeeper includes the bank methods used by the escrow keeper
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// PaymentHook is called whenever a payment changes state
+type PaymentHook func(sdk.Context, types.Payment)
+
+// Keeper of the escrow store
+type Keeper struct {
+	cdc     codec.BinaryMarshaler
+	skey    sdk.StoreKey
+	bkeeper BankKeeper
+
+	onPaymentClosed   []PaymentHook
+	onPaymentReopened []PaymentHook
+}
+
+// NewKeeper creates and returns an instance for escrow keeper
+func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey, bkeeper BankKeeper) Keeper {
+	return Keeper{
+		cdc:     cdc,
+		skey:    skey,
+		bkeeper: bkeeper,
+	}
+}
+
+// Codec returns keeper codec
+func (k Keeper) Codec() codec.BinaryMarshaler {
+	return k.cdc
+}
+
+// AddOnPaymentClosedHook registers a hook which is called whenever a payment
+// is closed or overdrawn. Hooks must be added before the keeper is handed
+// out to other modules.
+func (k *Keeper) AddOnPaymentClosedHook(hook PaymentHook) *Keeper {
+	k.onPaymentClosed = append(k.onPaymentClosed, hook)
+	return k
+}
+
+// AddOnPaymentReopenedHook registers a hook which is called whenever an overdrawn
+// payment is reopened by a deposit. Hooks must be added before the keeper is
+// handed out to other modules.
+func (k *Keeper) AddOnPaymentReopenedHook(hook PaymentHook) *Keeper {
+	k.onPaymentReopened = append(k.onPaymentReopened, hook)
+	return k
+}
+
+// AccountCreate creates an escrow account funded with deposit from the owner's wallet
+func (k Keeper) AccountCreate(ctx sdk.Context, id types.AccountID, owner sdk.AccAddress, deposit sdk.Coin) error {
+	store := ctx.KVStore(k.skey)
+
+	key := accountKey(id)
+
+	if store.Has(key) {
+		return types.ErrAccountExists
+	}
+
+	if !deposit.IsValid() {
+		return types.ErrInvalidDenomination
+	}
+
+	obj := types.Account{
+		ID:          id,
+		Owner:       owner.String(),
+		State:       types.AccountOpen,
+		Balance:     deposit,
+		Transferred: sdk.NewCoin(deposit.Denom, sdk.ZeroInt()),
+		SettledAt:   ctx.BlockHeight(),
+	}
+
+	if err := obj.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.bkeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&obj))
+
+	return nil
+}
+
+// AccountDeposit adds amount from the owner's wallet to the escrow account.  An overdrawn
+// account is reopened along with its overdrawn payments when the deposit is made within
+// OverdrawnGracePeriod blocks of the account being found overdrawn and covers the payments
+// for every block since it ran out of funds.
+func (k Keeper) AccountDeposit(ctx sdk.Context, id types.AccountID, amount sdk.Coin) error {
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || amount.Denom != account.Balance.Denom {
+		return types.ErrInvalidDenomination
+	}
+
+	var payments []types.Payment
+
+	switch account.State {
+	case types.AccountOpen:
+	case types.AccountOverdrawn:
+		if ctx.BlockHeight()-account.OverdrawnAt > types.OverdrawnGracePeriod {
+			return types.ErrGracePeriodExpired
+		}
+
+		blocks := ctx.BlockHeight() - account.SettledAt
+
+		owed := sdk.ZeroInt()
+		k.WithAccountPayments(ctx, id, func(payment types.Payment) bool {
+			if payment.State == types.PaymentOverdrawn {
+				payments = append(payments, payment)
+				owed = owed.Add(payment.Rate.Amount.MulRaw(blocks))
+			}
+			return false
+		})
+
+		if account.Balance.Amount.Add(amount.Amount).LT(owed) {
+			return types.ErrInsufficientDeposit
+		}
+	default:
+		return types.ErrAccountClosed
+	}
+
+	owner, err := sdk.AccAddressFromBech32(account.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bkeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	account.Balance = account.Balance.Add(amount)
+
+	if account.State == types.AccountOpen {
+		k.saveAccount(ctx, account)
+		return nil
+	}
+
+	account.State = types.AccountOpen
+	account.OverdrawnAt = 0
+	for idx := range payments {
+		payments[idx].State = types.PaymentOpen
+	}
+
+	k.saveAccount(ctx, account)
+	k.savePayments(ctx, payments)
+
+	// pay for the blocks since the account ran out of funds
+	if _, err := k.AccountSettle(ctx, id); err != nil {
+		return err
+	}
+
+	for _, payment := range payments {
+		for _, hook := range k.onPaymentReopened {
+			hook(ctx, payment)
+		}
+	}
+
+	return nil
+}
+
+// AccountSettle pays out all open payments of the account up to the current height.
+// It returns true when the account was unable to cover its payments and was overdrawn.
+func (k Keeper) AccountSettle(ctx sdk.Context, id types.AccountID) (bool, error) {
+	account, payments, overdrawn, err := k.doAccountSettle(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if !overdrawn {
+		k.saveAccount(ctx, account)
+		k.savePayments(ctx, payments)
+		return false, nil
+	}
+
+	account.State = types.AccountOverdrawn
+	account.OverdrawnAt = ctx.BlockHeight()
+	if err := k.closePayments(ctx, account, payments, types.PaymentOverdrawn); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SettleDepletedAccounts settles the open accounts whose balance cannot pay for their
+// open payments through the next block, so that accounts are overdrawn as soon as they
+// run out of funds rather than when a transaction next touches them.
+func (k Keeper) SettleDepletedAccounts(ctx sdk.Context) error {
+	var ids []types.AccountID
+
+	// collect first; the store must not be modified while iterating it
+	k.WithAccounts(ctx, func(account types.Account) bool {
+		if account.State != types.AccountOpen {
+			return false
+		}
+
+		rate := sdk.ZeroInt()
+		k.WithAccountPayments(ctx, account.ID, func(payment types.Payment) bool {
+			if payment.State == types.PaymentOpen {
+				rate = rate.Add(payment.Rate.Amount)
+			}
+			return false
+		})
+
+		blocks := ctx.BlockHeight() - account.SettledAt + 1
+		if account.Balance.Amount.LT(rate.MulRaw(blocks)) {
+			ids = append(ids, account.ID)
+		}
+		return false
+	})
+
+	for _, id := range ids {
+		if _, err := k.AccountSettle(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AccountClose settles the account, closes all of its payments and returns
+// the unspent balance to the owner.
+func (k Keeper) AccountClose(ctx sdk.Context, id types.AccountID) error {
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var payments []types.Payment
+	pstate := types.PaymentClosed
+
+	switch account.State {
+	case types.AccountOpen:
+		var overdrawn bool
+		account, payments, overdrawn, err = k.doAccountSettle(ctx, id)
+		if err != nil {
+			return err
+		}
+		if overdrawn {
+			pstate = types.PaymentOverdrawn
+		}
+	case types.AccountOverdrawn:
+	default:
+		return types.ErrAccountClosed
+	}
+
+	owner, err := sdk.AccAddressFromBech32(account.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !account.Balance.IsZero() {
+		if err := k.bkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(account.Balance)); err != nil {
+			return err
+		}
+		account.Balance = sdk.NewCoin(account.Balance.Denom, sdk.ZeroInt())
+	}
+
+	account.State = types.AccountClosed
+
+	return k.closePayments(ctx, account, payments, pstate)
+}
+
+// PaymentCreate creates a payment from the escrow account to owner at the given rate per block
+func (k Keeper) PaymentCreate(ctx sdk.Context, id types.AccountID, pid string, owner sdk.AccAddress, rate sdk.Coin) error {
+	store := ctx.KVStore(k.skey)
+
+	overdrawn, err := k.AccountSettle(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if overdrawn {
+		return types.ErrAccountOverdrawn
+	}
+
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if rate.Denom != account.Balance.Denom {
+		return types.ErrInvalidDenomination
+	}
+
+	key := paymentKey(id, pid)
+
+	if store.Has(key) {
+		return types.ErrPaymentExists
+	}
+
+	obj := types.Payment{
+		AccountID: id,
+		PaymentID: pid,
+		Owner:     owner.String(),
+		State:     types.PaymentOpen,
+		Rate:      rate,
+		Balance:   sdk.NewCoin(rate.Denom, sdk.ZeroInt()),
+		Withdrawn: sdk.NewCoin(rate.Denom, sdk.ZeroInt()),
+	}
+
+	if err := obj.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&obj))
+
+	return nil
+}
+
+// PaymentWithdraw settles the account and transfers the earned balance of the payment to its owner
+func (k Keeper) PaymentWithdraw(ctx sdk.Context, id types.AccountID, pid string) error {
+	payment, err := k.GetPayment(ctx, id, pid)
+	if err != nil {
+		return err
+	}
+
+	if payment.State != types.PaymentOpen {
+		return types.ErrPaymentClosed
+	}
+
+	overdrawn, err := k.AccountSettle(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// overdrawn payments are paid out when they are closed
+	if overdrawn {
+		return nil
+	}
+
+	payment, err = k.GetPayment(ctx, id, pid)
+	if err != nil {
+		return err
+	}
+
+	if err := k.paymentWithdraw(ctx, &payment); err != nil {
+		return err
+	}
+
+	k.savePayment(ctx, payment)
+
+	return nil
+}
+
+// PaymentClose settles the account, pays out the payment's earned balance and closes it.
+// Closing an overdrawn payment prevents it from being reopened by a later deposit.
+func (k Keeper) PaymentClose(ctx sdk.Context, id types.AccountID, pid string) error {
+	payment, err := k.GetPayment(ctx, id, pid)
+	if err != nil {
+		return err
+	}
+
+	switch payment.State {
+	case types.PaymentOpen:
+	case types.PaymentOverdrawn:
+		payment.State = types.PaymentClosed
+		k.savePayment(ctx, payment)
+		return nil
+	default:
+		return types.ErrPaymentClosed
+	}
+
+	overdrawn, err := k.AccountSettle(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if overdrawn {
+		return nil
+	}
+
+	payment, err = k.GetPayment(ctx, id, pid)
+	if err != nil {
+		return err
+	}
+
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return k.closePayments(ctx, account, []types.Payment{payment}, types.PaymentClosed)
+}
+
+// GetAccount returns escrow account with the given ID
+func (k Keeper) GetAccount(ctx sdk.Context, id types.AccountID) (types.Account, error) {
+	store := ctx.KVStore(k.skey)
+
+	key := accountKey(id)
+
+	if !store.Has(key) {
+		return types.Account{}, types.ErrAccountNotFound
+	}
+
+	var val types.Account
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &val)
+	return val, nil
+}
+
+// GetPayment returns payment with the given ID
+func (k Keeper) GetPayment(ctx sdk.Context, id types.AccountID, pid string) (types.Payment, error) {
+	store := ctx.KVStore(k.skey)
+
+	key := paymentKey(id, pid)
+
+	if !store.Has(key) {
+		return types.Payment{}, types.ErrPaymentNotFound
+	}
+
+	var val types.Payment
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &val)
+	return val, nil
+}
+
+// SaveAccount stores the account as is. Used for genesis import.
+func (k Keeper) SaveAccount(ctx sdk.Context, obj types.Account) error {
+	if err := obj.ValidateBasic(); err != nil {
+		return err
+	}
+	k.saveAccount(ctx, obj)
+	return nil
+}
+
+// SavePayment stores the payment as is. Used for genesis import.
+func (k Keeper) SavePayment(ctx sdk.Context, obj types.Payment) error {
+	if err := obj.ValidateBasic(); err != nil {
+		return err
+	}
+	k.savePayment(ctx, obj)
+	return nil
+}
+
+// WithAccounts iterates all escrow accounts
+func (k Keeper) WithAccounts(ctx sdk.Context, fn func(types.Account) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, accountPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Account
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// WithPayments iterates all payments
+func (k Keeper) WithPayments(ctx sdk.Context, fn func(types.Payment) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, paymentPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Payment
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// WithAccountPayments iterates all payments of the given account
+func (k Keeper) WithAccountPayments(ctx sdk.Context, id types.AccountID, fn func(types.Payment) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, accountPaymentsKey(id))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Payment
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// doAccountSettle computes the settled state of an open account and its open
+// payments at the current height without persisting anything.  When the balance
+// cannot cover every block since the last settlement, only the affordable
+// blocks are paid for, the account is settled up to the height at which it
+// ran out of funds and overdrawn is returned as true.
+func (k Keeper) doAccountSettle(ctx sdk.Context, id types.AccountID) (types.Account, []types.Payment, bool, error) {
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return account, nil, false, err
+	}
+
+	if account.State != types.AccountOpen {
+		return account, nil, false, types.ErrAccountClosed
+	}
+
+	var payments []types.Payment
+	k.WithAccountPayments(ctx, id, func(payment types.Payment) bool {
+		if payment.State == types.PaymentOpen {
+			payments = append(payments, payment)
+		}
+		return false
+	})
+
+	settledAt := account.SettledAt
+	blocks := sdk.NewInt(ctx.BlockHeight() - settledAt)
+	account.SettledAt = ctx.BlockHeight()
+
+	if !blocks.IsPositive() || len(payments) == 0 {
+		return account, payments, false, nil
+	}
+
+	blockRate := sdk.NewCoin(account.Balance.Denom, sdk.ZeroInt())
+	for _, payment := range payments {
+		blockRate = blockRate.Add(payment.Rate)
+	}
+
+	overdrawn := false
+
+	if !blockRate.IsZero() {
+		if affordable := account.Balance.Amount.Quo(blockRate.Amount); affordable.LT(blocks) {
+			blocks = affordable
+			overdrawn = true
+			account.SettledAt = settledAt + affordable.Int64()
+		}
+	}
+
+	for idx := range payments {
+		amount := sdk.NewCoin(account.Balance.Denom, payments[idx].Rate.Amount.Mul(blocks))
+		payments[idx].Balance = payments[idx].Balance.Add(amount)
+		account.Balance = account.Balance.Sub(amount)
+		account.Transferred = account.Transferred.Add(amount)
+	}
+
+	return account, payments, overdrawn, nil
+}
+
+// closePayments pays out and closes the given payments with the given state,
+// persists them along with the account and notifies the registered hooks.
+func (k Keeper) closePayments(ctx sdk.Context, account types.Account, payments []types.Payment, state types.Payment_State) error {
+	for idx := range payments {
+		if payments[idx].State == types.PaymentOpen {
+			payments[idx].State = state
+		}
+		if err := k.paymentWithdraw(ctx, &payments[idx]); err != nil {
+			return err
+		}
+	}
+
+	k.saveAccount(ctx, account)
+	k.savePayments(ctx, payments)
+
+	for _, payment := range payments {
+		for _, hook := range k.onPaymentClosed {
+			hook(ctx, payment)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) paymentWithdraw(ctx sdk.Context, obj *types.Payment) error {
+	if obj.Balance.IsZero() {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(obj.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(obj.Balance)); err != nil {
+		return err
+	}
+
+	obj.Withdrawn = obj.Withdrawn.Add(obj.Balance)
+	obj.Balance = sdk.NewCoin(obj.Balance.Denom, sdk.ZeroInt())
+
+	return nil
+}
+
+func (k Keeper) saveAccount(ctx sdk.Context, obj types.Account) {
+	store := ctx.KVStore(k.skey)
+	store.Set(accountKey(obj.ID), k.cdc.MustMarshalBinaryBare(&obj))
+}
+
+func (k Keeper) savePayment(ctx sdk.Context, obj types.Payment) {
+	store := ctx.KVStore(k.skey)
+	store.Set(paymentKey(obj.AccountID, obj.PaymentID), k.cdc.MustMarshalBinaryBare(&obj))
+}
+
+func (k Keeper) savePayments(ctx sdk.Context, objs []types.Payment) {
+	for _, obj := range objs {
+		k.savePayment(ctx, obj)
+	}
+}
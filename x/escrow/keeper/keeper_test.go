@@ -0,0 +1,489 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/escrow/keeper"
+	"github.com/ovrclk/akash/x/escrow/keeper/mocks"
+	"github.com/ovrclk/akash/x/escrow/types"
+)
+
+func TestAccountCreate(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	deposit := testutil.AkashCoin(t, 1000)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", ctx, owner, types.ModuleName, sdk.NewCoins(deposit)).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, deposit))
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, deposit, account.Balance)
+	require.Equal(t, owner.String(), account.Owner)
+
+	require.Equal(t, types.ErrAccountExists, keeper.AccountCreate(ctx, id, owner, deposit))
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestAccountCreateInvalidDeposit(t *testing.T) {
+	ctx, keeper, _ := setupKeeper(t)
+
+	err := keeper.AccountCreate(ctx, testAccountID(t), testutil.AccAddress(t), sdk.Coin{})
+	require.Equal(t, types.ErrInvalidDenomination, err)
+}
+
+func TestAccountCloseRefunds(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+	deposit := testutil.AkashCoin(t, 1000)
+	rate := testutil.AkashCoin(t, 10)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, sdk.NewCoins(deposit)).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, sdk.NewCoins(testutil.AkashCoin(t, 50))).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, owner, sdk.NewCoins(testutil.AkashCoin(t, 950))).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, deposit))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, rate))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+
+	var closed []types.Payment
+	keeper.AddOnPaymentClosedHook(func(_ sdk.Context, obj types.Payment) {
+		closed = append(closed, obj)
+	})
+
+	require.NoError(t, keeper.AccountClose(ctx, id))
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountClosed, account.State)
+	require.True(t, account.Balance.IsZero())
+	require.Equal(t, testutil.AkashCoin(t, 50), account.Transferred)
+
+	payment, err := keeper.GetPayment(ctx, id, "pid")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentClosed, payment.State)
+	require.Equal(t, testutil.AkashCoin(t, 50), payment.Withdrawn)
+
+	require.Len(t, closed, 1)
+	require.Equal(t, payment, closed[0])
+
+	require.Equal(t, types.ErrAccountClosed, keeper.AccountClose(ctx, id))
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestPaymentWithdraw(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, sdk.NewCoins(testutil.AkashCoin(t, 30))).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 1000)))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+	require.Equal(t, types.ErrPaymentExists, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+
+	require.NoError(t, keeper.PaymentWithdraw(ctx, id, "pid"))
+
+	payment, err := keeper.GetPayment(ctx, id, "pid")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOpen, payment.State)
+	require.True(t, payment.Balance.IsZero())
+	require.Equal(t, testutil.AkashCoin(t, 30), payment.Withdrawn)
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, testutil.AkashCoin(t, 970), account.Balance)
+	require.Equal(t, ctx.BlockHeight(), account.SettledAt)
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestPaymentCreateInvalidDenomination(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 1000)))
+
+	err := keeper.PaymentCreate(ctx, id, "pid", testutil.AccAddress(t), sdk.NewInt64Coin("foo", 10))
+	require.Equal(t, types.ErrInvalidDenomination, err)
+}
+
+func TestAccountOverdrawn(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, sdk.NewCoins(testutil.AkashCoin(t, 90))).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 95)))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+
+	var closed []types.Payment
+	keeper.AddOnPaymentClosedHook(func(_ sdk.Context, obj types.Payment) {
+		closed = append(closed, obj)
+	})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 20)
+
+	overdrawn, err := keeper.AccountSettle(ctx, id)
+	require.NoError(t, err)
+	require.True(t, overdrawn)
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOverdrawn, account.State)
+	require.Equal(t, testutil.AkashCoin(t, 5), account.Balance)
+	require.Equal(t, int64(9), account.SettledAt)
+	require.Equal(t, ctx.BlockHeight(), account.OverdrawnAt)
+
+	payment, err := keeper.GetPayment(ctx, id, "pid")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOverdrawn, payment.State)
+	require.Equal(t, testutil.AkashCoin(t, 90), payment.Withdrawn)
+
+	require.Len(t, closed, 1)
+	require.Equal(t, types.PaymentOverdrawn, closed[0].State)
+
+	require.Equal(t, types.ErrPaymentClosed, keeper.PaymentWithdraw(ctx, id, "pid"))
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestSettleDepletedAccounts(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil)
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, mock.Anything).
+		Return(nil)
+
+	depleted := testAccountID(t)
+	funded := testAccountID(t)
+	unpaid := testAccountID(t)
+
+	require.NoError(t, keeper.AccountCreate(ctx, depleted, owner, testutil.AkashCoin(t, 95)))
+	require.NoError(t, keeper.PaymentCreate(ctx, depleted, "pid", provider, testutil.AkashCoin(t, 10)))
+	require.NoError(t, keeper.AccountCreate(ctx, funded, owner, testutil.AkashCoin(t, 1000)))
+	require.NoError(t, keeper.PaymentCreate(ctx, funded, "pid", provider, testutil.AkashCoin(t, 10)))
+	require.NoError(t, keeper.AccountCreate(ctx, unpaid, owner, testutil.AkashCoin(t, 5)))
+
+	var closed []types.Payment
+	keeper.AddOnPaymentClosedHook(func(_ sdk.Context, obj types.Payment) {
+		closed = append(closed, obj)
+	})
+
+	// the depleted account can pay for 9 blocks
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 8)
+	require.NoError(t, keeper.SettleDepletedAccounts(ctx))
+	require.Empty(t, closed)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, keeper.SettleDepletedAccounts(ctx))
+	require.Empty(t, closed)
+
+	// settled up to the last block it can pay for
+	account, err := keeper.GetAccount(ctx, depleted)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, ctx.BlockHeight(), account.SettledAt)
+	require.Equal(t, testutil.AkashCoin(t, 5), account.Balance)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, keeper.SettleDepletedAccounts(ctx))
+
+	account, err = keeper.GetAccount(ctx, depleted)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOverdrawn, account.State)
+
+	require.Len(t, closed, 1)
+	require.Equal(t, depleted, closed[0].AccountID)
+	require.Equal(t, types.PaymentOverdrawn, closed[0].State)
+
+	// accounts which can pay for the next block are left alone
+	account, err = keeper.GetAccount(ctx, funded)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, int64(0), account.SettledAt)
+
+	account, err = keeper.GetAccount(ctx, unpaid)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, int64(0), account.SettledAt)
+}
+
+func TestAccountDeposit(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, sdk.NewCoins(testutil.AkashCoin(t, 1000))).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, sdk.NewCoins(testutil.AkashCoin(t, 500))).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 1000)))
+	require.NoError(t, keeper.AccountDeposit(ctx, id, testutil.AkashCoin(t, 500)))
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, testutil.AkashCoin(t, 1500), account.Balance)
+
+	require.Equal(t, types.ErrInvalidDenomination, keeper.AccountDeposit(ctx, id, sdk.NewInt64Coin("foo", 10)))
+	require.Equal(t, types.ErrAccountNotFound, keeper.AccountDeposit(ctx, testAccountID(t), testutil.AkashCoin(t, 10)))
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestAccountDepositReopensOverdrawn(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, sdk.NewCoins(testutil.AkashCoin(t, 95))).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, sdk.NewCoins(testutil.AkashCoin(t, 200))).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, sdk.NewCoins(testutil.AkashCoin(t, 90))).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 95)))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+
+	var reopened []types.Payment
+	keeper.AddOnPaymentReopenedHook(func(_ sdk.Context, obj types.Payment) {
+		reopened = append(reopened, obj)
+	})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 20)
+
+	overdrawn, err := keeper.AccountSettle(ctx, id)
+	require.NoError(t, err)
+	require.True(t, overdrawn)
+
+	// 11 blocks at 10 per block are owed since the account ran out of funds
+	require.Equal(t, types.ErrInsufficientDeposit, keeper.AccountDeposit(ctx, id, testutil.AkashCoin(t, 100)))
+	require.NoError(t, keeper.AccountDeposit(ctx, id, testutil.AkashCoin(t, 200)))
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, testutil.AkashCoin(t, 95), account.Balance)
+	require.Equal(t, ctx.BlockHeight(), account.SettledAt)
+
+	payment, err := keeper.GetPayment(ctx, id, "pid")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOpen, payment.State)
+	require.Equal(t, testutil.AkashCoin(t, 110), payment.Balance)
+
+	require.Len(t, reopened, 1)
+	require.Equal(t, "pid", reopened[0].PaymentID)
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestAccountDepositGracePeriodExpired(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil).Once()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, mock.Anything).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 95)))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	overdrawn, err := keeper.AccountSettle(ctx, id)
+	require.NoError(t, err)
+	require.True(t, overdrawn)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.OverdrawnGracePeriod + 1)
+
+	require.Equal(t, types.ErrGracePeriodExpired, keeper.AccountDeposit(ctx, id, testutil.AkashCoin(t, 100000)))
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestAccountDepositGracePeriodAfterLateDetection(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil).Twice()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, mock.Anything).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 95)))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+
+	// the account runs out of funds after 9 blocks but is only settled much later
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10 + types.OverdrawnGracePeriod)
+
+	overdrawn, err := keeper.AccountSettle(ctx, id)
+	require.NoError(t, err)
+	require.True(t, overdrawn)
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), account.SettledAt)
+	require.Equal(t, ctx.BlockHeight(), account.OverdrawnAt)
+
+	// the grace period starts when the account is found overdrawn
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.OverdrawnGracePeriod)
+
+	blocks := ctx.BlockHeight() - account.SettledAt
+	require.NoError(t, keeper.AccountDeposit(ctx, id, testutil.AkashCoin(t, 10*blocks)))
+
+	account, err = keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, ctx.BlockHeight(), account.SettledAt)
+	require.Equal(t, int64(0), account.OverdrawnAt)
+	require.Equal(t, testutil.AkashCoin(t, 5), account.Balance)
+
+	payment, err := keeper.GetPayment(ctx, id, "pid")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOpen, payment.State)
+
+	bkeeper.AssertExpectations(t)
+}
+
+func TestPaymentCloseOverdrawn(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+
+	id := testAccountID(t)
+	owner := testutil.AccAddress(t)
+	provider := testutil.AccAddress(t)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, owner, types.ModuleName, mock.Anything).
+		Return(nil).Twice()
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, provider, mock.Anything).
+		Return(nil).Once()
+
+	require.NoError(t, keeper.AccountCreate(ctx, id, owner, testutil.AkashCoin(t, 95)))
+	require.NoError(t, keeper.PaymentCreate(ctx, id, "pid", provider, testutil.AkashCoin(t, 10)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 20)
+
+	overdrawn, err := keeper.AccountSettle(ctx, id)
+	require.NoError(t, err)
+	require.True(t, overdrawn)
+
+	require.NoError(t, keeper.PaymentClose(ctx, id, "pid"))
+	require.Equal(t, types.ErrPaymentClosed, keeper.PaymentClose(ctx, id, "pid"))
+
+	// closed payments are not reopened by a deposit
+	require.NoError(t, keeper.AccountDeposit(ctx, id, testutil.AkashCoin(t, 10)))
+
+	payment, err := keeper.GetPayment(ctx, id, "pid")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentClosed, payment.State)
+
+	account, err := keeper.GetAccount(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, account.State)
+	require.Equal(t, testutil.AkashCoin(t, 15), account.Balance)
+
+	bkeeper.AssertExpectations(t)
+}
+
+func testAccountID(t testing.TB) types.AccountID {
+	t.Helper()
+	return types.AccountID{
+		Scope: "test",
+		XID:   testutil.AccAddress(t).String(),
+	}
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper, *mocks.BankKeeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	bkeeper := &mocks.BankKeeper{}
+	return ctx, keeper.NewKeeper(cdc, key, bkeeper), bkeeper
+}
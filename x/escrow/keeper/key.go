@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/ovrclk/akash/x/escrow/types"
+)
+
+var (
+	accountPrefix = []byte{0x01}
+	paymentPrefix = []byte{0x02}
+)
+
+func accountKey(id types.AccountID) []byte {
+	buf := bytes.NewBuffer(accountPrefix)
+	buf.Write([]byte(id.Scope))
+	buf.Write([]byte("/"))
+	buf.Write([]byte(id.XID))
+	return buf.Bytes()
+}
+
+// accountPaymentsKey provides the prefix for all payments of the given account.
+func accountPaymentsKey(id types.AccountID) []byte {
+	buf := bytes.NewBuffer(paymentPrefix)
+	buf.Write([]byte(id.Scope))
+	buf.Write([]byte("/"))
+	buf.Write([]byte(id.XID))
+	buf.Write([]byte("/"))
+	return buf.Bytes()
+}
+
+func paymentKey(id types.AccountID, pid string) []byte {
+	buf := bytes.NewBuffer(accountPaymentsKey(id))
+	buf.Write([]byte(pid))
+	return buf.Bytes()
+}
@@ -0,0 +1,139 @@
+package escrow
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/escrow/keeper"
+	"github.com/ovrclk/akash/x/escrow/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the escrow module.
+type AppModuleBasic struct {
+	cdc codec.Marshaler
+}
+
+// Name returns escrow module's name
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec performs no-op; escrow has no messages
+func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterInterfaces performs no-op; escrow has no messages
+func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+// DefaultGenesis returns default genesis state as raw bytes for the escrow
+// module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
+	return cdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis validation check of the Genesis
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	err := cdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return errors.Errorf("failed to unmarshal %s genesis state: %v", types.ModuleName, err)
+	}
+	return ValidateGenesis(&data)
+}
+
+// RegisterRESTRoutes performs no-op; escrow state is exposed through the deployment and market modules
+func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
+
+// RegisterGRPCGatewayRoutes performs no-op; escrow state is exposed through the deployment and market modules
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
+// GetQueryCmd returns no root query command for the escrow module
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return nil
+}
+
+// GetTxCmd returns no root tx command for the escrow module
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// AppModule implements an application module for the escrow module.
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(cdc codec.Marshaler, k keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		keeper:         k,
+	}
+}
+
+// Name returns the escrow module name
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants registers module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+// Route returns an empty route; escrow has no messages
+func (am AppModule) Route() sdk.Route {
+	return sdk.Route{}
+}
+
+// QuerierRoute returns an empty querier route; escrow has no legacy querier
+func (am AppModule) QuerierRoute() string {
+	return ""
+}
+
+// LegacyQuerierHandler returns no sdk.Querier for the escrow module
+func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices performs no-op; escrow has no services
+func (am AppModule) RegisterServices(_ module.Configurator) {}
+
+// BeginBlock performs no-op
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock settles the accounts which run out of funds. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if err := am.keeper.SettleDepletedAccounts(ctx); err != nil {
+		panic(err)
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// InitGenesis performs genesis initialization for the escrow module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	return InitGenesis(ctx, am.keeper, &genesisState)
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the escrow
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(gs)
+}
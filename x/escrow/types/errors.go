@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	errAccountExists uint32 = iota + 1
+	errAccountClosed
+	errAccountNotFound
+	errAccountOverdrawn
+	errInvalidDenomination
+	errPaymentExists
+	errPaymentClosed
+	errPaymentNotFound
+	errInvalidPayment
+	errInvalidAccountID
+	errInsufficientDeposit
+	errGracePeriodExpired
+)
+
+var (
+	// ErrAccountExists is the error when an escrow account already exists
+	ErrAccountExists = sdkerrors.Register(ModuleName, errAccountExists, "account exists")
+	// ErrAccountClosed is the error when an escrow account is not open
+	ErrAccountClosed = sdkerrors.Register(ModuleName, errAccountClosed, "account closed")
+	// ErrAccountNotFound is the error when an escrow account does not exist
+	ErrAccountNotFound = sdkerrors.Register(ModuleName, errAccountNotFound, "account not found")
+	// ErrAccountOverdrawn is the error when an escrow account cannot cover its payments
+	ErrAccountOverdrawn = sdkerrors.Register(ModuleName, errAccountOverdrawn, "account overdrawn")
+	// ErrInvalidDenomination is the error when coins do not match the account denomination
+	ErrInvalidDenomination = sdkerrors.Register(ModuleName, errInvalidDenomination, "invalid denomination")
+	// ErrPaymentExists is the error when a payment already exists
+	ErrPaymentExists = sdkerrors.Register(ModuleName, errPaymentExists, "payment exists")
+	// ErrPaymentClosed is the error when a payment is not open
+	ErrPaymentClosed = sdkerrors.Register(ModuleName, errPaymentClosed, "payment closed")
+	// ErrPaymentNotFound is the error when a payment does not exist
+	ErrPaymentNotFound = sdkerrors.Register(ModuleName, errPaymentNotFound, "payment not found")
+	// ErrInvalidPayment is the error for a malformed payment
+	ErrInvalidPayment = sdkerrors.Register(ModuleName, errInvalidPayment, "invalid payment")
+	// ErrInvalidAccountID is the error for a malformed account id
+	ErrInvalidAccountID = sdkerrors.Register(ModuleName, errInvalidAccountID, "invalid account id")
+	// ErrInsufficientDeposit is the error when a deposit cannot cover the overdrawn payments of an account
+	ErrInsufficientDeposit = sdkerrors.Register(ModuleName, errInsufficientDeposit, "insufficient deposit")
+	// ErrGracePeriodExpired is the error when an overdrawn account can no longer be reopened
+	ErrGracePeriodExpired = sdkerrors.Register(ModuleName, errGracePeriodExpired, "grace period expired")
+)
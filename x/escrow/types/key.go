@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "escrow"
+
+	// StoreKey is the store key string for escrow
+	StoreKey = ModuleName
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OverdrawnGracePeriod is the number of blocks after an account was found overdrawn
+// during which a deposit reopens the account along with its overdrawn payments.
+const OverdrawnGracePeriod int64 = 600
+
+// String returns a human readable representation of the AccountID
+func (id AccountID) String() string {
+	return fmt.Sprintf("%s/%s", id.Scope, id.XID)
+}
+
+// Validate checks that the AccountID is well formed
+func (id AccountID) Validate() error {
+	if id.Scope == "" || id.XID == "" {
+		return ErrInvalidAccountID
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation of the account
+func (obj Account) ValidateBasic() error {
+	if err := obj.ID.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(obj.Owner); err != nil {
+		return ErrInvalidAccountID
+	}
+	if obj.State == AccountStateInvalid {
+		return ErrInvalidAccountID
+	}
+	if !obj.Balance.IsValid() || !obj.Transferred.IsValid() {
+		return ErrInvalidDenomination
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation of the payment
+func (obj Payment) ValidateBasic() error {
+	if err := obj.AccountID.Validate(); err != nil {
+		return err
+	}
+	if obj.PaymentID == "" {
+		return ErrInvalidPayment
+	}
+	if _, err := sdk.AccAddressFromBech32(obj.Owner); err != nil {
+		return ErrInvalidPayment
+	}
+	if obj.State == PaymentStateInvalid {
+		return ErrInvalidPayment
+	}
+	if !obj.Rate.IsValid() || !obj.Balance.IsValid() || !obj.Withdrawn.IsValid() {
+		return ErrInvalidDenomination
+	}
+	return nil
+}
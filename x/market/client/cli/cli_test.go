@@ -70,6 +70,7 @@ func (s *IntegrationTestSuite) Test1QueryOrders() {
 		val.ClientCtx,
 		val.Address,
 		deploymentPath,
+		fmt.Sprintf("--deposit=%s", sdk.NewInt64Coin(s.cfg.BondDenom, 5000000)),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
@@ -212,7 +213,7 @@ func (s *IntegrationTestSuite) Test2CreateBid() {
 	_, err = cli.TxCreateBidExec(
 		val.ClientCtx,
 		createdOrder.OrderID,
-		sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(0)),
+		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(0)),
 		keyBar.GetAddress(),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
@@ -258,6 +259,20 @@ func (s *IntegrationTestSuite) Test2CreateBid() {
 	s.Require().Len(bidRes.Bids, 1)
 	s.Require().Equal(createdBid, bidRes.Bids[0])
 
+	// create lease
+	_, err = cli.TxCreateLeaseExec(
+		val.ClientCtx,
+		createdBid.BidID,
+		val.Address,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+
 	// test query bids with wrong owner value
 	_, err = cli.QueryBidsExec(
 		val.ClientCtx.WithOutputFormat("json"),
@@ -373,7 +388,7 @@ func (s *IntegrationTestSuite) Test4CloseOrder() {
 	_, err = cli.TxCreateBidExec(
 		val.ClientCtx,
 		openedOrders[0].OrderID,
-		sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(0)),
+		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(0)),
 		keyBar.GetAddress(),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
@@ -382,13 +397,22 @@ func (s *IntegrationTestSuite) Test4CloseOrder() {
 	)
 	s.Require().NoError(err)
 
-	height, err := s.network.LatestHeight()
-	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
 
-	// Wait for lease creation to modify state of bid
-	_, err = s.network.WaitForHeightWithTimeout(height+blockWaitHeight, blockWaitDuration)
+	// accept the bid
+	_, err = cli.TxCreateLeaseExec(
+		val.ClientCtx,
+		types.MakeBidID(openedOrders[0].OrderID, keyBar.GetAddress()),
+		val.Address,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
 	s.Require().NoError(err)
 
+	s.Require().NoError(s.network.WaitForNextBlock())
+
 	// test query matched bids
 	resp, err = cli.QueryBidsExec(val.ClientCtx.WithOutputFormat("json"), "--state=matched")
 	s.Require().NoError(err)
@@ -411,7 +435,7 @@ func (s *IntegrationTestSuite) Test4CloseOrder() {
 	)
 	s.Require().NoError(err)
 
-	height, err = s.network.LatestHeight()
+	height, err := s.network.LatestHeight()
 	s.Require().NoError(err)
 
 	// Wait for lease creation to modify state of bid
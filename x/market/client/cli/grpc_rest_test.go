@@ -60,6 +60,7 @@ func (s *GRPCRestTestSuite) SetupSuite() {
 		val.ClientCtx,
 		val.Address,
 		deploymentPath,
+		fmt.Sprintf("--deposit=%s", sdk.NewInt64Coin(s.cfg.BondDenom, 5000000)),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
@@ -116,7 +117,7 @@ func (s *GRPCRestTestSuite) SetupSuite() {
 	_, err = cli.TxCreateBidExec(
 		val.ClientCtx,
 		s.order.OrderID,
-		sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(0)),
+		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(0)),
 		keyBar.GetAddress(),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
@@ -140,6 +141,20 @@ func (s *GRPCRestTestSuite) SetupSuite() {
 
 	s.bid = bids[0]
 
+	// create lease
+	_, err = cli.TxCreateLeaseExec(
+		val.ClientCtx,
+		s.bid.BidID,
+		val.Address,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+
 	// test query leases
 	resp, err = cli.QueryLeasesExec(val.ClientCtx.WithOutputFormat("json"))
 	s.Require().NoError(err)
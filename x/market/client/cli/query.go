@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/ovrclk/akash/x/market/types"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		getOrderCmd(),
 		getBidCmd(),
 		getLeaseCmd(),
+		cmdParams(),
 	)
 
 	return cmd
@@ -76,3 +80,31 @@ func getLeaseCmd() *cobra.Command {
 
 	return cmd
 }
+
+func cmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the market module parameters",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MarketParams(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
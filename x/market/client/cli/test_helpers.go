@@ -60,6 +60,23 @@ func TxCloseOrderExec(clientCtx client.Context, orderID types.OrderID, from fmt.
 	return clitestutil.ExecTestCLICmd(clientCtx, cmdCloseOrder(key), args)
 }
 
+// TxCreateLeaseExec is used for testing create lease tx
+func TxCreateLeaseExec(clientCtx client.Context, bidID types.BidID, from fmt.Stringer,
+	extraArgs ...string) (sdktest.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--from=%s", from.String()),
+		fmt.Sprintf("--owner=%s", bidID.Owner),
+		fmt.Sprintf("--dseq=%v", bidID.DSeq),
+		fmt.Sprintf("--gseq=%v", bidID.GSeq),
+		fmt.Sprintf("--oseq=%v", bidID.OSeq),
+		fmt.Sprintf("--provider=%v", bidID.Provider),
+	}
+
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmdCreateLease(key), args)
+}
+
 // QueryOrdersExec is used for testing orders query
 func QueryOrdersExec(clientCtx client.Context, args ...string) (sdktest.BufferWriter, error) {
 	return clitestutil.ExecTestCLICmd(clientCtx, cmdGetOrders(), args)
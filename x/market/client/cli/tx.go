@@ -24,6 +24,8 @@ func GetTxCmd(key string) *cobra.Command {
 		cmdCreateBid(key),
 		cmdCloseBid(key),
 		cmdCloseOrder(key),
+		cmdCreateLease(key),
+		cmdWithdrawLease(key),
 	)
 	return cmd
 }
@@ -145,3 +147,74 @@ func cmdCloseOrder(key string) *cobra.Command {
 
 	return cmd
 }
+
+func cmdCreateLease(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease-create",
+		Short: fmt.Sprintf("Accept a %s bid and create a lease from it", key),
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			id, err := BidIDFromFlagsWithoutCtx(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateLease{
+				BidID: id,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddBidIDFlags(cmd.Flags())
+	MarkReqBidIDFlags(cmd)
+
+	return cmd
+}
+
+func cmdWithdrawLease(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease-withdraw",
+		Short: fmt.Sprintf("Settle and withdraw available funds from a %s lease payment", key),
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			id, err := BidIDFromFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawLease{
+				LeaseID: types.MakeLeaseID(id),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddBidIDFlags(cmd.Flags())
+
+	return cmd
+}
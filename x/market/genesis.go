@@ -2,6 +2,8 @@ package market
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
 	"github.com/ovrclk/akash/x/market/keeper"
 	"github.com/ovrclk/akash/x/market/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -9,21 +11,76 @@ import (
 
 // ValidateGenesis does validation check of the Genesis
 func ValidateGenesis(data *types.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	for _, record := range data.Orders {
+		if err := record.ID().Validate(); err != nil {
+			return errors.Wrap(err, types.ErrInvalidOrder.Error())
+		}
+	}
+	for _, record := range data.Bids {
+		if err := record.ID().Validate(); err != nil {
+			return errors.Wrap(err, types.ErrUnknownBid.Error())
+		}
+	}
+	for _, record := range data.Leases {
+		if err := record.ID().Validate(); err != nil {
+			return errors.Wrap(err, types.ErrLeaseNotFound.Error())
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the market
 // module.
 func DefaultGenesisState() *types.GenesisState {
-	return &types.GenesisState{}
+	return &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
 }
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, record := range data.Orders {
+		keeper.SaveOrder(ctx, record)
+	}
+	for _, record := range data.Bids {
+		keeper.SaveBid(ctx, record)
+	}
+	for _, record := range data.Leases {
+		keeper.SaveLease(ctx, record)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis returns genesis state as raw bytes for the market module
+// ExportGenesis returns genesis state for the market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{}
+	var (
+		orders []types.Order
+		bids   []types.Bid
+		leases []types.Lease
+	)
+
+	k.WithOrders(ctx, func(order types.Order) bool {
+		orders = append(orders, order)
+		return false
+	})
+	k.WithBids(ctx, func(bid types.Bid) bool {
+		bids = append(bids, bid)
+		return false
+	})
+	k.WithLeases(ctx, func(lease types.Lease) bool {
+		leases = append(leases, lease)
+		return false
+	})
+
+	return &types.GenesisState{
+		Orders: orders,
+		Bids:   bids,
+		Leases: leases,
+		Params: k.GetParams(ctx),
+	}
 }
@@ -9,62 +9,15 @@ import (
 	"github.com/pkg/errors"
 )
 
-// OnEndBlock transfer funds for active leases and update order states
+// OnEndBlock update order states
 // Executed at the end of block
 func OnEndBlock(ctx sdk.Context, keepers Keepers) error {
-	if err := transferFundsForActiveLeases(ctx, keepers); err != nil {
-		return err
-	}
 	if err := matchOrders(ctx, keepers); err != nil {
 		return err
 	}
 	return nil
 }
 
-func transferFundsForActiveLeases(ctx sdk.Context, keepers Keepers) error {
-	// for all active leases, transfer funds
-	count := 0
-	keepers.Market.WithActiveLeases(ctx, func(lease types.Lease) bool {
-
-		owner, err := sdk.AccAddressFromBech32(lease.ID().Owner)
-		if err != nil {
-			ctx.Logger().Error("error transferring funds", "err", err)
-			return false
-		}
-
-		provider, err := sdk.AccAddressFromBech32(lease.ID().Provider)
-		if err != nil {
-			ctx.Logger().Error("error transferring funds", "err", err)
-			return false
-		}
-
-		amt := sdk.NewCoins(lease.Price)
-
-		if !keepers.Bank.HasBalance(ctx, owner, lease.Price) {
-			ctx.Logger().Debug("keeper balance insufficient", "leaseID", lease.ID())
-			keepers.Deployment.OnLeaseInsufficientFunds(ctx, lease.ID().GroupID())
-			keepers.Market.OnInsufficientFunds(ctx, lease)
-			return false
-		}
-
-		err = keepers.Bank.SendCoins(ctx, owner, provider, amt)
-
-		if err != nil {
-			ctx.Logger().Error("error transferring funds", "err", err)
-			// TODO: cancel order, lease.
-			// TODO: notify deployment module.
-			return false
-		}
-
-		count++
-		return false
-	})
-
-	ctx.Logger().Info("processed active leases", "count", count)
-
-	return nil
-}
-
 var ErrNoBids = errors.New("no bids to pick winner from")
 
 func PickBidWinner(bids []types.Bid) (winner *types.Bid, err error) {
@@ -111,26 +64,24 @@ func PickBidWinner(bids []types.Bid) (winner *types.Bid, err error) {
 	return &bids[n], nil
 }
 
-// matchOrders that are open, picks a winning Bid, creates a Lease, and closes
-// originating Order.
+// matchOrders that are open and opted into automatic matching, picks a winning Bid,
+// creates a Lease, and closes originating Order. Orders which exceeded their bidding
+// duration are closed along with their open bids.
 func matchOrders(ctx sdk.Context, keepers Keepers) error {
 	keepers.Market.WithOpenOrders(ctx, func(order types.Order) bool {
 		if err := order.ValidateCanMatch(ctx.BlockHeight()); err != nil {
 			if errors.Is(err, types.ErrOrderDurationExceeded) {
-				keepers.Market.OnOrderClosed(ctx, order) // change order state to closed
-				return false
+				expireOrder(ctx, keepers, order)
 			}
 			return false
 		}
 
-		var bids []types.Bid
-		keepers.Market.WithBidsForOrder(ctx, order.ID(), func(bid types.Bid) bool {
-			if bid.State != types.BidOpen {
-				return false
-			}
-			bids = append(bids, bid)
+		// orders without automatic matching wait for the owner to pick a bid
+		if !order.Spec.AutoMatch {
 			return false
-		})
+		}
+
+		bids := openBids(ctx, keepers, order.ID())
 
 		// no open bids
 		if len(bids) == 0 {
@@ -143,28 +94,74 @@ func matchOrders(ctx sdk.Context, keepers Keepers) error {
 			panic(pErr.Error())
 		}
 
-		// create lease
-		keepers.Market.CreateLease(ctx, *winner)
-
-		// set winning bid state to matched
-		keepers.Market.OnBidMatched(ctx, *winner)
-
-		// set losing bids to state lost
-		// Set all but winning bid to State: Lost
-		for _, bid := range bids {
-			if winner.ID().Equals(bid.BidID) {
-				continue // skip setting state to lost
-			}
-			keepers.Market.OnBidLost(ctx, bid)
+		// skip the order if the deployment cannot fund the lease
+		if err := createLease(ctx, keepers, order, *winner, bids); err != nil {
+			ctx.Logger().Error("unable to create lease", "err", err, "bid", winner.ID())
 		}
 
-		// set order state to matched
-		keepers.Market.OnOrderMatched(ctx, order)
+		return false
+	})
+	return nil
+}
 
-		// notify group of match
-		keepers.Deployment.OnLeaseCreated(ctx, order.ID().GroupID())
+// expireOrder closes the order and all of its open bids
+func expireOrder(ctx sdk.Context, keepers Keepers, order types.Order) {
+	keepers.Market.OnOrderClosed(ctx, order) // change order state to closed
+	for _, bid := range openBids(ctx, keepers, order.ID()) {
+		keepers.Market.OnBidClosed(ctx, bid)
+	}
+}
 
+func openBids(ctx sdk.Context, keepers Keepers, id types.OrderID) []types.Bid {
+	var bids []types.Bid
+	keepers.Market.WithBidsForOrder(ctx, id, func(bid types.Bid) bool {
+		if bid.State != types.BidOpen {
+			return false
+		}
+		bids = append(bids, bid)
 		return false
 	})
+	return bids
+}
+
+// createLease opens the escrow payment for the winning bid, creates the lease and
+// updates the state of the order, its bids and its group accordingly.
+func createLease(ctx sdk.Context, keepers Keepers, order types.Order, winner types.Bid, bids []types.Bid) error {
+	lid := types.LeaseID(winner.ID())
+	provider, err := sdk.AccAddressFromBech32(lid.Provider)
+	if err != nil {
+		return err
+	}
+
+	// open escrow payment for the lease
+	if err := keepers.Escrow.PaymentCreate(ctx,
+		types.EscrowAccountForLease(lid),
+		types.EscrowPaymentForLease(lid),
+		provider,
+		winner.Price); err != nil {
+		return err
+	}
+
+	// create lease
+	keepers.Market.CreateLease(ctx, winner)
+
+	// set winning bid state to matched
+	keepers.Market.OnBidMatched(ctx, winner)
+
+	// set losing bids to state lost
+	// Set all but winning bid to State: Lost
+	for _, bid := range bids {
+		if winner.ID().Equals(bid.BidID) {
+			continue // skip setting state to lost
+		}
+		keepers.Market.OnBidLost(ctx, bid)
+	}
+
+	// set order state to matched
+	keepers.Market.OnOrderMatched(ctx, order)
+
+	// notify group of match
+	keepers.Deployment.OnLeaseCreated(ctx, order.ID().GroupID())
+
 	return nil
 }
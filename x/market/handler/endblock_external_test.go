@@ -3,11 +3,13 @@ package handler_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ovrclk/akash/testutil"
 	"github.com/ovrclk/akash/testutil/state"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/handler"
 	"github.com/ovrclk/akash/x/market/types"
 )
@@ -24,8 +26,22 @@ func TestMatchOrders(t *testing.T) {
 	dID2.DSeq = uint64(suite.Context().BlockHeight())
 	closedGroups := testutil.DeploymentGroups(t, dID2, uint32(2))
 
+	t.Run("create escrow account", func(t *testing.T) {
+		owner, err := sdk.AccAddressFromBech32(dID1.Owner)
+		require.NoError(t, err)
+
+		err = suite.EscrowKeeper().AccountCreate(
+			suite.Context(),
+			dtypes.EscrowAccountForDeployment(dID1),
+			owner,
+			testutil.AkashCoin(t, 1000000),
+		)
+		require.NoError(t, err)
+	})
+
 	t.Run("create open orders", func(t *testing.T) {
 		for _, g := range openGroups {
+			g.GroupSpec.AutoMatch = true
 			order, err := suite.MarketKeeper().CreateOrder(
 				suite.Context(),
 				g.ID(),
@@ -83,6 +99,8 @@ func TestMatchOrders(t *testing.T) {
 			Market:     suite.MarketKeeper(),
 			Deployment: suite.DeploymentKeeper(),
 			Provider:   suite.ProviderKeeper(),
+			Audit:      suite.AuditKeeper(),
+			Escrow:     suite.EscrowKeeper(),
 			Bank:       suite.BankKeeper(),
 		}
 		err := handler.OnEndBlock(suite.Context(), k)
@@ -112,6 +130,8 @@ func TestMatchOrders(t *testing.T) {
 			Market:     suite.MarketKeeper(),
 			Deployment: suite.DeploymentKeeper(),
 			Provider:   suite.ProviderKeeper(),
+			Audit:      suite.AuditKeeper(),
+			Escrow:     suite.EscrowKeeper(),
 			Bank:       suite.BankKeeper(),
 		}
 		err := handler.OnEndBlock(suite.Context(), k)
@@ -143,3 +163,55 @@ func TestMatchOrders(t *testing.T) {
 		assert.Equal(t, len(openGroups), count)
 	})
 }
+
+func TestExpireOrders(t *testing.T) {
+	codec := types.ModuleCdc
+	suite := state.SetupTestSuite(t, codec)
+
+	did := testutil.DeploymentID(t)
+	did.DSeq = uint64(suite.Context().BlockHeight())
+	group := testutil.DeploymentGroup(t, did, uint32(1))
+
+	order, err := suite.MarketKeeper().CreateOrder(suite.Context(), group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	bid, err := suite.MarketKeeper().CreateBid(suite.Context(), order.ID(), testutil.AccAddress(t), order.Price())
+	require.NoError(t, err)
+
+	k := handler.Keepers{
+		Market:     suite.MarketKeeper(),
+		Deployment: suite.DeploymentKeeper(),
+		Provider:   suite.ProviderKeeper(),
+		Audit:      suite.AuditKeeper(),
+		Escrow:     suite.EscrowKeeper(),
+		Bank:       suite.BankKeeper(),
+	}
+
+	t.Run("bids are not matched without auto-matching", func(t *testing.T) {
+		suite.SetBlockHeight(order.StartAt)
+		err := handler.OnEndBlock(suite.Context(), k)
+		require.NoError(t, err)
+
+		order, found := suite.MarketKeeper().GetOrder(suite.Context(), order.ID())
+		require.True(t, found)
+		assert.Equal(t, types.OrderOpen, order.State)
+
+		bid, found := suite.MarketKeeper().GetBid(suite.Context(), bid.ID())
+		require.True(t, found)
+		assert.Equal(t, types.BidOpen, bid.State)
+	})
+
+	t.Run("order and bids close once the bidding duration elapses", func(t *testing.T) {
+		suite.SetBlockHeight(order.CloseAt)
+		err := handler.OnEndBlock(suite.Context(), k)
+		require.NoError(t, err)
+
+		order, found := suite.MarketKeeper().GetOrder(suite.Context(), order.ID())
+		require.True(t, found)
+		assert.Equal(t, types.OrderClosed, order.State)
+
+		bid, found := suite.MarketKeeper().GetBid(suite.Context(), bid.ID())
+		require.True(t, found)
+		assert.Equal(t, types.BidClosed, bid.State)
+	})
+}
@@ -25,6 +25,14 @@ func NewHandler(keepers Keepers) sdk.Handler {
 			res, err := ms.CloseOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateLease:
+			res, err := ms.CreateLease(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgWithdrawLease:
+			res, err := ms.WithdrawLease(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.ErrUnknownRequest
 		}
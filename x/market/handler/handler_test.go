@@ -5,21 +5,30 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdktestdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ovrclk/akash/testutil"
 	atypes "github.com/ovrclk/akash/types"
+	akeeper "github.com/ovrclk/akash/x/audit/keeper"
+	audittypes "github.com/ovrclk/akash/x/audit/types"
 	dkeeper "github.com/ovrclk/akash/x/deployment/keeper"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
+	emocks "github.com/ovrclk/akash/x/escrow/keeper/mocks"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	"github.com/ovrclk/akash/x/market/handler"
 	"github.com/ovrclk/akash/x/market/keeper"
 	"github.com/ovrclk/akash/x/market/types"
@@ -34,6 +43,8 @@ type testSuite struct {
 	mkeeper keeper.Keeper
 	dkeeper dkeeper.Keeper
 	pkeeper pkeeper.Keeper
+	akeeper akeeper.Keeper
+	ekeeper ekeeper.Keeper
 	bkeeper bankkeeper.Keeper
 
 	handler sdk.Handler
@@ -47,26 +58,51 @@ func setupTestSuite(t *testing.T) *testSuite {
 	mKey := sdk.NewKVStoreKey(types.StoreKey)
 	dKey := sdk.NewKVStoreKey(dtypes.StoreKey)
 	pKey := sdk.NewKVStoreKey(ptypes.StoreKey)
+	aKey := sdk.NewKVStoreKey(audittypes.StoreKey)
+	eKey := sdk.NewKVStoreKey(etypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(aKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(eKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
 
 	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
 
-	suite.mkeeper = keeper.NewKeeper(types.ModuleCdc, mKey)
-	suite.dkeeper = dkeeper.NewKeeper(types.ModuleCdc, dKey)
+	paramsKeeper := paramskeeper.NewKeeper(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey)
+
+	suite.mkeeper = keeper.NewKeeper(types.ModuleCdc, mKey, paramsKeeper.Subspace(types.ModuleName))
+	suite.mkeeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.dkeeper = dkeeper.NewKeeper(types.ModuleCdc, dKey, paramsKeeper.Subspace(dtypes.ModuleName))
+	suite.dkeeper.SetParams(suite.ctx, dtypes.DefaultParams())
 	suite.pkeeper = pkeeper.NewKeeper(types.ModuleCdc, pKey)
+	suite.akeeper = akeeper.NewKeeper(types.ModuleCdc, aKey)
+
+	bkeeper := &emocks.BankKeeper{}
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.ekeeper = ekeeper.NewKeeper(types.ModuleCdc, eKey, bkeeper)
 
 	suite.handler = handler.NewHandler(handler.Keepers{
 		Market:     suite.mkeeper,
 		Deployment: suite.dkeeper,
 		Provider:   suite.pkeeper,
+		Audit:      suite.akeeper,
+		Escrow:     suite.ekeeper,
 		Bank:       suite.bkeeper,
 	})
 
@@ -231,6 +267,41 @@ func TestCreateBidInvalidAttributes(t *testing.T) {
 	require.EqualError(t, err, types.ErrAttributeMismatch.Error())
 }
 
+func TestCreateBidUnsignedAttributes(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	auditor := testutil.AccAddress(t)
+
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 0)
+	group.GroupSpec.SignedBy = atypes.SignedBy{
+		AllOf: []string{auditor.String()},
+	}
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	gspec := group.GroupSpec
+	provider := suite.createProvider(gspec.Requirements).Owner
+
+	msg := &types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: provider,
+		Price:    sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(1)),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrSignedAttributeMismatch.Error())
+
+	owner, err := sdk.AccAddressFromBech32(provider)
+	require.NoError(t, err)
+	err = suite.akeeper.CreateOrUpdateProviderAttributes(suite.ctx, owner, auditor, gspec.Requirements)
+	require.NoError(t, err)
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NotNil(t, res)
+	require.NoError(t, err)
+}
+
 func TestCreateBidAlreadyExists(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -339,7 +410,7 @@ func TestCloseBidUnknownLease(t *testing.T) {
 func TestCloseBidValid(t *testing.T) {
 	suite := setupTestSuite(t)
 
-	_, bid, _ := suite.createLease()
+	lid, bid, _ := suite.createLease()
 
 	msg := &types.MsgCloseBid{
 		BidID: bid.ID(),
@@ -349,6 +420,12 @@ func TestCloseBidValid(t *testing.T) {
 	require.NotNil(t, res)
 	require.NoError(t, err)
 
+	t.Run("ensure escrow payment closed", func(t *testing.T) {
+		payment, err := suite.ekeeper.GetPayment(suite.ctx, types.EscrowAccountForLease(lid), types.EscrowPaymentForLease(lid))
+		require.NoError(t, err)
+		require.Equal(t, etypes.PaymentClosed, payment.State)
+	})
+
 	t.Run("ensure event created", func(t *testing.T) {
 		iev := testutil.ParseMarketEvent(t, res.Events[3:4])
 		require.IsType(t, types.EventBidClosed{}, iev)
@@ -421,6 +498,138 @@ func TestCloseBidUnknownOrder(t *testing.T) {
 	require.EqualError(t, err, types.ErrUnknownOrderForBid.Error())
 }
 
+func TestCreateLeaseNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, _ := suite.createOrder(testutil.Resources(t))
+
+	msg := &types.MsgCreateLease{
+		BidID: types.MakeBidID(order.ID(), testutil.AccAddress(t)),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrUnknownBid.Error())
+}
+
+func TestCreateLeaseBidNotOpen(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	lid, _, _ := suite.createLease()
+
+	msg := &types.MsgCreateLease{
+		BidID: lid.BidID(),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrBidNotOpen.Error())
+}
+
+func TestCreateLeaseOrderExpired(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	bid, order := suite.createBid()
+	suite.createEscrowAccount(order.ID(), bid.Price)
+
+	suite.ctx = suite.ctx.WithBlockHeight(order.CloseAt)
+
+	msg := &types.MsgCreateLease{
+		BidID: bid.ID(),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrOrderDurationExceeded.Error())
+}
+
+func TestCreateLeaseValid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	bid, order := suite.createBid()
+	suite.createEscrowAccount(order.ID(), bid.Price)
+
+	loser, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), testutil.AccAddress(t), bid.Price)
+	require.NoError(t, err)
+
+	msg := &types.MsgCreateLease{
+		BidID: bid.ID(),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NotNil(t, res)
+	require.NoError(t, err)
+
+	lease, found := suite.mkeeper.GetLease(suite.ctx, types.MakeLeaseID(bid.ID()))
+	require.True(t, found)
+	require.Equal(t, types.LeaseActive, lease.State)
+
+	bid, found = suite.mkeeper.GetBid(suite.ctx, bid.ID())
+	require.True(t, found)
+	require.Equal(t, types.BidMatched, bid.State)
+
+	loser, found = suite.mkeeper.GetBid(suite.ctx, loser.ID())
+	require.True(t, found)
+	require.Equal(t, types.BidLost, loser.State)
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, order.ID())
+	require.True(t, found)
+	require.Equal(t, types.OrderMatched, order.State)
+}
+
+func TestWithdrawLeaseNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	bid, _ := suite.createBid()
+
+	msg := &types.MsgWithdrawLease{
+		LeaseID: types.MakeLeaseID(bid.ID()),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrLeaseNotFound.Error())
+}
+
+func TestWithdrawLeaseNotActive(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	lid, _, _ := suite.createLease()
+
+	suite.mkeeper.OnLeaseClosed(suite.ctx, types.Lease{
+		LeaseID: lid,
+	})
+
+	msg := &types.MsgWithdrawLease{
+		LeaseID: lid,
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrLeaseNotActive.Error())
+}
+
+func TestWithdrawLeaseValid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	lid, bid, _ := suite.createLease()
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+
+	msg := &types.MsgWithdrawLease{
+		LeaseID: lid,
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NotNil(t, res)
+	require.NoError(t, err)
+
+	payment, err := suite.ekeeper.GetPayment(suite.ctx, types.EscrowAccountForLease(lid), types.EscrowPaymentForLease(lid))
+	require.NoError(t, err)
+	require.Equal(t, etypes.PaymentOpen, payment.State)
+	require.Equal(t, bid.Price.Amount.MulRaw(10), payment.Withdrawn.Amount)
+}
+
 func (st *testSuite) createLease() (types.LeaseID, types.Bid, types.Order) {
 	st.t.Helper()
 	bid, order := st.createBid()
@@ -430,9 +639,40 @@ func (st *testSuite) createLease() (types.LeaseID, types.Bid, types.Order) {
 	st.mkeeper.OnOrderMatched(st.ctx, order)
 
 	lid := types.MakeLeaseID(bid.ID())
+	st.createEscrowPayment(lid, bid.Price)
+
 	return lid, bid, order
 }
 
+func (st *testSuite) createEscrowPayment(lid types.LeaseID, rate sdk.Coin) {
+	st.t.Helper()
+
+	owner, err := sdk.AccAddressFromBech32(lid.Owner)
+	require.NoError(st.t, err)
+
+	provider, err := sdk.AccAddressFromBech32(lid.Provider)
+	require.NoError(st.t, err)
+
+	aid := types.EscrowAccountForLease(lid)
+	err = st.ekeeper.AccountCreate(st.ctx, aid, owner, sdk.NewInt64Coin(rate.Denom, math.MaxInt32))
+	require.NoError(st.t, err)
+
+	err = st.ekeeper.PaymentCreate(st.ctx, aid, types.EscrowPaymentForLease(lid), provider, rate)
+	require.NoError(st.t, err)
+}
+
+func (st *testSuite) createEscrowAccount(oid types.OrderID, rate sdk.Coin) {
+	st.t.Helper()
+
+	owner, err := sdk.AccAddressFromBech32(oid.Owner)
+	require.NoError(st.t, err)
+
+	did := oid.GroupID().DeploymentID()
+	err = st.ekeeper.AccountCreate(st.ctx, dtypes.EscrowAccountForDeployment(did), owner,
+		sdk.NewInt64Coin(rate.Denom, math.MaxInt32))
+	require.NoError(st.t, err)
+}
+
 func (st *testSuite) createBid() (types.Bid, types.Order) {
 	st.t.Helper()
 	order, _ := st.createOrder(testutil.Resources(st.t))
@@ -3,7 +3,9 @@ package handler
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	"github.com/ovrclk/akash/x/market/keeper"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
 )
@@ -14,6 +16,11 @@ type ProviderKeeper interface {
 	WithProviders(ctx sdk.Context, fn func(ptypes.Provider) bool)
 }
 
+// AuditKeeper Interface includes audit methods
+type AuditKeeper interface {
+	GetProviderAttributes(ctx sdk.Context, id sdk.Address) (atypes.Providers, bool)
+}
+
 // DeploymentKeeper Interface includes deployment methods
 type DeploymentKeeper interface {
 	GetGroup(ctx sdk.Context, id dtypes.GroupID) (dtypes.Group, bool)
@@ -22,10 +29,20 @@ type DeploymentKeeper interface {
 	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID)
 }
 
+// EscrowKeeper Interface includes escrow methods
+type EscrowKeeper interface {
+	PaymentCreate(ctx sdk.Context, id etypes.AccountID, pid string, owner sdk.AccAddress, rate sdk.Coin) error
+	PaymentWithdraw(ctx sdk.Context, id etypes.AccountID, pid string) error
+	PaymentClose(ctx sdk.Context, id etypes.AccountID, pid string) error
+	GetPayment(ctx sdk.Context, id etypes.AccountID, pid string) (etypes.Payment, error)
+}
+
 // Keepers include all modules keepers
 type Keepers struct {
 	Market     keeper.Keeper
 	Deployment DeploymentKeeper
 	Provider   ProviderKeeper
+	Audit      AuditKeeper
+	Escrow     EscrowKeeper
 	Bank       bankkeeper.Keeper
 }
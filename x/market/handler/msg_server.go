@@ -55,6 +55,11 @@ func (ms msgServer) CreateBid(goCtx context.Context, msg *types.MsgCreateBid) (*
 		return nil, types.ErrAttributeMismatch
 	}
 
+	signed, _ := ms.keepers.Audit.GetProviderAttributes(ctx, provider)
+	if !order.MatchSignedAttributes(signed) {
+		return nil, types.ErrSignedAttributeMismatch
+	}
+
 	if _, err := ms.keepers.Market.CreateBid(ctx, msg.Order, provider, msg.Price); err != nil {
 		return nil, err
 	}
@@ -98,6 +103,12 @@ func (ms msgServer) CloseBid(goCtx context.Context, msg *types.MsgCloseBid) (*ty
 	ms.keepers.Market.OnOrderClosed(ctx, order)
 	ms.keepers.Deployment.OnLeaseClosed(ctx, order.ID().GroupID())
 
+	if err := ms.keepers.Escrow.PaymentClose(ctx,
+		types.EscrowAccountForLease(lease.ID()),
+		types.EscrowPaymentForLease(lease.ID())); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCloseBidResponse{}, nil
 }
 
@@ -118,5 +129,60 @@ func (ms msgServer) CloseOrder(goCtx context.Context, msg *types.MsgCloseOrder)
 	ms.keepers.Market.OnLeaseClosed(ctx, lease)
 	ms.keepers.Deployment.OnLeaseClosed(ctx, order.ID().GroupID())
 
+	if err := ms.keepers.Escrow.PaymentClose(ctx,
+		types.EscrowAccountForLease(lease.ID()),
+		types.EscrowPaymentForLease(lease.ID())); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCloseOrderResponse{}, nil
 }
+
+func (ms msgServer) CreateLease(goCtx context.Context, msg *types.MsgCreateLease) (*types.MsgCreateLeaseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bid, found := ms.keepers.Market.GetBid(ctx, msg.BidID)
+	if !found {
+		return nil, types.ErrUnknownBid
+	}
+
+	if bid.State != types.BidOpen {
+		return nil, types.ErrBidNotOpen
+	}
+
+	order, found := ms.keepers.Market.GetOrder(ctx, msg.BidID.OrderID())
+	if !found {
+		return nil, types.ErrUnknownOrderForBid
+	}
+
+	if err := order.ValidateCanLease(ctx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
+	if err := createLease(ctx, ms.keepers, order, bid, openBids(ctx, ms.keepers, order.ID())); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateLeaseResponse{}, nil
+}
+
+func (ms msgServer) WithdrawLease(goCtx context.Context, msg *types.MsgWithdrawLease) (*types.MsgWithdrawLeaseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lease, found := ms.keepers.Market.GetLease(ctx, msg.LeaseID)
+	if !found {
+		return nil, types.ErrLeaseNotFound
+	}
+
+	if lease.State != types.LeaseActive {
+		return nil, types.ErrLeaseNotActive
+	}
+
+	if err := ms.keepers.Escrow.PaymentWithdraw(ctx,
+		types.EscrowAccountForLease(lease.ID()),
+		types.EscrowPaymentForLease(lease.ID())); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawLeaseResponse{}, nil
+}
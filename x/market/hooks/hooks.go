@@ -0,0 +1,92 @@
+package hooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
+	"github.com/ovrclk/akash/x/market/keeper"
+	"github.com/ovrclk/akash/x/market/types"
+)
+
+// DeploymentKeeper Interface includes deployment methods used by the hooks
+type DeploymentKeeper interface {
+	OnLeaseInsufficientFunds(ctx sdk.Context, id dtypes.GroupID)
+	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID)
+	OnLeaseReopened(ctx sdk.Context, id dtypes.GroupID)
+}
+
+// Hooks reacts to escrow events affecting market leases
+type Hooks interface {
+	OnEscrowPaymentClosed(ctx sdk.Context, obj etypes.Payment)
+	OnEscrowPaymentReopened(ctx sdk.Context, obj etypes.Payment)
+}
+
+type hooks struct {
+	dkeeper DeploymentKeeper
+	mkeeper keeper.Keeper
+}
+
+// New returns market hooks for the given keepers
+func New(dkeeper DeploymentKeeper, mkeeper keeper.Keeper) Hooks {
+	return &hooks{
+		dkeeper: dkeeper,
+		mkeeper: mkeeper,
+	}
+}
+
+// OnEscrowPaymentClosed closes the lease paid by the given payment if it is still active.
+// Leases whose payment became overdrawn are marked as having insufficient funds.
+func (h *hooks) OnEscrowPaymentClosed(ctx sdk.Context, obj etypes.Payment) {
+	id, ok := types.LeaseIDFromEscrowAccount(obj.AccountID, obj.PaymentID)
+	if !ok {
+		return
+	}
+
+	lease, found := h.mkeeper.GetLease(ctx, id)
+	if !found || lease.State != types.LeaseActive {
+		return
+	}
+
+	if order, found := h.mkeeper.GetOrder(ctx, id.OrderID()); found {
+		h.mkeeper.OnOrderClosed(ctx, order)
+	}
+
+	if bid, found := h.mkeeper.GetBid(ctx, id.BidID()); found {
+		h.mkeeper.OnBidClosed(ctx, bid)
+	}
+
+	if obj.State == etypes.PaymentOverdrawn {
+		h.mkeeper.OnInsufficientFunds(ctx, lease)
+		h.dkeeper.OnLeaseInsufficientFunds(ctx, id.GroupID())
+		return
+	}
+
+	h.mkeeper.OnLeaseClosed(ctx, lease)
+	h.dkeeper.OnLeaseClosed(ctx, id.GroupID())
+}
+
+// OnEscrowPaymentReopened reactivates the lease paid by the given payment, along with
+// its order, bid and group, if it was closed for insufficient funds.
+func (h *hooks) OnEscrowPaymentReopened(ctx sdk.Context, obj etypes.Payment) {
+	id, ok := types.LeaseIDFromEscrowAccount(obj.AccountID, obj.PaymentID)
+	if !ok {
+		return
+	}
+
+	lease, found := h.mkeeper.GetLease(ctx, id)
+	if !found || lease.State != types.LeaseInsufficientFunds {
+		return
+	}
+
+	if order, found := h.mkeeper.GetOrder(ctx, id.OrderID()); found {
+		h.mkeeper.OnOrderMatched(ctx, order)
+	}
+
+	if bid, found := h.mkeeper.GetBid(ctx, id.BidID()); found {
+		h.mkeeper.OnBidMatched(ctx, bid)
+	}
+
+	h.mkeeper.OnLeaseReopened(ctx, lease)
+	h.dkeeper.OnLeaseReopened(ctx, id.GroupID())
+}
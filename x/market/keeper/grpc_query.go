@@ -9,12 +9,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	"github.com/ovrclk/akash/x/market/types"
 )
 
+// EscrowKeeper Interface includes escrow methods used by the querier
+type EscrowKeeper interface {
+	GetPayment(ctx sdk.Context, id etypes.AccountID, pid string) (etypes.Payment, error)
+}
+
 // Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
 type Querier struct {
 	Keeper
+	EscrowKeeper EscrowKeeper
 }
 
 var _ types.QueryServer = Querier{}
@@ -101,8 +108,14 @@ func (k Querier) Bids(c context.Context, req *types.QueryBidsRequest) (*types.Qu
 	var bids types.Bids
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// bids are keyed by owner first, so the bids of an owner are found without a full scan
+	storePrefix := bidPrefix
+	if req.Filters.Owner != "" {
+		storePrefix = bidsForOwnerPrefix(req.Filters.Owner)
+	}
+
 	store := ctx.KVStore(k.skey)
-	bidStore := prefix.NewStore(store, bidPrefix)
+	bidStore := prefix.NewStore(store, storePrefix)
 
 	pageRes, err := sdkquery.FilteredPaginate(bidStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var bid types.Bid
@@ -172,8 +185,14 @@ func (k Querier) Leases(c context.Context, req *types.QueryLeasesRequest) (*type
 	var leases types.Leases
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// leases are keyed by owner first, so the leases of an owner are found without a full scan
+	storePrefix := leasePrefix
+	if req.Filters.Owner != "" {
+		storePrefix = leasesForOwnerPrefix(req.Filters.Owner)
+	}
+
 	store := ctx.KVStore(k.skey)
-	leaseStore := prefix.NewStore(store, leasePrefix)
+	leaseStore := prefix.NewStore(store, storePrefix)
 
 	pageRes, err := sdkquery.FilteredPaginate(leaseStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var lease types.Lease
@@ -225,5 +244,126 @@ func (k Querier) Lease(c context.Context, req *types.QueryLeaseRequest) (*types.
 		return nil, types.ErrLeaseNotFound
 	}
 
-	return &types.QueryLeaseResponse{Lease: lease}, nil
+	payment, err := k.EscrowKeeper.GetPayment(ctx,
+		types.EscrowAccountForLease(lease.ID()),
+		types.EscrowPaymentForLease(lease.ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaseResponse{
+		Lease:         lease,
+		EscrowPayment: payment,
+	}, nil
+}
+
+// BidsByProvider returns bids placed by a provider, using the provider index
+func (k Querier) BidsByProvider(c context.Context, req *types.QueryBidsByProviderRequest) (*types.QueryBidsByProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	stateVal := types.Bid_State(types.Bid_State_value[req.State])
+
+	if req.State != "" && stateVal == types.BidStateInvalid {
+		return nil, status.Error(codes.InvalidArgument, "invalid state value")
+	}
+
+	var bids types.Bids
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.skey)
+	indexStore := prefix.NewStore(store, bidsForProviderPrefix(req.Provider))
+
+	pageRes, err := sdkquery.FilteredPaginate(indexStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var bid types.Bid
+
+		err := k.cdc.UnmarshalBinaryBare(store.Get(value), &bid)
+		if err != nil {
+			return false, err
+		}
+
+		if req.State != "" && bid.State != stateVal {
+			return false, nil
+		}
+
+		if accumulate {
+			bids = append(bids, bid)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBidsByProviderResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
+
+// LeasesByProvider returns leases won by a provider, using the provider index
+func (k Querier) LeasesByProvider(c context.Context, req *types.QueryLeasesByProviderRequest) (*types.QueryLeasesByProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	stateVal := types.Lease_State(types.Lease_State_value[req.State])
+
+	if req.State != "" && stateVal == types.LeaseStateInvalid {
+		return nil, status.Error(codes.InvalidArgument, "invalid state value")
+	}
+
+	var leases types.Leases
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.skey)
+	indexStore := prefix.NewStore(store, leasesForProviderPrefix(req.Provider))
+
+	pageRes, err := sdkquery.FilteredPaginate(indexStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var lease types.Lease
+
+		err := k.cdc.UnmarshalBinaryBare(store.Get(value), &lease)
+		if err != nil {
+			return false, err
+		}
+
+		if req.State != "" && lease.State != stateVal {
+			return false, nil
+		}
+
+		if accumulate {
+			leases = append(leases, lease)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeasesByProviderResponse{
+		Leases:     leases,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MarketParams returns the market module parameters
+func (k Querier) MarketParams(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
@@ -11,15 +11,19 @@ import (
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ovrclk/akash/app"
 	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/testutil/state"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	"github.com/ovrclk/akash/x/market/keeper"
 	"github.com/ovrclk/akash/x/market/types"
 )
 
 type grpcTestSuite struct {
-	t      *testing.T
-	app    *app.AkashApp
-	ctx    sdk.Context
-	keeper keeper.Keeper
+	t       *testing.T
+	app     *app.AkashApp
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	ekeeper ekeeper.Keeper
 
 	queryClient types.QueryClient
 }
@@ -30,8 +34,11 @@ func setupTest(t *testing.T) *grpcTestSuite {
 	}
 
 	suite.app = app.Setup(false)
-	suite.ctx, suite.keeper = setupKeeper(t)
-	querier := keeper.Querier{Keeper: suite.keeper}
+	ssuite := state.SetupTestSuite(t, suite.app.AppCodec())
+	suite.ctx = ssuite.Context()
+	suite.keeper = ssuite.MarketKeeper()
+	suite.ekeeper = ssuite.EscrowKeeper()
+	querier := keeper.Querier{Keeper: suite.keeper, EscrowKeeper: suite.ekeeper}
 
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, querier)
@@ -255,7 +262,7 @@ func TestGRPCQueryBids(t *testing.T) {
 	suite := setupTest(t)
 
 	// creating bids with different states
-	_, _ = createBid(t, suite.ctx, suite.keeper)
+	bid1, _ := createBid(t, suite.ctx, suite.keeper)
 	bid2, _ := createBid(t, suite.ctx, suite.keeper)
 	suite.keeper.OnBidLost(suite.ctx, bid2)
 
@@ -292,6 +299,23 @@ func TestGRPCQueryBids(t *testing.T) {
 			},
 			1,
 		},
+		{
+			"query bids with owner filter",
+			func() {
+				req = &types.QueryBidsRequest{Filters: types.BidFilters{Owner: bid1.ID().Owner}}
+			},
+			1,
+		},
+		{
+			"query bids with owner and state filters",
+			func() {
+				req = &types.QueryBidsRequest{Filters: types.BidFilters{
+					Owner: bid1.ID().Owner,
+					State: types.BidLost.String(),
+				}}
+			},
+			0,
+		},
 		{
 			"query bids with pagination",
 			func() {
@@ -323,10 +347,12 @@ func TestGRPCQueryLease(t *testing.T) {
 	leaseID := createLease(t, suite.ctx, suite.keeper)
 	lease, ok := suite.keeper.GetLease(suite.ctx, leaseID)
 	require.True(t, ok)
+	payment := suite.createEscrowPayment(lease)
 
 	var (
-		req      *types.QueryLeaseRequest
-		expLease types.Lease
+		req        *types.QueryLeaseRequest
+		expLease   types.Lease
+		expPayment etypes.Payment
 	)
 
 	testCases := []struct {
@@ -366,6 +392,7 @@ func TestGRPCQueryLease(t *testing.T) {
 			func() {
 				req = &types.QueryLeaseRequest{ID: lease.LeaseID}
 				expLease = lease
+				expPayment = payment
 			},
 			true,
 		},
@@ -383,6 +410,7 @@ func TestGRPCQueryLease(t *testing.T) {
 				require.NoError(t, err)
 				require.NotNil(t, res)
 				require.Equal(t, expLease, res.Lease)
+				require.Equal(t, expPayment, res.EscrowPayment)
 			} else {
 				require.Error(t, err)
 				require.Nil(t, res)
@@ -438,6 +466,23 @@ func TestGRPCQueryLeases(t *testing.T) {
 			},
 			1,
 		},
+		{
+			"query leases with owner filter",
+			func() {
+				req = &types.QueryLeasesRequest{Filters: types.LeaseFilters{Owner: leaseID2.Owner}}
+			},
+			1,
+		},
+		{
+			"query leases with owner and state filters",
+			func() {
+				req = &types.QueryLeasesRequest{Filters: types.LeaseFilters{
+					Owner: leaseID.Owner,
+					State: types.LeaseClosed.String(),
+				}}
+			},
+			0,
+		},
 		{
 			"query leases with pagination",
 			func() {
@@ -461,3 +506,207 @@ func TestGRPCQueryLeases(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCQueryBidsByProvider(t *testing.T) {
+	suite := setupTest(t)
+
+	provider := testutil.AccAddress(t)
+
+	// creating bids of a single provider with different states, plus a bid of another provider
+	order, _ := createOrder(t, suite.ctx, suite.keeper)
+	_, err := suite.keeper.CreateBid(suite.ctx, order.ID(), provider, order.Price())
+	require.NoError(t, err)
+
+	order2, _ := createOrder(t, suite.ctx, suite.keeper)
+	bid2, err := suite.keeper.CreateBid(suite.ctx, order2.ID(), provider, order2.Price())
+	require.NoError(t, err)
+	suite.keeper.OnBidLost(suite.ctx, bid2)
+
+	_, _ = createBid(t, suite.ctx, suite.keeper)
+
+	var req *types.QueryBidsByProviderRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expLen   int
+		expPass  bool
+	}{
+		{
+			"invalid provider address",
+			func() {
+				req = &types.QueryBidsByProviderRequest{Provider: "invalid"}
+			},
+			0,
+			false,
+		},
+		{
+			"invalid state",
+			func() {
+				req = &types.QueryBidsByProviderRequest{Provider: provider.String(), State: "invalid"}
+			},
+			0,
+			false,
+		},
+		{
+			"query bids of provider",
+			func() {
+				req = &types.QueryBidsByProviderRequest{Provider: provider.String()}
+			},
+			2,
+			true,
+		},
+		{
+			"query bids of provider with state filter",
+			func() {
+				req = &types.QueryBidsByProviderRequest{Provider: provider.String(), State: types.BidLost.String()}
+			},
+			1,
+			true,
+		},
+		{
+			"query bids of provider with pagination",
+			func() {
+				req = &types.QueryBidsByProviderRequest{
+					Provider:   provider.String(),
+					Pagination: &sdkquery.PageRequest{Limit: 1},
+				}
+			},
+			1,
+			true,
+		},
+		{
+			"query bids of provider without bids",
+			func() {
+				req = &types.QueryBidsByProviderRequest{Provider: testutil.AccAddress(t).String()}
+			},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Case %s", tc.msg), func(t *testing.T) {
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.BidsByProvider(ctx, req)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, tc.expLen, len(res.Bids))
+				for _, bid := range res.Bids {
+					require.Equal(t, provider.String(), bid.ID().Provider)
+				}
+			} else {
+				require.Error(t, err)
+				require.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestGRPCQueryLeasesByProvider(t *testing.T) {
+	suite := setupTest(t)
+
+	// creating leases with different states
+	leaseID := createLease(t, suite.ctx, suite.keeper)
+	lease, ok := suite.keeper.GetLease(suite.ctx, leaseID)
+	require.True(t, ok)
+	suite.keeper.OnLeaseClosed(suite.ctx, lease)
+
+	_ = createLease(t, suite.ctx, suite.keeper)
+
+	var req *types.QueryLeasesByProviderRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expLen   int
+		expPass  bool
+	}{
+		{
+			"invalid provider address",
+			func() {
+				req = &types.QueryLeasesByProviderRequest{Provider: "invalid"}
+			},
+			0,
+			false,
+		},
+		{
+			"query leases of provider",
+			func() {
+				req = &types.QueryLeasesByProviderRequest{Provider: leaseID.Provider}
+			},
+			1,
+			true,
+		},
+		{
+			"query leases of provider with state filter",
+			func() {
+				req = &types.QueryLeasesByProviderRequest{Provider: leaseID.Provider, State: types.LeaseActive.String()}
+			},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Case %s", tc.msg), func(t *testing.T) {
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.LeasesByProvider(ctx, req)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, tc.expLen, len(res.Leases))
+				for _, lease := range res.Leases {
+					require.Equal(t, leaseID, lease.ID())
+				}
+			} else {
+				require.Error(t, err)
+				require.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestGRPCQueryMarketParams(t *testing.T) {
+	suite := setupTest(t)
+
+	params := types.Params{OrderStartDelay: 10}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	res, err := suite.queryClient.MarketParams(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func (suite *grpcTestSuite) createEscrowPayment(lease types.Lease) etypes.Payment {
+	suite.t.Helper()
+
+	owner, err := sdk.AccAddressFromBech32(lease.ID().Owner)
+	require.NoError(suite.t, err)
+
+	provider, err := sdk.AccAddressFromBech32(lease.ID().Provider)
+	require.NoError(suite.t, err)
+
+	aid := types.EscrowAccountForLease(lease.ID())
+	pid := types.EscrowPaymentForLease(lease.ID())
+
+	err = suite.ekeeper.AccountCreate(suite.ctx, aid, owner, sdk.NewInt64Coin(lease.Price.Denom, 1000000))
+	require.NoError(suite.t, err)
+
+	err = suite.ekeeper.PaymentCreate(suite.ctx, aid, pid, provider, lease.Price)
+	require.NoError(suite.t, err)
+
+	payment, err := suite.ekeeper.GetPayment(suite.ctx, aid, pid)
+	require.NoError(suite.t, err)
+
+	return payment
+}
@@ -3,25 +3,26 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/types"
 	"github.com/pkg/errors"
 )
 
-const (
-	// TODO: parameterize somewhere.
-	orderTTL = 5 // blocks
-)
-
 // Keeper of the market store
 type Keeper struct {
-	cdc  codec.BinaryMarshaler
-	skey sdk.StoreKey
+	cdc    codec.BinaryMarshaler
+	skey   sdk.StoreKey
+	pspace paramtypes.Subspace
 }
 
 // NewKeeper creates and returns an instance for Market keeper
-func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey) Keeper {
-	return Keeper{cdc: cdc, skey: skey}
+func NewKeeper(cdc codec.BinaryMarshaler, skey sdk.StoreKey, pspace paramtypes.Subspace) Keeper {
+	if !pspace.HasKeyTable() {
+		pspace = pspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{cdc: cdc, skey: skey, pspace: pspace}
 }
 
 // Codec returns keeper codec
@@ -29,6 +30,17 @@ func (k Keeper) Codec() codec.BinaryMarshaler {
 	return k.cdc
 }
 
+// GetParams returns the market module parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.pspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the market module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
 // CreateOrder creates a new order with given group id and specifications. It returns created order
 func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.GroupSpec) (types.Order, error) {
 	store := ctx.KVStore(k.skey)
@@ -48,12 +60,14 @@ func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.Gro
 		return types.Order{}, errors.Wrap(err, "create order: active order exists")
 	}
 
+	delay := k.GetParams(ctx).OrderStartDelay
+
 	order := types.Order{
 		OrderID: types.MakeOrderID(gid, oseq),
 		Spec:    spec,
 		State:   types.OrderOpen,
-		StartAt: ctx.BlockHeight() + orderTTL,                         // TODO: check overflow
-		CloseAt: ctx.BlockHeight() + orderTTL + spec.OrderBidDuration, // TODO: check overflow, set via parameter
+		StartAt: ctx.BlockHeight() + delay,                         // TODO: check overflow
+		CloseAt: ctx.BlockHeight() + delay + spec.OrderBidDuration, // TODO: check overflow
 	}
 
 	key := orderKey(order.ID())
@@ -90,6 +104,7 @@ func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAd
 
 	// XXX TODO: check not overwrite
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&bid))
+	store.Set(bidProviderKey(bid.ID()), key)
 
 	ctx.EventManager().EmitEvent(
 		types.NewEventBidCreated(bid.ID(), price).
@@ -100,7 +115,8 @@ func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAd
 }
 
 // CreateLease creates lease for bid with given bidID.
-// Should only be called by the EndBlock handler or unit tests.
+// Should only be called by the MsgCreateLease handler, the EndBlock handler matching
+// orders which opted into automatic matching, or unit tests.
 func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
 	store := ctx.KVStore(k.skey)
 
@@ -113,6 +129,7 @@ func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
 	// create (active) lease in store
 	key := leaseKey(lease.ID())
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&lease))
+	store.Set(leaseProviderKey(lease.ID()), key)
 	k.updateActiveLeaseIndex(store, lease)
 
 	ctx.Logger().Info("created lease", "lease", lease.ID())
@@ -189,6 +206,20 @@ func (k Keeper) OnInsufficientFunds(ctx sdk.Context, lease types.Lease) {
 	)
 }
 
+// OnLeaseReopened updates lease state from insufficient funds back to active
+func (k Keeper) OnLeaseReopened(ctx sdk.Context, lease types.Lease) {
+	if lease.State != types.LeaseInsufficientFunds {
+		return
+	}
+	lease.State = types.LeaseActive
+	k.updateLease(ctx, lease)
+	ctx.Logger().Info("reopened lease", "lease", lease.ID())
+	ctx.EventManager().EmitEvent(
+		types.NewEventLeaseCreated(lease.ID(), lease.Price).
+			ToSDKEvent(),
+	)
+}
+
 // OnLeaseClosed updates lease state to closed
 func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease) {
 	// TODO: assert state transition
@@ -205,6 +236,17 @@ func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease) {
 	)
 }
 
+// OnInsufficientFundsLeaseClosed updates state of a lease closed for insufficient funds to closed.
+// The lease closed event was emitted when the lease ran out of funds.
+func (k Keeper) OnInsufficientFundsLeaseClosed(ctx sdk.Context, lease types.Lease) {
+	if lease.State != types.LeaseInsufficientFunds {
+		return
+	}
+	lease.State = types.LeaseClosed
+	k.updateLease(ctx, lease)
+	ctx.Logger().Info("keeper closed lease with insufficient funds", "lease", lease.ID())
+}
+
 // OnGroupClosed updates state of all orders, bids and leases in group to closed
 func (k Keeper) OnGroupClosed(ctx sdk.Context, id dtypes.GroupID) {
 	k.WithOrdersForGroup(ctx, id, func(order types.Order) bool {
@@ -398,6 +440,49 @@ func (k Keeper) WithBidsForOrder(ctx sdk.Context, id types.OrderID, fn func(type
 	}
 }
 
+// WithBidsForProvider iterates all bids placed by the given provider
+func (k Keeper) WithBidsForProvider(ctx sdk.Context, provider sdk.AccAddress, fn func(types.Bid) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, bidsForProviderPrefix(provider.String()))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Bid
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iter.Value()), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// WithLeasesForProvider iterates all leases won by the given provider
+func (k Keeper) WithLeasesForProvider(ctx sdk.Context, provider sdk.AccAddress, fn func(types.Lease) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, leasesForProviderPrefix(provider.String()))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Lease
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iter.Value()), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// SaveOrder stores the given order and its indexes; used for genesis import
+func (k Keeper) SaveOrder(ctx sdk.Context, order types.Order) {
+	k.updateOrder(ctx, order)
+}
+
+// SaveBid stores the given bid and its indexes; used for genesis import
+func (k Keeper) SaveBid(ctx sdk.Context, bid types.Bid) {
+	k.updateBid(ctx, bid)
+}
+
+// SaveLease stores the given lease and its indexes; used for genesis import
+func (k Keeper) SaveLease(ctx sdk.Context, lease types.Lease) {
+	k.updateLease(ctx, lease)
+}
+
 func (k Keeper) updateOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.skey)
 	key := orderKey(order.ID())
@@ -409,12 +494,14 @@ func (k Keeper) updateBid(ctx sdk.Context, bid types.Bid) {
 	store := ctx.KVStore(k.skey)
 	key := bidKey(bid.ID())
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&bid))
+	store.Set(bidProviderKey(bid.ID()), key)
 }
 
 func (k Keeper) updateLease(ctx sdk.Context, lease types.Lease) {
 	store := ctx.KVStore(k.skey)
 	key := leaseKey(lease.ID())
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&lease))
+	store.Set(leaseProviderKey(lease.ID()), key)
 	k.updateActiveLeaseIndex(store, lease)
 }
 
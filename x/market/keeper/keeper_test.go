@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -28,6 +30,19 @@ func Test_CreateOrder(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_CreateOrderStartDelay(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	keeper.SetParams(ctx, types.Params{OrderStartDelay: 20})
+
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 0)
+
+	order, err := keeper.CreateOrder(ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+	require.Equal(t, int64(120), order.StartAt)
+	require.Equal(t, int64(120)+group.GroupSpec.OrderBidDuration, order.CloseAt)
+}
+
 func Test_GetOrder(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 	order, _ := createOrder(t, ctx, keeper)
@@ -137,6 +152,30 @@ func Test_WithBidsForOrder(t *testing.T) {
 	assert.Equal(t, 1, count)
 }
 
+func Test_WithBidsForProvider(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	bid, _ := createBid(t, ctx, keeper)
+
+	// create extra bids
+	createBid(t, ctx, keeper)
+	createBid(t, ctx, keeper)
+
+	keeper.OnBidLost(ctx, bid)
+
+	provider, err := sdk.AccAddressFromBech32(bid.ID().Provider)
+	require.NoError(t, err)
+
+	count := 0
+	keeper.WithBidsForProvider(ctx, provider, func(result types.Bid) bool {
+		if assert.Equal(t, bid.ID(), result.ID()) {
+			assert.Equal(t, types.BidLost, result.State)
+			count++
+		}
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
 func Test_GetLease(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 	id := createLease(t, ctx, keeper)
@@ -178,6 +217,32 @@ func Test_WithLeases(t *testing.T) {
 	})
 }
 
+func Test_WithLeasesForProvider(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+
+	// extra leases
+	createLease(t, ctx, keeper)
+	createLease(t, ctx, keeper)
+
+	lease, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	keeper.OnLeaseClosed(ctx, lease)
+
+	provider, err := sdk.AccAddressFromBech32(id.Provider)
+	require.NoError(t, err)
+
+	count := 0
+	keeper.WithLeasesForProvider(ctx, provider, func(result types.Lease) bool {
+		if assert.Equal(t, id, result.ID()) {
+			assert.Equal(t, types.LeaseClosed, result.State)
+			count++
+		}
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
 func Test_LeaseForOrder(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 	id := createLease(t, ctx, keeper)
@@ -252,6 +317,32 @@ func Test_OnInsufficientFunds(t *testing.T) {
 	assert.Equal(t, types.LeaseInsufficientFunds, result.State)
 }
 
+func Test_OnLeaseReopened(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+
+	lease, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+
+	keeper.OnInsufficientFunds(ctx, lease)
+
+	lease, ok = keeper.GetLease(ctx, id)
+	require.True(t, ok)
+
+	keeper.OnLeaseReopened(ctx, lease)
+
+	result, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	assert.Equal(t, types.LeaseActive, result.State)
+
+	count := 0
+	keeper.WithActiveLeases(ctx, func(types.Lease) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
 func Test_OnLeaseClosed(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 	id := createLease(t, ctx, keeper)
@@ -266,6 +357,33 @@ func Test_OnLeaseClosed(t *testing.T) {
 	assert.Equal(t, types.LeaseClosed, result.State)
 }
 
+func Test_OnInsufficientFundsLeaseClosed(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+
+	lease, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+
+	// active leases are closed with OnLeaseClosed
+	keeper.OnInsufficientFundsLeaseClosed(ctx, lease)
+
+	result, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	assert.Equal(t, types.LeaseActive, result.State)
+
+	keeper.OnInsufficientFunds(ctx, lease)
+
+	lease, ok = keeper.GetLease(ctx, id)
+	require.True(t, ok)
+
+	keeper.OnLeaseClosed(ctx, lease)
+	keeper.OnInsufficientFundsLeaseClosed(ctx, lease)
+
+	result, ok = keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	assert.Equal(t, types.LeaseClosed, result.State)
+}
+
 func Test_OnGroupClosed(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 	id := createLease(t, ctx, keeper)
@@ -324,10 +442,17 @@ func createOrder(t testing.TB, ctx sdk.Context, keeper keeper.Keeper) (types.Ord
 func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	pKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	ptKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(ptKey, sdk.StoreTypeTransient, db)
 	require.NoError(t, ms.LoadLatestVersion())
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
+	pspace := paramstypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), pKey, ptKey, types.ModuleName)
+	k := keeper.NewKeeper(types.ModuleCdc, key, pspace)
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k
 }
@@ -9,11 +9,13 @@ import (
 )
 
 var (
-	orderPrefix       = []byte{0x01, 0x00}
-	orderOpenPrefix   = []byte{0x01, 0x01}
-	bidPrefix         = []byte{0x02, 0x00}
-	leasePrefix       = []byte{0x03, 0x00}
-	leaseActivePrefix = []byte{0x03, 0x01}
+	orderPrefix         = []byte{0x01, 0x00}
+	orderOpenPrefix     = []byte{0x01, 0x01}
+	bidPrefix           = []byte{0x02, 0x00}
+	bidProviderPrefix   = []byte{0x02, 0x01}
+	leasePrefix         = []byte{0x03, 0x00}
+	leaseActivePrefix   = []byte{0x03, 0x01}
+	leaseProviderPrefix = []byte{0x03, 0x02}
 )
 
 func orderKey(id types.OrderID) []byte {
@@ -138,3 +140,63 @@ func bidsForOrderPrefix(id types.OrderID) []byte {
 	}
 	return buf.Bytes()
 }
+
+// bidProviderKey is the provider index entry of a bid. The value stored under it
+// is the bid's primary key.
+func bidProviderKey(id types.BidID) []byte {
+	buf := bytes.NewBuffer(bidsForProviderPrefix(id.Provider))
+	buf.Write([]byte(id.Owner))
+	if err := binary.Write(buf, binary.BigEndian, id.DSeq); err != nil {
+		panic(err)
+	}
+	if err := binary.Write(buf, binary.BigEndian, id.GSeq); err != nil {
+		panic(err)
+	}
+	if err := binary.Write(buf, binary.BigEndian, id.OSeq); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// leaseProviderKey is the provider index entry of a lease. The value stored under it
+// is the lease's primary key.
+func leaseProviderKey(id types.LeaseID) []byte {
+	buf := bytes.NewBuffer(leasesForProviderPrefix(id.Provider))
+	buf.Write([]byte(id.Owner))
+	if err := binary.Write(buf, binary.BigEndian, id.DSeq); err != nil {
+		panic(err)
+	}
+	if err := binary.Write(buf, binary.BigEndian, id.GSeq); err != nil {
+		panic(err)
+	}
+	if err := binary.Write(buf, binary.BigEndian, id.OSeq); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func bidsForProviderPrefix(provider string) []byte {
+	buf := bytes.NewBuffer(bidProviderPrefix)
+	buf.Write([]byte(provider))
+	return buf.Bytes()
+}
+
+func leasesForProviderPrefix(provider string) []byte {
+	buf := bytes.NewBuffer(leaseProviderPrefix)
+	buf.Write([]byte(provider))
+	return buf.Bytes()
+}
+
+// bids and leases are keyed by owner first, so the primary keys double as the owner index
+
+func bidsForOwnerPrefix(owner string) []byte {
+	buf := bytes.NewBuffer(bidPrefix)
+	buf.Write([]byte(owner))
+	return buf.Bytes()
+}
+
+func leasesForOwnerPrefix(owner string) []byte {
+	buf := bytes.NewBuffer(leasePrefix)
+	buf.Write([]byte(owner))
+	return buf.Bytes()
+}
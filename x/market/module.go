@@ -110,6 +110,8 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	dkeeper handler.DeploymentKeeper,
 	pkeeper handler.ProviderKeeper,
+	akeeper handler.AuditKeeper,
+	ekeeper handler.EscrowKeeper,
 	bkeeper bankkeeper.Keeper,
 ) AppModule {
 	return AppModule{
@@ -118,6 +120,8 @@ func NewAppModule(
 			Market:     keeper,
 			Deployment: dkeeper,
 			Provider:   pkeeper,
+			Audit:      akeeper,
+			Escrow:     ekeeper,
 			Bank:       bkeeper,
 		},
 	}
@@ -149,7 +153,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers the module's services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keepers))
-	querier := keeper.Querier{Keeper: am.keepers.Market}
+	querier := keeper.Querier{Keeper: am.keepers.Market, EscrowKeeper: am.keepers.Escrow}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 }
 
@@ -192,6 +196,7 @@ func NewAppModuleSimulation(
 	akeeper govtypes.AccountKeeper,
 	dkeeper handler.DeploymentKeeper,
 	pkeeper handler.ProviderKeeper,
+	ekeeper handler.EscrowKeeper,
 	bkeeper bankkeeper.Keeper,
 ) AppModuleSimulation {
 	return AppModuleSimulation{
@@ -199,6 +204,7 @@ func NewAppModuleSimulation(
 			Market:     keeper,
 			Deployment: dkeeper,
 			Provider:   pkeeper,
+			Escrow:     ekeeper,
 			Bank:       bkeeper,
 		},
 		akeeper: akeeper,
@@ -7,7 +7,9 @@ import (
 
 // RandomizedGenState generates a random GenesisState for supply
 func RandomizedGenState(simState *module.SimulationState) {
-	marketGenesis := &types.GenesisState{}
+	marketGenesis := &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(marketGenesis)
 }
@@ -20,9 +20,10 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateBid  = "op_weight_msg_create_bid"
-	OpWeightMsgCloseBid   = "op_weight_msg_close_bid"
-	OpWeightMsgCloseOrder = "op_weight_msg_close_order"
+	OpWeightMsgCreateBid   = "op_weight_msg_create_bid"
+	OpWeightMsgCloseBid    = "op_weight_msg_close_bid"
+	OpWeightMsgCloseOrder  = "op_weight_msg_close_order"
+	OpWeightMsgCreateLease = "op_weight_msg_create_lease"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -30,9 +31,10 @@ func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak govtypes.AccountKeeper,
 	ks keepers.Keepers) simulation.WeightedOperations {
 	var (
-		weightMsgCreateBid  int
-		weightMsgCloseBid   int
-		weightMsgCloseOrder int
+		weightMsgCreateBid   int
+		weightMsgCloseBid    int
+		weightMsgCloseOrder  int
+		weightMsgCreateLease int
 	)
 
 	appParams.GetOrGenerate(
@@ -53,6 +55,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgCreateLease, &weightMsgCreateLease, nil, func(r *rand.Rand) {
+			weightMsgCreateLease = appparams.DefaultWeightMsgCreateLease
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateBid,
@@ -66,6 +74,10 @@ func WeightedOperations(
 			weightMsgCloseOrder,
 			SimulateMsgCloseOrder(ak, ks),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateLease,
+			SimulateMsgCreateLease(ak, ks),
+		),
 	}
 }
 
@@ -273,3 +285,73 @@ func SimulateMsgCloseOrder(ak govtypes.AccountKeeper, ks keepers.Keepers) simtyp
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgCreateLease generates a MsgCreateLease with random values
+func SimulateMsgCreateLease(ak govtypes.AccountKeeper, ks keepers.Keepers) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simtypes.Account,
+		chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var bids []types.Bid
+
+		ks.Market.WithBids(ctx, func(bid types.Bid) bool {
+			if bid.State == types.BidOpen {
+				order, ok := ks.Market.GetOrder(ctx, bid.ID().OrderID())
+				if ok && order.ValidateCanLease(ctx.BlockHeight()) == nil {
+					bids = append(bids, bid)
+				}
+			}
+
+			return false
+		})
+
+		if len(bids) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateLease, "no open bids found"), nil, nil
+		}
+
+		// Get random bid
+		i := r.Intn(len(bids))
+		bid := bids[i]
+
+		ownerAddr, convertErr := sdk.AccAddressFromBech32(bid.ID().Owner)
+		if convertErr != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateLease, "error while converting address"), nil, convertErr
+		}
+
+		simAccount, found := simtypes.FindAccount(accounts, ownerAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateLease, "unable to find bid with owner"),
+				nil, errors.Errorf("bid with %s not found", bid.ID().Owner)
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := ks.Bank.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeCreateLease, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgCreateLease(bid.BidID)
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
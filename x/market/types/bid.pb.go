@@ -66,7 +66,7 @@ func (x Bid_State) String() string {
 }
 
 func (Bid_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_057fd80e533b030c, []int{5, 0}
+	return fileDescriptor_057fd80e533b030c, []int{7, 0}
 }
 
 // MsgCreateBid defines an SDK message for creating Bid
@@ -249,6 +249,88 @@ func (m *MsgCloseBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCloseBidResponse proto.InternalMessageInfo
 
+// MsgCreateLease defines an SDK message for creating a lease from a bid selected by the order owner
+type MsgCreateLease struct {
+	BidID BidID `protobuf:"bytes,1,opt,name=bid_id,json=bidId,proto3" json:"id" yaml:"id"`
+}
+
+func (m *MsgCreateLease) Reset()         { *m = MsgCreateLease{} }
+func (m *MsgCreateLease) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLease) ProtoMessage()    {}
+func (*MsgCreateLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_057fd80e533b030c, []int{4}
+}
+func (m *MsgCreateLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLease.Merge(m, src)
+}
+func (m *MsgCreateLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLease proto.InternalMessageInfo
+
+func (m *MsgCreateLease) GetBidID() BidID {
+	if m != nil {
+		return m.BidID
+	}
+	return BidID{}
+}
+
+// MsgCreateLeaseResponse defines the Msg/CreateLease response type.
+type MsgCreateLeaseResponse struct {
+}
+
+func (m *MsgCreateLeaseResponse) Reset()         { *m = MsgCreateLeaseResponse{} }
+func (m *MsgCreateLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLeaseResponse) ProtoMessage()    {}
+func (*MsgCreateLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_057fd80e533b030c, []int{5}
+}
+func (m *MsgCreateLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLeaseResponse.Merge(m, src)
+}
+func (m *MsgCreateLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLeaseResponse proto.InternalMessageInfo
+
 // BidID stores owner and all other seq numbers
 // A successful bid becomes a Lease(ID).
 type BidID struct {
@@ -262,7 +344,7 @@ type BidID struct {
 func (m *BidID) Reset()      { *m = BidID{} }
 func (*BidID) ProtoMessage() {}
 func (*BidID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_057fd80e533b030c, []int{4}
+	return fileDescriptor_057fd80e533b030c, []int{6}
 }
 func (m *BidID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +418,7 @@ type Bid struct {
 func (m *Bid) Reset()      { *m = Bid{} }
 func (*Bid) ProtoMessage() {}
 func (*Bid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_057fd80e533b030c, []int{5}
+	return fileDescriptor_057fd80e533b030c, []int{7}
 }
 func (m *Bid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +482,7 @@ func (m *BidFilters) Reset()         { *m = BidFilters{} }
 func (m *BidFilters) String() string { return proto.CompactTextString(m) }
 func (*BidFilters) ProtoMessage()    {}
 func (*BidFilters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_057fd80e533b030c, []int{6}
+	return fileDescriptor_057fd80e533b030c, []int{8}
 }
 func (m *BidFilters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,6 +559,8 @@ func init() {
 	proto.RegisterType((*MsgCreateBidResponse)(nil), "akash.market.v1beta1.MsgCreateBidResponse")
 	proto.RegisterType((*MsgCloseBid)(nil), "akash.market.v1beta1.MsgCloseBid")
 	proto.RegisterType((*MsgCloseBidResponse)(nil), "akash.market.v1beta1.MsgCloseBidResponse")
+	proto.RegisterType((*MsgCreateLease)(nil), "akash.market.v1beta1.MsgCreateLease")
+	proto.RegisterType((*MsgCreateLeaseResponse)(nil), "akash.market.v1beta1.MsgCreateLeaseResponse")
 	proto.RegisterType((*BidID)(nil), "akash.market.v1beta1.BidID")
 	proto.RegisterType((*Bid)(nil), "akash.market.v1beta1.Bid")
 	proto.RegisterType((*BidFilters)(nil), "akash.market.v1beta1.BidFilters")
@@ -485,55 +569,59 @@ func init() {
 func init() { proto.RegisterFile("akash/market/v1beta1/bid.proto", fileDescriptor_057fd80e533b030c) }
 
 var fileDescriptor_057fd80e533b030c = []byte{
-	// 758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0xb5, 0x13, 0x3b, 0x6d, 0x36, 0xfd, 0x13, 0xb9, 0xed, 0x4f, 0xad, 0xab, 0x7a, 0xfd, 0x33,
-	0x52, 0x55, 0x40, 0xb2, 0xd5, 0xf6, 0x56, 0x2e, 0xc8, 0xad, 0x40, 0x91, 0x28, 0x45, 0x29, 0x07,
-	0x44, 0x25, 0x90, 0x93, 0x5d, 0xb9, 0xab, 0x26, 0xd9, 0xd4, 0x36, 0x81, 0x7e, 0x03, 0x94, 0x13,
-	0x17, 0x24, 0x0e, 0x04, 0x2a, 0xf1, 0x65, 0x7a, 0xec, 0x91, 0x93, 0x85, 0xd2, 0x0b, 0xca, 0x31,
-	0x1f, 0x00, 0xa1, 0xdd, 0x75, 0x9c, 0x54, 0x4a, 0x5b, 0x90, 0xe0, 0xc6, 0x29, 0x99, 0x37, 0xef,
-	0x8d, 0x67, 0xdf, 0x8e, 0x66, 0x81, 0xe1, 0x1d, 0x79, 0xe1, 0xa1, 0x53, 0xf7, 0x82, 0x23, 0x1c,
-	0x39, 0xad, 0xf5, 0x0a, 0x8e, 0xbc, 0x75, 0xa7, 0x42, 0x90, 0xdd, 0x0c, 0x68, 0x44, 0xb5, 0x79,
-	0x9e, 0xb7, 0x45, 0xde, 0x4e, 0xf2, 0xfa, 0xbc, 0x4f, 0x7d, 0xca, 0x09, 0x0e, 0xfb, 0x27, 0xb8,
-	0xba, 0x39, 0xb6, 0x16, 0x0d, 0x10, 0x0e, 0x12, 0x86, 0x51, 0xa5, 0x61, 0x9d, 0x86, 0x4e, 0xc5,
-	0x0b, 0x71, 0x4a, 0xa8, 0x52, 0xd2, 0x10, 0x79, 0xeb, 0x87, 0x0c, 0xa6, 0x76, 0x43, 0x7f, 0x3b,
-	0xc0, 0x5e, 0x84, 0x5d, 0x82, 0xb4, 0x03, 0xa0, 0x72, 0xfd, 0xa2, 0x6c, 0xca, 0x6b, 0x85, 0x8d,
-	0x15, 0x7b, 0x5c, 0x3b, 0xf6, 0x1e, 0xa3, 0x94, 0x76, 0xdc, 0xd5, 0xb3, 0x18, 0x4a, 0xdd, 0x18,
-	0xaa, 0x1c, 0xe8, 0xc5, 0x50, 0x88, 0xfb, 0x31, 0x9c, 0x3a, 0xf1, 0xea, 0xb5, 0x2d, 0x8b, 0x87,
-	0x56, 0x59, 0xc0, 0xda, 0x3d, 0x30, 0xd9, 0x0c, 0x68, 0x8b, 0xb0, 0xfa, 0x19, 0x53, 0x5e, 0xcb,
-	0xbb, 0xb0, 0x17, 0xc3, 0x14, 0xeb, 0xc7, 0x70, 0x56, 0xc8, 0x06, 0x88, 0x55, 0x4e, 0x93, 0xda,
-	0x63, 0xa0, 0x36, 0x03, 0x52, 0xc5, 0x8b, 0x59, 0xde, 0xd9, 0x92, 0x2d, 0x8e, 0x66, 0xb3, 0xa3,
-	0xa5, 0x8d, 0x6d, 0x53, 0xd2, 0x70, 0x57, 0x58, 0x57, 0xac, 0x19, 0xce, 0x1f, 0x36, 0xc3, 0x43,
-	0xab, 0x2c, 0xe0, 0x2d, 0xe5, 0xfb, 0x29, 0x94, 0xac, 0xff, 0xc0, 0xfc, 0xe8, 0xf9, 0xcb, 0x38,
-	0x6c, 0xd2, 0x46, 0x88, 0x2d, 0x02, 0x0a, 0x0c, 0xaf, 0xd1, 0x90, 0xdb, 0xf2, 0x14, 0xe4, 0x2a,
-	0x04, 0xbd, 0x24, 0x28, 0xf1, 0x65, 0x79, 0xbc, 0x2f, 0x2e, 0x41, 0xa5, 0x1d, 0xd7, 0x1c, 0xb8,
-	0xc2, 0xc3, 0x5e, 0x0c, 0x33, 0x04, 0xf5, 0x63, 0x98, 0x17, 0x5d, 0x10, 0x64, 0x95, 0xd5, 0x0a,
-	0x41, 0x25, 0x94, 0xb4, 0xb0, 0x00, 0xe6, 0x46, 0x3e, 0x95, 0x76, 0xf0, 0x29, 0x03, 0x44, 0x01,
-	0xcd, 0x01, 0x2a, 0x7d, 0xdd, 0x48, 0xee, 0x24, 0xef, 0x2e, 0x71, 0x9f, 0x19, 0x30, 0xe2, 0x33,
-	0x0b, 0x99, 0xcf, 0xec, 0x57, 0xdb, 0x04, 0x0a, 0x0a, 0xf1, 0x31, 0xf7, 0x58, 0x71, 0x61, 0x37,
-	0x86, 0xca, 0xce, 0x3e, 0x3e, 0xee, 0xc5, 0x90, 0xe3, 0xfd, 0x18, 0x16, 0x84, 0x8c, 0x45, 0x56,
-	0x99, 0x83, 0x4c, 0xe4, 0x33, 0x11, 0xb3, 0x77, 0x5a, 0x88, 0x1e, 0x26, 0x22, 0xff, 0x92, 0xc8,
-	0x17, 0x22, 0x3f, 0x11, 0x51, 0x26, 0x52, 0x86, 0xa2, 0xbd, 0x44, 0x44, 0x2f, 0x89, 0xa8, 0x10,
-	0xb1, 0x9f, 0x4b, 0x63, 0xa0, 0xfe, 0xe6, 0x18, 0x6c, 0x4d, 0x7e, 0x38, 0x85, 0x12, 0xf7, 0xed,
-	0x73, 0x16, 0x64, 0xff, 0xda, 0xdd, 0x68, 0x4f, 0x80, 0x1a, 0x46, 0x5e, 0x84, 0xb9, 0x89, 0x33,
-	0x1b, 0xf0, 0xca, 0xa2, 0xf6, 0x3e, 0xa3, 0x89, 0x5b, 0xe1, 0x8a, 0xe1, 0xad, 0xf0, 0xd0, 0x2a,
-	0x0b, 0xf8, 0x4f, 0x0f, 0xb0, 0xf5, 0x5e, 0x06, 0x2a, 0xff, 0xb6, 0x66, 0x82, 0x09, 0xd2, 0x68,
-	0x79, 0x35, 0x82, 0x8a, 0x92, 0x3e, 0xd7, 0xee, 0x98, 0xb3, 0x2e, 0x41, 0x3c, 0x55, 0x12, 0xb0,
-	0xb6, 0x00, 0x14, 0xda, 0xc4, 0x8d, 0xa2, 0xac, 0x17, 0xda, 0x1d, 0x73, 0xc2, 0x25, 0x68, 0xaf,
-	0x89, 0x1b, 0xda, 0x32, 0x98, 0xa8, 0x7b, 0x51, 0xf5, 0x10, 0xa3, 0x62, 0x46, 0x9f, 0x69, 0x77,
-	0x4c, 0xe0, 0x12, 0xb4, 0x2b, 0x10, 0xa6, 0xa9, 0xd1, 0x30, 0x2a, 0x66, 0x53, 0xcd, 0x23, 0x1a,
-	0x46, 0xda, 0x12, 0xc8, 0x55, 0xd9, 0xac, 0xa2, 0xa2, 0xa2, 0x4f, 0xb7, 0x3b, 0x66, 0xde, 0x25,
-	0x88, 0x0f, 0x2f, 0xd2, 0x95, 0xb7, 0x5f, 0x0c, 0x69, 0xe4, 0x86, 0xce, 0x33, 0x80, 0x15, 0x7c,
-	0x40, 0x6a, 0x11, 0x0e, 0xc2, 0x7f, 0x73, 0x3c, 0xba, 0xce, 0x9c, 0xc1, 0x7c, 0xe5, 0x86, 0x66,
-	0x5c, 0x37, 0x3e, 0x62, 0x59, 0x6c, 0x7c, 0xcc, 0x80, 0xec, 0x6e, 0xe8, 0x6b, 0x07, 0x20, 0x3f,
-	0x5c, 0xda, 0xd6, 0xf8, 0xe1, 0x1c, 0x5d, 0x6c, 0xfa, 0x9d, 0x9b, 0x39, 0x83, 0xd5, 0xa3, 0x3d,
-	0x03, 0x93, 0xe9, 0xe6, 0xfb, 0xff, 0x6a, 0x5d, 0x42, 0xd1, 0x6f, 0xdf, 0x48, 0x49, 0x2b, 0xbf,
-	0x00, 0x80, 0x63, 0xfc, 0xbd, 0xd0, 0x6e, 0x5d, 0x2f, 0xe4, 0x24, 0xfd, 0xee, 0x2f, 0x90, 0x06,
-	0xf5, 0xdd, 0xfb, 0x67, 0x5d, 0x43, 0x3e, 0xef, 0x1a, 0xf2, 0xb7, 0xae, 0x21, 0xbf, 0xbb, 0x30,
-	0xa4, 0xf3, 0x0b, 0x43, 0xfa, 0x7a, 0x61, 0x48, 0xcf, 0x57, 0x7d, 0x12, 0x1d, 0xbe, 0xaa, 0xd8,
-	0x55, 0x5a, 0x77, 0x68, 0x2b, 0xa8, 0xd6, 0x8e, 0x1c, 0xf1, 0x7a, 0xbe, 0x19, 0xbc, 0x9f, 0xd1,
-	0x49, 0x13, 0x87, 0x95, 0x1c, 0x7f, 0x18, 0x37, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x71, 0x94,
-	0x2b, 0xf3, 0xa8, 0x07, 0x00, 0x00,
+	// 823 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0xe3, 0x46,
+	0x14, 0x96, 0x6c, 0xc9, 0x89, 0xc7, 0x9b, 0xac, 0xd1, 0x66, 0x97, 0x44, 0xcb, 0x6a, 0x54, 0xb5,
+	0x84, 0xed, 0x0f, 0x24, 0x36, 0x7b, 0x4b, 0x2f, 0x45, 0x1b, 0x5a, 0x0c, 0x9b, 0xa6, 0x38, 0x85,
+	0x96, 0x06, 0x5a, 0x64, 0xcf, 0x20, 0x0f, 0x91, 0x35, 0x8e, 0x46, 0x75, 0x9a, 0xff, 0xa0, 0xf8,
+	0xd4, 0x4b, 0xa1, 0x17, 0xb7, 0x81, 0xfe, 0x33, 0x39, 0xe6, 0xd8, 0x93, 0x28, 0xce, 0x25, 0xf8,
+	0xe8, 0x3f, 0xa0, 0x94, 0x99, 0x91, 0x65, 0x1b, 0x9c, 0x1f, 0x85, 0xe6, 0xb6, 0x27, 0x7b, 0xbe,
+	0xf9, 0xbe, 0xf7, 0xbe, 0x79, 0xef, 0x69, 0x24, 0x60, 0x05, 0xc7, 0x01, 0xeb, 0x78, 0xdd, 0x20,
+	0x39, 0xc6, 0xa9, 0xd7, 0x7f, 0xd5, 0xc2, 0x69, 0xf0, 0xca, 0x6b, 0x11, 0xe4, 0xf6, 0x12, 0x9a,
+	0x52, 0x63, 0x43, 0xec, 0xbb, 0x72, 0xdf, 0xcd, 0xf7, 0xcd, 0x8d, 0x90, 0x86, 0x54, 0x10, 0x3c,
+	0xfe, 0x4f, 0x72, 0x4d, 0x7b, 0x69, 0x2c, 0x9a, 0x20, 0x9c, 0xdc, 0xca, 0x88, 0x70, 0xc0, 0x70,
+	0xce, 0xb0, 0xda, 0x94, 0x75, 0x29, 0xf3, 0x5a, 0x01, 0xc3, 0x05, 0xa1, 0x4d, 0x49, 0x2c, 0xf7,
+	0x9d, 0x7f, 0x54, 0xf0, 0x68, 0x9f, 0x85, 0x6f, 0x12, 0x1c, 0xa4, 0xd8, 0x27, 0xc8, 0x38, 0x02,
+	0xba, 0xc8, 0xb0, 0xa9, 0xda, 0xea, 0xcb, 0xda, 0xce, 0x0b, 0x77, 0x99, 0x61, 0xf7, 0x80, 0x53,
+	0x1a, 0x7b, 0xfe, 0xf6, 0x45, 0x06, 0x95, 0x51, 0x06, 0x75, 0x01, 0x8c, 0x33, 0x28, 0xc5, 0x93,
+	0x0c, 0x3e, 0x3a, 0x0b, 0xba, 0xd1, 0xae, 0x23, 0x96, 0x4e, 0x53, 0xc2, 0xc6, 0xa7, 0x60, 0xb5,
+	0x97, 0xd0, 0x3e, 0xe1, 0xf1, 0x4b, 0xb6, 0xfa, 0xb2, 0xea, 0xc3, 0x71, 0x06, 0x0b, 0x6c, 0x92,
+	0xc1, 0xc7, 0x52, 0x36, 0x45, 0x9c, 0x66, 0xb1, 0x69, 0x7c, 0x09, 0xf4, 0x5e, 0x42, 0xda, 0x78,
+	0xb3, 0x2c, 0x9c, 0x6d, 0xb9, 0xf2, 0x68, 0x2e, 0x3f, 0x5a, 0x61, 0xec, 0x0d, 0x25, 0xb1, 0xff,
+	0x82, 0xbb, 0xe2, 0x66, 0x04, 0x7f, 0x66, 0x46, 0x2c, 0x9d, 0xa6, 0x84, 0x77, 0xb5, 0xeb, 0x73,
+	0xa8, 0x38, 0xcf, 0xc0, 0xc6, 0xfc, 0xf9, 0x9b, 0x98, 0xf5, 0x68, 0xcc, 0xb0, 0x43, 0x40, 0x8d,
+	0xe3, 0x11, 0x65, 0xa2, 0x2c, 0x5f, 0x83, 0x4a, 0x8b, 0xa0, 0x1f, 0x08, 0xca, 0xeb, 0xf2, 0x7c,
+	0x79, 0x5d, 0x7c, 0x82, 0x1a, 0x7b, 0xbe, 0x3d, 0xad, 0x8a, 0x58, 0x8e, 0x33, 0x58, 0x22, 0x68,
+	0x92, 0xc1, 0xaa, 0x74, 0x41, 0x90, 0xd3, 0xd4, 0x5b, 0x04, 0x35, 0x50, 0x6e, 0xe1, 0x29, 0x78,
+	0x32, 0x97, 0xaa, 0x70, 0x10, 0x81, 0xf5, 0xc2, 0xd9, 0x5b, 0xde, 0xd2, 0x07, 0x35, 0xb1, 0x09,
+	0x9e, 0x2d, 0x66, 0x2b, 0x7c, 0xfc, 0x5e, 0x02, 0x32, 0x86, 0xe1, 0x01, 0x9d, 0x9e, 0xc6, 0xf9,
+	0x6c, 0x54, 0xfd, 0x2d, 0xd1, 0x6f, 0x0e, 0xcc, 0xf5, 0xfb, 0x34, 0x96, 0xfd, 0xe6, 0xbf, 0xc6,
+	0x6b, 0xa0, 0x21, 0x86, 0x4f, 0x44, 0xaf, 0x35, 0x1f, 0x8e, 0x32, 0xa8, 0xed, 0x1d, 0xe2, 0x93,
+	0x71, 0x06, 0x05, 0x3e, 0xc9, 0x60, 0x4d, 0xca, 0xf8, 0xca, 0x69, 0x0a, 0x90, 0x8b, 0x42, 0x2e,
+	0xe2, 0x6d, 0x5e, 0x93, 0xa2, 0x2f, 0x72, 0x51, 0xb8, 0x20, 0x0a, 0xa5, 0x28, 0xcc, 0x45, 0x94,
+	0x8b, 0xb4, 0x99, 0xe8, 0x20, 0x17, 0xd1, 0x05, 0x11, 0x95, 0x22, 0xfe, 0xb3, 0x30, 0x8e, 0xfa,
+	0x7f, 0x1c, 0xc7, 0xdd, 0xd5, 0xdf, 0xce, 0xa1, 0x22, 0x4a, 0xf7, 0x47, 0x19, 0x94, 0x1f, 0x6c,
+	0x46, 0x8c, 0xaf, 0x80, 0xce, 0xd2, 0x20, 0xc5, 0xa2, 0x88, 0xeb, 0x3b, 0xf0, 0xc6, 0xa0, 0xee,
+	0x21, 0xa7, 0xc9, 0xae, 0x08, 0xc5, 0xac, 0x2b, 0x62, 0xe9, 0x34, 0x25, 0xfc, 0x7f, 0x3f, 0x48,
+	0xce, 0xaf, 0x2a, 0xd0, 0x45, 0x6e, 0xc3, 0x06, 0x2b, 0x24, 0xee, 0x07, 0x11, 0x41, 0x75, 0xc5,
+	0x7c, 0x32, 0x18, 0xda, 0x8f, 0x7d, 0x82, 0xc4, 0x56, 0x43, 0xc2, 0xc6, 0x53, 0xa0, 0xd1, 0x1e,
+	0x8e, 0xeb, 0xaa, 0x59, 0x1b, 0x0c, 0xed, 0x15, 0x9f, 0xa0, 0x83, 0x1e, 0x8e, 0x8d, 0xe7, 0x60,
+	0xa5, 0x1b, 0xa4, 0xed, 0x0e, 0x46, 0xf5, 0x92, 0xb9, 0x3e, 0x18, 0xda, 0xc0, 0x27, 0x68, 0x5f,
+	0x22, 0x5c, 0x13, 0x51, 0x96, 0xd6, 0xcb, 0x85, 0xe6, 0x2d, 0x65, 0xa9, 0xb1, 0x05, 0x2a, 0x6d,
+	0xfe, 0xcc, 0xa0, 0xba, 0x66, 0xae, 0x0d, 0x86, 0x76, 0xd5, 0x27, 0x48, 0x3c, 0x44, 0xc8, 0xd4,
+	0x7e, 0xfe, 0xd3, 0x52, 0xe6, 0x3a, 0x74, 0x59, 0x02, 0x3c, 0xe0, 0xe7, 0x24, 0x4a, 0x71, 0xc2,
+	0xde, 0xcd, 0xf1, 0xfc, 0xb5, 0xea, 0x4d, 0xe7, 0xab, 0x32, 0x2b, 0xc6, 0x6d, 0xe3, 0x23, 0xef,
+	0x8b, 0x9d, 0xeb, 0x32, 0x28, 0xef, 0xb3, 0xd0, 0x38, 0x02, 0xd5, 0xd9, 0xcb, 0xc3, 0x59, 0x3e,
+	0x9c, 0xf3, 0x17, 0xac, 0xf9, 0xd1, 0xdd, 0x9c, 0xe9, 0xd5, 0x63, 0x7c, 0x0b, 0x56, 0x8b, 0x1b,
+	0xf8, 0xbd, 0x9b, 0x75, 0x39, 0xc5, 0xfc, 0xf0, 0x4e, 0x4a, 0x11, 0xf9, 0x7b, 0x00, 0x04, 0x26,
+	0xde, 0x5b, 0xc6, 0xfb, 0xb7, 0x0b, 0x05, 0xc9, 0xfc, 0xf8, 0x1e, 0xa4, 0x22, 0x7e, 0x00, 0x6a,
+	0xf3, 0x37, 0xf7, 0x07, 0x77, 0x1c, 0x5a, 0xb0, 0xcc, 0x4f, 0xee, 0xc3, 0x2a, 0x52, 0x84, 0x60,
+	0xed, 0x1b, 0x92, 0x76, 0x50, 0x12, 0x9c, 0xca, 0x24, 0xdb, 0x37, 0xca, 0x17, 0x78, 0xa6, 0x7b,
+	0x3f, 0xde, 0x34, 0x91, 0xff, 0xd9, 0xc5, 0xc8, 0x52, 0x2f, 0x47, 0x96, 0xfa, 0xf7, 0xc8, 0x52,
+	0x7f, 0xb9, 0xb2, 0x94, 0xcb, 0x2b, 0x4b, 0xf9, 0xeb, 0xca, 0x52, 0xbe, 0xdb, 0x0e, 0x49, 0xda,
+	0xf9, 0xb1, 0xe5, 0xb6, 0x69, 0xd7, 0xa3, 0xfd, 0xa4, 0x1d, 0x1d, 0x7b, 0xf2, 0x8b, 0xe4, 0xa7,
+	0xe9, 0x37, 0x49, 0x7a, 0xd6, 0xc3, 0xac, 0x55, 0x11, 0x1f, 0x1b, 0xaf, 0xff, 0x1d, 0x00, 0xa4,
+	0x29, 0xd9, 0x89, 0x1e, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -554,6 +642,10 @@ type MsgClient interface {
 	CloseBid(ctx context.Context, in *MsgCloseBid, opts ...grpc.CallOption) (*MsgCloseBidResponse, error)
 	// CloseOrder defines a method to close an order given proper inputs.
 	CloseOrder(ctx context.Context, in *MsgCloseOrder, opts ...grpc.CallOption) (*MsgCloseOrderResponse, error)
+	// CreateLease creates a new lease from an open bid selected by the order owner
+	CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error)
+	// WithdrawLease withdraws accrued funds from the lease payment
+	WithdrawLease(ctx context.Context, in *MsgWithdrawLease, opts ...grpc.CallOption) (*MsgWithdrawLeaseResponse, error)
 }
 
 type msgClient struct {
@@ -591,6 +683,24 @@ func (c *msgClient) CloseOrder(ctx context.Context, in *MsgCloseOrder, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error) {
+	out := new(MsgCreateLeaseResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta1.Msg/CreateLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawLease(ctx context.Context, in *MsgWithdrawLease, opts ...grpc.CallOption) (*MsgWithdrawLeaseResponse, error) {
+	out := new(MsgWithdrawLeaseResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta1.Msg/WithdrawLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateBid defines a method to create a bid given proper inputs.
@@ -599,6 +709,10 @@ type MsgServer interface {
 	CloseBid(context.Context, *MsgCloseBid) (*MsgCloseBidResponse, error)
 	// CloseOrder defines a method to close an order given proper inputs.
 	CloseOrder(context.Context, *MsgCloseOrder) (*MsgCloseOrderResponse, error)
+	// CreateLease creates a new lease from an open bid selected by the order owner
+	CreateLease(context.Context, *MsgCreateLease) (*MsgCreateLeaseResponse, error)
+	// WithdrawLease withdraws accrued funds from the lease payment
+	WithdrawLease(context.Context, *MsgWithdrawLease) (*MsgWithdrawLeaseResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -614,6 +728,12 @@ func (*UnimplementedMsgServer) CloseBid(ctx context.Context, req *MsgCloseBid) (
 func (*UnimplementedMsgServer) CloseOrder(ctx context.Context, req *MsgCloseOrder) (*MsgCloseOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseOrder not implemented")
 }
+func (*UnimplementedMsgServer) CreateLease(ctx context.Context, req *MsgCreateLease) (*MsgCreateLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLease not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawLease(ctx context.Context, req *MsgWithdrawLease) (*MsgWithdrawLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawLease not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -673,6 +793,42 @@ func _Msg_CloseOrder_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta1.Msg/CreateLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLease(ctx, req.(*MsgCreateLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta1.Msg/WithdrawLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawLease(ctx, req.(*MsgWithdrawLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "akash.market.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -689,6 +845,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CloseOrder",
 			Handler:    _Msg_CloseOrder_Handler,
 		},
+		{
+			MethodName: "CreateLease",
+			Handler:    _Msg_CreateLease_Handler,
+		},
+		{
+			MethodName: "WithdrawLease",
+			Handler:    _Msg_WithdrawLease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "akash/market/v1beta1/bid.proto",
@@ -823,6 +987,62 @@ func (m *MsgCloseBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BidID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *BidID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1039,6 +1259,26 @@ func (m *MsgCloseBidResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BidID.Size()
+	n += 1 + l + sovBid(uint64(l))
+	return n
+}
+
+func (m *MsgCreateLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *BidID) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1460,6 +1700,145 @@ func (m *MsgCloseBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBid
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBid
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BidID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
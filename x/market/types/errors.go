@@ -28,6 +28,8 @@ const (
 	errCodeOrderExists
 	errCodeOrderDurationExceeded
 	errCodeOrderTooEarly
+	errCodeBidNotOpen
+	errCodeSignedAttributeMismatch
 )
 
 var (
@@ -77,4 +79,8 @@ var (
 	ErrOrderTooEarly = sdkerrors.New(ModuleName, errCodeOrderTooEarly, "order: chain height to low for bidding")
 	// ErrOrderDurationExceeded order should be closed
 	ErrOrderDurationExceeded = sdkerrors.New(ModuleName, errCodeOrderDurationExceeded, "order duration has exceeded the bidding duration")
+	// ErrBidNotOpen is the error when a bid is not open
+	ErrBidNotOpen = sdkerrors.Register(ModuleName, errCodeBidNotOpen, "bid not open")
+	// ErrSignedAttributeMismatch is the error when provider attributes are not signed by the required auditors
+	ErrSignedAttributeMismatch = sdkerrors.Register(ModuleName, errCodeSignedAttributeMismatch, "attributes not signed by required auditors")
 )
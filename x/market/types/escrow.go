@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
+)
+
+// EscrowAccountForLease returns the ID of the escrow account funding the lease
+func EscrowAccountForLease(id LeaseID) etypes.AccountID {
+	return dtypes.EscrowAccountForDeployment(id.DeploymentID())
+}
+
+// EscrowPaymentForLease returns the ID of the escrow payment for the lease
+func EscrowPaymentForLease(id LeaseID) string {
+	return fmt.Sprintf("%v/%v/%s", id.GSeq, id.OSeq, id.Provider)
+}
+
+// LeaseIDFromEscrowAccount returns the ID of the lease paid by the given escrow payment
+func LeaseIDFromEscrowAccount(id etypes.AccountID, pid string) (LeaseID, bool) {
+	did, ok := dtypes.DeploymentIDFromEscrowAccount(id)
+	if !ok {
+		return LeaseID{}, false
+	}
+
+	parts := strings.Split(pid, "/")
+	if len(parts) != 3 {
+		return LeaseID{}, false
+	}
+
+	gseq, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return LeaseID{}, false
+	}
+
+	oseq, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return LeaseID{}, false
+	}
+
+	lid := LeaseID{
+		Owner:    did.Owner,
+		DSeq:     did.DSeq,
+		GSeq:     uint32(gseq),
+		OSeq:     uint32(oseq),
+		Provider: parts[2],
+	}
+
+	return lid, lid.Validate() == nil
+}
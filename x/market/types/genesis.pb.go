@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Orders []Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders" yaml:"orders"`
 	Leases []Lease `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases" yaml:"leases"`
+	Bids   []Bid   `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids" yaml:"bids"`
+	Params Params  `protobuf:"bytes,4,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,20 @@ func (m *GenesisState) GetLeases() []Lease {
 	return nil
 }
 
+func (m *GenesisState) GetBids() []Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "akash.market.v1beta1.GenesisState")
 }
@@ -85,23 +101,28 @@ func init() {
 }
 
 var fileDescriptor_3add0908026fd9bf = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xcc, 0x4e, 0x2c,
-	0xce, 0xd0, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xab, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x97, 0x5f, 0x94, 0x92, 0x5a, 0x84, 0x57,
-	0x45, 0x4e, 0x6a, 0x62, 0x71, 0x2a, 0x44, 0x85, 0xd2, 0x2e, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x0b,
-	0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x42, 0xb8, 0xd8, 0xc0, 0x26, 0x14, 0x4b, 0x30, 0x2a, 0x30,
-	0x6b, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x73, 0x91, 0x9e, 0x3f, 0x48, 0x8d, 0x93, 0xfc, 0x89, 0x7b,
-	0xf2, 0x0c, 0xaf, 0xee, 0xc9, 0x43, 0xb5, 0x7c, 0xba, 0x27, 0xcf, 0x5b, 0x99, 0x98, 0x9b, 0x63,
-	0xa5, 0x04, 0xe1, 0x2b, 0x05, 0x41, 0x25, 0x40, 0xa6, 0x82, 0x6d, 0x2d, 0x96, 0x60, 0xc2, 0x67,
-	0xaa, 0x0f, 0x48, 0x0d, 0xc2, 0x54, 0x88, 0x16, 0x84, 0xa9, 0x10, 0xbe, 0x52, 0x10, 0x54, 0xc2,
-	0xc9, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xd4, 0xd2, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xf3, 0xcb, 0x8a, 0x92, 0x73, 0xb2, 0xf5, 0x21,
-	0x41, 0x51, 0x01, 0x0b, 0x8c, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x28, 0x18, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x06, 0x67, 0x6f, 0x8f, 0x9b, 0x01, 0x00, 0x00,
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x93, 0xb6, 0xea, 0x90, 0xc2, 0x12, 0x75, 0x08, 0x2d, 0x72, 0x8a, 0x07, 0xd4, 0xc9,
+	0x56, 0xcb, 0xc6, 0x84, 0xb2, 0x20, 0x21, 0x24, 0x50, 0x80, 0x85, 0xcd, 0x69, 0xac, 0x34, 0x6a,
+	0x83, 0x2b, 0xdb, 0x54, 0xf4, 0x2d, 0x78, 0xac, 0x8e, 0x1d, 0x59, 0x88, 0x50, 0xb3, 0x31, 0xf6,
+	0x09, 0x50, 0x6c, 0xa3, 0x2c, 0x56, 0xb7, 0x5c, 0xee, 0xbb, 0x4f, 0xff, 0xf9, 0x3c, 0x48, 0x16,
+	0x44, 0xcc, 0x71, 0x41, 0xf8, 0x82, 0x4a, 0xbc, 0x9e, 0x24, 0x54, 0x92, 0x09, 0xce, 0xe8, 0x1b,
+	0x15, 0xb9, 0x40, 0x2b, 0xce, 0x24, 0xf3, 0xfb, 0x8a, 0x41, 0x9a, 0x41, 0x86, 0x19, 0xf4, 0x33,
+	0x96, 0x31, 0x05, 0xe0, 0xfa, 0x4b, 0xb3, 0x83, 0x91, 0xd5, 0xc7, 0x78, 0x4a, 0xb9, 0x21, 0x80,
+	0x95, 0x48, 0xf2, 0xf4, 0xa8, 0x61, 0x49, 0x89, 0xa0, 0x86, 0xb8, 0xb0, 0x12, 0x2b, 0xc2, 0x49,
+	0x61, 0x22, 0xc3, 0xef, 0x96, 0x77, 0x72, 0xab, 0x97, 0x78, 0x92, 0x44, 0x52, 0xff, 0xd9, 0xeb,
+	0xaa, 0x10, 0x22, 0x70, 0x47, 0xed, 0x71, 0x6f, 0x3a, 0x44, 0xb6, 0xa5, 0xd0, 0x43, 0xcd, 0x44,
+	0xe1, 0xb6, 0x0c, 0x9d, 0xdf, 0x32, 0x34, 0x23, 0x87, 0x32, 0x3c, 0xdd, 0x90, 0x62, 0x79, 0x0d,
+	0x75, 0x0d, 0x63, 0xd3, 0xa8, 0xad, 0x2a, 0x98, 0x08, 0x5a, 0xc7, 0xac, 0xf7, 0x35, 0xd3, 0x58,
+	0xf5, 0x48, 0x63, 0xd5, 0x35, 0x8c, 0x4d, 0xc3, 0xbf, 0xf3, 0x3a, 0x49, 0x9e, 0x8a, 0xa0, 0xad,
+	0x9c, 0x67, 0x76, 0x67, 0x94, 0xa7, 0xd1, 0xd0, 0x18, 0x15, 0x7e, 0x28, 0xc3, 0x9e, 0xf6, 0xd5,
+	0x15, 0x8c, 0xd5, 0x4f, 0xff, 0xc5, 0xeb, 0xea, 0x87, 0x09, 0x3a, 0x23, 0x77, 0xdc, 0x9b, 0x9e,
+	0xdb, 0x6d, 0x8f, 0x8a, 0x69, 0x22, 0xea, 0x99, 0x26, 0xa2, 0xae, 0x61, 0x6c, 0x1a, 0xd1, 0xcd,
+	0x76, 0x0f, 0xdc, 0xdd, 0x1e, 0xb8, 0x3f, 0x7b, 0xe0, 0x7e, 0x56, 0xc0, 0xd9, 0x55, 0xc0, 0xf9,
+	0xaa, 0x80, 0xf3, 0x7a, 0x99, 0xe5, 0x72, 0xfe, 0x9e, 0xa0, 0x19, 0x2b, 0x30, 0x5b, 0xf3, 0xd9,
+	0x72, 0x81, 0xf5, 0xb9, 0x3e, 0xfe, 0x0f, 0x26, 0x37, 0x2b, 0x2a, 0x92, 0xae, 0x3a, 0xd4, 0xd5,
+	0xdf, 0x00, 0xed, 0x7a, 0x31, 0xb6, 0x81, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +145,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Leases) > 0 {
 		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -184,6 +229,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -290,6 +343,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
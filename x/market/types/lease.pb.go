@@ -57,9 +57,91 @@ func (x Lease_State) String() string {
 }
 
 func (Lease_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b81e11575e79ba08, []int{1, 0}
+	return fileDescriptor_b81e11575e79ba08, []int{3, 0}
 }
 
+// MsgWithdrawLease defines an SDK message for withdrawing lease funds
+type MsgWithdrawLease struct {
+	LeaseID LeaseID `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"id" yaml:"id"`
+}
+
+func (m *MsgWithdrawLease) Reset()         { *m = MsgWithdrawLease{} }
+func (m *MsgWithdrawLease) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLease) ProtoMessage()    {}
+func (*MsgWithdrawLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81e11575e79ba08, []int{0}
+}
+func (m *MsgWithdrawLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLease.Merge(m, src)
+}
+func (m *MsgWithdrawLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawLease proto.InternalMessageInfo
+
+func (m *MsgWithdrawLease) GetLeaseID() LeaseID {
+	if m != nil {
+		return m.LeaseID
+	}
+	return LeaseID{}
+}
+
+// MsgWithdrawLeaseResponse defines the Msg/WithdrawLease response type.
+type MsgWithdrawLeaseResponse struct {
+}
+
+func (m *MsgWithdrawLeaseResponse) Reset()         { *m = MsgWithdrawLeaseResponse{} }
+func (m *MsgWithdrawLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLeaseResponse) ProtoMessage()    {}
+func (*MsgWithdrawLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81e11575e79ba08, []int{1}
+}
+func (m *MsgWithdrawLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLeaseResponse.Merge(m, src)
+}
+func (m *MsgWithdrawLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawLeaseResponse proto.InternalMessageInfo
+
 // LeaseID stores bid details of lease
 type LeaseID struct {
 	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
@@ -72,7 +154,7 @@ type LeaseID struct {
 func (m *LeaseID) Reset()      { *m = LeaseID{} }
 func (*LeaseID) ProtoMessage() {}
 func (*LeaseID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81e11575e79ba08, []int{0}
+	return fileDescriptor_b81e11575e79ba08, []int{2}
 }
 func (m *LeaseID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +228,7 @@ type Lease struct {
 func (m *Lease) Reset()      { *m = Lease{} }
 func (*Lease) ProtoMessage() {}
 func (*Lease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81e11575e79ba08, []int{1}
+	return fileDescriptor_b81e11575e79ba08, []int{3}
 }
 func (m *Lease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +292,7 @@ func (m *LeaseFilters) Reset()         { *m = LeaseFilters{} }
 func (m *LeaseFilters) String() string { return proto.CompactTextString(m) }
 func (*LeaseFilters) ProtoMessage()    {}
 func (*LeaseFilters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81e11575e79ba08, []int{2}
+	return fileDescriptor_b81e11575e79ba08, []int{4}
 }
 func (m *LeaseFilters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,6 +365,8 @@ func (m *LeaseFilters) GetState() string {
 
 func init() {
 	proto.RegisterEnum("akash.market.v1beta1.Lease_State", Lease_State_name, Lease_State_value)
+	proto.RegisterType((*MsgWithdrawLease)(nil), "akash.market.v1beta1.MsgWithdrawLease")
+	proto.RegisterType((*MsgWithdrawLeaseResponse)(nil), "akash.market.v1beta1.MsgWithdrawLeaseResponse")
 	proto.RegisterType((*LeaseID)(nil), "akash.market.v1beta1.LeaseID")
 	proto.RegisterType((*Lease)(nil), "akash.market.v1beta1.Lease")
 	proto.RegisterType((*LeaseFilters)(nil), "akash.market.v1beta1.LeaseFilters")
@@ -291,45 +375,103 @@ func init() {
 func init() { proto.RegisterFile("akash/market/v1beta1/lease.proto", fileDescriptor_b81e11575e79ba08) }
 
 var fileDescriptor_b81e11575e79ba08 = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xb5, 0x53, 0xbb, 0x8f, 0x49, 0xa1, 0xc1, 0x2a, 0xa8, 0x35, 0xaa, 0xc7, 0xcc, 0x02, 0x75,
-	0x65, 0xab, 0xe9, 0xae, 0x6c, 0x20, 0xad, 0x8a, 0x2a, 0x21, 0x90, 0xdc, 0x1d, 0x42, 0xaa, 0x1c,
-	0x7b, 0xea, 0x8e, 0xea, 0x78, 0x52, 0x8f, 0x1b, 0xe8, 0x1f, 0xa0, 0xae, 0x58, 0xb2, 0x89, 0x88,
-	0xc4, 0x37, 0xf0, 0x0f, 0x5d, 0x56, 0xac, 0x58, 0x59, 0x28, 0xd9, 0xa0, 0x2c, 0xf3, 0x05, 0x68,
-	0xee, 0xe4, 0x55, 0x09, 0xf5, 0x0b, 0x58, 0x25, 0xf7, 0xdc, 0x73, 0xce, 0x8c, 0xcf, 0xbd, 0x1a,
-	0xe4, 0x86, 0xe7, 0xa1, 0x38, 0xf3, 0x5b, 0x61, 0x7e, 0x4e, 0x0b, 0xbf, 0xb3, 0xd3, 0xa4, 0x45,
-	0xb8, 0xe3, 0xa7, 0x34, 0x14, 0xd4, 0x6b, 0xe7, 0xbc, 0xe0, 0xd6, 0x3a, 0x30, 0x3c, 0xc5, 0xf0,
-	0xc6, 0x0c, 0x7b, 0x3d, 0xe1, 0x09, 0x07, 0x82, 0x2f, 0xff, 0x29, 0xae, 0xed, 0x44, 0x5c, 0xb4,
-	0xb8, 0xf0, 0x9b, 0xa1, 0xa0, 0x53, 0xb3, 0x88, 0xb3, 0x4c, 0xf5, 0x49, 0xaf, 0x82, 0x96, 0xde,
-	0x48, 0xef, 0xa3, 0x03, 0xcb, 0x47, 0x26, 0xff, 0x98, 0xd1, 0x7c, 0x43, 0x77, 0xf5, 0xed, 0x95,
-	0xc6, 0xe6, 0xb0, 0xc4, 0x0a, 0x18, 0x95, 0x78, 0xf5, 0x2a, 0x6c, 0xa5, 0x7b, 0x04, 0x4a, 0x12,
-	0x28, 0xd8, 0xda, 0x45, 0x46, 0x2c, 0xe8, 0xc5, 0x46, 0xc5, 0xd5, 0xb7, 0x8d, 0x06, 0xee, 0x97,
-	0xd8, 0x38, 0x38, 0xa6, 0x17, 0xc3, 0x12, 0x03, 0x3e, 0x2a, 0x71, 0x55, 0xc9, 0x64, 0x45, 0x02,
-	0x00, 0xa5, 0x28, 0x91, 0xa2, 0x05, 0x57, 0xdf, 0x7e, 0xa0, 0x44, 0xaf, 0xc7, 0xa2, 0xe4, 0x8e,
-	0x28, 0x51, 0xa2, 0x64, 0x2c, 0xe2, 0x52, 0x64, 0xcc, 0x44, 0xef, 0xc6, 0x22, 0x7e, 0x47, 0xc4,
-	0x95, 0x48, 0xfe, 0x58, 0x2f, 0xd0, 0x72, 0x3b, 0xe7, 0x1d, 0x16, 0xd3, 0x7c, 0xc3, 0x84, 0x4f,
-	0xc2, 0xc3, 0x12, 0x4f, 0xb1, 0x51, 0x89, 0xd7, 0x94, 0x68, 0x82, 0x90, 0x60, 0xda, 0xdc, 0x5b,
-	0xfe, 0xda, 0xc3, 0xda, 0x9f, 0x1e, 0xd6, 0xc8, 0x8f, 0x05, 0x64, 0x42, 0x44, 0xd6, 0x07, 0xb4,
-	0x0c, 0x73, 0x38, 0x61, 0x31, 0x64, 0x54, 0xad, 0x6f, 0x79, 0xff, 0x9a, 0x85, 0x37, 0x4e, 0xb4,
-	0x41, 0x6e, 0x4a, 0xac, 0xf5, 0x4b, 0x3c, 0x89, 0x78, 0x58, 0xe2, 0x0a, 0x8b, 0x47, 0x25, 0x5e,
-	0x51, 0x07, 0xb3, 0x98, 0x04, 0x4b, 0x60, 0x79, 0x14, 0x5b, 0x01, 0x32, 0x45, 0x11, 0x16, 0x14,
-	0xe2, 0x7c, 0x58, 0x7f, 0x76, 0x8f, 0xb5, 0x77, 0x2c, 0x89, 0x6a, 0x42, 0xa0, 0x99, 0x4d, 0x08,
-	0x4a, 0x12, 0x28, 0xd8, 0x7a, 0x8b, 0xcc, 0x76, 0xce, 0x22, 0x0a, 0x69, 0x57, 0xeb, 0x9b, 0x9e,
-	0x5a, 0x07, 0x4f, 0xae, 0xc3, 0xd4, 0x72, 0x9f, 0xb3, 0xac, 0xb1, 0x25, 0xaf, 0x2a, 0xfd, 0x80,
-	0x3f, 0xf3, 0x83, 0x92, 0x04, 0x0a, 0x26, 0xdf, 0x74, 0x64, 0xc2, 0xd9, 0x16, 0x41, 0x4b, 0x2c,
-	0xeb, 0x84, 0x29, 0x8b, 0x6b, 0x9a, 0xfd, 0xf8, 0xba, 0xeb, 0x3e, 0x82, 0x9b, 0x41, 0xf3, 0x48,
-	0x35, 0xac, 0xa7, 0x68, 0x31, 0x8c, 0x0a, 0xd6, 0xa1, 0x35, 0xdd, 0x5e, 0xbb, 0xee, 0xba, 0x55,
-	0xa0, 0xbc, 0x02, 0xc8, 0xaa, 0x23, 0x8b, 0x65, 0xe2, 0xf2, 0xf4, 0x94, 0x45, 0x8c, 0x66, 0xc5,
-	0xc9, 0xe9, 0x65, 0x16, 0x8b, 0x5a, 0xc5, 0xb6, 0xaf, 0xbb, 0xee, 0x13, 0x95, 0xd7, 0x5c, 0xfb,
-	0x50, 0x76, 0xa5, 0x61, 0x94, 0x72, 0x41, 0xe3, 0xda, 0xc2, 0x9c, 0xe1, 0x3e, 0x40, 0xb6, 0xf1,
-	0xf9, 0xbb, 0xa3, 0xcd, 0xcd, 0xed, 0x67, 0x05, 0xad, 0x42, 0xff, 0x90, 0xa5, 0x05, 0xcd, 0xc5,
-	0xff, 0xfd, 0x9e, 0xdb, 0x6f, 0x19, 0x86, 0xda, 0xb6, 0xc5, 0x59, 0x18, 0xf7, 0xad, 0xd2, 0x9e,
-	0x21, 0x43, 0x6d, 0xbc, 0xbc, 0xe9, 0x3b, 0xfa, 0x6d, 0xdf, 0xd1, 0x7f, 0xf7, 0x1d, 0xfd, 0xcb,
-	0xc0, 0xd1, 0x6e, 0x07, 0x8e, 0xf6, 0x6b, 0xe0, 0x68, 0xef, 0x9f, 0x27, 0xac, 0x38, 0xbb, 0x6c,
-	0x7a, 0x11, 0x6f, 0xf9, 0xbc, 0x93, 0x47, 0xe9, 0xb9, 0xaf, 0x5e, 0xb2, 0x4f, 0x93, 0xb7, 0xac,
-	0xb8, 0x6a, 0x53, 0xd1, 0x5c, 0x84, 0x87, 0x67, 0xf7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x97,
-	0x66, 0x1c, 0x67, 0xe8, 0x04, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0x3d, 0x4f, 0xdc, 0x4c,
+	0x10, 0xb6, 0x8f, 0x33, 0x1f, 0x7b, 0xbc, 0x2f, 0x17, 0x8b, 0x44, 0x87, 0x23, 0xbc, 0xce, 0x16,
+	0x11, 0x95, 0x2d, 0x8e, 0x8e, 0x34, 0xc9, 0x81, 0x88, 0x90, 0xf2, 0x21, 0x99, 0x22, 0x52, 0x14,
+	0x09, 0xf9, 0xec, 0xc5, 0xac, 0xf0, 0x79, 0x0f, 0xaf, 0x31, 0xe1, 0x1f, 0x44, 0x54, 0x29, 0xd3,
+	0xa0, 0x20, 0xe5, 0x37, 0xe4, 0x3f, 0x50, 0xa2, 0x54, 0xa9, 0xac, 0xe8, 0x68, 0xa2, 0x2b, 0xef,
+	0x17, 0x44, 0x3b, 0x7b, 0x5f, 0x44, 0x11, 0x65, 0xaa, 0x54, 0x77, 0xf3, 0xcc, 0xf3, 0x3c, 0xb3,
+	0x9e, 0x19, 0x0d, 0x72, 0x82, 0xa3, 0x40, 0x1c, 0x7a, 0x9d, 0x20, 0x3b, 0xa2, 0xb9, 0x57, 0xac,
+	0xb7, 0x69, 0x1e, 0xac, 0x7b, 0x09, 0x0d, 0x04, 0x75, 0xbb, 0x19, 0xcf, 0xb9, 0xb9, 0x0c, 0x0c,
+	0x57, 0x31, 0xdc, 0x21, 0xc3, 0x5a, 0x8e, 0x79, 0xcc, 0x81, 0xe0, 0xc9, 0x7f, 0x8a, 0x6b, 0xd9,
+	0x21, 0x17, 0x1d, 0x2e, 0xbc, 0x76, 0x20, 0xe8, 0xd8, 0x2c, 0xe4, 0x2c, 0x55, 0x79, 0x52, 0xa0,
+	0xfa, 0x4b, 0x11, 0xbf, 0x61, 0xf9, 0x61, 0x94, 0x05, 0xa7, 0x2f, 0x64, 0x15, 0xf3, 0x1d, 0x9a,
+	0x87, 0x72, 0xfb, 0x2c, 0x6a, 0xe8, 0x8e, 0xbe, 0x56, 0x6b, 0xae, 0xba, 0x7f, 0x2a, 0xe9, 0x02,
+	0x7d, 0x77, 0xbb, 0x45, 0xae, 0x4a, 0xac, 0xf5, 0x4a, 0x3c, 0x37, 0x04, 0xfa, 0x25, 0xae, 0xb0,
+	0x68, 0x50, 0xe2, 0x85, 0xb3, 0xa0, 0x93, 0x6c, 0x12, 0x16, 0x11, 0x7f, 0x0e, 0x2c, 0x77, 0xa3,
+	0xcd, 0xea, 0xcf, 0x4b, 0xac, 0x11, 0x0b, 0x35, 0x7e, 0xaf, 0xeb, 0x53, 0xd1, 0xe5, 0xa9, 0xa0,
+	0xe4, 0xb2, 0x82, 0x46, 0x4e, 0xa6, 0x87, 0x0c, 0x7e, 0x9a, 0xd2, 0x0c, 0x1e, 0xb2, 0xd0, 0x5a,
+	0xe9, 0x97, 0x58, 0x01, 0x83, 0x12, 0x2f, 0x2a, 0x77, 0x08, 0x89, 0xaf, 0x60, 0x73, 0x03, 0x55,
+	0x23, 0x41, 0x8f, 0x1b, 0x15, 0x47, 0x5f, 0xab, 0xb6, 0x70, 0xaf, 0xc4, 0xd5, 0xed, 0x3d, 0x7a,
+	0xdc, 0x2f, 0x31, 0xe0, 0x83, 0x12, 0xd7, 0x94, 0x4c, 0x46, 0xc4, 0x07, 0x50, 0x8a, 0x62, 0x29,
+	0x9a, 0x71, 0xf4, 0xb5, 0xff, 0x94, 0xe8, 0xf9, 0x50, 0x14, 0xdf, 0x12, 0xc5, 0x4a, 0x14, 0x0f,
+	0x45, 0x5c, 0x8a, 0xaa, 0x13, 0xd1, 0xeb, 0xa1, 0x88, 0xdf, 0x12, 0x71, 0x25, 0x92, 0x3f, 0xe6,
+	0x13, 0x34, 0xdf, 0xcd, 0x78, 0xc1, 0x22, 0x9a, 0x35, 0x0c, 0xf8, 0x24, 0xdc, 0x2f, 0xf1, 0x18,
+	0x1b, 0x94, 0x78, 0x49, 0x89, 0x46, 0x08, 0xf1, 0xc7, 0xc9, 0xcd, 0xf9, 0x4f, 0x97, 0x58, 0x83,
+	0xf6, 0x7d, 0x9d, 0x41, 0xc6, 0x5f, 0x18, 0x96, 0xe9, 0x23, 0x43, 0xe4, 0x41, 0x4e, 0xa1, 0x9d,
+	0xff, 0x37, 0x1f, 0xdd, 0x61, 0xed, 0xee, 0x49, 0xa2, 0x9a, 0x10, 0x68, 0x26, 0x13, 0x82, 0x90,
+	0xf8, 0x0a, 0x36, 0x5f, 0x21, 0xa3, 0x9b, 0xb1, 0x90, 0x42, 0xb7, 0x6b, 0xcd, 0x15, 0x57, 0xad,
+	0xa8, 0x2b, 0x57, 0x74, 0x6c, 0xb9, 0xc5, 0x59, 0xda, 0x5a, 0x95, 0x4f, 0x95, 0x7e, 0xc0, 0x9f,
+	0xf8, 0x41, 0x48, 0x7c, 0x05, 0x93, 0xcf, 0x3a, 0x32, 0xa0, 0xb6, 0x49, 0xd0, 0x1c, 0x4b, 0x8b,
+	0x20, 0x61, 0x51, 0x5d, 0xb3, 0xee, 0x9f, 0x5f, 0x38, 0xf7, 0xe0, 0x65, 0x90, 0xdc, 0x55, 0x09,
+	0xf3, 0x21, 0x9a, 0x0d, 0xc2, 0x9c, 0x15, 0xb4, 0xae, 0x5b, 0x4b, 0xe7, 0x17, 0x4e, 0x0d, 0x28,
+	0xcf, 0x00, 0x32, 0x9b, 0xc8, 0x64, 0xa9, 0x38, 0x39, 0x38, 0x60, 0x21, 0xa3, 0x69, 0xbe, 0x7f,
+	0x70, 0x92, 0x46, 0xa2, 0x5e, 0xb1, 0xac, 0xf3, 0x0b, 0xe7, 0x81, 0xea, 0xd7, 0x54, 0x7a, 0x47,
+	0x66, 0xa5, 0x61, 0x98, 0x70, 0x41, 0xa3, 0xfa, 0xcc, 0x94, 0xe1, 0x16, 0x40, 0x56, 0xf5, 0xc3,
+	0x17, 0x5b, 0x9b, 0x9a, 0xdb, 0xb7, 0x0a, 0x5a, 0x84, 0xfc, 0x0e, 0x4b, 0x72, 0x9a, 0x89, 0x7f,
+	0xfb, 0x3d, 0xb5, 0xdf, 0xb2, 0x19, 0x6a, 0xdb, 0x66, 0x27, 0xcd, 0xb8, 0x6b, 0x95, 0xd4, 0x2d,
+	0x69, 0x3d, 0xbd, 0xea, 0xd9, 0xfa, 0x75, 0xcf, 0xd6, 0x7f, 0xf4, 0x6c, 0xfd, 0xe3, 0x8d, 0xad,
+	0x5d, 0xdf, 0xd8, 0xda, 0xf7, 0x1b, 0x5b, 0x7b, 0xfb, 0x38, 0x66, 0xf9, 0xe1, 0x49, 0xdb, 0x0d,
+	0x79, 0xc7, 0xe3, 0x45, 0x16, 0x26, 0x47, 0x9e, 0xba, 0xae, 0xef, 0x47, 0xf7, 0x35, 0x3f, 0xeb,
+	0x52, 0xd1, 0x9e, 0x85, 0x63, 0xb8, 0xf1, 0x6b, 0x00, 0x66, 0x8e, 0x59, 0xb6, 0x7c, 0x05, 0x00,
+	0x00,
+}
+
+func (m *MsgWithdrawLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LeaseID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLease(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
 
 func (m *LeaseID) Marshal() (dAtA []byte, err error) {
@@ -502,6 +644,26 @@ func encodeVarintLease(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *MsgWithdrawLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LeaseID.Size()
+	n += 1 + l + sovLease(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *LeaseID) Size() (n int) {
 	if m == nil {
 		return 0
@@ -580,6 +742,145 @@ func sovLease(x uint64) (n int) {
 func sozLease(x uint64) (n int) {
 	return sovLease(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *MsgWithdrawLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLease
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLease
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLease
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeaseID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLease(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLease
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLease
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLease
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLease(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLease
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLease
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LeaseID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
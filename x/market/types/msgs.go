@@ -5,13 +5,15 @@ import (
 )
 
 const (
-	MsgTypeCreateBid  = "create-bid"
-	MsgTypeCloseBid   = "close-bid"
-	MsgTypeCloseOrder = "close-order"
+	MsgTypeCreateBid     = "create-bid"
+	MsgTypeCloseBid      = "close-bid"
+	MsgTypeCloseOrder    = "close-order"
+	MsgTypeCreateLease   = "create-lease"
+	MsgTypeWithdrawLease = "withdraw-lease"
 )
 
 var (
-	_, _, _ sdk.Msg = &MsgCreateBid{}, &MsgCloseBid{}, &MsgCloseOrder{}
+	_, _, _, _, _ sdk.Msg = &MsgCreateBid{}, &MsgCloseBid{}, &MsgCloseOrder{}, &MsgCreateLease{}, &MsgWithdrawLease{}
 )
 
 // NewMsgCreateBid creates a new MsgCreateBid instance
@@ -132,3 +134,69 @@ func (msg MsgCloseOrder) GetSigners() []sdk.AccAddress {
 func (msg MsgCloseOrder) ValidateBasic() error {
 	return msg.OrderID.Validate()
 }
+
+// NewMsgCreateLease creates a new MsgCreateLease instance
+func NewMsgCreateLease(id BidID) *MsgCreateLease {
+	return &MsgCreateLease{
+		BidID: id,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCreateLease) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgCreateLease) Type() string { return MsgTypeCreateLease }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateLease) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateLease) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.BidID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic method for MsgCreateLease
+func (msg MsgCreateLease) ValidateBasic() error {
+	return msg.BidID.Validate()
+}
+
+// NewMsgWithdrawLease creates a new MsgWithdrawLease instance
+func NewMsgWithdrawLease(id LeaseID) *MsgWithdrawLease {
+	return &MsgWithdrawLease{
+		LeaseID: id,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgWithdrawLease) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgWithdrawLease) Type() string { return MsgTypeWithdrawLease }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWithdrawLease) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawLease) GetSigners() []sdk.AccAddress {
+	provider, err := sdk.AccAddressFromBech32(msg.LeaseID.Provider)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{provider}
+}
+
+// ValidateBasic method for MsgWithdrawLease
+func (msg MsgWithdrawLease) ValidateBasic() error {
+	return msg.LeaseID.Validate()
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// DefaultOrderStartDelay is the default number of blocks between order creation and bidding
+const DefaultOrderStartDelay = int64(5)
+
+// KeyOrderStartDelay is the parameter store key of OrderStartDelay
+var KeyOrderStartDelay = []byte("OrderStartDelay")
+
+// ParamKeyTable returns the parameter key table for the market module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default market module parameters
+func DefaultParams() Params {
+	return Params{
+		OrderStartDelay: DefaultOrderStartDelay,
+	}
+}
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyOrderStartDelay, &p.OrderStartDelay, validateOrderStartDelay),
+	}
+}
+
+// Validate checks the market module parameters
+func (p Params) Validate() error {
+	if err := validateOrderStartDelay(p.OrderStartDelay); err != nil {
+		return errors.Wrapf(err, "param %s", KeyOrderStartDelay)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateOrderStartDelay(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("order start delay must not be negative: %v", v)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/ovrclk/akash/x/escrow/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -575,7 +576,8 @@ func (m *QueryLeaseRequest) GetID() LeaseID {
 
 // QueryLeaseResponse is response type for the Query/Lease RPC method
 type QueryLeaseResponse struct {
-	Lease Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease"`
+	Lease         Lease         `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease"`
+	EscrowPayment types.Payment `protobuf:"bytes,2,opt,name=escrow_payment,json=escrowPayment,proto3" json:"escrow_payment"`
 }
 
 func (m *QueryLeaseResponse) Reset()         { *m = QueryLeaseResponse{} }
@@ -618,6 +620,323 @@ func (m *QueryLeaseResponse) GetLease() Lease {
 	return Lease{}
 }
 
+func (m *QueryLeaseResponse) GetEscrowPayment() types.Payment {
+	if m != nil {
+		return m.EscrowPayment
+	}
+	return types.Payment{}
+}
+
+// QueryBidsByProviderRequest is request type for the Query/BidsByProvider RPC method
+type QueryBidsByProviderRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	State      string             `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBidsByProviderRequest) Reset()         { *m = QueryBidsByProviderRequest{} }
+func (m *QueryBidsByProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidsByProviderRequest) ProtoMessage()    {}
+func (*QueryBidsByProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f1db5c661b7517, []int{12}
+}
+func (m *QueryBidsByProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidsByProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidsByProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidsByProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidsByProviderRequest.Merge(m, src)
+}
+func (m *QueryBidsByProviderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidsByProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidsByProviderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidsByProviderRequest proto.InternalMessageInfo
+
+func (m *QueryBidsByProviderRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryBidsByProviderRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *QueryBidsByProviderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBidsByProviderResponse is response type for the Query/BidsByProvider RPC method
+type QueryBidsByProviderResponse struct {
+	Bids       Bids                `protobuf:"bytes,1,rep,name=bids,proto3,castrepeated=Bids" json:"bids"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBidsByProviderResponse) Reset()         { *m = QueryBidsByProviderResponse{} }
+func (m *QueryBidsByProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidsByProviderResponse) ProtoMessage()    {}
+func (*QueryBidsByProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f1db5c661b7517, []int{13}
+}
+func (m *QueryBidsByProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidsByProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidsByProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidsByProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidsByProviderResponse.Merge(m, src)
+}
+func (m *QueryBidsByProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidsByProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidsByProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidsByProviderResponse proto.InternalMessageInfo
+
+func (m *QueryBidsByProviderResponse) GetBids() Bids {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryBidsByProviderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLeasesByProviderRequest is request type for the Query/LeasesByProvider RPC method
+type QueryLeasesByProviderRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	State      string             `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLeasesByProviderRequest) Reset()         { *m = QueryLeasesByProviderRequest{} }
+func (m *QueryLeasesByProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeasesByProviderRequest) ProtoMessage()    {}
+func (*QueryLeasesByProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f1db5c661b7517, []int{14}
+}
+func (m *QueryLeasesByProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeasesByProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeasesByProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeasesByProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeasesByProviderRequest.Merge(m, src)
+}
+func (m *QueryLeasesByProviderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeasesByProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeasesByProviderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeasesByProviderRequest proto.InternalMessageInfo
+
+func (m *QueryLeasesByProviderRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryLeasesByProviderRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *QueryLeasesByProviderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLeasesByProviderResponse is response type for the Query/LeasesByProvider RPC method
+type QueryLeasesByProviderResponse struct {
+	Leases     Leases              `protobuf:"bytes,1,rep,name=leases,proto3,castrepeated=Leases" json:"leases"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLeasesByProviderResponse) Reset()         { *m = QueryLeasesByProviderResponse{} }
+func (m *QueryLeasesByProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeasesByProviderResponse) ProtoMessage()    {}
+func (*QueryLeasesByProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f1db5c661b7517, []int{15}
+}
+func (m *QueryLeasesByProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeasesByProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeasesByProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeasesByProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeasesByProviderResponse.Merge(m, src)
+}
+func (m *QueryLeasesByProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeasesByProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeasesByProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeasesByProviderResponse proto.InternalMessageInfo
+
+func (m *QueryLeasesByProviderResponse) GetLeases() Leases {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+func (m *QueryLeasesByProviderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/MarketParams RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f1db5c661b7517, []int{16}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/MarketParams RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f1db5c661b7517, []int{17}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryOrdersRequest)(nil), "akash.market.v1beta1.QueryOrdersRequest")
 	proto.RegisterType((*QueryOrdersResponse)(nil), "akash.market.v1beta1.QueryOrdersResponse")
@@ -631,57 +950,78 @@ func init() {
 	proto.RegisterType((*QueryLeasesResponse)(nil), "akash.market.v1beta1.QueryLeasesResponse")
 	proto.RegisterType((*QueryLeaseRequest)(nil), "akash.market.v1beta1.QueryLeaseRequest")
 	proto.RegisterType((*QueryLeaseResponse)(nil), "akash.market.v1beta1.QueryLeaseResponse")
+	proto.RegisterType((*QueryBidsByProviderRequest)(nil), "akash.market.v1beta1.QueryBidsByProviderRequest")
+	proto.RegisterType((*QueryBidsByProviderResponse)(nil), "akash.market.v1beta1.QueryBidsByProviderResponse")
+	proto.RegisterType((*QueryLeasesByProviderRequest)(nil), "akash.market.v1beta1.QueryLeasesByProviderRequest")
+	proto.RegisterType((*QueryLeasesByProviderResponse)(nil), "akash.market.v1beta1.QueryLeasesByProviderResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "akash.market.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "akash.market.v1beta1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("akash/market/v1beta1/query.proto", fileDescriptor_50f1db5c661b7517) }
 
 var fileDescriptor_50f1db5c661b7517 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x69, 0x1b, 0xa4, 0x2b, 0xe2, 0xc7, 0xd1, 0xa1, 0xa4, 0xe0, 0xb4, 0x46, 0x24,
-	0x29, 0x83, 0xad, 0x96, 0xa1, 0x42, 0x2c, 0x95, 0x29, 0x45, 0x45, 0xfc, 0xcc, 0xc8, 0x66, 0xd7,
-	0x57, 0xf7, 0xd4, 0xd4, 0x97, 0xfa, 0xdc, 0x8a, 0x0e, 0x48, 0x08, 0x09, 0x89, 0x11, 0x89, 0x09,
-	0x89, 0x01, 0x89, 0x8d, 0x85, 0x7f, 0xa3, 0x63, 0x25, 0x16, 0xa6, 0x82, 0x12, 0xfe, 0x10, 0xe4,
-	0x77, 0xe7, 0x38, 0x01, 0xe7, 0x4c, 0xa4, 0xc2, 0x56, 0xa9, 0xdf, 0xf7, 0xbd, 0xcf, 0x7b, 0xef,
-	0xbe, 0xe7, 0xe0, 0x79, 0x77, 0xc7, 0x15, 0xdb, 0xf6, 0xae, 0x1b, 0xed, 0xd0, 0xd8, 0x3e, 0x58,
-	0xf2, 0x68, 0xec, 0x2e, 0xd9, 0x7b, 0xfb, 0x34, 0x3a, 0xb4, 0x3a, 0x11, 0x8f, 0x39, 0x99, 0x01,
-	0x85, 0x25, 0x15, 0x96, 0x52, 0x54, 0x67, 0x02, 0x1e, 0x70, 0x10, 0xd8, 0xc9, 0x5f, 0x52, 0x5b,
-	0xbd, 0x12, 0x70, 0x1e, 0xb4, 0xa9, 0xed, 0x76, 0x98, 0xed, 0x86, 0x21, 0x8f, 0xdd, 0x98, 0xf1,
-	0x50, 0xa8, 0xff, 0xde, 0xd8, 0xe4, 0x62, 0x97, 0x0b, 0xdb, 0x73, 0x05, 0x95, 0x47, 0xf4, 0x0f,
-	0xec, 0xb8, 0x01, 0x0b, 0x41, 0xac, 0xb4, 0xf9, 0x5c, 0x3c, 0xf2, 0x69, 0xa4, 0x14, 0x46, 0xae,
-	0xc2, 0x63, 0xbe, 0xd6, 0xa1, 0x4d, 0x5d, 0x41, 0xa5, 0xc2, 0xfc, 0x88, 0x30, 0x79, 0x9a, 0x60,
-	0x3c, 0x4e, 0x6c, 0x45, 0x8b, 0xee, 0xed, 0x53, 0x11, 0x13, 0x07, 0x9f, 0xd9, 0x62, 0xed, 0x98,
-	0x46, 0x62, 0x16, 0xcd, 0xa3, 0xe6, 0xf4, 0xb2, 0x69, 0xe5, 0x8d, 0xc0, 0x82, 0xaa, 0x75, 0xa9,
-	0x74, 0x26, 0x8f, 0x4e, 0x6a, 0xa5, 0x56, 0x5a, 0x48, 0xd6, 0x31, 0xce, 0x5a, 0x9a, 0x2d, 0x83,
-	0x4d, 0xdd, 0x92, 0xfd, 0x5b, 0x49, 0xff, 0x96, 0x1c, 0x71, 0xea, 0xf5, 0xc4, 0x0d, 0xa8, 0x3a,
-	0xbf, 0x35, 0x50, 0x69, 0x7e, 0x42, 0xf8, 0xd2, 0x10, 0xa2, 0xe8, 0xf0, 0x50, 0x50, 0x72, 0x07,
-	0x57, 0x60, 0x16, 0x09, 0xe2, 0x44, 0x73, 0x7a, 0x79, 0x4e, 0x83, 0xe8, 0x9c, 0x4b, 0xd8, 0x3e,
-	0x7f, 0xaf, 0x55, 0x94, 0x89, 0x2a, 0x25, 0xf7, 0x72, 0x20, 0x1b, 0x85, 0x90, 0x92, 0x60, 0x88,
-	0xf2, 0x11, 0xbe, 0x98, 0x41, 0xa6, 0x63, 0xbc, 0x85, 0xcb, 0xcc, 0x57, 0x13, 0xbc, 0xaa, 0xc1,
-	0xdb, 0x58, 0x73, 0x70, 0x02, 0xd8, 0x3d, 0xa9, 0x95, 0x37, 0xd6, 0x5a, 0x65, 0xe6, 0x9b, 0x0f,
-	0x07, 0xf7, 0xd2, 0xef, 0x79, 0x05, 0x4f, 0x01, 0xb8, 0xf2, 0xd4, 0xb6, 0x2c, 0xd7, 0x21, 0xf5,
-	0xe6, 0x07, 0x84, 0x2f, 0x80, 0x9f, 0xc3, 0xfc, 0xfe, 0x96, 0x57, 0x7f, 0xdf, 0xf2, 0x7c, 0xbe,
-	0x9f, 0xc3, 0xfc, 0x7f, 0xbc, 0xe3, 0xf7, 0x48, 0x8d, 0x4f, 0xe2, 0xa9, 0x6e, 0x6f, 0xe3, 0x49,
-	0x8f, 0xf9, 0xe9, 0x7e, 0x2f, 0x8f, 0x84, 0x73, 0xce, 0xaa, 0xed, 0x4e, 0x42, 0x39, 0x14, 0x9d,
-	0xde, 0x66, 0xef, 0xe3, 0xf3, 0x29, 0x5a, 0x3a, 0xb8, 0x95, 0x81, 0xbd, 0xce, 0x8d, 0xc4, 0xca,
-	0xd9, 0xea, 0xdd, 0x6c, 0x0b, 0xfd, 0x2e, 0x97, 0xf0, 0x84, 0xd7, 0x77, 0xd3, 0x34, 0x29, 0x47,
-	0x9f, 0x68, 0xb3, 0xd4, 0x3e, 0x48, 0xa2, 0x3c, 0x76, 0x6a, 0xa1, 0xea, 0x7f, 0xa5, 0x36, 0x45,
-	0xcc, 0x52, 0x0b, 0xef, 0x4f, 0x41, 0x6a, 0xa1, 0x2a, 0x4b, 0xad, 0x32, 0x51, 0xa5, 0xa7, 0x9f,
-	0x5a, 0xf0, 0x1f, 0x23, 0xb5, 0xa0, 0xd7, 0xa4, 0x56, 0xf9, 0x65, 0xa9, 0x05, 0x70, 0xfd, 0x8d,
-	0x91, 0x2d, 0xab, 0xd4, 0x82, 0x7e, 0xf9, 0x4b, 0x05, 0x4f, 0x81, 0x1f, 0x79, 0x83, 0xb0, 0x7a,
-	0xba, 0x48, 0x33, 0xbf, 0xfc, 0xcf, 0x57, 0xbc, 0xba, 0xf8, 0x17, 0x4a, 0x89, 0x68, 0x2e, 0xbe,
-	0xfa, 0xfa, 0xf3, 0x5d, 0xf9, 0x1a, 0x59, 0xb0, 0x47, 0x7f, 0x74, 0x84, 0xdd, 0x66, 0x22, 0x26,
-	0xaf, 0x11, 0x9e, 0x82, 0x6a, 0xd2, 0x28, 0xf2, 0x4f, 0x41, 0x9a, 0xc5, 0xc2, 0xb1, 0x38, 0x58,
-	0xb8, 0xc5, 0xc9, 0x4b, 0x84, 0x21, 0xef, 0xa4, 0xae, 0x71, 0x1f, 0x78, 0xee, 0xaa, 0x8d, 0x42,
-	0x9d, 0x82, 0x68, 0x00, 0xc4, 0x02, 0xa9, 0xd9, 0xa3, 0xbe, 0xaf, 0x6a, 0x14, 0x2f, 0xf0, 0x84,
-	0xc3, 0x7c, 0x72, 0x5d, 0x6f, 0x9c, 0x9e, 0x5f, 0x2f, 0x92, 0x8d, 0x71, 0x3c, 0x4c, 0x20, 0xb9,
-	0x14, 0x32, 0x19, 0xda, 0x4b, 0x31, 0xf4, 0x48, 0x68, 0x2f, 0xc5, 0x70, 0x56, 0x8b, 0x96, 0x21,
-	0xc3, 0x98, 0x5d, 0x0a, 0xa8, 0xd6, 0x5e, 0x8a, 0xc1, 0x98, 0x55, 0x9b, 0xc5, 0xc2, 0xb1, 0x38,
-	0x92, 0x91, 0x38, 0xab, 0x47, 0x5d, 0x03, 0x1d, 0x77, 0x0d, 0xf4, 0xa3, 0x6b, 0xa0, 0xb7, 0x3d,
-	0xa3, 0x74, 0xdc, 0x33, 0x4a, 0xdf, 0x7a, 0x46, 0xe9, 0x59, 0x3d, 0x60, 0xf1, 0xf6, 0xbe, 0x67,
-	0x6d, 0xf2, 0x5d, 0x9b, 0x1f, 0x44, 0x9b, 0xed, 0x1d, 0xe5, 0xf6, 0x3c, 0xf5, 0x8b, 0x0f, 0x3b,
-	0x54, 0x78, 0x15, 0xf8, 0x61, 0x74, 0xf3, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x5e, 0x86,
-	0x8b, 0x16, 0x0a, 0x00, 0x00,
+	// 959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x9b, 0x1f, 0xd0, 0xd7, 0x12, 0xda, 0x69, 0x0e, 0xc1, 0x49, 0x37, 0xc9, 0x02,
+	0xc9, 0x86, 0x83, 0x9d, 0x6c, 0x25, 0xca, 0x8f, 0x4b, 0x65, 0x4a, 0x51, 0x2b, 0x0a, 0xe9, 0x1e,
+	0xb9, 0xa0, 0xd9, 0x78, 0xba, 0xb5, 0xb2, 0xbb, 0xe3, 0x7a, 0x9c, 0x40, 0x84, 0x2a, 0x21, 0x24,
+	0x24, 0xb8, 0x21, 0x21, 0x21, 0x2a, 0x71, 0x40, 0xea, 0x0d, 0xa9, 0x47, 0xfe, 0x87, 0x1e, 0x2b,
+	0x71, 0xe1, 0x54, 0x50, 0xc2, 0x1f, 0x82, 0xfc, 0xe6, 0xd9, 0x6b, 0x27, 0x5e, 0x3b, 0x2b, 0x42,
+	0x95, 0x9b, 0x7f, 0x7c, 0xdf, 0x9b, 0xcf, 0xbc, 0x5f, 0x1e, 0xc3, 0xb2, 0xd8, 0x11, 0xfa, 0xbe,
+	0xd3, 0x17, 0xe1, 0x8e, 0x8c, 0x9c, 0xbd, 0xcd, 0x8e, 0x8c, 0xc4, 0xa6, 0xf3, 0x60, 0x57, 0x86,
+	0xfb, 0x76, 0x10, 0xaa, 0x48, 0xf1, 0x39, 0x54, 0xd8, 0x46, 0x61, 0x93, 0xc2, 0x9a, 0xeb, 0xaa,
+	0xae, 0x42, 0x81, 0x13, 0x5f, 0x19, 0xad, 0xb5, 0xd8, 0x55, 0xaa, 0xdb, 0x93, 0x8e, 0x08, 0x7c,
+	0x47, 0x0c, 0x06, 0x2a, 0x12, 0x91, 0xaf, 0x06, 0x9a, 0xde, 0xbe, 0xb5, 0xad, 0x74, 0x5f, 0x69,
+	0xa7, 0x23, 0xb4, 0x34, 0x4b, 0xa4, 0x0b, 0x06, 0xa2, 0xeb, 0x0f, 0x50, 0x4c, 0xda, 0x62, 0x2e,
+	0x15, 0x7a, 0x32, 0x24, 0x45, 0xbd, 0x50, 0xd1, 0xf1, 0xbd, 0x52, 0x0f, 0x3d, 0x29, 0xb4, 0x24,
+	0xc5, 0x4a, 0xa1, 0x22, 0x10, 0xa1, 0xe8, 0xeb, 0xbc, 0x13, 0xa9, 0xb7, 0x43, 0xf5, 0x45, 0x2a,
+	0x89, 0xf6, 0x03, 0x49, 0x8a, 0xc6, 0xaf, 0x0c, 0xf8, 0xdd, 0x78, 0x2f, 0x9f, 0xc6, 0x6c, 0xba,
+	0x2d, 0x1f, 0xec, 0x4a, 0x1d, 0x71, 0x17, 0x5e, 0xba, 0xe7, 0xf7, 0x22, 0x19, 0xea, 0x79, 0xb6,
+	0xcc, 0x9a, 0xe7, 0x5b, 0x0d, 0xbb, 0x28, 0x8e, 0x36, 0x5a, 0xdd, 0x34, 0x4a, 0x77, 0xea, 0xe9,
+	0xf3, 0xa5, 0x89, 0x76, 0x62, 0xc8, 0x6f, 0x02, 0x0c, 0xe3, 0x32, 0x5f, 0x43, 0x37, 0xab, 0xb6,
+	0x09, 0xa2, 0x1d, 0x07, 0xd1, 0x36, 0x79, 0x4a, 0x7c, 0x6d, 0x89, 0xae, 0xa4, 0xf5, 0xdb, 0x19,
+	0xcb, 0xc6, 0x63, 0x06, 0x97, 0x73, 0x88, 0x3a, 0x50, 0x03, 0x2d, 0xf9, 0x07, 0x30, 0x83, 0x01,
+	0x8d, 0x11, 0x27, 0x9b, 0xe7, 0x5b, 0x0b, 0x25, 0x88, 0xee, 0x6c, 0xcc, 0xf6, 0xdb, 0x5f, 0x4b,
+	0x33, 0xe4, 0x84, 0x4c, 0xf9, 0x47, 0x05, 0x90, 0x6b, 0x95, 0x90, 0x86, 0x20, 0x47, 0xf9, 0x09,
+	0x5c, 0x1a, 0x42, 0x26, 0x61, 0x7c, 0x17, 0x6a, 0xbe, 0x47, 0x11, 0xbc, 0x52, 0x82, 0x77, 0xeb,
+	0x86, 0x0b, 0x31, 0xe0, 0xc1, 0xf3, 0xa5, 0xda, 0xad, 0x1b, 0xed, 0x9a, 0xef, 0x35, 0xee, 0x64,
+	0xf3, 0x92, 0xee, 0xf9, 0x1a, 0x4c, 0x23, 0x38, 0xf9, 0x2c, 0xdd, 0xb2, 0x49, 0x87, 0xd1, 0x37,
+	0x7e, 0x61, 0x70, 0x11, 0xfd, 0xb9, 0xbe, 0x97, 0x66, 0xf9, 0xfa, 0xd1, 0x2c, 0x2f, 0x17, 0xfb,
+	0x73, 0x7d, 0xef, 0x7f, 0xce, 0xf1, 0x23, 0x06, 0x97, 0x32, 0x78, 0xb4, 0xdb, 0xf7, 0x61, 0xaa,
+	0xe3, 0x7b, 0x49, 0x7e, 0x5f, 0x1b, 0x09, 0xe7, 0x5e, 0xa0, 0xec, 0x4e, 0xa1, 0x39, 0x1a, 0x9d,
+	0x5e, 0x66, 0x6f, 0xc3, 0xab, 0x09, 0x5a, 0x12, 0xb8, 0x6b, 0x99, 0xbc, 0x2e, 0x8c, 0xc4, 0x2a,
+	0xc8, 0xea, 0x87, 0xc3, 0x2c, 0xa4, 0xbb, 0xdc, 0x84, 0xc9, 0x4e, 0xea, 0xad, 0x64, 0x93, 0x26,
+	0xf4, 0xb1, 0x76, 0xd8, 0xb5, 0x1f, 0x4b, 0xa1, 0xe5, 0xd8, 0x5d, 0x8b, 0x56, 0x2f, 0xaa, 0x6b,
+	0x13, 0xc4, 0x61, 0xd7, 0xe2, 0x10, 0xab, 0xe8, 0x5a, 0xb4, 0x1a, 0x76, 0x2d, 0x39, 0x21, 0xd3,
+	0xd3, 0xef, 0x5a, 0xf4, 0x3f, 0x46, 0xd7, 0xa2, 0xbe, 0x20, 0xbf, 0x8f, 0x72, 0x89, 0xc9, 0xb6,
+	0x2d, 0x92, 0x97, 0x97, 0x8c, 0xd9, 0x33, 0xb5, 0x2d, 0xea, 0xf9, 0x6d, 0x98, 0x35, 0xc3, 0xfb,
+	0xf3, 0x40, 0xec, 0xf7, 0xe5, 0x20, 0x9a, 0xaf, 0xe5, 0xb0, 0xcc, 0xcb, 0xcc, 0x3e, 0x51, 0x44,
+	0x3e, 0x5e, 0x31, 0x6f, 0xe9, 0x61, 0xe3, 0x27, 0x06, 0x56, 0xda, 0x63, 0xee, 0xfe, 0x56, 0xa8,
+	0xf6, 0xfc, 0xcc, 0xac, 0xb2, 0xe0, 0xe5, 0x80, 0x1e, 0x21, 0xe6, 0xb9, 0x76, 0x7a, 0xcf, 0xe7,
+	0x60, 0x5a, 0x47, 0x22, 0x92, 0xb8, 0xfa, 0xb9, 0xb6, 0xb9, 0x39, 0x52, 0x2a, 0x93, 0xff, 0xa5,
+	0x54, 0x16, 0x0a, 0xc1, 0xce, 0xd4, 0x18, 0xf8, 0x99, 0xc1, 0x62, 0xa6, 0xa0, 0xcf, 0x52, 0x00,
+	0x9f, 0x30, 0xb8, 0x32, 0x02, 0xed, 0x4c, 0x76, 0xdd, 0x1c, 0x35, 0xc9, 0x16, 0x9e, 0x55, 0x68,
+	0x47, 0x8d, 0xbb, 0x70, 0x39, 0xf7, 0x94, 0xd0, 0xdf, 0x83, 0x19, 0x73, 0xa6, 0xa1, 0xe6, 0x59,
+	0x2c, 0x46, 0x37, 0x56, 0x54, 0xf9, 0x64, 0xd1, 0x7a, 0x0c, 0x30, 0x8d, 0x3e, 0xf9, 0x77, 0x0c,
+	0xe8, 0xd3, 0xcf, 0x9b, 0xc5, 0x0e, 0x8e, 0x9f, 0x82, 0xac, 0xf5, 0x13, 0x28, 0x0d, 0x65, 0x63,
+	0xfd, 0x9b, 0x3f, 0xfe, 0xf9, 0xb1, 0xf6, 0x3a, 0x5f, 0x71, 0x46, 0x9f, 0xfc, 0xb4, 0xd3, 0xf3,
+	0x75, 0xc4, 0xbf, 0x65, 0x30, 0x8d, 0xd6, 0x7c, 0xad, 0xca, 0x7f, 0x02, 0xd2, 0xac, 0x16, 0x8e,
+	0xc5, 0xe1, 0x0f, 0xee, 0x29, 0xfe, 0x35, 0x03, 0x6c, 0x14, 0xbe, 0x5a, 0xe2, 0x3d, 0x73, 0x5c,
+	0xb0, 0xd6, 0x2a, 0x75, 0x04, 0xb1, 0x86, 0x10, 0x2b, 0x7c, 0xc9, 0x19, 0x75, 0xc8, 0xa5, 0x50,
+	0x3c, 0x84, 0x49, 0xd7, 0xf7, 0xf8, 0x9b, 0xe5, 0x8e, 0x93, 0xf5, 0x57, 0xab, 0x64, 0x63, 0x2c,
+	0x8f, 0x11, 0x88, 0x8b, 0xc2, 0xd4, 0x78, 0x69, 0x51, 0xe4, 0x3e, 0xb2, 0xd6, 0xfa, 0x09, 0x94,
+	0x27, 0x4b, 0x86, 0x69, 0xab, 0x61, 0x51, 0xa0, 0x75, 0x69, 0x51, 0x64, 0x3f, 0x53, 0x56, 0xb3,
+	0x5a, 0x38, 0x16, 0x07, 0x86, 0xe4, 0x09, 0x83, 0xd9, 0xfc, 0x18, 0xe6, 0x1b, 0x15, 0x69, 0x3f,
+	0x36, 0x09, 0xad, 0xcd, 0x31, 0x2c, 0x08, 0xf1, 0x6d, 0x44, 0xdc, 0xe0, 0x76, 0x49, 0xce, 0x92,
+	0x69, 0xea, 0x7c, 0x95, 0x5c, 0x3d, 0xe4, 0xbf, 0x33, 0xb8, 0x78, 0x74, 0xea, 0xf1, 0x56, 0x65,
+	0x8a, 0x8e, 0x33, 0x5f, 0x1d, 0xcb, 0x86, 0xa8, 0xdf, 0x41, 0xea, 0x16, 0xdf, 0x28, 0x0d, 0x6c,
+	0x11, 0xf7, 0xf7, 0x0c, 0x2e, 0xdc, 0x41, 0xb5, 0x19, 0x5c, 0xa5, 0x05, 0x98, 0x9b, 0x93, 0xd6,
+	0xfa, 0x09, 0x94, 0xc4, 0xf7, 0x06, 0xf2, 0xd5, 0xf9, 0xa2, 0x53, 0xf2, 0xaf, 0xe8, 0x5e, 0x7f,
+	0x7a, 0x50, 0x67, 0xcf, 0x0e, 0xea, 0xec, 0xef, 0x83, 0x3a, 0xfb, 0xe1, 0xb0, 0x3e, 0xf1, 0xec,
+	0xb0, 0x3e, 0xf1, 0xe7, 0x61, 0x7d, 0xe2, 0xb3, 0xd5, 0xae, 0x1f, 0xdd, 0xdf, 0xed, 0xd8, 0xdb,
+	0xaa, 0xef, 0xa8, 0xbd, 0x70, 0xbb, 0xb7, 0x43, 0x8e, 0xbe, 0x4c, 0x5c, 0xe1, 0xbf, 0x64, 0x67,
+	0x06, 0x7f, 0x26, 0xaf, 0xfe, 0x3b, 0x00, 0x0c, 0x87, 0xf2, 0x69, 0x8f, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -708,6 +1048,12 @@ type QueryClient interface {
 	Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error)
 	// Lease queries lease details
 	Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error)
+	// BidsByProvider queries bids placed by a provider
+	BidsByProvider(ctx context.Context, in *QueryBidsByProviderRequest, opts ...grpc.CallOption) (*QueryBidsByProviderResponse, error)
+	// LeasesByProvider queries leases won by a provider
+	LeasesByProvider(ctx context.Context, in *QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*QueryLeasesByProviderResponse, error)
+	// MarketParams queries the market module parameters
+	MarketParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -772,6 +1118,33 @@ func (c *queryClient) Lease(ctx context.Context, in *QueryLeaseRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) BidsByProvider(ctx context.Context, in *QueryBidsByProviderRequest, opts ...grpc.CallOption) (*QueryBidsByProviderResponse, error) {
+	out := new(QueryBidsByProviderResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta1.Query/BidsByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LeasesByProvider(ctx context.Context, in *QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*QueryLeasesByProviderResponse, error) {
+	out := new(QueryLeasesByProviderResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta1.Query/LeasesByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta1.Query/MarketParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Orders queries orders with filters
@@ -786,6 +1159,12 @@ type QueryServer interface {
 	Leases(context.Context, *QueryLeasesRequest) (*QueryLeasesResponse, error)
 	// Lease queries lease details
 	Lease(context.Context, *QueryLeaseRequest) (*QueryLeaseResponse, error)
+	// BidsByProvider queries bids placed by a provider
+	BidsByProvider(context.Context, *QueryBidsByProviderRequest) (*QueryBidsByProviderResponse, error)
+	// LeasesByProvider queries leases won by a provider
+	LeasesByProvider(context.Context, *QueryLeasesByProviderRequest) (*QueryLeasesByProviderResponse, error)
+	// MarketParams queries the market module parameters
+	MarketParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -810,6 +1189,15 @@ func (*UnimplementedQueryServer) Leases(ctx context.Context, req *QueryLeasesReq
 func (*UnimplementedQueryServer) Lease(ctx context.Context, req *QueryLeaseRequest) (*QueryLeaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lease not implemented")
 }
+func (*UnimplementedQueryServer) BidsByProvider(ctx context.Context, req *QueryBidsByProviderRequest) (*QueryBidsByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidsByProvider not implemented")
+}
+func (*UnimplementedQueryServer) LeasesByProvider(ctx context.Context, req *QueryLeasesByProviderRequest) (*QueryLeasesByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeasesByProvider not implemented")
+}
+func (*UnimplementedQueryServer) MarketParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -923,6 +1311,60 @@ func _Query_Lease_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BidsByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidsByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidsByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta1.Query/BidsByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidsByProvider(ctx, req.(*QueryBidsByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LeasesByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeasesByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LeasesByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta1.Query/LeasesByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeasesByProvider(ctx, req.(*QueryLeasesByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta1.Query/MarketParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "akash.market.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -951,6 +1393,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Lease",
 			Handler:    _Query_Lease_Handler,
 		},
+		{
+			MethodName: "BidsByProvider",
+			Handler:    _Query_BidsByProvider_Handler,
+		},
+		{
+			MethodName: "LeasesByProvider",
+			Handler:    _Query_LeasesByProvider_Handler,
+		},
+		{
+			MethodName: "MarketParams",
+			Handler:    _Query_MarketParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "akash/market/v1beta1/query.proto",
@@ -1423,6 +1877,16 @@ func (m *QueryLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.EscrowPayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1436,6 +1900,258 @@ func (m *QueryLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBidsByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidsByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidsByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidsByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidsByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidsByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1608,20 +2324,661 @@ func (m *QueryLeaseResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Lease.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.Lease.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EscrowPayment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBidsByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidsByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1644,15 +3001,15 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1679,7 +3036,8 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1713,7 +3071,7 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1743,7 +3101,7 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1766,15 +3124,15 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1801,8 +3159,179 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1836,7 +3365,7 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1866,7 +3395,7 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1876,28 +3405,62 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1924,7 +3487,10 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1952,7 +3518,7 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1975,15 +3541,15 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2010,7 +3576,7 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2038,7 +3604,7 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2061,15 +3627,15 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2096,13 +3662,13 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowPayment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2129,10 +3695,7 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EscrowPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2160,7 +3723,7 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsByProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2183,17 +3746,17 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsByProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2203,27 +3766,57 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bids = append(m.Bids, Bid{})
-			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2253,7 +3846,7 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2283,7 +3876,7 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsByProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,15 +3899,15 @@ func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsByProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2341,66 +3934,14 @@ func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2427,7 +3968,10 @@ func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2455,7 +3999,7 @@ func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeasesByProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2478,17 +4022,17 @@ func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeasesByProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeasesByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2498,26 +4042,57 @@ func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2577,7 +4152,7 @@ func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2600,10 +4175,10 @@ func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeasesByProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeasesByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2700,7 +4275,7 @@ func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2723,45 +4298,12 @@ func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeaseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2786,7 +4328,7 @@ func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2809,15 +4351,15 @@ func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2844,7 +4386,7 @@ func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
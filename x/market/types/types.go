@@ -6,7 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/types"
-	"github.com/ovrclk/akash/validation"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -71,14 +71,19 @@ func (o Order) MatchAttributes(attrs []types.Attribute) bool {
 	return o.Spec.MatchAttributes(attrs)
 }
 
+// MatchSignedAttributes method checks that the order requirements have been signed by the
+// auditors the order requires signatures from
+func (o Order) MatchSignedAttributes(signed []atypes.Provider) bool {
+	return o.Spec.MatchSignedAttributes(signed)
+}
+
 // ValidateCanMatch method validates whether to match order for provided height
 func (o Order) ValidateCanMatch(height int64) error {
+	// the spec was validated against the deployment params when the deployment was
+	// created; params changed by governance since then must not invalidate open orders
 	if err := o.validateMatchableState(); err != nil {
 		return err
 	}
-	if err := validation.ValidateDeploymentGroup(o.Spec); err != nil {
-		return err
-	}
 
 	if height < o.StartAt {
 		return errors.Wrap(ErrOrderTooEarly, fmt.Sprintf("(%v > %v)", o.StartAt, height))
@@ -90,6 +95,18 @@ func (o Order) ValidateCanMatch(height int64) error {
 	return nil
 }
 
+// ValidateCanLease method validates whether the order owner can create a lease
+// for the order at the provided height
+func (o Order) ValidateCanLease(height int64) error {
+	if err := o.validateMatchableState(); err != nil {
+		return err
+	}
+	if height >= o.CloseAt {
+		return ErrOrderDurationExceeded
+	}
+	return nil
+}
+
 func (o Order) validateMatchableState() error {
 	switch o.State {
 	case OrderOpen:
@@ -105,6 +105,27 @@ func (s *IntegrationTestSuite) TestProvider() {
 	err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), &providerV2)
 	s.Require().NoError(err)
 	s.Require().NotEqual(provider.HostURI, providerV2.HostURI)
+
+	// test deleting provider
+	_, err = cli.TxDeleteProviderExec(
+		val.ClientCtx,
+		val.Address,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	resp, err = cli.QueryProvidersExec(val.ClientCtx.WithOutputFormat("json"))
+	s.Require().NoError(err)
+
+	out = &types.QueryProvidersResponse{}
+	err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), out)
+	s.Require().NoError(err)
+	s.Require().Len(out.Providers, 0, "Provider Deletion Failed")
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
@@ -35,6 +35,17 @@ func TxUpdateProviderExec(clientCtx client.Context, from fmt.Stringer, filepath
 	return clitestutil.ExecTestCLICmd(clientCtx, cmdUpdate(key), args)
 }
 
+// TxDeleteProviderExec is used for testing delete provider tx
+func TxDeleteProviderExec(clientCtx client.Context, from fmt.Stringer, extraArgs ...string) (sdktest.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--from=%s", from.String()),
+	}
+
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmdDelete(key), args)
+}
+
 // QueryProvidersExec is used for testing providers query
 func QueryProvidersExec(clientCtx client.Context, args ...string) (sdktest.BufferWriter, error) {
 	return clitestutil.ExecTestCLICmd(clientCtx, cmdGetProviders(), args)
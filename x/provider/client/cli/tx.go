@@ -24,6 +24,7 @@ func GetTxCmd(key string) *cobra.Command {
 	cmd.AddCommand(
 		cmdCreate(key),
 		cmdUpdate(key),
+		cmdDelete(key),
 	)
 	return cmd
 }
@@ -101,3 +102,30 @@ func cmdUpdate(key string) *cobra.Command {
 
 	return cmd
 }
+
+func cmdDelete(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: fmt.Sprintf("Delete %s, closing all of its leases and bids", key),
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteProvider(clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
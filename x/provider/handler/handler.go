@@ -10,8 +10,8 @@ import (
 )
 
 // NewHandler returns a handler for "provider" type messages.
-func NewHandler(keeper keeper.Keeper, mkeeper mkeeper.Keeper) sdk.Handler {
-	ms := NewMsgServerImpl(keeper, mkeeper)
+func NewHandler(keeper keeper.Keeper, mkeeper mkeeper.Keeper, dkeeper DeploymentKeeper, ekeeper EscrowKeeper) sdk.Handler {
+	ms := NewMsgServerImpl(keeper, mkeeper, dkeeper, ekeeper)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
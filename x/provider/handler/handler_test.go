@@ -1,18 +1,29 @@
 package handler_test
 
 import (
+	"math"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdktestdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ovrclk/akash/testutil"
+	akashtypes "github.com/ovrclk/akash/types"
+	dkeeper "github.com/ovrclk/akash/x/deployment/keeper"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	ekeeper "github.com/ovrclk/akash/x/escrow/keeper"
+	emocks "github.com/ovrclk/akash/x/escrow/keeper/mocks"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
 	mkeeper "github.com/ovrclk/akash/x/market/keeper"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	"github.com/ovrclk/akash/x/provider/handler"
@@ -27,6 +38,8 @@ type testSuite struct {
 	ctx     sdk.Context
 	keeper  keeper.Keeper
 	mkeeper mkeeper.Keeper
+	dkeeper dkeeper.Keeper
+	ekeeper ekeeper.Keeper
 	handler sdk.Handler
 }
 
@@ -37,21 +50,44 @@ func setupTestSuite(t *testing.T) *testSuite {
 
 	pKey := sdk.NewTransientStoreKey(types.StoreKey)
 	mKey := sdk.NewTransientStoreKey(mtypes.StoreKey)
+	dKey := sdk.NewTransientStoreKey(dtypes.StoreKey)
+	eKey := sdk.NewTransientStoreKey(etypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(eKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
 
 	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
 
+	paramsKeeper := paramskeeper.NewKeeper(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey)
+
 	suite.keeper = keeper.NewKeeper(types.ModuleCdc, pKey)
-	suite.mkeeper = mkeeper.NewKeeper(types.ModuleCdc, mKey)
+	suite.mkeeper = mkeeper.NewKeeper(types.ModuleCdc, mKey, paramsKeeper.Subspace(mtypes.ModuleName))
+	suite.mkeeper.SetParams(suite.ctx, mtypes.DefaultParams())
+	suite.dkeeper = dkeeper.NewKeeper(types.ModuleCdc, dKey, paramsKeeper.Subspace(dtypes.ModuleName))
+	suite.dkeeper.SetParams(suite.ctx, dtypes.DefaultParams())
+
+	bkeeper := &emocks.BankKeeper{}
+	bkeeper.
+		On("SendCoinsFromAccountToModule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	bkeeper.
+		On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.ekeeper = ekeeper.NewKeeper(types.ModuleCdc, eKey, bkeeper)
 
-	suite.handler = handler.NewHandler(suite.keeper, suite.mkeeper)
+	suite.handler = handler.NewHandler(suite.keeper, suite.mkeeper, suite.dkeeper, suite.ekeeper)
 
 	return suite
 }
@@ -108,6 +144,22 @@ func TestProviderCreateWithDuplicated(t *testing.T) {
 	require.EqualError(t, err, types.ErrDuplicateAttributes.Error())
 }
 
+func TestProviderCreateWithOperator(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	msg := &types.MsgCreateProvider{
+		Owner:   testutil.AccAddress(t).String(),
+		HostURI: testutil.Hostname(t),
+		Attributes: []akashtypes.Attribute{
+			{Key: "region", Value: "us-west", Operator: akashtypes.AttributeOperatorNotEqual},
+		},
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrAttributes))
+}
+
 func TestProviderUpdateWithDuplicated(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -237,6 +289,58 @@ func TestProviderUpdateAttributes(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestProviderUpdateAttributesWithOperators(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+
+	createMsg := &types.MsgCreateProvider{
+		Owner:   addr.String(),
+		HostURI: testutil.Hostname(t),
+		Attributes: []akashtypes.Attribute{
+			akashtypes.NewStringAttribute("region", "us-west"),
+		},
+	}
+
+	err := suite.keeper.Create(suite.ctx, types.Provider(*createMsg))
+	require.NoError(t, err)
+
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 0)
+
+	group.GroupSpec.Resources = testutil.Resources(t)
+	group.GroupSpec.Requirements = []akashtypes.Attribute{
+		{Key: "region", Value: "us-west,us-east", Operator: akashtypes.AttributeOperatorIn},
+	}
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), addr, testutil.Coin(t))
+	require.NoError(t, err)
+
+	suite.mkeeper.CreateLease(suite.ctx, bid)
+
+	updateMsg := &types.MsgUpdateProvider{
+		Owner:   addr.String(),
+		HostURI: createMsg.HostURI,
+		Attributes: []akashtypes.Attribute{
+			akashtypes.NewStringAttribute("region", "us-east"),
+		},
+	}
+
+	res, err := suite.handler(suite.ctx, updateMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updateMsg.Attributes = []akashtypes.Attribute{
+		akashtypes.NewStringAttribute("region", "eu-west"),
+	}
+
+	res, err = suite.handler(suite.ctx, updateMsg)
+	require.True(t, errors.Is(err, types.ErrIncompatibleAttributes))
+	require.Nil(t, res)
+}
+
 func TestProviderDeleteExisting(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -255,13 +359,182 @@ func TestProviderDeleteExisting(t *testing.T) {
 	require.NoError(t, err)
 
 	res, err := suite.handler(suite.ctx, deleteMsg)
-	require.Nil(t, res)
-	require.EqualError(t, err, "NOTIMPLEMENTED: "+handler.ErrInternal.Error())
-	require.True(t, errors.Is(err, handler.ErrInternal))
+	require.NoError(t, err)
+	require.NotNil(t, res)
 
 	t.Run("ensure event created", func(t *testing.T) {
-		// TODO: this should emit a ProviderDelete
+		iev := testutil.ParseProviderEvent(t, res.Events[len(res.Events)-1:])
+		require.IsType(t, types.EventProviderDeleted{}, iev)
+
+		dev := iev.(types.EventProviderDeleted)
+
+		require.Equal(t, deleteMsg.Owner, dev.Owner.String())
+	})
+
+	_, found := suite.keeper.Get(suite.ctx, addr)
+	require.False(t, found)
+}
+
+func TestProviderDeleteClosesLeasesAndBids(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:   addr.String(),
+		HostURI: testutil.Hostname(t),
 	})
+	require.NoError(t, err)
+
+	did := testutil.DeploymentID(t)
+	leasedGroup := testutil.DeploymentGroup(t, did, 1)
+	biddedGroup := testutil.DeploymentGroup(t, did, 2)
+
+	err = suite.dkeeper.Create(suite.ctx, dtypes.Deployment{
+		DeploymentID: did,
+		State:        dtypes.DeploymentActive,
+	}, []dtypes.Group{leasedGroup, biddedGroup})
+	require.NoError(t, err)
+
+	// leased group
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, leasedGroup.ID(), leasedGroup.GroupSpec)
+	require.NoError(t, err)
+
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), addr, testutil.AkashCoin(t, 10))
+	require.NoError(t, err)
+
+	suite.mkeeper.CreateLease(suite.ctx, bid)
+	suite.mkeeper.OnBidMatched(suite.ctx, bid)
+	suite.mkeeper.OnOrderMatched(suite.ctx, order)
+	suite.dkeeper.OnLeaseCreated(suite.ctx, leasedGroup.ID())
+
+	lid := mtypes.MakeLeaseID(bid.ID())
+	owner, err := sdk.AccAddressFromBech32(did.Owner)
+	require.NoError(t, err)
+
+	aid := mtypes.EscrowAccountForLease(lid)
+	err = suite.ekeeper.AccountCreate(suite.ctx, aid, owner, testutil.AkashCoin(t, math.MaxInt32))
+	require.NoError(t, err)
+	err = suite.ekeeper.PaymentCreate(suite.ctx, aid, mtypes.EscrowPaymentForLease(lid), addr, bid.Price)
+	require.NoError(t, err)
+
+	// group with an open bid only
+	openOrder, err := suite.mkeeper.CreateOrder(suite.ctx, biddedGroup.ID(), biddedGroup.GroupSpec)
+	require.NoError(t, err)
+
+	openBid, err := suite.mkeeper.CreateBid(suite.ctx, openOrder.ID(), addr, testutil.AkashCoin(t, 10))
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.ctx, &types.MsgDeleteProvider{Owner: addr.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	lease, found := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, found)
+	require.Equal(t, mtypes.LeaseClosed, lease.State)
+
+	bid, found = suite.mkeeper.GetBid(suite.ctx, bid.ID())
+	require.True(t, found)
+	require.Equal(t, mtypes.BidClosed, bid.State)
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, order.ID())
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderClosed, order.State)
+
+	group, found := suite.dkeeper.GetGroup(suite.ctx, leasedGroup.ID())
+	require.True(t, found)
+	require.Equal(t, dtypes.GroupOpen, group.State)
+
+	payment, err := suite.ekeeper.GetPayment(suite.ctx, aid, mtypes.EscrowPaymentForLease(lid))
+	require.NoError(t, err)
+	require.Equal(t, etypes.PaymentClosed, payment.State)
+
+	openBid, found = suite.mkeeper.GetBid(suite.ctx, openBid.ID())
+	require.True(t, found)
+	require.Equal(t, mtypes.BidClosed, openBid.State)
+
+	openOrder, found = suite.mkeeper.GetOrder(suite.ctx, openOrder.ID())
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderOpen, openOrder.State)
+
+	_, found = suite.keeper.Get(suite.ctx, addr)
+	require.False(t, found)
+}
+
+func TestProviderDeleteClosesInsufficientFundsLeases(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:   addr.String(),
+		HostURI: testutil.Hostname(t),
+	})
+	require.NoError(t, err)
+
+	did := testutil.DeploymentID(t)
+	group := testutil.DeploymentGroup(t, did, 1)
+
+	err = suite.dkeeper.Create(suite.ctx, dtypes.Deployment{
+		DeploymentID: did,
+		State:        dtypes.DeploymentActive,
+	}, []dtypes.Group{group})
+	require.NoError(t, err)
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), addr, testutil.AkashCoin(t, 10))
+	require.NoError(t, err)
+
+	suite.mkeeper.CreateLease(suite.ctx, bid)
+	suite.mkeeper.OnBidMatched(suite.ctx, bid)
+	suite.mkeeper.OnOrderMatched(suite.ctx, order)
+	suite.dkeeper.OnLeaseCreated(suite.ctx, group.ID())
+
+	lid := mtypes.MakeLeaseID(bid.ID())
+	owner, err := sdk.AccAddressFromBech32(did.Owner)
+	require.NoError(t, err)
+
+	aid := mtypes.EscrowAccountForLease(lid)
+	pid := mtypes.EscrowPaymentForLease(lid)
+	err = suite.ekeeper.AccountCreate(suite.ctx, aid, owner, testutil.AkashCoin(t, 15))
+	require.NoError(t, err)
+	err = suite.ekeeper.PaymentCreate(suite.ctx, aid, pid, addr, bid.Price)
+	require.NoError(t, err)
+
+	// the account runs out of funds
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 2)
+	overdrawn, err := suite.ekeeper.AccountSettle(suite.ctx, aid)
+	require.NoError(t, err)
+	require.True(t, overdrawn)
+
+	lease, found := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, found)
+	suite.mkeeper.OnOrderClosed(suite.ctx, order)
+	suite.mkeeper.OnBidClosed(suite.ctx, bid)
+	suite.mkeeper.OnInsufficientFunds(suite.ctx, lease)
+	suite.dkeeper.OnLeaseInsufficientFunds(suite.ctx, group.ID())
+
+	res, err := suite.handler(suite.ctx, &types.MsgDeleteProvider{Owner: addr.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	lease, found = suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, found)
+	require.Equal(t, mtypes.LeaseClosed, lease.State)
+
+	// the group is not re-ordered against its overdrawn account
+	result, found := suite.dkeeper.GetGroup(suite.ctx, group.ID())
+	require.True(t, found)
+	require.Equal(t, dtypes.GroupInsufficientFunds, result.State)
+
+	payment, err := suite.ekeeper.GetPayment(suite.ctx, aid, pid)
+	require.NoError(t, err)
+	require.Equal(t, etypes.PaymentClosed, payment.State)
+
+	_, found = suite.keeper.Get(suite.ctx, addr)
+	require.False(t, found)
 }
 
 func TestProviderDeleteNonExisting(t *testing.T) {
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	etypes "github.com/ovrclk/akash/x/escrow/types"
+)
+
+// DeploymentKeeper Interface includes deployment methods
+type DeploymentKeeper interface {
+	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID)
+}
+
+// EscrowKeeper Interface includes escrow methods
+type EscrowKeeper interface {
+	PaymentClose(ctx sdk.Context, id etypes.AccountID, pid string) error
+}
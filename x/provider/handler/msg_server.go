@@ -19,14 +19,16 @@ var (
 )
 
 type msgServer struct {
-	provider keeper.Keeper
-	market   mkeeper.Keeper
+	provider   keeper.Keeper
+	market     mkeeper.Keeper
+	deployment DeploymentKeeper
+	escrow     EscrowKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the market MsgServer interface
 // for the provided Keeper.
-func NewMsgServerImpl(k keeper.Keeper, mk mkeeper.Keeper) types.MsgServer {
-	return &msgServer{provider: k, market: mk}
+func NewMsgServerImpl(k keeper.Keeper, mk mkeeper.Keeper, dk DeploymentKeeper, ek EscrowKeeper) types.MsgServer {
+	return &msgServer{provider: k, market: mk, deployment: dk, escrow: ek}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -62,7 +64,7 @@ func (ms msgServer) UpdateProvider(goCtx context.Context, msg *types.MsgUpdatePr
 		return nil, err
 	}
 
-	prov, found := ms.provider.Get(ctx, owner)
+	_, found := ms.provider.Get(ctx, owner)
 	if !found {
 		return nil, errors.Wrapf(types.ErrProviderNotFound, "id: %s", msg.Owner)
 	}
@@ -71,10 +73,8 @@ func (ms msgServer) UpdateProvider(goCtx context.Context, msg *types.MsgUpdatePr
 		return nil, err
 	}
 
-	// all filtering code below is madness!. should make an index to not melt the cpu
-	// TODO: use WithActiveLeases, filter by lease.Provider
-	ms.market.WithLeases(ctx, func(lease mtypes.Lease) bool {
-		if prov.Owner == lease.ID().Provider && (lease.State == mtypes.LeaseActive) {
+	ms.market.WithLeasesForProvider(ctx, owner, func(lease mtypes.Lease) bool {
+		if lease.State == mtypes.LeaseActive {
 			var order mtypes.Order
 			order, found = ms.market.GetOrder(ctx, lease.ID().OrderID())
 			if !found {
@@ -115,6 +115,61 @@ func (ms msgServer) DeleteProvider(goCtx context.Context, msg *types.MsgDeletePr
 		return nil, types.ErrProviderNotFound
 	}
 
-	// TODO: cancel leases
-	return nil, sdkerrors.Wrapf(ErrInternal, "NOTIMPLEMENTED")
+	var (
+		leases []mtypes.Lease
+		bids   []mtypes.Bid
+	)
+
+	// collect first; the store must not be modified while iterating it
+	ms.market.WithLeasesForProvider(ctx, owner, func(lease mtypes.Lease) bool {
+		if lease.State == mtypes.LeaseActive || lease.State == mtypes.LeaseInsufficientFunds {
+			leases = append(leases, lease)
+		}
+		return false
+	})
+
+	ms.market.WithBidsForProvider(ctx, owner, func(bid mtypes.Bid) bool {
+		if bid.State == mtypes.BidOpen {
+			bids = append(bids, bid)
+		}
+		return false
+	})
+
+	for _, lease := range leases {
+		if err := ms.closeLease(ctx, lease); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, bid := range bids {
+		ms.market.OnBidClosed(ctx, bid)
+	}
+
+	ms.provider.Delete(ctx, owner)
+
+	return &types.MsgDeleteProviderResponse{}, nil
+}
+
+// closeLease closes the lease along with its bid, order and escrow payment, and
+// re-opens the lease's group for ordering.  The group of a lease with insufficient
+// funds is left as is, as its escrow account cannot pay for another lease.
+func (ms msgServer) closeLease(ctx sdk.Context, lease mtypes.Lease) error {
+	if bid, found := ms.market.GetBid(ctx, lease.ID().BidID()); found && bid.State == mtypes.BidMatched {
+		ms.market.OnBidClosed(ctx, bid)
+	}
+
+	if order, found := ms.market.GetOrder(ctx, lease.ID().OrderID()); found && order.State == mtypes.OrderMatched {
+		ms.market.OnOrderClosed(ctx, order)
+	}
+
+	if lease.State == mtypes.LeaseInsufficientFunds {
+		ms.market.OnInsufficientFundsLeaseClosed(ctx, lease)
+	} else {
+		ms.market.OnLeaseClosed(ctx, lease)
+		ms.deployment.OnLeaseClosed(ctx, lease.ID().GroupID())
+	}
+
+	return ms.escrow.PaymentClose(ctx,
+		mtypes.EscrowAccountForLease(lease.ID()),
+		mtypes.EscrowPaymentForLease(lease.ID()))
 }
@@ -102,5 +102,10 @@ func (k Keeper) Update(ctx sdk.Context, provider types.Provider) error {
 
 // Delete delete a provider
 func (k Keeper) Delete(ctx sdk.Context, id sdk.Address) {
-	panic("TODO")
+	store := ctx.KVStore(k.skey)
+	store.Delete(providerKey(id))
+
+	ctx.EventManager().EmitEvent(
+		types.NewEventProviderDeleted(sdk.AccAddress(id.Bytes())).ToSDKEvent(),
+	)
 }
@@ -64,13 +64,11 @@ func TestProviderDeleteExisting(t *testing.T) {
 	owner, err := sdk.AccAddressFromBech32(prov.Owner)
 	require.NoError(t, err)
 
-	require.Panics(t, func() {
-		keeper.Delete(ctx, owner)
-	})
+	keeper.Delete(ctx, owner)
 
 	foundProv, found := keeper.Get(ctx, owner)
-	require.True(t, found)
-	require.Equal(t, prov, foundProv)
+	require.False(t, found)
+	require.Equal(t, types.Provider{}, foundProv)
 }
 
 func TestProviderUpdateNonExisting(t *testing.T) {
@@ -107,15 +107,26 @@ type AppModule struct {
 	keeper  keeper.Keeper
 	bkeeper bankkeeper.Keeper
 	mkeeper mkeeper.Keeper
+	dkeeper handler.DeploymentKeeper
+	ekeeper handler.EscrowKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Marshaler, k keeper.Keeper, bkeeper bankkeeper.Keeper, mkeeper mkeeper.Keeper) AppModule {
+func NewAppModule(
+	cdc codec.Marshaler,
+	k keeper.Keeper,
+	bkeeper bankkeeper.Keeper,
+	mkeeper mkeeper.Keeper,
+	dkeeper handler.DeploymentKeeper,
+	ekeeper handler.EscrowKeeper,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
 		bkeeper:        bkeeper,
 		mkeeper:        mkeeper,
+		dkeeper:        dkeeper,
+		ekeeper:        ekeeper,
 	}
 }
 
@@ -129,7 +140,7 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the provider module.
 func (am AppModule) Route() sdk.Route {
-	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.mkeeper))
+	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.mkeeper, am.dkeeper, am.ekeeper))
 }
 
 // QuerierRoute returns the provider module's querier route name.
@@ -144,7 +155,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 
 // RegisterServices registers the module's servicess
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper, am.mkeeper))
+	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper, am.mkeeper, am.dkeeper, am.ekeeper))
 	querier := keeper.Querier{Keeper: am.keeper}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ovrclk/akash/types"
 )
@@ -15,6 +16,10 @@ func (attr Attributes) Validate() error {
 	store := make(map[string]bool)
 
 	for i := range attr {
+		if attr[i].Operator != types.AttributeOperatorEqual {
+			return sdkerrors.Wrapf(ErrAttributes, "%s: provider attributes cannot have operators", attr[i].Key)
+		}
+
 		if _, ok := store[attr[i].Key]; ok {
 			return ErrDuplicateAttributes
 		}